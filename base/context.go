@@ -19,6 +19,7 @@ package base
 
 import (
 	"crypto/tls"
+	"net"
 	"net/http"
 	"sync"
 
@@ -31,6 +32,7 @@ import (
 const (
 	defaultInsecure = false
 	defaultCertsDir = "certs"
+	defaultUser     = "root"
 	plainScheme     = "http"
 	sslScheme       = "https"
 )
@@ -47,11 +49,20 @@ type Context struct {
 	// Required unless Insecure is true.
 	Certs string
 
-	// clientTLSConfig is the loaded client tlsConfig. It is initialized lazily.
+	// User is the user whose client certificate, if found in Certs, is
+	// presented to servers.
+	User string
+
+	// clientTLSConfig is the permissive client tlsConfig used without a
+	// Certs directory. It is initialized lazily.
 	clientTLSConfig *tls.Config
-	// serverTLSConfig is the loaded server tlsConfig. It is initialized lazily.
-	serverTLSConfig *tls.Config
-	// Protects both clientTLSConfig and serverTLSConfig.
+	// clientCertManager loads and reloads the client tlsConfig from the
+	// Certs directory. It is initialized lazily.
+	clientCertManager *security.CertificateManager
+	// certManager loads and reloads the server tlsConfig. It is
+	// initialized lazily.
+	certManager *security.CertificateManager
+	// Protects clientTLSConfig, clientCertManager and certManager.
 	tlsConfigMu sync.Mutex
 
 	// httpClient is a lazily-initialized http client.
@@ -66,6 +77,7 @@ type Context struct {
 func (ctx *Context) InitDefaults() {
 	ctx.Insecure = defaultInsecure
 	ctx.Certs = defaultCertsDir
+	ctx.User = defaultUser
 }
 
 // RequestScheme returns "http" or "https" based on the value of Insecure.
@@ -79,7 +91,11 @@ func (ctx *Context) RequestScheme() string {
 // GetClientTLSConfig returns the context client TLS config, initializing it if needed.
 // If Insecure is true, return a nil config, otherwise load a config based
 // on the Certs directory. If Certs is empty, use a very permissive config.
-// TODO(marc): empty Certs dir should fail when client certificates are required.
+// Servers reject KV requests which don't present a client certificate, so an
+// empty Certs dir only suffices for non-KV endpoints. Once the server TLS config
+// has been loaded, the context is a node's and always presents the node
+// certificate, whichever client certificates Certs holds. The returned config is
+// replaced when certificates are reloaded via ReloadCertificates.
 func (ctx *Context) GetClientTLSConfig() (*tls.Config, error) {
 	// Early out.
 	if ctx.Insecure {
@@ -89,28 +105,43 @@ func (ctx *Context) GetClientTLSConfig() (*tls.Config, error) {
 	ctx.tlsConfigMu.Lock()
 	defer ctx.tlsConfigMu.Unlock()
 
-	if ctx.clientTLSConfig != nil {
+	if ctx.certManager != nil {
+		return ctx.certManager.ClientTLSConfig(), nil
+	}
+
+	if ctx.Certs == "" {
+		if ctx.clientTLSConfig == nil {
+			log.V(1).Infof("no certificates directory specified: using insecure TLS")
+			ctx.clientTLSConfig = security.LoadInsecureClientTLSConfig()
+		}
 		return ctx.clientTLSConfig, nil
 	}
 
-	if ctx.Certs != "" {
+	if ctx.clientCertManager == nil {
 		log.V(1).Infof("setting up TLS from certificates directory: %s", ctx.Certs)
-		cfg, err := security.LoadClientTLSConfigFromDir(ctx.Certs)
+		certManager, err := security.NewClientCertificateManager(ctx.Certs, ctx.User)
 		if err != nil {
 			return nil, util.Errorf("error setting up client TLS config: %s", err)
 		}
-		ctx.clientTLSConfig = cfg
-	} else {
-		log.V(1).Infof("no certificates directory specified: using insecure TLS")
-		ctx.clientTLSConfig = security.LoadInsecureClientTLSConfig()
+		ctx.clientCertManager = certManager
 	}
 
-	return ctx.clientTLSConfig, nil
+	return ctx.clientCertManager.ClientTLSConfig(), nil
+}
+
+// GetClientCertificateManager returns the certificate manager of the
+// context client TLS config, or nil if the client TLS config hasn't been
+// loaded from a Certs directory.
+func (ctx *Context) GetClientCertificateManager() *security.CertificateManager {
+	ctx.tlsConfigMu.Lock()
+	defer ctx.tlsConfigMu.Unlock()
+	return ctx.clientCertManager
 }
 
 // GetServerTLSConfig returns the context server TLS config, initializing it if needed.
 // If Insecure is true, return a nil config, otherwise load a config based
-// on the Certs directory. Fails if Insecure=false and Certs="".
+// on the Certs directory. Fails if Insecure=false and Certs="". The returned
+// config is replaced when certificates are reloaded via ReloadCertificates.
 func (ctx *Context) GetServerTLSConfig() (*tls.Config, error) {
 	// Early out.
 	if ctx.Insecure {
@@ -120,22 +151,46 @@ func (ctx *Context) GetServerTLSConfig() (*tls.Config, error) {
 	ctx.tlsConfigMu.Lock()
 	defer ctx.tlsConfigMu.Unlock()
 
-	if ctx.serverTLSConfig != nil {
-		return ctx.serverTLSConfig, nil
-	}
+	if ctx.certManager == nil {
+		if ctx.Certs == "" {
+			return nil, util.Errorf("-insecure=false, but -certs is empty. We need a certs directory")
+		}
 
-	if ctx.Certs == "" {
-		return nil, util.Errorf("-insecure=false, but -certs is empty. We need a certs directory")
+		log.V(1).Infof("setting up TLS from certificates directory: %s", ctx.Certs)
+		certManager, err := security.NewCertificateManager(ctx.Certs)
+		if err != nil {
+			return nil, util.Errorf("error setting up server TLS config: %s", err)
+		}
+		ctx.certManager = certManager
 	}
 
-	log.V(1).Infof("setting up TLS from certificates directory: %s", ctx.Certs)
-	cfg, err := security.LoadTLSConfigFromDir(ctx.Certs)
-	if err != nil {
-		return nil, util.Errorf("error setting up server TLS config: %s", err)
-	}
-	ctx.serverTLSConfig = cfg
+	return ctx.certManager.TLSConfig(), nil
+}
+
+// GetCertificateManager returns the certificate manager of the context
+// server TLS config, or nil if the server TLS config hasn't been
+// initialized or Insecure is true.
+func (ctx *Context) GetCertificateManager() *security.CertificateManager {
+	ctx.tlsConfigMu.Lock()
+	defer ctx.tlsConfigMu.Unlock()
+	return ctx.certManager
+}
 
-	return ctx.serverTLSConfig, nil
+// ReloadCertificates reloads the certificates of the server and client
+// TLS configs loaded so far from the Certs directory. On error, the
+// previously loaded certificates remain in use.
+func (ctx *Context) ReloadCertificates() error {
+	ctx.tlsConfigMu.Lock()
+	defer ctx.tlsConfigMu.Unlock()
+	for _, certManager := range []*security.CertificateManager{ctx.certManager, ctx.clientCertManager} {
+		if certManager == nil {
+			continue
+		}
+		if err := certManager.LoadCertificates(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // GetHTTPClient returns the context http client, initializing it
@@ -155,7 +210,14 @@ func (ctx *Context) GetHTTPClient() (*http.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	ctx.httpClient = &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	transport := &http.Transport{TLSClientConfig: tlsConfig}
+	if certManager := ctx.GetClientCertificateManager(); certManager != nil {
+		// Dial with the current config so reloaded certificates are used.
+		transport.DialTLS = func(network, addr string) (net.Conn, error) {
+			return tls.Dial(network, addr, certManager.ClientTLSConfig())
+		}
+	}
+	ctx.httpClient = &http.Client{Transport: transport}
 
 	return ctx.httpClient, nil
 }
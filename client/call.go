@@ -48,6 +48,37 @@ func (c *Call) Method() proto.Method {
 	return c.Args.Method()
 }
 
+// AsOf returns the call set to read at the specified historical
+// timestamp instead of the current time. Reads older than the GC TTL of
+// the zone being read fail, as versions they should see may have been
+// garbage collected. Within a transaction, reads are performed at the
+// transaction's timestamp instead. Only reads can be made as of a
+// timestamp.
+func (c Call) AsOf(timestamp proto.Timestamp) Call {
+	if !proto.IsReadOnly(c.Args) {
+		c.Err = util.Errorf("%s cannot be made as of a timestamp", c.Method())
+		return c
+	}
+	c.Args.Header().Timestamp = timestamp
+	return c
+}
+
+// ExpireAt returns the call set to write a value which expires at the
+// specified timestamp. Reads at or after the expiration don't see the
+// value, and it's garbage collected once the expiration is older than
+// the GC TTL of its zone. Only puts and conditional puts can expire.
+func (c Call) ExpireAt(expiration proto.Timestamp) Call {
+	switch args := c.Args.(type) {
+	case *proto.PutRequest:
+		args.Value.Expiration = &expiration
+	case *proto.ConditionalPutRequest:
+		args.Value.Expiration = &expiration
+	default:
+		c.Err = util.Errorf("%s cannot expire", c.Method())
+	}
+	return c
+}
+
 // Get returns a Call object initialized to get the value at key.
 func Get(key proto.Key) Call {
 	return Call{
@@ -154,6 +185,42 @@ func DeleteRange(startKey, endKey proto.Key) Call {
 	}
 }
 
+// Ingest returns a Call object initialized to add the contents of an
+// SSTable, built with an engine.SSTWriter, to the empty key range
+// (excluding the endpoint), which must lie within a single range.
+func Ingest(startKey, endKey proto.Key, data []byte) Call {
+	return Call{
+		Args: &proto.IngestRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:    startKey,
+				EndKey: endKey,
+			},
+			Data: data,
+		},
+		Reply: &proto.IngestResponse{},
+	}
+}
+
+// Export returns a Call object initialized to export the versions of
+// keys in the key range (excluding the endpoint), written after
+// startTime and up to and including endTime, as an SSTable. The key
+// range must lie within a single range. If allVersions is false,
+// only the most recent version of each key is exported.
+func Export(startKey, endKey proto.Key, startTime, endTime proto.Timestamp, allVersions bool) Call {
+	return Call{
+		Args: &proto.ExportRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       startKey,
+				EndKey:    endKey,
+				Timestamp: endTime,
+			},
+			StartTime:   startTime,
+			AllVersions: allVersions,
+		},
+		Reply: &proto.ExportResponse{},
+	}
+}
+
 // Scan returns a Call object initialized to scan from start to
 // end keys with max results.
 func Scan(key, endKey proto.Key, maxResults int64) Call {
@@ -0,0 +1,114 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// A ChangeFeed reads the events of a change feed streamed by a node.
+// The node must replicate the whole span of the feed on one of its
+// stores. Values are received in commit order for each key, and
+// checkpoints report the timestamps up to which all values committed
+// in the span have been received.
+type ChangeFeed struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+}
+
+// NewChangeFeed subscribes to the values committed to the keys in
+// [key, endKey) after startTime via the node at server.
+func NewChangeFeed(server string, ctx *base.Context, user string, key, endKey proto.Key,
+	startTime proto.Timestamp) (*ChangeFeed, error) {
+	client, err := ctx.GetHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	body, err := gogoproto.Marshal(&proto.ChangeFeedRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       key,
+			EndKey:    endKey,
+			User:      user,
+			Timestamp: startTime,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	url := ctx.RequestScheme() + "://" + server + KVFeedEndpoint
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, util.Errorf("unable to create request: %s", err)
+	}
+	req.Header.Add(util.ContentTypeHeader, util.ProtoContentType)
+	req.Header.Add("Accept", util.ProtoContentType)
+	// The stream can't be flushed through a compressing writer.
+	req.Header.Add("Accept-Encoding", "identity")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return nil, util.Errorf("%s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return &ChangeFeed{body: resp.Body, reader: bufio.NewReader(resp.Body)}, nil
+}
+
+// Next returns the next event of the feed, blocking until it arrives.
+// It returns io.EOF if the node ended the feed, or the feed's error if
+// it failed.
+func (f *ChangeFeed) Next() (*proto.ChangeFeedEvent, error) {
+	n, err := binary.ReadUvarint(f.reader)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(f.reader, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	e := &proto.ChangeFeedEvent{}
+	if err := gogoproto.Unmarshal(b, e); err != nil {
+		return nil, err
+	}
+	if err := e.GoError(); err != nil {
+		return nil, err
+	}
+	if e.Value == nil && e.Resolved == nil {
+		return nil, errors.New("change feed event holds neither a value nor a checkpoint")
+	}
+	return e, nil
+}
+
+// Close unsubscribes from the feed.
+func (f *ChangeFeed) Close() error {
+	return f.body.Close()
+}
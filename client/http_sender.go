@@ -38,6 +38,9 @@ const (
 	// KVDBEndpoint is the URL path prefix which accepts incoming
 	// HTTP requests for the KV API.
 	KVDBEndpoint = "/kv/db/"
+	// KVFeedEndpoint is the URL path which accepts change feed
+	// subscriptions.
+	KVFeedEndpoint = "/kv/feed"
 	// StatusTooManyRequests indicates client should retry due to
 	// server having too many requests.
 	StatusTooManyRequests = 429
@@ -26,7 +26,14 @@ import (
 
 // TransactionOptions are parameters for use with KV.RunTransaction.
 type TransactionOptions struct {
-	Name         string // Concise desc of txn for debugging
+	Name string // Concise desc of txn for debugging
+	// Isolation is the isolation level of the transaction. SERIALIZABLE
+	// transactions restart whenever a write is pushed to a later
+	// timestamp, for example by concurrent readers. SNAPSHOT
+	// transactions commit at the pushed timestamp instead, which avoids
+	// such restarts at the cost of allowing write skew; they suit
+	// read-heavy transactions whose writes don't depend on invariants
+	// spanning keys written by others.
 	Isolation    proto.IsolationType
 	UserPriority int32
 }
@@ -54,6 +54,9 @@ func NewRPCSender(server string, context *base.Context) (*RPCSender, error) {
 		return nil, err
 	}
 	ctx := rpc.NewContext(hlc.NewClock(hlc.UnixNano), tlsConfig, nil)
+	if certManager := context.GetClientCertificateManager(); certManager != nil {
+		ctx.TLSConfigFn = certManager.ClientTLSConfig
+	}
 	client := rpc.NewClient(addr, &HTTPRetryOptions, ctx)
 	return &RPCSender{client: client}, nil
 }
@@ -28,6 +28,9 @@ type txnSender struct {
 }
 
 func (ts *txnSender) Send(call Call) {
+	// Every call is assigned a new sequence number so that its writes
+	// can be told apart from earlier ones on rollback to a savepoint.
+	ts.txn.Sequence++
 	// Send call through wrapped sender.
 	call.Args.Header().Txn = &ts.txn
 	ts.wrapped.Send(call)
@@ -181,6 +184,43 @@ func (t *Txn) Flush() error {
 	return t.kv.Run(calls...)
 }
 
+// Savepoint marks a position in the transaction which a later call
+// to RollbackToSavepoint can return to.
+type Savepoint struct {
+	seq int32
+}
+
+// Savepoint flushes any prepared calls and returns a marker for the
+// current position in the transaction. Writes made after the
+// savepoint can be undone via RollbackToSavepoint without aborting
+// the transaction; this allows recovery from application errors
+// partway through a transaction. Savepoints may be nested.
+func (t *Txn) Savepoint() (Savepoint, error) {
+	if err := t.Flush(); err != nil {
+		return Savepoint{}, err
+	}
+	return Savepoint{seq: t.txn.Sequence}, nil
+}
+
+// RollbackToSavepoint undoes all writes made since the specified
+// savepoint was created, including any prepared calls which haven't
+// yet been flushed. The writes are ignored by subsequent reads within
+// the transaction and are discarded when the transaction commits.
+// Savepoints created after sp are invalidated.
+func (t *Txn) RollbackToSavepoint(sp Savepoint) error {
+	if sp.seq > t.txn.Sequence {
+		return util.Errorf("savepoint at sequence %d is ahead of transaction sequence %d", sp.seq, t.txn.Sequence)
+	}
+	t.prepared = nil
+	if sp.seq < t.txn.Sequence {
+		t.txn.IgnoredSeqs = append(t.txn.IgnoredSeqs, proto.SequenceRange{
+			Start: sp.seq + 1,
+			End:   t.txn.Sequence,
+		})
+	}
+	return nil
+}
+
 func (t *Txn) updateState(calls []Call) {
 	for _, c := range calls {
 		if b, ok := c.Args.(*proto.BatchRequest); ok {
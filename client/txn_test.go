@@ -18,6 +18,7 @@
 package client
 
 import (
+	"reflect"
 	"testing"
 
 	"code.google.com/p/go-uuid/uuid"
@@ -104,3 +105,54 @@ func TestTxnResetTxnOnAbort(t *testing.T) {
 		t.Errorf("expected txn to be cleared")
 	}
 }
+
+// TestTxnRollbackToSavepoint verifies that rolling back to a savepoint
+// marks the sequence numbers of intervening calls as ignored and that
+// the ignored ranges are sent with subsequent calls.
+func TestTxnRollbackToSavepoint(t *testing.T) {
+	var lastTxn proto.Transaction
+	kv := NewKV(nil, newTestSender(func(call Call) {
+		lastTxn = *call.Args.Header().Txn
+	}))
+	txn := newTxn(kv, nil)
+
+	if err := txn.Run(Call{Args: testPutReq, Reply: &proto.PutResponse{}}); err != nil {
+		t.Fatal(err)
+	}
+	sp, err := txn.Savepoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := txn.Run(Call{Args: testPutReq, Reply: &proto.PutResponse{}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A prepared call is discarded by the rollback.
+	txn.Prepare(Call{Args: testPutReq, Reply: &proto.PutResponse{}})
+	if err := txn.RollbackToSavepoint(sp); err != nil {
+		t.Fatal(err)
+	}
+	if len(txn.prepared) != 0 {
+		t.Errorf("expected prepared calls to be discarded; got %d", len(txn.prepared))
+	}
+	if err := txn.RollbackToSavepoint(Savepoint{seq: 10}); err == nil {
+		t.Errorf("expected error rolling back to savepoint ahead of txn")
+	}
+
+	if err := txn.Run(Call{Args: testPutReq, Reply: &proto.PutResponse{}}); err != nil {
+		t.Fatal(err)
+	}
+	if lastTxn.Sequence != 4 {
+		t.Errorf("expected sequence 4; got %d", lastTxn.Sequence)
+	}
+	expIgnored := []proto.SequenceRange{{Start: 2, End: 3}}
+	if !reflect.DeepEqual(lastTxn.IgnoredSeqs, expIgnored) {
+		t.Errorf("expected ignored sequences %v; got %v", expIgnored, lastTxn.IgnoredSeqs)
+	}
+	for seq, expIgnored := range []bool{false, false, true, true, false} {
+		if ignored := lastTxn.IsSeqIgnored(int32(seq)); ignored != expIgnored {
+			t.Errorf("%d: expected ignored=%t; got %t", seq, expIgnored, ignored)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package gossip
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+const (
+	// ttlPeersGossip is the time-to-live for a node's gossiped peer
+	// connections. A node whose peers have expired is considered
+	// unreachable.
+	ttlPeersGossip = 1 * time.Minute
+)
+
+func init() {
+	gob.Register(&Peers{})
+}
+
+// Peers describes the gossip connections of a node. Each node
+// gossips its peers under KeyPeersPrefix.
+type Peers struct {
+	NodeID   proto.NodeID   `json:"nodeID"`
+	Incoming []proto.NodeID `json:"incoming"`
+	Outgoing []proto.NodeID `json:"outgoing"`
+	// MaxHops is the maximum number of hops to reach the furthest
+	// info in the node's infostore.
+	MaxHops uint32 `json:"maxHops"`
+}
+
+// A ConnectivityNode is a node in the gossip connectivity graph.
+type ConnectivityNode struct {
+	Peers
+	// Straggler is set if the node's MaxHops exceeds the maximum number
+	// of hops the gossip network should tolerate.
+	Straggler bool `json:"straggler"`
+}
+
+// A ConnectivityGraph is the topology of the gossip network as
+// assembled from the peer connections gossiped by each node.
+type ConnectivityGraph struct {
+	MaxToleratedHops uint32             `json:"maxToleratedHops"`
+	Nodes            []ConnectivityNode `json:"nodes"`
+	// Components are the sets of nodes connected to each other. More
+	// than one component indicates a partitioned gossip network.
+	Components [][]proto.NodeID `json:"components"`
+	// Unreachable lists the nodes which are known from their gossiped
+	// descriptors but whose peer connections have expired.
+	Unreachable []proto.NodeID `json:"unreachable"`
+	// Partitioned is set if the gossip network has split, i.e. if there
+	// is more than one component or any node is unreachable.
+	Partitioned bool `json:"partitioned"`
+}
+
+// nodeIDs implements sort.Interface for a slice of node IDs.
+type nodeIDs []proto.NodeID
+
+func (n nodeIDs) Len() int           { return len(n) }
+func (n nodeIDs) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n nodeIDs) Less(i, j int) bool { return n[i] < n[j] }
+
+// maybeGossipPeers gossips this node's peer connections if they have
+// changed since they were last gossiped or if the gossiped info is
+// halfway to expiring. g.mu must be held.
+func (g *Gossip) maybeGossipPeers() {
+	if g.is.NodeID == 0 {
+		return
+	}
+	peers := &Peers{
+		NodeID:   g.is.NodeID,
+		Incoming: g.incoming.asSlice(),
+		Outgoing: g.outgoing.asSlice(),
+		MaxHops:  g.is.maxHops(),
+	}
+	sort.Sort(nodeIDs(peers.Incoming))
+	sort.Sort(nodeIDs(peers.Outgoing))
+	now := time.Now()
+	if reflect.DeepEqual(peers, g.lastPeers) && now.Sub(g.lastPeersAt) < ttlPeersGossip/2 {
+		return
+	}
+	if err := g.is.addInfo(g.is.newInfo(MakePeersKey(peers.NodeID), peers, ttlPeersGossip)); err != nil {
+		return
+	}
+	g.lastPeers, g.lastPeersAt = peers, now
+}
+
+// Connectivity assembles the gossip connectivity graph from the peer
+// connections gossiped by each node.
+func (g *Gossip) Connectivity() *ConnectivityGraph {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	graph := &ConnectivityGraph{
+		MaxToleratedHops: g.maxToleratedHops(),
+	}
+	known := map[proto.NodeID]struct{}{}
+	peersPrefix := MakeKey(KeyPeersPrefix, "")
+	nodePrefix := MakeKey(KeyNodeIDPrefix, "")
+	g.is.visitInfos(nil, func(i *info) error {
+		if peers, ok := i.Val.(*Peers); ok && strings.HasPrefix(i.Key, peersPrefix) {
+			graph.Nodes = append(graph.Nodes, ConnectivityNode{
+				Peers:     *peers,
+				Straggler: peers.MaxHops > graph.MaxToleratedHops,
+			})
+		} else if desc, ok := i.Val.(*proto.NodeDescriptor); ok && strings.HasPrefix(i.Key, nodePrefix) {
+			known[desc.NodeID] = struct{}{}
+		}
+		return nil
+	})
+	sort.Sort(connectivityNodes(graph.Nodes))
+	graph.Components = components(graph.Nodes)
+	for _, n := range graph.Nodes {
+		delete(known, n.NodeID)
+	}
+	for nodeID := range known {
+		graph.Unreachable = append(graph.Unreachable, nodeID)
+	}
+	sort.Sort(nodeIDs(graph.Unreachable))
+	graph.Partitioned = len(graph.Components) > 1 || len(graph.Unreachable) > 0
+	return graph
+}
+
+// connectivityNodes implements sort.Interface, ordering nodes by ID.
+type connectivityNodes []ConnectivityNode
+
+func (c connectivityNodes) Len() int           { return len(c) }
+func (c connectivityNodes) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c connectivityNodes) Less(i, j int) bool { return c[i].NodeID < c[j].NodeID }
+
+// components returns the connected components of the graph formed by
+// the nodes, treating connections as undirected. Connections to nodes
+// which haven't gossiped their peers still join components. Each
+// component is sorted by node ID and components are ordered by their
+// lowest node ID.
+func components(nodes []ConnectivityNode) [][]proto.NodeID {
+	edges := map[proto.NodeID][]proto.NodeID{}
+	for _, n := range nodes {
+		edges[n.NodeID] = append(edges[n.NodeID], n.Outgoing...)
+		edges[n.NodeID] = append(edges[n.NodeID], n.Incoming...)
+		for _, peer := range n.Outgoing {
+			edges[peer] = append(edges[peer], n.NodeID)
+		}
+		for _, peer := range n.Incoming {
+			edges[peer] = append(edges[peer], n.NodeID)
+		}
+	}
+	var all nodeIDs
+	for nodeID := range edges {
+		all = append(all, nodeID)
+	}
+	sort.Sort(all)
+
+	var result [][]proto.NodeID
+	visited := map[proto.NodeID]bool{}
+	for _, start := range all {
+		if visited[start] {
+			continue
+		}
+		var component nodeIDs
+		visited[start] = true
+		stack := []proto.NodeID{start}
+		for len(stack) > 0 {
+			nodeID := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			component = append(component, nodeID)
+			for _, peer := range edges[nodeID] {
+				if !visited[peer] {
+					visited[peer] = true
+					stack = append(stack, peer)
+				}
+			}
+		}
+		sort.Sort(component)
+		result = append(result, component)
+	}
+	return result
+}
+
+// DOT returns the graph in the Graphviz DOT language. Edges point from
+// each node to its outgoing peers; stragglers and unreachable nodes
+// are highlighted.
+func (cg *ConnectivityGraph) DOT() string {
+	var buf bytes.Buffer
+	buf.WriteString("digraph gossip {\n")
+	for _, n := range cg.Nodes {
+		if n.Straggler {
+			fmt.Fprintf(&buf, "  %d [label=\"%d (%d hops)\", color=orange];\n", n.NodeID, n.NodeID, n.MaxHops)
+		}
+		for _, peer := range n.Outgoing {
+			fmt.Fprintf(&buf, "  %d -> %d;\n", n.NodeID, peer)
+		}
+	}
+	for _, nodeID := range cg.Unreachable {
+		fmt.Fprintf(&buf, "  %d [color=red, style=dashed];\n", nodeID)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
@@ -0,0 +1,90 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package gossip
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// TestConnectivity verifies that the connectivity graph is assembled
+// from gossiped peers, flagging partitions, unreachable nodes and
+// stragglers.
+func TestConnectivity(t *testing.T) {
+	g := New(nil, TestInterval, TestBootstrap)
+	for i := proto.NodeID(1); i <= 5; i++ {
+		if err := g.AddInfo(MakeNodeIDKey(i), &proto.NodeDescriptor{NodeID: i}, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Nodes 1 and 2 are connected to each other, as are nodes 3 and 4.
+	// Node 5's peers are unknown.
+	for _, peers := range []*Peers{
+		{NodeID: 1, Outgoing: []proto.NodeID{2}, MaxHops: 1},
+		{NodeID: 2, Incoming: []proto.NodeID{1}, MaxHops: 1},
+		{NodeID: 3, Outgoing: []proto.NodeID{4}, MaxHops: 1},
+		{NodeID: 4, Incoming: []proto.NodeID{3}, MaxHops: 8},
+	} {
+		if err := g.AddInfo(MakePeersKey(peers.NodeID), peers, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	graph := g.Connectivity()
+	if len(graph.Nodes) != 4 {
+		t.Fatalf("expected 4 nodes, got %+v", graph.Nodes)
+	}
+	for _, n := range graph.Nodes {
+		if expected := n.NodeID == 4; n.Straggler != expected {
+			t.Errorf("node %d: expected straggler=%t with %d tolerated hops", n.NodeID, expected, graph.MaxToleratedHops)
+		}
+	}
+	expComponents := [][]proto.NodeID{{1, 2}, {3, 4}}
+	if !reflect.DeepEqual(graph.Components, expComponents) {
+		t.Errorf("expected components %v, got %v", expComponents, graph.Components)
+	}
+	if !reflect.DeepEqual(graph.Unreachable, []proto.NodeID{5}) {
+		t.Errorf("expected node 5 to be unreachable, got %v", graph.Unreachable)
+	}
+	if !graph.Partitioned {
+		t.Error("expected partitioned graph")
+	}
+	dot := graph.DOT()
+	for _, expected := range []string{"1 -> 2;", "3 -> 4;", "4 [label=", "5 [color=red"} {
+		if !strings.Contains(dot, expected) {
+			t.Errorf("expected %q in DOT output:\n%s", expected, dot)
+		}
+	}
+
+	// Connecting node 2 to node 3 and node 5 to node 1 heals the partition.
+	for _, peers := range []*Peers{
+		{NodeID: 2, Incoming: []proto.NodeID{1}, Outgoing: []proto.NodeID{3}, MaxHops: 1},
+		{NodeID: 5, Outgoing: []proto.NodeID{1}, MaxHops: 1},
+	} {
+		if err := g.AddInfo(MakePeersKey(peers.NodeID), peers, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+	graph = g.Connectivity()
+	expComponents = [][]proto.NodeID{{1, 2, 3, 4, 5}}
+	if !reflect.DeepEqual(graph.Components, expComponents) || len(graph.Unreachable) != 0 || graph.Partitioned {
+		t.Errorf("expected a single component, got %+v", graph)
+	}
+}
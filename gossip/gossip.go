@@ -93,6 +93,11 @@ const (
 	TestInterval = 10 * time.Millisecond
 )
 
+// selfJoinRetryInterval is how often a self-joining node which hasn't
+// yet received the sentinel gossip tries the next bootstrap address.
+// It's a variable so that tests may shorten it.
+var selfJoinRetryInterval = 1 * time.Second
+
 var (
 	// TestBootstrap is the default gossip bootstrap used for running tests.
 	TestBootstrap = []Resolver{}
@@ -123,6 +128,11 @@ type Gossip struct {
 	resolverIdx int
 	resolvers   []Resolver
 	triedAll    bool // True when all resolvers have been tried once
+	selfJoin    bool // Keep retrying resolvers until the sentinel is received
+
+	// The peer connections last gossiped by this node and when.
+	lastPeers   *Peers
+	lastPeersAt time.Time
 }
 
 // New creates an instance of a gossip node.
@@ -149,6 +159,17 @@ func New(rpcContext *rpc.Context, gossipInterval time.Duration, resolvers []Reso
 	return g
 }
 
+// SetSelfJoin specifies whether to keep cycling through the bootstrap
+// resolvers until the sentinel gossip is received, rather than only
+// trying another bootstrap address when gossip connectivity is lost.
+// This allows nodes found through service discovery to be started in
+// any order.
+func (g *Gossip) SetSelfJoin(selfJoin bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.selfJoin = selfJoin
+}
+
 // GetNodeID returns the instance's saved NodeID.
 func (g *Gossip) GetNodeID() proto.NodeID {
 	g.mu.Lock()
@@ -214,6 +235,16 @@ func (g *Gossip) AddInfo(key string, val interface{}, ttl time.Duration) error {
 	return err
 }
 
+// RemoveInfo deletes the info for key on this node and, by gossiping a
+// tombstone, on all other nodes. Registered callbacks are invoked for
+// the deletion. Returns an error if the key does not exist or has
+// expired.
+func (g *Gossip) RemoveInfo(key string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.is.removeInfo(key)
+}
+
 // GetInfo returns an info value by key or an error if specified
 // key does not exist or has expired.
 func (g *Gossip) GetInfo(key string) (interface{}, error) {
@@ -260,7 +291,9 @@ func (g *Gossip) RegisterGroup(prefix string, limit int, typeOf GroupType) error
 // Callback is a callback method to be invoked on gossip update
 // of info denoted by key. The contentsChanged bool indicates whether
 // the info contents were updated. False indicates the info timestamp
-// was refreshed, but its contents remained unchanged.
+// was refreshed, but its contents remained unchanged. Deletion of an
+// info via RemoveInfo is reported with contentsChanged true, after
+// which GetInfo returns an error for the key.
 type Callback func(key string, contentsChanged bool)
 
 // RegisterCallback registers a callback for a key pattern to be
@@ -347,32 +380,47 @@ func (g *Gossip) filterExtant(nodes *nodeSet) *nodeSet {
 
 // getNextBootstrapAddress returns the next available bootstrap
 // address by consulting the first non-exhausted resolver from the
-// slice supplied to the constructor or set using setBootstrap().
+// slice supplied to the constructor or set using SetResolvers().
+// Resolvers may look up DNS records or read files, so they're
+// consulted without holding g.mu, which the caller must not hold.
+// Only the bootstrap worker may call it, as resolvers aren't safe for
+// concurrent use.
 func (g *Gossip) getNextBootstrapAddress() net.Addr {
-	if len(g.resolvers) == 0 {
+	g.mu.Lock()
+	numResolvers := len(g.resolvers)
+	g.mu.Unlock()
+	if numResolvers == 0 {
 		log.Fatalf("no resolvers specified for gossip network")
 	}
 
 	// Run through resolvers round robin starting at last resolved index.
-	for i := 0; i < len(g.resolvers); i++ {
+	for i := 0; i < numResolvers; i++ {
+		g.mu.Lock()
 		g.resolverIdx = (g.resolverIdx + 1) % len(g.resolvers)
 		if g.resolverIdx == len(g.resolvers)-1 {
 			g.triedAll = true
 		}
 		resolver := g.resolvers[g.resolverIdx]
+		g.mu.Unlock()
+
 		addr, err := resolver.GetAddress()
 		if err != nil {
 			log.Errorf("invalid bootstrap address: %+v, %v", resolver, err)
 			continue
-		} else if g.is.NodeAddr != nil && addr.String() == g.is.NodeAddr.String() {
+		}
+		g.mu.Lock()
+		if g.is.NodeAddr != nil && addr.String() == g.is.NodeAddr.String() {
 			// Skip our own node address.
+			g.mu.Unlock()
 			continue
 		}
 		_, addrActive := g.bootstrapping[addr.String()]
 		if !resolver.IsExhausted() || !addrActive {
 			g.bootstrapping[addr.String()] = struct{}{}
+			g.mu.Unlock()
 			return addr
 		}
+		g.mu.Unlock()
 	}
 
 	return nil
@@ -395,18 +443,31 @@ func (g *Gossip) bootstrap(stopper *util.Stopper) {
 			// Check whether or not we need bootstrap.
 			haveClients := g.outgoing.len() > 0
 			haveSentinel := g.is.getInfo(KeySentinel) != nil
+			selfJoin := g.selfJoin
+			g.mu.Unlock()
+
 			if !haveClients || !haveSentinel {
 				// Try to get another bootstrap address from the resolvers.
 				if addr := g.getNextBootstrapAddress(); addr != nil {
-					g.startClient(addr, g.bsRPCContext, stopper)
+					g.mu.Lock()
+					if !g.closed {
+						g.startClient(addr, g.bsRPCContext, stopper)
+					}
+					g.mu.Unlock()
 				}
 			}
-			g.mu.Unlock()
+			// A self-joining node retries until it has the sentinel.
+			var retry <-chan time.Time
+			if selfJoin && !haveSentinel {
+				retry = time.After(selfJoinRetryInterval)
+			}
 
 			// Block until we need bootstrapping again.
 			select {
 			case <-g.stalled:
 				// continue
+			case <-retry:
+				// continue
 			case <-stopper.ShouldStop():
 				return
 			}
@@ -444,6 +505,7 @@ func (g *Gossip) manage(stopper *util.Stopper) {
 
 			case <-checkTimeout:
 				g.mu.Lock()
+				g.maybeGossipPeers()
 				// Check whether the graph needs to be tightened to
 				// accommodate distant infos.
 				distant := g.filterExtant(g.is.distant(g.maxToleratedHops()))
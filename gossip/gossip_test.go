@@ -19,11 +19,13 @@ package gossip
 
 import (
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 )
 
@@ -54,6 +56,23 @@ func TestGossipInfoStore(t *testing.T) {
 	}
 }
 
+// TestGossipRemoveInfo verifies removal of infos via the gossip
+// instance.
+func TestGossipRemoveInfo(t *testing.T) {
+	rpcContext := rpc.NewContext(hlc.NewClock(hlc.UnixNano), security.LoadInsecureTLSConfig(), nil)
+	g := New(rpcContext, TestInterval, TestBootstrap)
+	if err := g.RemoveInfo("i"); err == nil {
+		t.Error("expected error removing nonexistent key \"i\"")
+	}
+	g.AddInfo("i", int64(1), time.Hour)
+	if err := g.RemoveInfo("i"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.GetInfo("i"); err == nil {
+		t.Error("expected error fetching removed key \"i\"")
+	}
+}
+
 // TestGossipGroupsInfoStore verifies gossiping of groups via the
 // gossip instance infostore.
 func TestGossipGroupsInfoStore(t *testing.T) {
@@ -163,3 +182,42 @@ func TestGossipGetNextBootstrapAddress(t *testing.T) {
 		}
 	}
 }
+
+// TestGossipSelfJoinRetry verifies that a self-joining node keeps
+// consulting its resolvers until it has the sentinel gossip, whereas
+// any other node waits to be notified of stalled connectivity, and
+// that resolvers are consulted without holding the gossip lock.
+func TestGossipSelfJoinRetry(t *testing.T) {
+	defer func(interval time.Duration) { selfJoinRetryInterval = interval }(selfJoinRetryInterval)
+	selfJoinRetryInterval = time.Millisecond
+
+	for _, selfJoin := range []bool{false, true} {
+		var lookups int32
+		resolver := &listResolver{typ: "srv", addr: "_gossip._tcp.example.com"}
+		g := New(nil, TestInterval, []Resolver{resolver})
+		resolver.lookup = func(addr string) ([]string, error) {
+			// Deadlocks if the resolver is consulted under g.mu.
+			g.GetNodeID()
+			atomic.AddInt32(&lookups, 1)
+			return nil, util.Errorf("no nodes registered for %q yet", addr)
+		}
+		g.SetSelfJoin(selfJoin)
+
+		stopper := util.NewStopper()
+		g.bootstrap(stopper)
+		if selfJoin {
+			if err := util.IsTrueWithin(func() bool {
+				return atomic.LoadInt32(&lookups) >= 3
+			}, 500*time.Millisecond); err != nil {
+				t.Errorf("expected self-joining node to retry resolvers; got %d lookups",
+					atomic.LoadInt32(&lookups))
+			}
+		} else {
+			time.Sleep(20 * selfJoinRetryInterval)
+			if n := atomic.LoadInt32(&lookups); n != 1 {
+				t.Errorf("expected a single lookup without self-join; got %d", n)
+			}
+		}
+		stopper.Stop()
+	}
+}
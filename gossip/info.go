@@ -37,6 +37,7 @@ type info struct {
 	TTLStamp  int64        `json:"-"` // Wall time before info is discarded (Unix-nanos)
 	Hops      uint32       `json:"-"` // Number of hops from originator
 	NodeID    proto.NodeID `json:"-"` // Originating node's ID
+	Deleted   bool         `json:"-"` // Tombstone: key was removed and info has no value
 	peerID    proto.NodeID // Proximate peer's ID which passed us the info
 	seq       int64        // Sequence number for incremental updates
 }
@@ -74,6 +75,14 @@ func (i *info) expired(now int64) bool {
 	return i.TTLStamp <= now
 }
 
+// supersedes returns true if i should replace existing, an info with
+// the same key: either i's timestamp is newer, or the timestamps are
+// equal but i has traveled fewer hops.
+func (i *info) supersedes(existing *info) bool {
+	return i.Timestamp > existing.Timestamp ||
+		(i.Timestamp == existing.Timestamp && i.Hops < existing.Hops)
+}
+
 // isFresh returns true if the info has a sequence number newer
 // than seq and wasn't either passed directly or originated from
 // the same node.
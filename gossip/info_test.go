@@ -56,16 +56,16 @@ func TestPrefix(t *testing.T) {
 
 func TestSort(t *testing.T) {
 	infos := infoSlice{
-		{"a", 3.0, 0, 0, 0, 0, 0, 0},
-		{"b", 1.0, 0, 0, 0, 0, 0, 0},
-		{"c", 2.1, 0, 0, 0, 0, 0, 0},
-		{"d", 2.0, 0, 0, 0, 0, 0, 0},
-		{"e", -1.0, 0, 0, 0, 0, 0, 0},
+		{"a", 3.0, 0, 0, 0, 0, false, 0, 0},
+		{"b", 1.0, 0, 0, 0, 0, false, 0, 0},
+		{"c", 2.1, 0, 0, 0, 0, false, 0, 0},
+		{"d", 2.0, 0, 0, 0, 0, false, 0, 0},
+		{"e", -1.0, 0, 0, 0, 0, false, 0, 0},
 	}
 
 	// Verify forward sort.
 	sort.Sort(infos)
-	last := &info{"last", -math.MaxFloat64, 0, 0, 0, 0, 0, 0}
+	last := &info{"last", -math.MaxFloat64, 0, 0, 0, 0, false, 0, 0}
 	for _, i := range infos {
 		if i.less(last) {
 			t.Errorf("info val %v not increasing", i.Val)
@@ -75,7 +75,7 @@ func TestSort(t *testing.T) {
 
 	// Verify reverse sort.
 	sort.Sort(sort.Reverse(infos))
-	last = &info{"last", math.MaxFloat64, 0, 0, 0, 0, 0, 0}
+	last = &info{"last", math.MaxFloat64, 0, 0, 0, 0, false, 0, 0}
 	for _, i := range infos {
 		if !i.less(last) {
 			t.Errorf("info val %v not decreasing", i.Val)
@@ -86,7 +86,7 @@ func TestSort(t *testing.T) {
 
 func TestExpired(t *testing.T) {
 	now := time.Now().UnixNano()
-	i := info{"a", float64(1), now, now + int64(time.Millisecond), 0, 0, 0, 0}
+	i := info{"a", float64(1), now, now + int64(time.Millisecond), 0, 0, false, 0, 0}
 	if i.expired(now) {
 		t.Error("premature expiration")
 	}
@@ -101,7 +101,7 @@ func TestIsFresh(t *testing.T) {
 	node1 := proto.NodeID(1)
 	node2 := proto.NodeID(2)
 	node3 := proto.NodeID(3)
-	i := info{"a", float64(1), now, now + int64(time.Millisecond), 0, node1, node2, seq}
+	i := info{"a", float64(1), now, now + int64(time.Millisecond), 0, node1, false, node2, seq}
 	if !i.isFresh(node3, seq-1) {
 		t.Error("info should be fresh:", i)
 	}
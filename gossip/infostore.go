@@ -157,6 +157,9 @@ func (is *infoStore) getInfo(key string) *info {
 			delete(is.Infos, key)
 			return nil
 		}
+		if info.Deleted {
+			return nil
+		}
 		return info
 	}
 	return nil
@@ -194,33 +197,56 @@ func (is *infoStore) registerGroup(g *group) error {
 // info is added to that group (prefix is defined by prefix of string up
 // until last period '.'). Otherwise, the info is added to the infos map.
 //
+// Tombstones (deleted infos) are always kept in the infos map so they
+// continue to be gossiped; a tombstone for a group key removes the
+// superseded info from its group. An info for the key which supersedes
+// the tombstone in turn replaces it.
+//
 // Returns nil if info was added; error otherwise.
 func (is *infoStore) addInfo(i *info) error {
+	group := is.belongsToGroup(i.Key)
 	// If the prefix matches a group, add to group.
-	if group := is.belongsToGroup(i.Key); group != nil {
+	if group != nil && !i.Deleted {
+		if tombstone, ok := is.Infos[i.Key]; ok && !i.supersedes(tombstone) {
+			return util.Errorf("info %+v older than tombstone %+v", i, tombstone)
+		}
 		contentsChanged, err := group.addInfo(i)
 		if err != nil {
 			return err
 		}
+		delete(is.Infos, i.Key)
 		if i.seq > is.MaxSeq {
 			is.MaxSeq = i.seq
 		}
 		is.processCallbacks(i.Key, contentsChanged)
 		return nil
 	}
+	// A tombstone for a group key must supersede the group's info.
+	var groupInfo *info
+	if group != nil {
+		if existingInfo, ok := group.Infos[i.Key]; ok {
+			if !i.supersedes(existingInfo) {
+				return util.Errorf("tombstone %+v older than current group info %+v", i, existingInfo)
+			}
+			groupInfo = existingInfo
+		}
+	}
 	// Only replace an existing info if new timestamp is greater, or if
 	// timestamps are equal, but new hops is smaller.
 	var contentsChanged bool
 	if existingInfo, ok := is.Infos[i.Key]; ok {
-		if i.Timestamp < existingInfo.Timestamp ||
-			(i.Timestamp == existingInfo.Timestamp && i.Hops >= existingInfo.Hops) {
+		if !i.supersedes(existingInfo) {
 			return util.Errorf("info %+v older than current info %+v", i, existingInfo)
 		}
-		contentsChanged = !reflect.DeepEqual(existingInfo.Val, i.Val)
+		contentsChanged = existingInfo.Deleted != i.Deleted || !reflect.DeepEqual(existingInfo.Val, i.Val)
 	} else {
 		// No preexisting info means contentsChanged is true.
 		contentsChanged = true
 	}
+	if groupInfo != nil {
+		group.removeInternal(groupInfo)
+		contentsChanged = true
+	}
 	// Update info map.
 	is.Infos[i.Key] = i
 	if i.seq > is.MaxSeq {
@@ -230,6 +256,21 @@ func (is *infoStore) addInfo(i *info) error {
 	return nil
 }
 
+// removeInfo deletes the info for key by adding a tombstone which
+// supersedes it. The tombstone is gossiped like any other info and
+// expires along with the info it replaces. Returns an error if the key
+// does not exist or has expired.
+func (is *infoStore) removeInfo(key string) error {
+	existing := is.getInfo(key)
+	if existing == nil {
+		return util.Errorf("key %q does not exist or has expired", key)
+	}
+	tombstone := is.newInfo(key, nil, 0)
+	tombstone.TTLStamp = existing.TTLStamp
+	tombstone.Deleted = true
+	return is.addInfo(tombstone)
+}
+
 // infoCount returns the count of infos stored in groups and the
 // non-group infos map. This is really just an approximation as
 // we don't check whether infos are expired.
@@ -262,7 +303,7 @@ func (is *infoStore) registerCallback(pattern string, method Callback) {
 	is.callbacks = append(is.callbacks, callback{pattern: re, method: method})
 	var infos []*info
 	if err := is.visitInfos(nil, func(i *info) error {
-		if re.MatchString(i.Key) {
+		if re.MatchString(i.Key) && !i.Deleted {
 			infos = append(infos, i)
 		}
 		return nil
@@ -301,7 +342,9 @@ func (is *infoStore) processCallbacks(key string, contentsChanged bool) {
 // turn. After each group is visited, the visitInfo function is run
 // against each of its infos. Finally, after all groups have been
 // visitied, the visitInfo function is run against each non-group info
-// in turn. Be sure to skip over any expired infos.
+// in turn. Be sure to skip over any expired infos. Tombstones are
+// visited so they can be gossiped; visitors interested in values must
+// skip them.
 func (is *infoStore) visitInfos(visitGroup func(*group) error, visitInfo func(*info) error) error {
 	now := time.Now().UnixNano()
 	for _, g := range is.Groups {
@@ -591,3 +591,132 @@ func TestRegisterCallback(t *testing.T) {
 		t.Errorf("expected %v, got %v", expKeys, cb.Keys())
 	}
 }
+
+// TestRemoveInfo verifies that removing an info hides it from reads,
+// invokes callbacks, and that the tombstone is only superseded by a
+// newer info.
+func TestRemoveInfo(t *testing.T) {
+	is := newInfoStore(1, emptyAddr)
+	wg := &sync.WaitGroup{}
+	cb := callbackRecord{wg: wg}
+	is.registerCallback("key1", cb.Add)
+
+	i1 := is.newInfo("key1", float64(1), time.Second)
+	wg.Add(2)
+	if err := is.addInfo(i1); err != nil {
+		t.Fatal(err)
+	}
+	if err := is.removeInfo("key1"); err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+	if expKeys := []string{"key1-true", "key1-true"}; !reflect.DeepEqual(cb.Keys(), expKeys) {
+		t.Errorf("expected %v, got %v", expKeys, cb.Keys())
+	}
+	if info := is.getInfo("key1"); info != nil {
+		t.Errorf("expected removed info to be hidden; got %+v", info)
+	}
+	tombstone := is.Infos["key1"]
+	if tombstone == nil || !tombstone.Deleted || tombstone.TTLStamp != i1.TTLStamp {
+		t.Fatalf("expected tombstone with TTL stamp %d; got %+v", i1.TTLStamp, tombstone)
+	}
+	if is.MaxSeq != tombstone.seq {
+		t.Errorf("expected max seq %d to be tombstone seq %d", is.MaxSeq, tombstone.seq)
+	}
+	if err := is.removeInfo("key1"); err == nil {
+		t.Error("expected error removing already removed info")
+	}
+
+	// The original info can't resurrect the key, but a newer one can.
+	if err := is.addInfo(i1); err == nil {
+		t.Error("expected error adding info older than tombstone")
+	}
+	i2 := is.newInfo("key1", float64(2), time.Second)
+	wg.Add(1)
+	if err := is.addInfo(i2); err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+	if info := is.getInfo("key1"); info != i2 {
+		t.Errorf("expected %+v; got %+v", i2, info)
+	}
+}
+
+// TestRemoveGroupInfo verifies that removing an info which belongs to
+// a group removes it from the group.
+func TestRemoveGroupInfo(t *testing.T) {
+	is := newInfoStore(1, emptyAddr)
+	if err := is.registerGroup(newGroup("a", 10, MinGroup)); err != nil {
+		t.Fatal(err)
+	}
+	info1 := is.newInfo("a.a", float64(1), time.Second)
+	info2 := is.newInfo("a.b", float64(2), time.Second)
+	if err1, err2 := is.addInfo(info1), is.addInfo(info2); err1 != nil || err2 != nil {
+		t.Fatal(err1, err2)
+	}
+	if err := is.removeInfo("a.a"); err != nil {
+		t.Fatal(err)
+	}
+	if infos := is.getGroupInfos("a"); len(infos) != 1 || infos[0] != info2 {
+		t.Errorf("expected only %+v in group; got %+v", info2, infos)
+	}
+	if info := is.getInfo("a.a"); info != nil {
+		t.Errorf("expected removed info to be hidden; got %+v", info)
+	}
+
+	// Re-adding the key with a newer info returns it to the group and
+	// discards the tombstone.
+	info3 := is.newInfo("a.a", float64(3), time.Second)
+	if err := is.addInfo(info3); err != nil {
+		t.Fatal(err)
+	}
+	if infos := is.getGroupInfos("a"); len(infos) != 2 || infos[1] != info3 {
+		t.Errorf("expected %+v in group; got %+v", info3, infos)
+	}
+	if _, ok := is.Infos["a.a"]; ok {
+		t.Error("expected tombstone to be discarded")
+	}
+}
+
+// TestCombineTombstone verifies that tombstones are passed in deltas
+// and remove infos from the infostores they're combined with.
+func TestCombineTombstone(t *testing.T) {
+	is1 := newInfoStore(1, emptyAddr)
+	is2 := newInfoStore(2, emptyAddr)
+	for _, is := range []*infoStore{is1, is2} {
+		if err := is.registerGroup(newGroup("a", 10, MinGroup)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err1, err2 := is1.addInfo(is1.newInfo("a.a", float64(1), time.Second)),
+		is1.addInfo(is1.newInfo("b", float64(1), time.Second)); err1 != nil || err2 != nil {
+		t.Fatal(err1, err2)
+	}
+	if freshCount := is2.combine(is1.delta(2, 0)); freshCount != 2 {
+		t.Fatalf("expected 2 fresh infos; got %d", freshCount)
+	}
+	if is2.getInfo("a.a") == nil || is2.getInfo("b") == nil {
+		t.Fatal("expected infos to be combined")
+	}
+
+	seq := is1.MaxSeq
+	if err1, err2 := is1.removeInfo("a.a"), is1.removeInfo("b"); err1 != nil || err2 != nil {
+		t.Fatal(err1, err2)
+	}
+	if freshCount := is2.combine(is1.delta(2, seq)); freshCount != 2 {
+		t.Fatalf("expected 2 fresh tombstones; got %d", freshCount)
+	}
+	if info := is2.getInfo("a.a"); info != nil {
+		t.Errorf("expected group info to be removed; got %+v", info)
+	}
+	if infos := is2.getGroupInfos("a"); len(infos) != 0 {
+		t.Errorf("expected empty group; got %+v", infos)
+	}
+	if info := is2.getInfo("b"); info != nil {
+		t.Errorf("expected info to be removed; got %+v", info)
+	}
+	// The tombstones are passed on to further peers.
+	if delta := is2.delta(3, 0); delta == nil || len(delta.Infos) != 2 {
+		t.Errorf("expected delta with 2 tombstones; got %+v", delta)
+	}
+}
@@ -36,6 +36,12 @@ const (
 	// The value is a string UUID for the cluster.
 	KeyClusterID = "cluster-id"
 
+	// KeyAcctStatsPrefix is the key prefix for gossiping the stats of
+	// accounting prefixes aggregated by each store. The suffix is
+	// composed of: <node ID>-<store ID>. The value is a
+	// storage.AcctStats map.
+	KeyAcctStatsPrefix = "acct-stats"
+
 	// KeyConfigAccounting is the accounting configuration map.
 	KeyConfigAccounting = "accounting"
 
@@ -45,6 +51,13 @@ const (
 	// KeyConfigZone is the zone configuration map.
 	KeyConfigZone = "zones"
 
+	// KeyDecommissionPrefix is the key prefix for marking nodes as
+	// decommissioning. The actual key is suffixed with the decimal
+	// representation of the node id and the value is a bool. The
+	// replicas and leader leases of decommissioning nodes are moved to
+	// other nodes.
+	KeyDecommissionPrefix = "decommission"
+
 	// KeyMaxAvailCapacityPrefix is the key prefix for gossiping available
 	// store capacity. The suffix is composed of: <node ID>-<store ID>.
 	// The value is a storage.StoreDescriptor struct.
@@ -62,6 +75,30 @@ const (
 	// string address of the node. E.g. node:1 => 127.0.0.1:24001
 	KeyNodeIDPrefix = "node"
 
+	// KeyNodeLivenessPrefix is the key prefix for gossiping node
+	// liveness heartbeats. The actual key is suffixed with the decimal
+	// representation of the node id and the value is the int64 wall
+	// time (Unix nanos) of the node's latest heartbeat.
+	KeyNodeLivenessPrefix = "node-liveness"
+
+	// KeyPeersPrefix is the key prefix for gossiping the gossip peer
+	// connections of each node. The actual key is suffixed with the
+	// decimal representation of the node id and the value is a
+	// *gossip.Peers struct.
+	KeyPeersPrefix = "gossip-peers"
+
+	// KeyProtectedTimestamps is the slice of protected timestamp
+	// records, which hold off garbage collection of key spans for
+	// long-running operations such as backups.
+	KeyProtectedTimestamps = "protected-timestamps"
+
+	// KeyStoreDecommissionPrefix is the key prefix for marking stores
+	// as being removed from their node. The actual key is suffixed with
+	// the decimal representation of the store id and the value is a
+	// bool. The replicas and leader leases of such stores are moved to
+	// other stores.
+	KeyStoreDecommissionPrefix = "store-decommission"
+
 	// KeySentinel is a key for gossip which must not expire or else the
 	// node considers itself partitioned and will retry with bootstrap hosts.
 	KeySentinel = KeyClusterID
@@ -93,7 +130,36 @@ func MakeNodeIDKey(nodeID proto.NodeID) string {
 	return MakeKey(KeyNodeIDPrefix, nodeID.String())
 }
 
+// MakeDecommissionKey returns the gossip key marking a node as
+// decommissioning.
+func MakeDecommissionKey(nodeID proto.NodeID) string {
+	return MakeKey(KeyDecommissionPrefix, nodeID.String())
+}
+
+// MakeStoreDecommissionKey returns the gossip key marking a store as
+// being removed from its node.
+func MakeStoreDecommissionKey(storeID proto.StoreID) string {
+	return MakeKey(KeyStoreDecommissionPrefix, storeID.String())
+}
+
+// MakeAcctStatsKey returns the gossip key for the given store's
+// accounting stats.
+func MakeAcctStatsKey(nodeID proto.NodeID, storeID proto.StoreID) string {
+	return MakeKey(KeyAcctStatsPrefix, nodeID.String(), storeID.String())
+}
+
 // MakeMaxAvailCapacityKey returns the gossip key for the given store's capacity.
 func MakeMaxAvailCapacityKey(nodeID proto.NodeID, storeID proto.StoreID) string {
 	return MakeKey(KeyMaxAvailCapacityPrefix, nodeID.String(), storeID.String())
 }
+
+// MakeNodeLivenessKey returns the gossip key for a node's liveness
+// heartbeat.
+func MakeNodeLivenessKey(nodeID proto.NodeID) string {
+	return MakeKey(KeyNodeLivenessPrefix, nodeID.String())
+}
+
+// MakePeersKey returns the gossip key for a node's peer connections.
+func MakePeersKey(nodeID proto.NodeID) string {
+	return MakeKey(KeyPeersPrefix, nodeID.String())
+}
@@ -18,7 +18,10 @@
 package gossip
 
 import (
+	"bufio"
 	"net"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -77,10 +80,101 @@ func (sr *socketResolver) GetAddress() (net.Addr, error) {
 // addresses.
 func (sr *socketResolver) IsExhausted() bool { return sr.exhausted }
 
+// lookupSRV is overwritten in tests to stand in for DNS.
+var lookupSRV = net.LookupSRV
+
+// A listResolver yields in turn each of the tcp addresses obtained by
+// looking up a list, e.g. from DNS SRV records or a file. Once every
+// address has been returned, the resolver is exhausted; the list is
+// looked up anew at the start of every pass, so changes to it are
+// picked up on subsequent calls.
+type listResolver struct {
+	typ       string
+	addr      string
+	lookup    func(addr string) ([]string, error)
+	addrs     []string // Addresses of the current pass
+	idx       int      // Index of the next address to return
+	exhausted bool     // Has every address been returned?
+}
+
+// Type returns the resolver type.
+func (lr *listResolver) Type() string { return lr.typ }
+
+// Addr returns the resolver address.
+func (lr *listResolver) Addr() string { return lr.addr }
+
+// GetAddress returns the next address from the list or an error if
+// the list can't be looked up or is empty.
+func (lr *listResolver) GetAddress() (net.Addr, error) {
+	if lr.idx >= len(lr.addrs) {
+		addrs, err := lr.lookup(lr.addr)
+		if err != nil {
+			return nil, err
+		}
+		if len(addrs) == 0 {
+			return nil, util.Errorf("no addresses found for %s resolver %q", lr.typ, lr.addr)
+		}
+		lr.addrs, lr.idx = addrs, 0
+	}
+	addr := lr.addrs[lr.idx]
+	lr.idx++
+	if lr.idx == len(lr.addrs) {
+		lr.exhausted = true
+	}
+	return util.MakeRawAddr("tcp", addr), nil
+}
+
+// IsExhausted returns whether the resolver can yield further
+// addresses which haven't been returned before.
+func (lr *listResolver) IsExhausted() bool { return lr.exhausted }
+
+// lookupSRVAddrs returns the host:port addresses of the targets of the
+// DNS SRV records for name.
+func lookupSRVAddrs(name string) ([]string, error) {
+	_, srvs, err := lookupSRV("", "", name)
+	if err != nil {
+		return nil, err
+	}
+	var addrs []string
+	for _, srv := range srvs {
+		addrs = append(addrs, net.JoinHostPort(strings.TrimSuffix(srv.Target, "."), strconv.Itoa(int(srv.Port))))
+	}
+	return addrs, nil
+}
+
+// readFileAddrs returns the addresses listed in the file at path, one
+// host:port per line. Blank lines and lines starting with '#' are
+// ignored.
+func readFileAddrs(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var addrs []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(line); err != nil {
+			return nil, util.Errorf("invalid address %q in %s: %s", line, path, err)
+		}
+		addrs = append(addrs, util.EnsureHost(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
+
 var validTypes = map[string]struct{}{
 	"tcp":  struct{}{},
 	"lb":   struct{}{},
 	"unix": struct{}{},
+	"srv":  struct{}{},
+	"file": struct{}{},
 }
 
 // NewResolver takes a resolver specification and returns a new resolver.
@@ -89,6 +183,8 @@ var validTypes = map[string]struct{}{
 // - tcp: plain hostname of ip address
 // - lb: load balancer host name or ip: points to an unknown number of backends
 // - unix: unix sockets
+// - srv: DNS name whose SRV records point to the bootstrap hosts
+// - file: file listing host:port entries, re-read as they're used up
 // If "network type" is not specified, "tcp" is assumed.
 func NewResolver(spec string) (Resolver, error) {
 	parts := strings.Split(spec, "=")
@@ -120,6 +216,12 @@ func NewResolver(spec string) (Resolver, error) {
 		addr = util.EnsureHost(addr)
 	}
 
+	switch typ {
+	case "srv":
+		return &listResolver{typ: typ, addr: addr, lookup: lookupSRVAddrs}, nil
+	case "file":
+		return &listResolver{typ: typ, addr: addr, lookup: readFileAddrs}, nil
+	}
 	return &socketResolver{typ: typ, addr: addr}, nil
 }
 
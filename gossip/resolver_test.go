@@ -18,6 +18,9 @@
 package gossip
 
 import (
+	"io/ioutil"
+	"net"
+	"os"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -37,6 +40,8 @@ func TestParseResolverSpec(t *testing.T) {
 		{"tcp=127.0.0.1", true, "tcp", "127.0.0.1"},
 		{"lb=127.0.0.1", true, "lb", "127.0.0.1"},
 		{"unix=/tmp/unix-socket12345", true, "unix", "/tmp/unix-socket12345"},
+		{"srv=_gossip._tcp.example.com", true, "srv", "_gossip._tcp.example.com"},
+		{"file=/etc/cockroach/hosts", true, "file", "/etc/cockroach/hosts"},
 		{"", false, "", ""},
 		{"foo=127.0.0.1", false, "", ""},
 		{"lb=", false, "", ""},
@@ -100,3 +105,101 @@ func TestGetAddress(t *testing.T) {
 		}
 	}
 }
+
+// verifyAddresses fetches addresses from the resolver and verifies
+// they match the expected ones, as well as whether the resolver is
+// exhausted after each.
+func verifyAddresses(t *testing.T, resolver Resolver, expAddrs []string, expExhausted []bool) {
+	for i, expAddr := range expAddrs {
+		addr, err := resolver.GetAddress()
+		if err != nil {
+			t.Fatalf("#%d: %s", i, err)
+		}
+		if addr.String() != expAddr {
+			t.Errorf("#%d: expected address %s, got %s", i, expAddr, addr)
+		}
+		if resolver.IsExhausted() != expExhausted[i] {
+			t.Errorf("#%d: expected exhausted=%t", i, expExhausted[i])
+		}
+	}
+}
+
+// TestSRVResolver verifies that the srv resolver yields the targets of
+// the SRV records in turn and looks them up again once exhausted.
+func TestSRVResolver(t *testing.T) {
+	defer func() { lookupSRV = net.LookupSRV }()
+	records := []*net.SRV{
+		{Target: "node1.example.com.", Port: 26257},
+		{Target: "node2.example.com.", Port: 26258},
+	}
+	lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		if name != "_gossip._tcp.example.com" {
+			return "", nil, util.Errorf("no such host %q", name)
+		}
+		return name, records, nil
+	}
+
+	resolver, err := NewResolver("srv=_gossip._tcp.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyAddresses(t, resolver, []string{"node1.example.com:26257", "node2.example.com:26258"},
+		[]bool{false, true})
+
+	// New records are picked up on the next pass.
+	records = append(records, &net.SRV{Target: "node3.example.com.", Port: 26259})
+	verifyAddresses(t, resolver, []string{"node1.example.com:26257", "node2.example.com:26258",
+		"node3.example.com:26259"}, []bool{true, true, true})
+
+	// Lookup failures and empty results are errors.
+	records = nil
+	if _, err := resolver.GetAddress(); err == nil {
+		t.Error("expected error on empty SRV records")
+	}
+	resolver, err = NewResolver("srv=_unknown._tcp.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolver.GetAddress(); err == nil {
+		t.Error("expected error on failed SRV lookup")
+	}
+}
+
+// TestFileResolver verifies that the file resolver yields the listed
+// addresses in turn and re-reads the file once exhausted.
+func TestFileResolver(t *testing.T) {
+	f, err := ioutil.TempFile("", "gossip-hosts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	writeHosts := func(contents string) {
+		if err := ioutil.WriteFile(f.Name(), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeHosts("# bootstrap hosts\n127.0.0.1:9000\n\n  localhost:9001  \n")
+
+	resolver, err := NewResolver("file=" + f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyAddresses(t, resolver, []string{"127.0.0.1:9000", "localhost:9001"}, []bool{false, true})
+
+	// Changes to the file are picked up on the next pass.
+	writeHosts("127.0.0.1:9002\n")
+	verifyAddresses(t, resolver, []string{"127.0.0.1:9002"}, []bool{true})
+
+	// Invalid entries and missing files are errors.
+	writeHosts("127.0.0.1\n")
+	if _, err := resolver.GetAddress(); err == nil {
+		t.Error("expected error on entry without port")
+	}
+	resolver, err = NewResolver("file=" + f.Name() + ".missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolver.GetAddress(); err == nil {
+		t.Error("expected error on missing file")
+	}
+}
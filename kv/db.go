@@ -21,10 +21,12 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util"
 )
 
@@ -80,6 +82,12 @@ func createArgsAndReply(method string) (proto.Request, proto.Response) {
 			return &proto.AdminSplitRequest{}, &proto.AdminSplitResponse{}
 		case proto.AdminMerge:
 			return &proto.AdminMergeRequest{}, &proto.AdminMergeResponse{}
+		case proto.AdminTransferLease:
+			return &proto.AdminTransferLeaseRequest{}, &proto.AdminTransferLeaseResponse{}
+		case proto.Ingest:
+			return &proto.IngestRequest{}, &proto.IngestResponse{}
+		case proto.Export:
+			return &proto.ExportRequest{}, &proto.ExportResponse{}
 		}
 	}
 	return nil, nil
@@ -88,7 +96,8 @@ func createArgsAndReply(method string) (proto.Request, proto.Response) {
 // A DBServer provides an HTTP server endpoint serving the key-value API.
 // It accepts either JSON or serialized protobuf content types.
 type DBServer struct {
-	sender client.KVSender
+	sender  client.KVSender
+	drainer requestDrainer
 }
 
 // NewDBServer allocates and returns a new DBServer.
@@ -105,6 +114,12 @@ func NewDBServer(sender client.KVSender) *DBServer {
 // present, in the same format as the request's incoming Content-Type
 // header.
 func (s *DBServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.drainer.start() {
+		http.Error(w, "service is draining", http.StatusServiceUnavailable)
+		return
+	}
+	defer s.drainer.finish()
+
 	method := r.URL.Path
 	if !strings.HasPrefix(method, DBPrefix) {
 		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
@@ -135,6 +150,12 @@ func (s *DBServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Bind the request's user to the client certificate.
+	if err := security.AuthenticateRequest(r.TLS, args.Header()); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
 	// Create a call and invoke through sender.
 	s.sender.Send(client.Call{Args: args, Reply: reply})
 
@@ -148,6 +169,13 @@ func (s *DBServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Write(body)
 }
 
+// Drain stops the server from accepting new requests and waits up to
+// timeout for the requests in flight to complete. Requests received
+// while draining are rejected.
+func (s *DBServer) Drain(timeout time.Duration) error {
+	return s.drainer.drain(timeout)
+}
+
 // RegisterRPC registers the RPC endpoints.
 func (s *DBServer) RegisterRPC(rpcServer *rpc.Server) error {
 	return rpcServer.RegisterName("Server", (*rpcDBServer)(s))
@@ -159,6 +187,10 @@ type rpcDBServer DBServer
 
 // executeCmd creates a client.Call struct and sends if via our local sender.
 func (s *rpcDBServer) executeCmd(args proto.Request, reply proto.Response) error {
+	if !s.drainer.start() {
+		return util.Errorf("service is draining")
+	}
+	defer s.drainer.finish()
 	s.sender.Send(client.Call{Args: args, Reply: reply})
 	return nil
 }
@@ -222,3 +254,18 @@ func (s *rpcDBServer) AdminSplit(args *proto.AdminSplitRequest, reply *proto.Adm
 func (s *rpcDBServer) AdminMerge(args *proto.AdminMergeRequest, reply *proto.AdminMergeResponse) error {
 	return s.executeCmd(args, reply)
 }
+
+// AdminTransferLease .
+func (s *rpcDBServer) AdminTransferLease(args *proto.AdminTransferLeaseRequest, reply *proto.AdminTransferLeaseResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+// Ingest .
+func (s *rpcDBServer) Ingest(args *proto.IngestRequest, reply *proto.IngestResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+// Export .
+func (s *rpcDBServer) Export(args *proto.ExportRequest, reply *proto.ExportResponse) error {
+	return s.executeCmd(args, reply)
+}
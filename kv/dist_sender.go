@@ -43,6 +43,12 @@ const (
 	retryBackoff           = 1 * time.Second
 	maxRetryBackoff        = 30 * time.Second
 
+	// Reads which any replica can serve are sent to the next replica
+	// after sendNextLatencyMultiple times the latency measured to the
+	// replica addressed first, but no sooner than minSendNextTimeout.
+	sendNextLatencyMultiple = 4
+	minSendNextTimeout      = 50 * time.Millisecond
+
 	// The default maximum number of ranges to return
 	// from an internal range lookup.
 	defaultRangeLookupMaxRanges = 8
@@ -112,6 +118,12 @@ type DistSender struct {
 	// outside of tests.
 	rpcSend         rpcSendFn
 	rpcRetryOptions util.RetryOptions
+	// rpcLatency returns the measured latency to an address and
+	// defaults to rpc.Latency outside of tests.
+	rpcLatency func(net.Addr) time.Duration
+	// followerReadLag is how far in the past FOLLOWER reads which
+	// don't specify a timestamp are made.
+	followerReadLag time.Duration
 }
 
 // rpcSendFn is the function type used to dispatch RPC calls.
@@ -129,6 +141,12 @@ type DistSenderContext struct {
 	RangeLookupMaxRanges int32
 	LeaderCacheSize      int32
 	RPCRetryOptions      *util.RetryOptions
+	// ClosedTimestampInterval is the interval at which range leaders
+	// close out timestamps. FOLLOWER reads which don't specify a
+	// timestamp read far enough in the past for followers to have
+	// closed it; without an interval, they read at the current time
+	// and are served by leaders.
+	ClosedTimestampInterval time.Duration
 	// nodeDescriptor, if provided, is used to describe which node the DistSender
 	// lives on, for instance when deciding where to send RPCs.
 	// Usually it is filled in from the Gossip network on demand.
@@ -136,6 +154,7 @@ type DistSenderContext struct {
 	// The RPC dispatcher. Defaults to rpc.Send but can be changed here
 	// for testing purposes.
 	rpcSend           rpcSendFn
+	rpcLatency        func(net.Addr) time.Duration
 	rangeDescriptorDB rangeDescriptorDB
 }
 
@@ -177,10 +196,17 @@ func NewDistSender(ctx *DistSenderContext, gossip *gossip.Gossip) *DistSender {
 	if ctx.rpcSend != nil {
 		ds.rpcSend = ctx.rpcSend
 	}
+	ds.rpcLatency = rpc.Latency
+	if ctx.rpcLatency != nil {
+		ds.rpcLatency = ctx.rpcLatency
+	}
 	ds.rpcRetryOptions = defaultRPCRetryOptions
 	if ctx.RPCRetryOptions != nil {
 		ds.rpcRetryOptions = *ctx.RPCRetryOptions
 	}
+	// Timestamps are closed every interval, an interval in the past, so
+	// the closed timestamp may lag by up to twice the interval.
+	ds.followerReadLag = 2 * ctx.ClosedTimestampInterval
 	return ds
 }
 
@@ -335,25 +361,66 @@ func (ds *DistSender) getRangeDescriptor(key proto.Key) ([]proto.RangeDescriptor
 	return ds.internalRangeLookup(metadataKey, desc)
 }
 
-func (ds *DistSender) optimizeReplicaOrder(replicas proto.ReplicaSlice) rpc.OrderingPolicy {
-	// Unless we know better, send the RPCs randomly.
-	order := rpc.OrderingPolicy(rpc.OrderRandom)
+// optimizeReplicaOrder rearranges the replicas so that those presumed
+// to be closest to this node come first and returns the ordering
+// policy with which they should be addressed. If preferLocal is true,
+// a replica located on this node is moved to the very front.
+func (ds *DistSender) optimizeReplicaOrder(replicas proto.ReplicaSlice, preferLocal bool) rpc.OrderingPolicy {
+	// Unless we know better, send the RPCs to the replicas with the
+	// lowest measured latency first.
+	order := rpc.OrderingPolicy(rpc.OrderByLatency)
 	nodeDesc := ds.getNodeDescriptor()
 	// If we don't know which node we're on, don't optimize anything.
 	if nodeDesc == nil {
 		return order
 	}
 	// Sort replicas by attribute affinity, which we treat as a stand-in for
-	// proximity (for now).
-	if replicas.SortByCommonAttributePrefix(nodeDesc.Attrs.Attrs) > 0 {
-		// There's at least some attribute prefix, and we hope that the
-		// replicas that come early in the slice are now located close to
-		// us and hence better candidates.
-		order = rpc.OrderStable
+	// proximity among the replicas whose latency hasn't been measured yet.
+	replicas.SortByCommonAttributePrefix(nodeDesc.Attrs.Attrs)
+	if preferLocal {
+		for i := range replicas {
+			if replicas[i].NodeID == nodeDesc.NodeID {
+				replicas.MoveToFront(i)
+				order = rpc.OrderStable
+				break
+			}
+		}
 	}
 	return order
 }
 
+// sendNextTimeout returns the duration after which an RPC is sent to
+// the next of the supplied replica addresses. Requests which must go
+// to the leader may be delayed by Raft and use the default. Reads
+// which any replica can serve are sent on after a multiple of the
+// latency measured to the replica addressed first.
+func (ds *DistSender) sendNextTimeout(leaderOnly bool, order rpc.OrderingPolicy, addrs []net.Addr) time.Duration {
+	if leaderOnly {
+		return defaultSendNextTimeout
+	}
+	var latency time.Duration
+	switch order {
+	case rpc.OrderStable:
+		latency = ds.rpcLatency(addrs[0])
+	case rpc.OrderByLatency:
+		for _, addr := range addrs {
+			if l := ds.rpcLatency(addr); l > 0 && (latency == 0 || l < latency) {
+				latency = l
+			}
+		}
+	}
+	if latency == 0 {
+		return defaultSendNextTimeout
+	}
+	timeout := sendNextLatencyMultiple * latency
+	if timeout < minSendNextTimeout {
+		return minSendNextTimeout
+	} else if timeout > defaultSendNextTimeout {
+		return defaultSendNextTimeout
+	}
+	return timeout
+}
+
 // getNodeDescriptor returns ds.nodeDescriptor, but makes an attempt to load
 // it from the Gossip network if a nil value is found.
 // We must jump through hoops here to get the node descriptor because it's not available
@@ -391,12 +458,16 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 	replicas := proto.ReplicaSlice(append([]proto.Replica(nil), desc.Replicas...))
 	// Rearrange the replicas so that those replicas with long common
 	// prefix of attributes end up first. If there's no prefix, this is a
-	// no-op.
-	order := ds.optimizeReplicaOrder(replicas)
+	// no-op. Follower reads may be served by any replica, so prefer a
+	// local one.
+	consistency := args.Header().ReadConsistency
+	followerRead := consistency == proto.FOLLOWER && !proto.IsWrite(args)
+	order := ds.optimizeReplicaOrder(replicas, followerRead)
 
 	// If this request needs to go to a leader and we know who that is, move
 	// it to the front and send requests in order.
-	if args.Header().ReadConsistency != proto.INCONSISTENT || proto.IsWrite(args) {
+	leaderOnly := (consistency != proto.INCONSISTENT && !followerRead) || proto.IsWrite(args)
+	if leaderOnly {
 		if leader := ds.leaderCache.Lookup(proto.RaftID(desc.RaftID)); leader != nil {
 			i, _ := replicas.FindReplica(leader.StoreID)
 			if i >= 0 {
@@ -406,7 +477,10 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 		}
 	}
 
-	// Build a slice of replica addresses (if gossiped).
+	// Build a slice of replica addresses (if gossiped). A node with more
+	// than one replica, as while a replica moves between its stores, is
+	// sent the request only once, for the replica ordered first: the
+	// leader, if known.
 	var addrs []net.Addr
 	replicaMap := map[string]*proto.Replica{}
 	for i := range replicas {
@@ -415,6 +489,9 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 			log.V(1).Infof("node %d address is not gossiped: %v", replicas[i].NodeID, err)
 			continue
 		}
+		if _, ok := replicaMap[addr.String()]; ok {
+			continue
+		}
 		addrs = append(addrs, addr)
 		replicaMap[addr.String()] = &replicas[i]
 	}
@@ -431,7 +508,7 @@ func (ds *DistSender) sendRPC(desc *proto.RangeDescriptor,
 	rpcOpts := rpc.Options{
 		N:               1,
 		Ordering:        order,
-		SendNextTimeout: defaultSendNextTimeout,
+		SendNextTimeout: ds.sendNextTimeout(leaderOnly, order, addrs),
 		Timeout:         defaultRPCTimeout,
 	}
 	// getArgs clones the arguments on demand for all but the first replica.
@@ -490,10 +567,20 @@ func (ds *DistSender) Send(call client.Call) {
 	reply := call.Reply
 	endKey := args.Header().EndKey
 
+	readConsistency := args.Header().ReadConsistency
+
 	// In the event that timestamp isn't set and read consistency isn't
-	// required, set the timestamp using the local clock.
-	if args.Header().ReadConsistency == proto.INCONSISTENT && args.Header().Timestamp.Equal(proto.ZeroTimestamp) {
-		args.Header().Timestamp = ds.clock.Now()
+	// required, set the timestamp using the local clock. The same goes
+	// for follower reads, which must read at the same timestamp from
+	// each range; they read in the past, at a timestamp followers are
+	// likely to have closed.
+	if args.Header().Timestamp.Equal(proto.ZeroTimestamp) {
+		switch readConsistency {
+		case proto.INCONSISTENT:
+			args.Header().Timestamp = ds.clock.Now()
+		case proto.FOLLOWER:
+			args.Header().Timestamp = ds.clock.Now().Add(-ds.followerReadLag.Nanoseconds(), 0)
+		}
 	}
 
 	for {
@@ -511,9 +598,10 @@ func (ds *DistSender) Send(call client.Call) {
 					// If there's no transaction and op spans ranges, possibly
 					// re-run as part of a transaction for consistency. The
 					// case where we don't need to re-run is if the read
-					// consistency is not required.
-					if args.Header().Txn == nil &&
-						args.Header().ReadConsistency != proto.INCONSISTENT {
+					// consistency is not required, or the read is a
+					// follower read at a fixed timestamp.
+					if args.Header().Txn == nil && readConsistency != proto.INCONSISTENT &&
+						readConsistency != proto.FOLLOWER {
 						return util.RetryBreak, &proto.OpRequiresTxnError{}
 					}
 					// This next lookup is likely for free since we've read the
@@ -548,7 +636,16 @@ func (ds *DistSender) Send(call client.Call) {
 					if leader != nil {
 						ds.updateLeaderCache(proto.RaftID(desc.RaftID), *leader)
 					}
+					// The replica was unable to serve a follower read;
+					// send it to the leader as a consistent read.
+					if args.Header().ReadConsistency == proto.FOLLOWER {
+						args.Header().ReadConsistency = proto.CONSISTENT
+					}
 					return util.RetryReset, nil
+				case *proto.StoreBackpressureError:
+					// The leader's store is falling behind on compactions;
+					// back off before retrying to give it time to recover.
+					return util.RetryContinue, nil
 				default:
 					if retryErr, ok := err.(util.Retryable); ok && retryErr.CanRetry() {
 						return util.RetryContinue, nil
@@ -581,8 +678,10 @@ func (ds *DistSender) Send(call client.Call) {
 		})
 
 		// "Untruncate" EndKey to original. We do this even on error in
-		// case the caller will retry using the same args.
+		// case the caller will retry using the same args. Likewise,
+		// restore the read consistency for the next range.
 		args.Header().EndKey = endKey
+		args.Header().ReadConsistency = readConsistency
 
 		// Immediately return if querying a range failed non-retryably.
 		// For multi-range requests, we return the failing range's reply.
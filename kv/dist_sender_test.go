@@ -34,6 +34,7 @@ import (
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
 	gogoproto "github.com/gogo/protobuf/proto"
 )
 
@@ -132,21 +133,22 @@ func TestSendRPCOrder(t *testing.T) {
 		// Likely a test setup here will never have a read lease, but good
 		// to keep in mind.
 		consistent bool
+		follower   bool // Sent as a FOLLOWER read.
 	}{
 		// Inconsistent Scan without matching attributes.
 		{
 			args:  &proto.ScanRequest{},
 			attrs: []string{},
-			fn:    makeVerifier(rpc.OrderRandom, []int32{1, 2, 3, 4, 5}),
+			fn:    makeVerifier(rpc.OrderByLatency, []int32{1, 2, 3, 4, 5}),
 		},
 		// Inconsistent Scan with matching attributes.
 		// Should move the two nodes matching the attributes to the front and
-		// go stable.
+		// order by latency.
 		{
 			args:  &proto.ScanRequest{},
 			attrs: nodeAttrs[5],
 			// Compare only the first two resulting addresses.
-			fn: makeVerifier(rpc.OrderStable, []int32{5, 4, 0, 0, 0}),
+			fn: makeVerifier(rpc.OrderByLatency, []int32{5, 4, 0, 0, 0}),
 		},
 
 		// Scan without matching attributes that requires but does not find
@@ -154,24 +156,24 @@ func TestSendRPCOrder(t *testing.T) {
 		{
 			args:       &proto.ScanRequest{},
 			attrs:      []string{},
-			fn:         makeVerifier(rpc.OrderRandom, []int32{1, 2, 3, 4, 5}),
+			fn:         makeVerifier(rpc.OrderByLatency, []int32{1, 2, 3, 4, 5}),
 			consistent: true,
 		},
 		// Put without matching attributes that requires but does not find leader.
-		// Should go random and not change anything.
+		// Should order by latency and not change anything.
 		{
 			args:  &proto.PutRequest{},
 			attrs: []string{"nomatch"},
-			fn:    makeVerifier(rpc.OrderRandom, []int32{1, 2, 3, 4, 5}),
+			fn:    makeVerifier(rpc.OrderByLatency, []int32{1, 2, 3, 4, 5}),
 		},
 		// Put with matching attributes but no leader.
 		// Should move the two nodes matching the attributes to the front and
-		// go stable.
+		// order by latency.
 		{
 			args:  &proto.PutRequest{},
 			attrs: append(nodeAttrs[5], "irrelevant"),
 			// Compare only the first two resulting addresses.
-			fn: makeVerifier(rpc.OrderStable, []int32{5, 4, 0, 0, 0}),
+			fn: makeVerifier(rpc.OrderByLatency, []int32{5, 4, 0, 0, 0}),
 		},
 
 		// Put with matching attributes that finds the leader (node 3).
@@ -184,6 +186,24 @@ func TestSendRPCOrder(t *testing.T) {
 			fn:     makeVerifier(rpc.OrderStable, []int32{2, 5, 4, 0, 0}),
 			leader: 2,
 		},
+
+		// Follower read without matching attributes which knows the leader.
+		// Should address the local replica first and ignore the leader.
+		{
+			args:     &proto.ScanRequest{},
+			attrs:    []string{},
+			fn:       makeVerifier(rpc.OrderStable, []int32{1, 0, 0, 0, 0}),
+			leader:   2,
+			follower: true,
+		},
+		// Follower read with matching attributes. Should address the local
+		// replica first, followed by the two nodes matching the attributes.
+		{
+			args:     &proto.ScanRequest{},
+			attrs:    nodeAttrs[5],
+			fn:       makeVerifier(rpc.OrderStable, []int32{1, 5, 4, 0, 0}),
+			follower: true,
+		},
 	}
 
 	// Stub to be changed in each test case.
@@ -243,7 +263,9 @@ func TestSendRPCOrder(t *testing.T) {
 		call := client.Scan(proto.Key("b"), proto.Key("y"), 0)
 		args := tc.args
 		args.Header().RaftID = raftID // Not used in this test, but why not.
-		if !tc.consistent {
+		if tc.follower {
+			args.Header().ReadConsistency = proto.FOLLOWER
+		} else if !tc.consistent {
 			args.Header().ReadConsistency = proto.INCONSISTENT
 		}
 		// Kill the cached NodeDescriptor, enforcing a lookup from Gossip.
@@ -254,6 +276,98 @@ func TestSendRPCOrder(t *testing.T) {
 	}
 }
 
+// TestSendRPCSameNodeReplicas verifies that a node holding two replicas
+// of a range, as while a replica moves between its stores, is addressed
+// only once, for the leader if known.
+func TestSendRPCSameNodeReplicas(t *testing.T) {
+	g := makeTestGossip(t)
+	g.SetNodeDescriptor(&proto.NodeDescriptor{NodeID: 1})
+	addr := util.MakeRawAddr("tcp", "node2:8080")
+	g.AddInfo(gossip.MakeNodeIDKey(2), &proto.NodeDescriptor{
+		NodeID: 2,
+		Address: proto.Addr{
+			Network: addr.Network(),
+			Address: addr.String(),
+		},
+	}, time.Hour)
+
+	var storeIDs []proto.StoreID
+	var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+		for _, a := range addrs {
+			storeIDs = append(storeIDs, getArgs(a).(proto.Request).Header().Replica.StoreID)
+		}
+		return nil, nil
+	}
+	ds := NewDistSender(&DistSenderContext{rpcSend: testFn}, g)
+
+	descriptor := proto.RangeDescriptor{
+		RaftID: 1,
+		Replicas: []proto.Replica{
+			{NodeID: 1, StoreID: 1},
+			{NodeID: 1, StoreID: 2},
+			{NodeID: 2, StoreID: 3},
+		},
+	}
+	ds.leaderCache.Update(proto.RaftID(descriptor.RaftID), descriptor.Replicas[1])
+
+	call := client.Put(proto.Key("a"), []byte("value"))
+	if err := ds.sendRPC(&descriptor, call.Args, call.Reply); err != nil {
+		t.Fatal(err)
+	}
+	if expStoreIDs := []proto.StoreID{2, 3}; !reflect.DeepEqual(storeIDs, expStoreIDs) {
+		t.Errorf("expected replicas on stores %v to be addressed, got %v", expStoreIDs, storeIDs)
+	}
+}
+
+// TestSendNextTimeout verifies that reads which any replica can serve
+// are sent to the next replica after a multiple of the measured latency
+// of the replica addressed first, within bounds.
+func TestSendNextTimeout(t *testing.T) {
+	latencies := map[string]time.Duration{
+		"near":    5 * time.Millisecond,
+		"far":     100 * time.Millisecond,
+		"farther": 1 * time.Second,
+		"nearest": 1 * time.Millisecond,
+	}
+	ds := NewDistSender(&DistSenderContext{
+		rpcLatency: func(addr net.Addr) time.Duration {
+			return latencies[addr.String()]
+		},
+	}, makeTestGossip(t))
+	makeAddrs := func(names ...string) []net.Addr {
+		var addrs []net.Addr
+		for _, name := range names {
+			addrs = append(addrs, util.MakeRawAddr("tcp", name))
+		}
+		return addrs
+	}
+
+	testCases := []struct {
+		leaderOnly bool
+		order      rpc.OrderingPolicy
+		addrs      []net.Addr
+		expected   time.Duration
+	}{
+		// Requests to the leader always use the default.
+		{true, rpc.OrderByLatency, makeAddrs("near", "far"), defaultSendNextTimeout},
+		// The lowest latency is addressed first.
+		{false, rpc.OrderByLatency, makeAddrs("far", "near", "unknown"), 20 * time.Millisecond},
+		// The first address is addressed first.
+		{false, rpc.OrderStable, makeAddrs("far", "near"), 400 * time.Millisecond},
+		// Bounded from below and above.
+		{false, rpc.OrderStable, makeAddrs("nearest"), minSendNextTimeout},
+		{false, rpc.OrderStable, makeAddrs("farther"), defaultSendNextTimeout},
+		// Unknown latencies and random order use the default.
+		{false, rpc.OrderByLatency, makeAddrs("unknown"), defaultSendNextTimeout},
+		{false, rpc.OrderRandom, makeAddrs("near"), defaultSendNextTimeout},
+	}
+	for i, tc := range testCases {
+		if timeout := ds.sendNextTimeout(tc.leaderOnly, tc.order, tc.addrs); timeout != tc.expected {
+			t.Errorf("%d: expected timeout %s, got %s", i, tc.expected, timeout)
+		}
+	}
+}
+
 type mockRangeDescriptorDB func(proto.Key) ([]proto.RangeDescriptor, error)
 
 func (mdb mockRangeDescriptorDB) getRangeDescriptor(k proto.Key) ([]proto.RangeDescriptor, error) {
@@ -305,6 +419,129 @@ func TestRetryOnNotLeaderError(t *testing.T) {
 	}
 }
 
+// TestRetryOnStoreBackpressureError verifies that the DistSender backs
+// off and retries writes rejected by a store applying backpressure,
+// and returns the error once its retry attempts are exhausted.
+func TestRetryOnStoreBackpressureError(t *testing.T) {
+	g := makeTestGossip(t)
+	for _, rejections := range []int{2, 5} {
+		attempts := 0
+		var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+			attempts++
+			if attempts <= rejections {
+				getReply().(proto.Response).Header().SetGoError(
+					proto.NewStoreBackpressureError(1, "test"))
+			}
+			return nil, nil
+		}
+
+		ctx := &DistSenderContext{
+			rpcSend: testFn,
+			rangeDescriptorDB: mockRangeDescriptorDB(func(_ proto.Key) ([]proto.RangeDescriptor, error) {
+				return []proto.RangeDescriptor{testRangeDescriptor}, nil
+			}),
+			RPCRetryOptions: &util.RetryOptions{
+				Backoff:     time.Millisecond,
+				MaxBackoff:  time.Millisecond,
+				Constant:    2,
+				MaxAttempts: 3,
+			},
+		}
+		ds := NewDistSender(ctx, g)
+		call := client.Put(proto.Key("a"), []byte("value"))
+		reply := call.Reply.(*proto.PutResponse)
+		ds.Send(call)
+		if rejections < 3 {
+			if err := reply.GoError(); err != nil {
+				t.Errorf("%d: put encountered error: %s", rejections, err)
+			}
+			if attempts != rejections+1 {
+				t.Errorf("%d: expected %d attempts; got %d", rejections, rejections+1, attempts)
+			}
+		} else if reply.GoError() == nil {
+			t.Errorf("%d: expected put to fail once retries were exhausted", rejections)
+		}
+	}
+}
+
+// TestFollowerReadRetryOnNotLeaderError verifies that follower reads
+// are sent at a fixed timestamp and retried as consistent reads when
+// a replica is unable to serve them.
+func TestFollowerReadRetryOnNotLeaderError(t *testing.T) {
+	g := makeTestGossip(t)
+	var sent []proto.RequestHeader
+
+	var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+		sent = append(sent, *getArgs(testAddress).(proto.Request).Header())
+		if len(sent) == 1 {
+			getReply().(proto.Response).Header().SetGoError(&proto.NotLeaderError{})
+		}
+		return nil, nil
+	}
+
+	ctx := &DistSenderContext{
+		rpcSend: testFn,
+		rangeDescriptorDB: mockRangeDescriptorDB(func(_ proto.Key) ([]proto.RangeDescriptor, error) {
+			return []proto.RangeDescriptor{testRangeDescriptor}, nil
+		}),
+	}
+	ds := NewDistSender(ctx, g)
+	call := client.Get(proto.Key("a"))
+	call.Args.Header().ReadConsistency = proto.FOLLOWER
+	ds.Send(call)
+	if err := call.Reply.Header().GoError(); err != nil {
+		t.Fatalf("get encountered error: %s", err)
+	}
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 attempts; got %d", len(sent))
+	}
+	if sent[0].ReadConsistency != proto.FOLLOWER || sent[1].ReadConsistency != proto.CONSISTENT {
+		t.Errorf("expected follower read retried as consistent read; got %s, %s",
+			sent[0].ReadConsistency, sent[1].ReadConsistency)
+	}
+	if sent[0].Timestamp.Equal(proto.ZeroTimestamp) || !sent[0].Timestamp.Equal(sent[1].Timestamp) {
+		t.Errorf("expected both attempts at the same non-zero timestamp; got %s, %s",
+			sent[0].Timestamp, sent[1].Timestamp)
+	}
+	if rc := call.Args.Header().ReadConsistency; rc != proto.FOLLOWER {
+		t.Errorf("expected read consistency to be restored; got %s", rc)
+	}
+}
+
+// TestFollowerReadTimestamp verifies that follower reads which don't
+// specify a timestamp are sent at a timestamp old enough for followers
+// to have closed it.
+func TestFollowerReadTimestamp(t *testing.T) {
+	g := makeTestGossip(t)
+	var sent []proto.RequestHeader
+	var testFn rpcSendFn = func(_ rpc.Options, method string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+		sent = append(sent, *getArgs(testAddress).(proto.Request).Header())
+		return nil, nil
+	}
+
+	manual := hlc.NewManualClock(int64(time.Hour))
+	interval := time.Second
+	ctx := &DistSenderContext{
+		Clock:                   hlc.NewClock(manual.UnixNano),
+		ClosedTimestampInterval: interval,
+		rpcSend:                 testFn,
+		rangeDescriptorDB: mockRangeDescriptorDB(func(_ proto.Key) ([]proto.RangeDescriptor, error) {
+			return []proto.RangeDescriptor{testRangeDescriptor}, nil
+		}),
+	}
+	ds := NewDistSender(ctx, g)
+	call := client.Get(proto.Key("a"))
+	call.Args.Header().ReadConsistency = proto.FOLLOWER
+	ds.Send(call)
+	if err := call.Reply.Header().GoError(); err != nil {
+		t.Fatalf("get encountered error: %s", err)
+	}
+	expTS := proto.Timestamp{WallTime: int64(time.Hour) - 2*interval.Nanoseconds()}
+	if len(sent) != 1 || !sent[0].Timestamp.Equal(expTS) {
+		t.Errorf("expected follower read at %s; got %+v", expTS, sent)
+	}
+}
+
 // TestRetryOnWrongReplicaError sets up a DistSender on a minimal gossip
 // network and a mock of rpc.Send, and verifies that the DistSender correctly
 // retries upon encountering a stale entry in its range descriptor cache.
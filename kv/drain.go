@@ -0,0 +1,70 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package kv
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// A requestDrainer tracks in-flight client requests so that a server
+// can stop accepting new requests and wait for those in flight to
+// complete.
+type requestDrainer struct {
+	mu       sync.Mutex
+	draining bool
+	inFlight sync.WaitGroup
+}
+
+// start registers a new request. Returns false if the server is
+// draining, in which case the request must be rejected. Otherwise,
+// finish must be invoked once the request completes.
+func (d *requestDrainer) start() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return false
+	}
+	d.inFlight.Add(1)
+	return true
+}
+
+// finish marks a request registered with start as complete.
+func (d *requestDrainer) finish() {
+	d.inFlight.Done()
+}
+
+// drain stops accepting new requests and waits up to timeout for the
+// requests in flight to complete.
+func (d *requestDrainer) drain(timeout time.Duration) error {
+	d.mu.Lock()
+	d.draining = true
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		return util.Errorf("requests still in flight after draining for %s", timeout)
+	}
+}
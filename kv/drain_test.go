@@ -0,0 +1,40 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package kv
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRequestDrainer verifies that draining waits for in-flight
+// requests and rejects new ones.
+func TestRequestDrainer(t *testing.T) {
+	var d requestDrainer
+	if !d.start() {
+		t.Fatal("expected request to be accepted")
+	}
+	if err := d.drain(time.Millisecond); err == nil {
+		t.Error("expected drain to time out with a request in flight")
+	}
+	if d.start() {
+		t.Error("expected request to be rejected while draining")
+	}
+	d.finish()
+	if err := d.drain(time.Second); err != nil {
+		t.Errorf("expected drain to succeed; got %s", err)
+	}
+}
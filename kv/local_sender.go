@@ -164,14 +164,26 @@ func (ls *LocalSender) lookupReplica(start, end proto.Key) (raftID int64, replic
 		if rng == nil {
 			continue
 		}
+		// Skip a replica which has been removed from the range, as once
+		// moved to another store of this node, but not yet collected.
+		if _, r := rng.Desc().FindReplica(store.StoreID()); r == nil {
+			continue
+		}
 		if replica == nil {
 			raftID = rng.Desc().RaftID
 			replica = rng.GetReplica()
 			continue
 		}
-		// Should never happen outside of tests.
-		return 0, nil, util.Errorf(
-			"range %+v exists on additional store: %+v", rng, store)
+		if rng.Desc().RaftID != raftID {
+			// Should never happen outside of tests.
+			return 0, nil, util.Errorf(
+				"range %+v exists on additional store: %+v", rng, store)
+		}
+		// While a replica moves between stores of this node, both are
+		// members of the range; address the holder of the leader lease.
+		if held, _ := rng.HasLeaderLease(proto.ZeroTimestamp); held {
+			replica = rng.GetReplica()
+		}
 	}
 	if replica == nil {
 		err = proto.NewRangeKeyMismatchError(start, end, nil)
@@ -107,15 +107,19 @@ func TestLocalSenderLookupReplica(t *testing.T) {
 	ctx.Clock = hlc.NewClock(manualClock.UnixNano)
 	ls := NewLocalSender()
 
-	// Create two new stores with ranges we care about.
-	var e [2]engine.Engine
-	var s [2]*storage.Store
+	// Create three new stores with ranges we care about. The third
+	// store holds a replica which has since been moved to the second.
+	var e [3]engine.Engine
+	var s [3]*storage.Store
 	ranges := []struct {
-		storeID    proto.StoreID
-		start, end proto.Key
+		storeID     proto.StoreID
+		raftID      int64
+		start, end  proto.Key
+		descStoreID proto.StoreID
 	}{
-		{2, proto.Key("a"), proto.Key("c")},
-		{3, proto.Key("x"), proto.Key("z")},
+		{2, 0, proto.Key("a"), proto.Key("c"), 2},
+		{3, 1, proto.Key("x"), proto.Key("z"), 3},
+		{4, 1, proto.Key("x"), proto.Key("z"), 3},
 	}
 	for i, rng := range ranges {
 		e[i] = engine.NewInMem(proto.Attributes{}, 1<<20)
@@ -125,10 +129,10 @@ func TestLocalSenderLookupReplica(t *testing.T) {
 		s[i].Ident.StoreID = rng.storeID
 
 		desc := &proto.RangeDescriptor{
-			RaftID:   int64(i),
+			RaftID:   rng.raftID,
 			StartKey: rng.start,
 			EndKey:   rng.end,
-			Replicas: []proto.Replica{{StoreID: rng.storeID}},
+			Replicas: []proto.Replica{{StoreID: rng.descStoreID}},
 		}
 		newRng, err := storage.NewRange(desc, s[i])
 		if err != nil {
@@ -25,6 +25,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
@@ -87,7 +88,8 @@ var routingTable = map[string]map[string]actionHandler{
 // A RESTServer provides a RESTful HTTP API to interact with
 // an underlying key-value store.
 type RESTServer struct {
-	db *client.KV // Key-value database client
+	db      *client.KV // Key-value database client
+	drainer requestDrainer
 }
 
 // NewRESTServer allocates and returns a new server.
@@ -98,6 +100,12 @@ func NewRESTServer(db *client.KV) *RESTServer {
 // ServeHTTP satisfies the http.Handler interface and arbitrates requests
 // to the appropriate function based on the request’s HTTP method.
 func (s *RESTServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.drainer.start() {
+		http.Error(w, "service is draining", http.StatusServiceUnavailable)
+		return
+	}
+	defer s.drainer.finish()
+
 	for endPoint, epRoutes := range routingTable {
 		if strings.HasPrefix(r.URL.Path, endPoint) {
 			epHandler := epRoutes[r.Method]
@@ -112,6 +120,13 @@ func (s *RESTServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 }
 
+// Drain stops the server from accepting new requests and waits up to
+// timeout for the requests in flight to complete. Requests received
+// while draining are rejected.
+func (s *RESTServer) Drain(timeout time.Duration) error {
+	return s.drainer.drain(timeout)
+}
+
 // writeJSON marshals v to JSON and writes the result to w with
 // the given status code.
 func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
@@ -152,8 +167,51 @@ const (
 	rangeParamStart = "start"
 	rangeParamEnd   = "end"
 	rangeParamLimit = "limit"
+	// asOfParam specifies the wall time, in nanoseconds since the Unix
+	// epoch, as of which entries and ranges are read.
+	asOfParam = "as_of"
+	// expireAtParam specifies the wall time, in nanoseconds since the
+	// Unix epoch, at which put entries expire.
+	expireAtParam = "expire_at"
 )
 
+// parseAsOf returns the timestamp specified by the request's as_of
+// parameter, or the zero timestamp to read at the current time. It
+// writes an error response and returns false if the parameter is
+// invalid, or set for a write.
+func parseAsOf(w http.ResponseWriter, r *http.Request, isRead bool) (proto.Timestamp, bool) {
+	param := r.URL.Query().Get(asOfParam)
+	if len(param) == 0 {
+		return proto.ZeroTimestamp, true
+	}
+	if !isRead {
+		http.Error(w, asOfParam+" is only supported for reads", http.StatusBadRequest)
+		return proto.ZeroTimestamp, false
+	}
+	wallTime, err := strconv.ParseInt(param, 10, 64)
+	if err != nil || wallTime <= 0 {
+		http.Error(w, "invalid "+asOfParam+" timestamp: "+param, http.StatusBadRequest)
+		return proto.ZeroTimestamp, false
+	}
+	return proto.Timestamp{WallTime: wallTime}, true
+}
+
+// parseExpireAt returns the expiration specified by the request's
+// expire_at parameter, or nil if the entry doesn't expire. It writes
+// an error response and returns false if the parameter is invalid.
+func parseExpireAt(w http.ResponseWriter, r *http.Request) (*proto.Timestamp, bool) {
+	param := r.URL.Query().Get(expireAtParam)
+	if len(param) == 0 {
+		return nil, true
+	}
+	wallTime, err := strconv.ParseInt(param, 10, 64)
+	if err != nil || wallTime <= 0 {
+		http.Error(w, "invalid "+expireAtParam+" timestamp: "+param, http.StatusBadRequest)
+		return nil, false
+	}
+	return &proto.Timestamp{WallTime: wallTime}, true
+}
+
 func (s *RESTServer) handleRangeAction(w http.ResponseWriter, r *http.Request) {
 	// TODO(andybons): Allow the client to specify range parameters via
 	// request headers as well, allowing query parameters to override the
@@ -182,10 +240,15 @@ func (s *RESTServer) handleRangeAction(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "limit must be non-negative", http.StatusBadRequest)
 		return
 	}
+	timestamp, ok := parseAsOf(w, r, r.Method == methodGet)
+	if !ok {
+		return
+	}
 	reqHeader := proto.RequestHeader{
-		Key:    startKey,
-		EndKey: endKey,
-		User:   storage.UserRoot,
+		Key:       startKey,
+		EndKey:    endKey,
+		User:      storage.UserRoot,
+		Timestamp: timestamp,
 	}
 	var results proto.Response
 	if r.Method == methodGet {
@@ -213,6 +276,9 @@ func (s *RESTServer) handleRangeAction(w http.ResponseWriter, r *http.Request) {
 func (s *RESTServer) handleCounterAction(w http.ResponseWriter, r *http.Request, key proto.Key) {
 	// GET Requests are just an increment with 0 value.
 	var inputVal int64
+	if _, ok := parseAsOf(w, r, false); !ok {
+		return
+	}
 
 	if r.Method == methodPost {
 		b, err := ioutil.ReadAll(r.Body)
@@ -245,6 +311,13 @@ func (s *RESTServer) handleCounterAction(w http.ResponseWriter, r *http.Request,
 }
 
 func (s *RESTServer) handlePutAction(w http.ResponseWriter, r *http.Request, key proto.Key) {
+	if _, ok := parseAsOf(w, r, false); !ok {
+		return
+	}
+	expiration, ok := parseExpireAt(w, r)
+	if !ok {
+		return
+	}
 	b, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -258,7 +331,7 @@ func (s *RESTServer) handlePutAction(w http.ResponseWriter, r *http.Request, key
 				Key:  key,
 				User: storage.UserRoot,
 			},
-			Value: proto.Value{Bytes: b},
+			Value: proto.Value{Bytes: b, Expiration: expiration},
 		},
 		Reply: pr}); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -268,12 +341,17 @@ func (s *RESTServer) handlePutAction(w http.ResponseWriter, r *http.Request, key
 }
 
 func (s *RESTServer) handleGetAction(w http.ResponseWriter, r *http.Request, key proto.Key) {
+	timestamp, ok := parseAsOf(w, r, true)
+	if !ok {
+		return
+	}
 	gr := &proto.GetResponse{}
 	if err := s.db.Run(client.Call{
 		Args: &proto.GetRequest{
 			RequestHeader: proto.RequestHeader{
-				Key:  key,
-				User: storage.UserRoot,
+				Key:       key,
+				User:      storage.UserRoot,
+				Timestamp: timestamp,
 			},
 		}, Reply: gr}); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -288,12 +366,17 @@ func (s *RESTServer) handleGetAction(w http.ResponseWriter, r *http.Request, key
 }
 
 func (s *RESTServer) handleHeadAction(w http.ResponseWriter, r *http.Request, key proto.Key) {
+	timestamp, ok := parseAsOf(w, r, true)
+	if !ok {
+		return
+	}
 	cr := &proto.ContainsResponse{}
 	if err := s.db.Run(client.Call{
 		Args: &proto.ContainsRequest{
 			RequestHeader: proto.RequestHeader{
-				Key:  key,
-				User: storage.UserRoot,
+				Key:       key,
+				User:      storage.UserRoot,
+				Timestamp: timestamp,
 			},
 		}, Reply: cr}); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -307,6 +390,9 @@ func (s *RESTServer) handleHeadAction(w http.ResponseWriter, r *http.Request, ke
 }
 
 func (s *RESTServer) handleDeleteAction(w http.ResponseWriter, r *http.Request, key proto.Key) {
+	if _, ok := parseAsOf(w, r, false); !ok {
+		return
+	}
 	dr := &proto.DeleteResponse{}
 	if err := s.db.Run(client.Call{
 		Args: &proto.DeleteRequest{
@@ -231,6 +231,10 @@ func (tc *TxnCoordSender) maybeBeginTxn(header *proto.RequestHeader) {
 			if newTxn.Priority < header.Txn.Priority {
 				newTxn.Priority = header.Txn.Priority
 			}
+			// Carry over savepoint state which the client may have
+			// accumulated before the txn was first sent.
+			newTxn.Sequence = header.Txn.Sequence
+			newTxn.IgnoredSeqs = header.Txn.IgnoredSeqs
 			header.Txn = newTxn
 		}
 	}
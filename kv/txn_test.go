@@ -694,3 +694,129 @@ func TestTxnRestartedSerializableTimestampRegression(t *testing.T) {
 	// Wait for txnA to finish.
 	<-ch
 }
+
+// TestTxnSnapshotCommitsPushedTimestamp verifies that a SNAPSHOT txn
+// whose timestamp is pushed by a concurrent read commits without
+// restarting, whereas a SERIALIZABLE txn restarts.
+func TestTxnSnapshotCommitsPushedTimestamp(t *testing.T) {
+	s := createTestDB(t)
+	defer s.Stop()
+
+	testCases := []struct {
+		isolation   proto.IsolationType
+		expAttempts int
+	}{
+		{proto.SERIALIZABLE, 2},
+		{proto.SNAPSHOT, 1},
+	}
+	for i, test := range testCases {
+		key := proto.Key(fmt.Sprintf("key-%d", i))
+		// Use the lowest priority so the non-transactional read can
+		// always push.
+		txnOpts := &client.TransactionOptions{
+			Name:         "test",
+			Isolation:    test.isolation,
+			UserPriority: -1,
+		}
+		attempts := 0
+		err := s.KV.RunTransaction(txnOpts, func(txn *client.Txn) error {
+			attempts++
+			if err := txn.Run(client.Put(key, []byte("value"))); err != nil {
+				return err
+			}
+			// Read outside of the txn on the first attempt only, pushing
+			// the txn's timestamp.
+			if attempts == 1 {
+				return s.KV.Run(client.Get(key))
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if attempts != test.expAttempts {
+			t.Errorf("%d: expected %d attempt(s); got %d", i, test.expAttempts, attempts)
+		}
+	}
+}
+
+// TestTxnWriteSkew verifies that two txns which each read keys A and B
+// and then write the sum of both plus one to one of them, txn1 to A
+// and txn2 to B, both commit at SNAPSHOT isolation, exhibiting write
+// skew, whereas at SERIALIZABLE isolation txn1, whose write is pushed
+// past txn2's read of A, restarts and reads txn2's write.
+func TestTxnWriteSkew(t *testing.T) {
+	testCases := []struct {
+		isolation    proto.IsolationType
+		expAttempts1 int
+		expA, expB   int64
+	}{
+		{proto.SNAPSHOT, 1, 1, 1},
+		{proto.SERIALIZABLE, 2, 2, 1},
+	}
+	for i, test := range testCases {
+		func() {
+			s := createTestDB(t)
+			defer s.Stop()
+			keyA, keyB := proto.Key("a"), proto.Key("b")
+			txnOpts := &client.TransactionOptions{Name: "test", Isolation: test.isolation}
+
+			// readSum reads A and B within the txn and returns their sum.
+			readSum := func(txn *client.Txn) (int64, error) {
+				getA, getB := client.Get(keyA), client.Get(keyB)
+				if err := txn.Run(getA, getB); err != nil {
+					return 0, err
+				}
+				return getA.Reply.(*proto.GetResponse).Value.GetInteger() +
+					getB.Reply.(*proto.GetResponse).Value.GetInteger(), nil
+			}
+
+			read1 := make(chan struct{})
+			done2 := make(chan error, 1)
+			attempts1, attempts2 := 0, 0
+			go func() {
+				<-read1
+				done2 <- s.KV.RunTransaction(txnOpts, func(txn *client.Txn) error {
+					attempts2++
+					sum, err := readSum(txn)
+					if err != nil {
+						return err
+					}
+					return txn.Run(client.Increment(keyB, sum+1))
+				})
+			}()
+			err := s.KV.RunTransaction(txnOpts, func(txn *client.Txn) error {
+				attempts1++
+				sum, err := readSum(txn)
+				if err != nil {
+					return err
+				}
+				// On the first attempt, let txn2 read A and B and commit
+				// its write before writing A.
+				if attempts1 == 1 {
+					close(read1)
+					if err := <-done2; err != nil {
+						t.Errorf("%d: txn2 failed: %s", i, err)
+					}
+				}
+				return txn.Run(client.Increment(keyA, sum+1))
+			})
+			if err != nil {
+				t.Fatalf("%d: txn1 failed: %s", i, err)
+			}
+			if attempts1 != test.expAttempts1 || attempts2 != 1 {
+				t.Errorf("%d: expected %d and 1 attempt(s); got %d and %d", i, test.expAttempts1, attempts1, attempts2)
+			}
+
+			getA, getB := client.Get(keyA), client.Get(keyB)
+			if err := s.KV.Run(getA, getB); err != nil {
+				t.Fatal(err)
+			}
+			a := getA.Reply.(*proto.GetResponse).Value.GetInteger()
+			b := getB.Reply.(*proto.GetResponse).Value.GetInteger()
+			if a != test.expA || b != test.expB {
+				t.Errorf("%d: expected A=%d, B=%d; got A=%d, B=%d", i, test.expA, test.expB, a, b)
+			}
+		}()
+	}
+}
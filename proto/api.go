@@ -227,6 +227,19 @@ func (rh *ResponseHeader) SetGoError(err error) {
 	}
 }
 
+// GoError returns the error ending the change feed, if any.
+func (e *ChangeFeedEvent) GoError() error {
+	rh := ResponseHeader{Error: e.Error}
+	return rh.GoError()
+}
+
+// SetGoError sets the error ending the change feed.
+func (e *ChangeFeedEvent) SetGoError(err error) {
+	rh := ResponseHeader{}
+	rh.SetGoError(err)
+	e.Error = rh.Error
+}
+
 // Verify verifies the integrity of the get response value.
 func (gr *GetResponse) Verify(req Request) error {
 	if gr.Value != nil {
@@ -337,6 +350,15 @@ func (*AdminSplitRequest) Method() Method { return AdminSplit }
 // Method implements the Request interface.
 func (*AdminMergeRequest) Method() Method { return AdminMerge }
 
+// Method implements the Request interface.
+func (*AdminTransferLeaseRequest) Method() Method { return AdminTransferLease }
+
+// Method implements the Request interface.
+func (*IngestRequest) Method() Method { return Ingest }
+
+// Method implements the Request interface.
+func (*ExportRequest) Method() Method { return Export }
+
 // Method implements the Request interface.
 func (*InternalHeartbeatTxnRequest) Method() Method { return InternalHeartbeatTxn }
 
@@ -346,6 +368,9 @@ func (*InternalGCRequest) Method() Method { return InternalGC }
 // Method implements the Request interface.
 func (*InternalPushTxnRequest) Method() Method { return InternalPushTxn }
 
+// Method implements the Request interface.
+func (*InternalQueryTxnRequest) Method() Method { return InternalQueryTxn }
+
 // Method implements the Request interface.
 func (*InternalRangeLookupRequest) Method() Method { return InternalRangeLookup }
 
@@ -361,6 +386,9 @@ func (*InternalLeaderLeaseRequest) Method() Method { return InternalLeaderLease
 // Method implements the Request interface.
 func (*InternalTruncateLogRequest) Method() Method { return InternalTruncateLog }
 
+// Method implements the Request interface.
+func (*InternalCloseTimestampRequest) Method() Method { return InternalCloseTimestamp }
+
 // Method implements the Request interface.
 func (*InternalBatchRequest) Method() Method { return InternalBatch }
 
@@ -400,6 +428,15 @@ func (*AdminSplitRequest) CreateReply() Response { return &AdminSplitResponse{}
 // CreateReply implements the Request interface.
 func (*AdminMergeRequest) CreateReply() Response { return &AdminMergeResponse{} }
 
+// CreateReply implements the Request interface.
+func (*AdminTransferLeaseRequest) CreateReply() Response { return &AdminTransferLeaseResponse{} }
+
+// CreateReply implements the Request interface.
+func (*IngestRequest) CreateReply() Response { return &IngestResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ExportRequest) CreateReply() Response { return &ExportResponse{} }
+
 // CreateReply implements the Request interface.
 func (*InternalHeartbeatTxnRequest) CreateReply() Response { return &InternalHeartbeatTxnResponse{} }
 
@@ -409,6 +446,9 @@ func (*InternalGCRequest) CreateReply() Response { return &InternalGCResponse{}
 // CreateReply implements the Request interface.
 func (*InternalPushTxnRequest) CreateReply() Response { return &InternalPushTxnResponse{} }
 
+// CreateReply implements the Request interface.
+func (*InternalQueryTxnRequest) CreateReply() Response { return &InternalQueryTxnResponse{} }
+
 // CreateReply implements the Request interface.
 func (*InternalRangeLookupRequest) CreateReply() Response { return &InternalRangeLookupResponse{} }
 
@@ -424,27 +464,37 @@ func (*InternalTruncateLogRequest) CreateReply() Response { return &InternalTrun
 // CreateReply implements the Request interface.
 func (*InternalLeaderLeaseRequest) CreateReply() Response { return &InternalLeaderLeaseResponse{} }
 
+// CreateReply implements the Request interface.
+func (*InternalCloseTimestampRequest) CreateReply() Response {
+	return &InternalCloseTimestampResponse{}
+}
+
 // CreateReply implements the Request interface.
 func (*InternalBatchRequest) CreateReply() Response { return &InternalBatchResponse{} }
 
-func (*ContainsRequest) flags() int              { return isRead }
-func (*GetRequest) flags() int                   { return isRead }
-func (*PutRequest) flags() int                   { return isWrite | isTxnWrite }
-func (*ConditionalPutRequest) flags() int        { return isRead | isWrite | isTxnWrite }
-func (*IncrementRequest) flags() int             { return isRead | isWrite | isTxnWrite }
-func (*DeleteRequest) flags() int                { return isWrite | isTxnWrite }
-func (*DeleteRangeRequest) flags() int           { return isWrite | isTxnWrite }
-func (*ScanRequest) flags() int                  { return isRead }
-func (*EndTransactionRequest) flags() int        { return isWrite }
-func (*BatchRequest) flags() int                 { return isWrite }
-func (*AdminSplitRequest) flags() int            { return isAdmin }
-func (*AdminMergeRequest) flags() int            { return isAdmin }
-func (*InternalHeartbeatTxnRequest) flags() int  { return isWrite }
-func (*InternalGCRequest) flags() int            { return isWrite }
-func (*InternalPushTxnRequest) flags() int       { return isWrite }
-func (*InternalRangeLookupRequest) flags() int   { return isRead }
-func (*InternalResolveIntentRequest) flags() int { return isWrite }
-func (*InternalMergeRequest) flags() int         { return isWrite }
-func (*InternalTruncateLogRequest) flags() int   { return isWrite }
-func (*InternalLeaderLeaseRequest) flags() int   { return isWrite }
-func (*InternalBatchRequest) flags() int         { return isWrite }
+func (*ContainsRequest) flags() int               { return isRead }
+func (*GetRequest) flags() int                    { return isRead }
+func (*PutRequest) flags() int                    { return isWrite | isTxnWrite }
+func (*ConditionalPutRequest) flags() int         { return isRead | isWrite | isTxnWrite }
+func (*IncrementRequest) flags() int              { return isRead | isWrite | isTxnWrite }
+func (*DeleteRequest) flags() int                 { return isWrite | isTxnWrite }
+func (*DeleteRangeRequest) flags() int            { return isWrite | isTxnWrite }
+func (*ScanRequest) flags() int                   { return isRead }
+func (*EndTransactionRequest) flags() int         { return isWrite }
+func (*BatchRequest) flags() int                  { return isWrite }
+func (*AdminSplitRequest) flags() int             { return isAdmin }
+func (*AdminMergeRequest) flags() int             { return isAdmin }
+func (*AdminTransferLeaseRequest) flags() int     { return isAdmin }
+func (*IngestRequest) flags() int                 { return isWrite }
+func (*ExportRequest) flags() int                 { return isRead }
+func (*InternalHeartbeatTxnRequest) flags() int   { return isWrite }
+func (*InternalGCRequest) flags() int             { return isWrite }
+func (*InternalPushTxnRequest) flags() int        { return isWrite }
+func (*InternalQueryTxnRequest) flags() int       { return isRead }
+func (*InternalRangeLookupRequest) flags() int    { return isRead }
+func (*InternalResolveIntentRequest) flags() int  { return isWrite }
+func (*InternalMergeRequest) flags() int          { return isWrite }
+func (*InternalTruncateLogRequest) flags() int    { return isWrite }
+func (*InternalLeaderLeaseRequest) flags() int    { return isWrite }
+func (*InternalCloseTimestampRequest) flags() int { return isWrite }
+func (*InternalBatchRequest) flags() int          { return isWrite }
@@ -118,9 +118,19 @@ func (m *GCPolicy) GetTTLSeconds() int32 {
 	return 0
 }
 
-// AcctConfig holds accounting configuration.
+// AcctConfig holds accounting configuration. Zero quotas and limits
+// are unlimited.
 type AcctConfig struct {
-	ClusterId        string `protobuf:"bytes,1,opt,name=cluster_id" json:"cluster_id" yaml:"cluster_id,omitempty"`
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id" json:"cluster_id" yaml:"cluster_id,omitempty"`
+	// MaxBytes is the quota for the total bytes (keys and values) stored
+	// under the accounting prefix.
+	MaxBytes int64 `protobuf:"varint,2,opt,name=max_bytes" json:"max_bytes" yaml:"max_bytes,omitempty"`
+	// MaxKeys is the quota for the number of live keys stored under the
+	// accounting prefix.
+	MaxKeys int64 `protobuf:"varint,3,opt,name=max_keys" json:"max_keys" yaml:"max_keys,omitempty"`
+	// MaxQPS limits the rate of requests per second to keys under the
+	// accounting prefix, enforced separately by each store.
+	MaxQPS           int64  `protobuf:"varint,4,opt,name=max_qps" json:"max_qps" yaml:"max_qps,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -135,6 +145,27 @@ func (m *AcctConfig) GetClusterId() string {
 	return ""
 }
 
+func (m *AcctConfig) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *AcctConfig) GetMaxKeys() int64 {
+	if m != nil {
+		return m.MaxKeys
+	}
+	return 0
+}
+
+func (m *AcctConfig) GetMaxQPS() int64 {
+	if m != nil {
+		return m.MaxQPS
+	}
+	return 0
+}
+
 // PermConfig holds permission configuration, specifying read/write ACLs.
 type PermConfig struct {
 	// ACL lists users with read permissions.
@@ -324,11 +355,13 @@ func (m *NodeDescriptor) GetAttrs() Attributes {
 // StoreDescriptor holds store information including store attributes, node
 // descriptor and store capacity.
 type StoreDescriptor struct {
-	StoreID          StoreID        `protobuf:"varint,1,opt,name=store_id,customtype=StoreID" json:"store_id"`
-	Attrs            Attributes     `protobuf:"bytes,2,opt,name=attrs" json:"attrs"`
-	Node             NodeDescriptor `protobuf:"bytes,3,opt,name=node" json:"node"`
-	Capacity         StoreCapacity  `protobuf:"bytes,4,opt,name=capacity" json:"capacity"`
-	XXX_unrecognized []byte         `json:"-"`
+	StoreID  StoreID        `protobuf:"varint,1,opt,name=store_id,customtype=StoreID" json:"store_id"`
+	Attrs    Attributes     `protobuf:"bytes,2,opt,name=attrs" json:"attrs"`
+	Node     NodeDescriptor `protobuf:"bytes,3,opt,name=node" json:"node"`
+	Capacity StoreCapacity  `protobuf:"bytes,4,opt,name=capacity" json:"capacity"`
+	// The number of ranges for which this store holds the leader lease.
+	LeaseCount       int32  `protobuf:"varint,5,opt,name=lease_count" json:"lease_count"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *StoreDescriptor) Reset()         { *m = StoreDescriptor{} }
@@ -356,6 +389,13 @@ func (m *StoreDescriptor) GetCapacity() StoreCapacity {
 	return StoreCapacity{}
 }
 
+func (m *StoreDescriptor) GetLeaseCount() int32 {
+	if m != nil {
+		return m.LeaseCount
+	}
+	return 0
+}
+
 func init() {
 }
 func (m *Attributes) Unmarshal(data []byte) error {
@@ -744,6 +784,51 @@ func (m *AcctConfig) Unmarshal(data []byte) error {
 			}
 			m.ClusterId = string(data[index:postIndex])
 			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.MaxBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeys", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.MaxKeys |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQPS", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.MaxQPS |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			var sizeOfWire int
 			for {
@@ -1567,6 +1652,21 @@ func (m *StoreDescriptor) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseCount", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.LeaseCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			var sizeOfWire int
 			for {
@@ -1653,6 +1753,9 @@ func (m *AcctConfig) Size() (n int) {
 	_ = l
 	l = len(m.ClusterId)
 	n += 1 + l + sovConfig(uint64(l))
+	n += 1 + sovConfig(uint64(m.MaxBytes))
+	n += 1 + sovConfig(uint64(m.MaxKeys))
+	n += 1 + sovConfig(uint64(m.MaxQPS))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1782,6 +1885,7 @@ func (m *StoreDescriptor) Size() (n int) {
 	n += 1 + l + sovConfig(uint64(l))
 	l = m.Capacity.Size()
 	n += 1 + l + sovConfig(uint64(l))
+	n += 1 + sovConfig(uint64(m.LeaseCount))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1967,6 +2071,15 @@ func (m *AcctConfig) MarshalTo(data []byte) (n int, err error) {
 	i++
 	i = encodeVarintConfig(data, i, uint64(len(m.ClusterId)))
 	i += copy(data[i:], m.ClusterId)
+	data[i] = 0x10
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.MaxBytes))
+	data[i] = 0x18
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.MaxKeys))
+	data[i] = 0x20
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.MaxQPS))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -2305,6 +2418,9 @@ func (m *StoreDescriptor) MarshalTo(data []byte) (n int, err error) {
 		return 0, err
 	}
 	i += n14
+	data[i] = 0x28
+	i++
+	i = encodeVarintConfig(data, i, uint64(m.LeaseCount))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -351,6 +351,12 @@ func (v *Value) Verify(key []byte) error {
 	return nil
 }
 
+// IsExpired returns true if the value has an expiration at or before
+// the specified timestamp. A nil value never expires.
+func (v *Value) IsExpired(timestamp Timestamp) bool {
+	return v != nil && v.Expiration != nil && !timestamp.Less(*v.Expiration)
+}
+
 // computeChecksum computes a checksum based on the provided key and
 // the contents of the value. If the value contains a byte slice, the
 // checksum includes it directly; if the value contains an integer,
@@ -499,6 +505,26 @@ func (t *Transaction) Update(o *Transaction) {
 	t.CertainNodes = NodeList{Nodes: append(Int32Slice(nil),
 		o.CertainNodes.Nodes...)}
 	t.UpgradePriority(o.Priority)
+	if t.Sequence < o.Sequence {
+		t.Sequence = o.Sequence
+	}
+	// Rolled back sequence ranges are only ever appended to.
+	if len(t.IgnoredSeqs) < len(o.IgnoredSeqs) {
+		t.IgnoredSeqs = append([]SequenceRange(nil), o.IgnoredSeqs...)
+	}
+}
+
+// IsSeqIgnored returns true if the specified sequence number falls
+// within a range which was rolled back to a savepoint. Intents
+// written at an ignored sequence number are not visible to the
+// transaction and are not committed.
+func (t *Transaction) IsSeqIgnored(seq int32) bool {
+	for _, r := range t.IgnoredSeqs {
+		if r.Start <= seq && seq <= r.End {
+			return true
+		}
+	}
+	return false
 }
 
 // UpgradePriority sets transaction priority to the maximum of current
@@ -529,6 +555,12 @@ func (mvcc *MVCCMetadata) IsInline() bool {
 	return mvcc.Value != nil
 }
 
+// IsExpired returns true if the most recent versioned value isn't a
+// deletion tombstone and has expired by the specified timestamp.
+func (mvcc *MVCCMetadata) IsExpired(timestamp Timestamp) bool {
+	return !mvcc.Deleted && mvcc.Expiration != nil && !timestamp.Less(*mvcc.Expiration)
+}
+
 // NewGCMetadata returns a GCMetadata initialized to have a ByteCounts
 // slice with ten byte count values set to zero.  Now is specified as
 // nanoseconds since the Unix epoch.
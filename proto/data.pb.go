@@ -51,13 +51,25 @@ func (x *ReplicaChangeType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// IsolationType TODO(jiajia) Needs documentation.
+// IsolationType specifies the isolation level of a transaction. Under
+// both levels, a transaction reads a consistent snapshot as of its
+// original timestamp and writes at its (possibly pushed) timestamp.
+// The levels differ in whether the transaction may commit after its
+// timestamp was pushed past the original timestamp.
 type IsolationType int32
 
 const (
-	// SERIALIZABLE TODO(jiajia) Needs documentation.
+	// SERIALIZABLE transactions must commit at their original
+	// timestamp. If the timestamp was pushed, for example by a
+	// concurrent read of a key written by the transaction, the reads
+	// performed at the original timestamp may no longer be valid and
+	// the transaction restarts at the pushed timestamp.
 	SERIALIZABLE IsolationType = 0
-	// SNAPSHOT TODO(jiajia) Needs documentation.
+	// SNAPSHOT transactions may commit at a pushed timestamp, so reads
+	// which conflict with their writes never cause restarts. A SNAPSHOT
+	// transaction may not overwrite a value committed after its original
+	// timestamp, which prevents lost updates, but it's subject to write
+	// skew: two transactions may each read what the other writes.
 	SNAPSHOT IsolationType = 1
 )
 
@@ -191,8 +203,12 @@ type Value struct {
 	// Tag is an optional string value which can be used to add additional
 	// metadata to this value. For example, Tag might provide information on how
 	// the bytes in the "bytes" field should be interpreted.
-	Tag              *string `protobuf:"bytes,5,opt,name=tag" json:"tag,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Tag *string `protobuf:"bytes,5,opt,name=tag" json:"tag,omitempty"`
+	// Expiration, if set, is the timestamp at which the value expires.
+	// Reads at or after it don't see the value, and the value is garbage
+	// collected like a deletion tombstone written at that time.
+	Expiration       *Timestamp `protobuf:"bytes,6,opt,name=expiration" json:"expiration,omitempty"`
+	XXX_unrecognized []byte     `json:"-"`
 }
 
 func (m *Value) Reset()         { *m = Value{} }
@@ -234,6 +250,13 @@ func (m *Value) GetTag() string {
 	return ""
 }
 
+func (m *Value) GetExpiration() *Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // MVCCValue differentiates between normal versioned values and
 // deletion tombstones.
 type MVCCValue struct {
@@ -462,6 +485,31 @@ func (m *NodeList) GetNodes() []int32 {
 	return nil
 }
 
+// SequenceRange is an inclusive range of transaction sequence numbers.
+type SequenceRange struct {
+	Start            int32  `protobuf:"varint,1,opt,name=start" json:"start"`
+	End              int32  `protobuf:"varint,2,opt,name=end" json:"end"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *SequenceRange) Reset()         { *m = SequenceRange{} }
+func (m *SequenceRange) String() string { return proto1.CompactTextString(m) }
+func (*SequenceRange) ProtoMessage()    {}
+
+func (m *SequenceRange) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *SequenceRange) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
 // A Transaction is a unit of work performed on the database.
 // Cockroach transactions support two isolation levels: snapshot
 // isolation and serializable snapshot isolation. Each Cockroach
@@ -487,9 +535,10 @@ type Transaction struct {
 	// The proposed timestamp for the transaction. This starts as
 	// the current wall time on the txn coordinator.
 	Timestamp Timestamp `protobuf:"bytes,9,opt,name=timestamp" json:"timestamp"`
-	// The original timestamp at which the transaction started. For serializable
-	// transactions, if the timestamp drifts from the original timestamp, the
-	// transaction will retry.
+	// The original timestamp at which the transaction started. Reads are
+	// performed at this timestamp. For serializable transactions, if the
+	// timestamp drifts from the original timestamp, the transaction will
+	// retry; snapshot transactions commit at the drifted timestamp.
 	OrigTimestamp Timestamp `protobuf:"bytes,10,opt,name=orig_timestamp" json:"orig_timestamp"`
 	// Initial Timestamp + clock skew. Reads which encounter values with
 	// timestamps between Timestamp and MaxTimestamp trigger a txn
@@ -516,8 +565,16 @@ type Transaction struct {
 	// Bits of this mechanism are found in the local sender, the range and the
 	// txn_coord_sender, with brief comments referring here.
 	// See https://github.com/cockroachdb/cockroach/pull/221.
-	CertainNodes     NodeList `protobuf:"bytes,12,opt,name=certain_nodes" json:"certain_nodes"`
-	XXX_unrecognized []byte   `json:"-"`
+	CertainNodes NodeList `protobuf:"bytes,12,opt,name=certain_nodes" json:"certain_nodes"`
+	// The sequence number of the transaction's most recent request.
+	// Incremented by the client for each request sent within the
+	// transaction, it's recorded with each intent the transaction writes.
+	Sequence int32 `protobuf:"varint,13,opt,name=sequence" json:"sequence"`
+	// Ranges of sequence numbers whose writes were rolled back to a
+	// savepoint. Intents written at an ignored sequence number are
+	// invisible to the transaction and are not committed.
+	IgnoredSeqs      []SequenceRange `protobuf:"bytes,14,rep,name=ignored_seqs" json:"ignored_seqs"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -600,6 +657,20 @@ func (m *Transaction) GetCertainNodes() NodeList {
 	return NodeList{}
 }
 
+func (m *Transaction) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Transaction) GetIgnoredSeqs() []SequenceRange {
+	if m != nil {
+		return m.IgnoredSeqs
+	}
+	return nil
+}
+
 // Lease contains information about leader leases including the
 // expiration and lease holder.
 type Lease struct {
@@ -638,6 +709,32 @@ func (m *Lease) GetRaftNodeID() uint64 {
 	return 0
 }
 
+// MVCCSequencedValue is a value written by a transaction at a
+// sequence number.
+type MVCCSequencedValue struct {
+	Sequence         int32     `protobuf:"varint,1,opt,name=sequence" json:"sequence"`
+	Value            MVCCValue `protobuf:"bytes,2,opt,name=value" json:"value"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *MVCCSequencedValue) Reset()         { *m = MVCCSequencedValue{} }
+func (m *MVCCSequencedValue) String() string { return proto1.CompactTextString(m) }
+func (*MVCCSequencedValue) ProtoMessage()    {}
+
+func (m *MVCCSequencedValue) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MVCCSequencedValue) GetValue() MVCCValue {
+	if m != nil {
+		return m.Value
+	}
+	return MVCCValue{}
+}
+
 // MVCCMetadata holds MVCC metadata for a key. Used by storage/engine/mvcc.go.
 type MVCCMetadata struct {
 	Txn *Transaction `protobuf:"bytes,1,opt,name=txn" json:"txn,omitempty"`
@@ -654,8 +751,17 @@ type MVCCMetadata struct {
 	// and subsequent version rows. If timestamp == (0, 0), then there
 	// is only a single MVCC metadata row with value inlined, and with
 	// empty timestamp, key_bytes, and val_bytes.
-	Value            *Value `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
-	XXX_unrecognized []byte `json:"-"`
+	Value *Value `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
+	// The sequence number at which the intent's transaction wrote the
+	// most recent versioned value. Zero for committed values.
+	Sequence int32 `protobuf:"varint,7,opt,name=sequence" json:"sequence"`
+	// Values previously written to the key by the intent's transaction,
+	// in increasing sequence order. Used to restore an earlier value if
+	// the transaction rolls back to a savepoint.
+	IntentHistory []MVCCSequencedValue `protobuf:"bytes,8,rep,name=intent_history" json:"intent_history"`
+	// The expiration of the most recent versioned value, if any.
+	Expiration       *Timestamp `protobuf:"bytes,9,opt,name=expiration" json:"expiration,omitempty"`
+	XXX_unrecognized []byte     `json:"-"`
 }
 
 func (m *MVCCMetadata) Reset()         { *m = MVCCMetadata{} }
@@ -704,6 +810,27 @@ func (m *MVCCMetadata) GetValue() *Value {
 	return nil
 }
 
+func (m *MVCCMetadata) GetSequence() int32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MVCCMetadata) GetIntentHistory() []MVCCSequencedValue {
+	if m != nil {
+		return m.IntentHistory
+	}
+	return nil
+}
+
+func (m *MVCCMetadata) GetExpiration() *Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // GCMetadata holds information about the last complete key/value
 // garbage collection scan of a range.
 type GCMetadata struct {
@@ -712,7 +839,11 @@ type GCMetadata struct {
 	// The oldest unresolved write intent in nanoseconds since epoch.
 	// Null if there are no unresolved write intents.
 	OldestIntentNanos *int64 `protobuf:"varint,2,opt,name=oldest_intent_nanos" json:"oldest_intent_nanos,omitempty"`
-	XXX_unrecognized  []byte `json:"-"`
+	// The earliest expiration in nanoseconds since epoch of the most
+	// recent values which survived the scan or were written since.
+	// Null if there are none.
+	NextExpirationNanos *int64 `protobuf:"varint,3,opt,name=next_expiration_nanos" json:"next_expiration_nanos,omitempty"`
+	XXX_unrecognized    []byte `json:"-"`
 }
 
 func (m *GCMetadata) Reset()         { *m = GCMetadata{} }
@@ -733,6 +864,45 @@ func (m *GCMetadata) GetOldestIntentNanos() int64 {
 	return 0
 }
 
+func (m *GCMetadata) GetNextExpirationNanos() int64 {
+	if m != nil && m.NextExpirationNanos != nil {
+		return *m.NextExpirationNanos
+	}
+	return 0
+}
+
+// A ProtectedTimestamp protects the MVCC versions of a key span needed
+// to read it at a timestamp from garbage collection until it expires.
+type ProtectedTimestamp struct {
+	// The span [key, end_key) of protected keys.
+	Key    Key `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
+	EndKey Key `protobuf:"bytes,2,opt,name=end_key,customtype=Key" json:"end_key"`
+	// The protected timestamp. Versions read at or after it are kept.
+	Timestamp Timestamp `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp"`
+	// The expiration after which the record no longer protects the
+	// span. Records with a zero expiration don't expire.
+	Expiration       Timestamp `protobuf:"bytes,4,opt,name=expiration" json:"expiration"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *ProtectedTimestamp) Reset()         { *m = ProtectedTimestamp{} }
+func (m *ProtectedTimestamp) String() string { return proto1.CompactTextString(m) }
+func (*ProtectedTimestamp) ProtoMessage()    {}
+
+func (m *ProtectedTimestamp) GetTimestamp() Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return Timestamp{}
+}
+
+func (m *ProtectedTimestamp) GetExpiration() Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return Timestamp{}
+}
+
 // TimeSeriesDatapoint is a single point of time series data; a value associated
 // with a timestamp.
 type TimeSeriesDatapoint struct {
@@ -1119,6 +1289,33 @@ func (m *Value) Unmarshal(data []byte) error {
 			s := string(data[index:postIndex])
 			m.Tag = &s
 			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -2019,6 +2216,78 @@ func (m *NodeList) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *SequenceRange) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Start |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.End |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
 func (m *Transaction) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
@@ -2289,6 +2558,44 @@ func (m *Transaction) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Sequence |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoredSeqs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoredSeqs = append(m.IgnoredSeqs, SequenceRange{})
+			m.IgnoredSeqs[len(m.IgnoredSeqs)-1].Unmarshal(data[index:postIndex])
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -2417,7 +2724,7 @@ func (m *Lease) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *MVCCMetadata) Unmarshal(data []byte) error {
+func (m *MVCCSequencedValue) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -2437,35 +2744,23 @@ func (m *MVCCMetadata) Unmarshal(data []byte) error {
 		wireType := int(wire & 0x7)
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Sequence |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Txn == nil {
-				m.Txn = &Transaction{}
-			}
-			if err := m.Txn.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
-			index = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2483,31 +2778,124 @@ func (m *MVCCMetadata) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timestamp.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
 					break
 				}
 			}
-			m.Deleted = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyBytes", wireType)
-			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *MVCCMetadata) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Txn == nil {
+				m.Txn = &Transaction{}
+			}
+			if err := m.Txn.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyBytes", wireType)
+			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
@@ -2561,6 +2949,71 @@ func (m *MVCCMetadata) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Sequence |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntentHistory = append(m.IntentHistory, MVCCSequencedValue{})
+			m.IntentHistory[len(m.IntentHistory)-1].Unmarshal(data[index:postIndex])
+			index = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -2635,6 +3088,161 @@ func (m *GCMetadata) Unmarshal(data []byte) error {
 				}
 			}
 			m.OldestIntentNanos = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExpirationNanos", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NextExpirationNanos = &v
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *ProtectedTimestamp) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndKey.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -3096,6 +3704,10 @@ func (m *Value) Size() (n int) {
 		l = len(*m.Tag)
 		n += 1 + l + sovData(uint64(l))
 	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3243,6 +3855,17 @@ func (m *NodeList) Size() (n int) {
 	return n
 }
 
+func (m *SequenceRange) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.Start))
+	n += 1 + sovData(uint64(m.End))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Transaction) Size() (n int) {
 	var l int
 	_ = l
@@ -3270,6 +3893,13 @@ func (m *Transaction) Size() (n int) {
 	n += 1 + l + sovData(uint64(l))
 	l = m.CertainNodes.Size()
 	n += 1 + l + sovData(uint64(l))
+	n += 1 + sovData(uint64(m.Sequence))
+	if len(m.IgnoredSeqs) > 0 {
+		for _, e := range m.IgnoredSeqs {
+			l = e.Size()
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3290,6 +3920,18 @@ func (m *Lease) Size() (n int) {
 	return n
 }
 
+func (m *MVCCSequencedValue) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovData(uint64(m.Sequence))
+	l = m.Value.Size()
+	n += 1 + l + sovData(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *MVCCMetadata) Size() (n int) {
 	var l int
 	_ = l
@@ -3306,6 +3948,17 @@ func (m *MVCCMetadata) Size() (n int) {
 		l = m.Value.Size()
 		n += 1 + l + sovData(uint64(l))
 	}
+	n += 1 + sovData(uint64(m.Sequence))
+	if len(m.IntentHistory) > 0 {
+		for _, e := range m.IntentHistory {
+			l = e.Size()
+			n += 1 + l + sovData(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovData(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3319,6 +3972,26 @@ func (m *GCMetadata) Size() (n int) {
 	if m.OldestIntentNanos != nil {
 		n += 1 + sovData(uint64(*m.OldestIntentNanos))
 	}
+	if m.NextExpirationNanos != nil {
+		n += 1 + sovData(uint64(*m.NextExpirationNanos))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtectedTimestamp) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovData(uint64(l))
+	l = m.EndKey.Size()
+	n += 1 + l + sovData(uint64(l))
+	l = m.Timestamp.Size()
+	n += 1 + l + sovData(uint64(l))
+	l = m.Expiration.Size()
+	n += 1 + l + sovData(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3467,6 +4140,16 @@ func (m *Value) MarshalTo(data []byte) (n int, err error) {
 		i = encodeVarintData(data, i, uint64(len(*m.Tag)))
 		i += copy(data[i:], *m.Tag)
 	}
+	if m.Expiration != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintData(data, i, uint64(m.Expiration.Size()))
+		n30, err := m.Expiration.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3828,6 +4511,33 @@ func (m *NodeList) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *SequenceRange) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SequenceRange) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.Start))
+	data[i] = 0x10
+	i++
+	i = encodeVarintData(data, i, uint64(m.End))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *Transaction) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -3915,6 +4625,21 @@ func (m *Transaction) MarshalTo(data []byte) (n int, err error) {
 		return 0, err
 	}
 	i += n19
+	data[i] = 0x68
+	i++
+	i = encodeVarintData(data, i, uint64(m.Sequence))
+	if len(m.IgnoredSeqs) > 0 {
+		for _, msg := range m.IgnoredSeqs {
+			data[i] = 0x72
+			i++
+			i = encodeVarintData(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -3961,6 +4686,38 @@ func (m *Lease) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *MVCCSequencedValue) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *MVCCSequencedValue) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintData(data, i, uint64(m.Sequence))
+	data[i] = 0x12
+	i++
+	i = encodeVarintData(data, i, uint64(m.Value.Size()))
+	n22, err := m.Value.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *MVCCMetadata) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -3980,20 +4737,20 @@ func (m *MVCCMetadata) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintData(data, i, uint64(m.Txn.Size()))
-		n22, err := m.Txn.MarshalTo(data[i:])
+		n23, err := m.Txn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	data[i] = 0x12
 	i++
 	i = encodeVarintData(data, i, uint64(m.Timestamp.Size()))
-	n23, err := m.Timestamp.MarshalTo(data[i:])
+	n24, err := m.Timestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n24
 	data[i] = 0x18
 	i++
 	if m.Deleted {
@@ -4012,11 +4769,36 @@ func (m *MVCCMetadata) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x32
 		i++
 		i = encodeVarintData(data, i, uint64(m.Value.Size()))
-		n24, err := m.Value.MarshalTo(data[i:])
+		n25, err := m.Value.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	data[i] = 0x38
+	i++
+	i = encodeVarintData(data, i, uint64(m.Sequence))
+	if len(m.IntentHistory) > 0 {
+		for _, msg := range m.IntentHistory {
+			data[i] = 0x42
+			i++
+			i = encodeVarintData(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Expiration != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintData(data, i, uint64(m.Expiration.Size()))
+		n31, err := m.Expiration.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n31
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -4047,6 +4829,64 @@ func (m *GCMetadata) MarshalTo(data []byte) (n int, err error) {
 		i++
 		i = encodeVarintData(data, i, uint64(*m.OldestIntentNanos))
 	}
+	if m.NextExpirationNanos != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintData(data, i, uint64(*m.NextExpirationNanos))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProtectedTimestamp) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ProtectedTimestamp) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintData(data, i, uint64(m.Key.Size()))
+	n26, err := m.Key.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	data[i] = 0x12
+	i++
+	i = encodeVarintData(data, i, uint64(m.EndKey.Size()))
+	n27, err := m.EndKey.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n27
+	data[i] = 0x1a
+	i++
+	i = encodeVarintData(data, i, uint64(m.Timestamp.Size()))
+	n28, err := m.Timestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	data[i] = 0x22
+	i++
+	i = encodeVarintData(data, i, uint64(m.Expiration.Size()))
+	n29, err := m.Expiration.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -374,6 +374,17 @@ func TestNodeList(t *testing.T) {
 	}
 }
 
+// TestTransactionIsSeqIgnored verifies that sequence numbers within
+// rolled back ranges are reported as ignored, inclusive of bounds.
+func TestTransactionIsSeqIgnored(t *testing.T) {
+	txn := Transaction{IgnoredSeqs: []SequenceRange{{Start: 3, End: 5}, {Start: 8, End: 8}}}
+	for seq, expIgnored := range []bool{false, false, false, true, true, true, false, false, true, false} {
+		if ignored := txn.IsSeqIgnored(int32(seq)); ignored != expIgnored {
+			t.Errorf("%d: expected ignored=%t; got %t", seq, expIgnored, ignored)
+		}
+	}
+}
+
 func ts(name string, dps ...*TimeSeriesDatapoint) *TimeSeriesData {
 	return &TimeSeriesData{
 		Name:       name,
@@ -177,3 +177,50 @@ func (e *OpRequiresTxnError) Error() string {
 func (e *ConditionFailedError) Error() string {
 	return fmt.Sprintf("unexpected value: %s", e.ActualValue)
 }
+
+// NewQuotaExceededError initializes a new QuotaExceededError.
+func NewQuotaExceededError(prefix Key, quota string, limit, usage int64) *QuotaExceededError {
+	return &QuotaExceededError{
+		Prefix: prefix,
+		Quota:  quota,
+		Limit:  limit,
+		Usage:  usage,
+	}
+}
+
+// Error formats error.
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("accounting prefix %q exceeded %s: %d >= %d", e.Prefix, e.Quota, e.Usage, e.Limit)
+}
+
+// CanRetry indicates whether or not this QuotaExceededError can be
+// retried. Request-rate limits are transient and may be retried.
+func (e *QuotaExceededError) CanRetry() bool {
+	return e.Quota == "max_qps"
+}
+
+// NewStoreBackpressureError initializes a new StoreBackpressureError.
+func NewStoreBackpressureError(storeID StoreID, reason string) *StoreBackpressureError {
+	return &StoreBackpressureError{
+		StoreID: storeID,
+		Reason:  reason,
+	}
+}
+
+// Error formats error.
+func (e *StoreBackpressureError) Error() string {
+	return fmt.Sprintf("store %d is applying backpressure to writes: %s", e.StoreID, e.Reason)
+}
+
+// CanRetry indicates whether or not this StoreBackpressureError can be
+// retried. Backpressure is transient and writes should be retried
+// after a backoff.
+func (e *StoreBackpressureError) CanRetry() bool {
+	return true
+}
+
+// CanRestartTransaction implements the TransactionRestartError interface.
+// A transaction whose write met backpressure restarts after a backoff.
+func (e *StoreBackpressureError) CanRestartTransaction() TransactionRestart {
+	return TransactionRestart_BACKOFF
+}
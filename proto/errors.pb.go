@@ -340,6 +340,64 @@ func (m *ConditionFailedError) GetActualValue() *Value {
 	return nil
 }
 
+// A QuotaExceededError indicates that a request was rejected because
+// the accounting prefix of its key exceeded one of the quotas or limits
+// of its accounting config.
+type QuotaExceededError struct {
+	Prefix Key `protobuf:"bytes,1,opt,name=prefix,customtype=Key" json:"prefix"`
+	// Quota is the name of the exceeded quota or limit, e.g. "max_bytes".
+	Quota            string `protobuf:"bytes,2,opt,name=quota" json:"quota"`
+	Limit            int64  `protobuf:"varint,3,opt,name=limit" json:"limit"`
+	Usage            int64  `protobuf:"varint,4,opt,name=usage" json:"usage"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *QuotaExceededError) Reset()         { *m = QuotaExceededError{} }
+func (m *QuotaExceededError) String() string { return proto1.CompactTextString(m) }
+func (*QuotaExceededError) ProtoMessage()    {}
+
+func (m *QuotaExceededError) GetQuota() string {
+	if m != nil {
+		return m.Quota
+	}
+	return ""
+}
+
+func (m *QuotaExceededError) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QuotaExceededError) GetUsage() int64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
+}
+
+// A StoreBackpressureError indicates that a store rejected a write
+// because its engine is falling behind on compactions. The write may
+// be retried after a backoff.
+type StoreBackpressureError struct {
+	StoreID StoreID `protobuf:"varint,1,opt,name=store_id,customtype=StoreID" json:"store_id"`
+	// Reason describes the engine condition which triggered backpressure.
+	Reason           string `protobuf:"bytes,2,opt,name=reason" json:"reason"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *StoreBackpressureError) Reset()         { *m = StoreBackpressureError{} }
+func (m *StoreBackpressureError) String() string { return proto1.CompactTextString(m) }
+func (*StoreBackpressureError) ProtoMessage()    {}
+
+func (m *StoreBackpressureError) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // ErrorDetail is a union type containing all available errors.
 type ErrorDetail struct {
 	NotLeader                     *NotLeaderError                     `protobuf:"bytes,1,opt,name=not_leader" json:"not_leader,omitempty"`
@@ -354,6 +412,8 @@ type ErrorDetail struct {
 	WriteTooOld                   *WriteTooOldError                   `protobuf:"bytes,10,opt,name=write_too_old" json:"write_too_old,omitempty"`
 	OpRequiresTxn                 *OpRequiresTxnError                 `protobuf:"bytes,11,opt,name=op_requires_txn" json:"op_requires_txn,omitempty"`
 	ConditionFailed               *ConditionFailedError               `protobuf:"bytes,12,opt,name=condition_failed" json:"condition_failed,omitempty"`
+	QuotaExceeded                 *QuotaExceededError                 `protobuf:"bytes,13,opt,name=quota_exceeded" json:"quota_exceeded,omitempty"`
+	StoreBackpressure             *StoreBackpressureError             `protobuf:"bytes,14,opt,name=store_backpressure" json:"store_backpressure,omitempty"`
 	XXX_unrecognized              []byte                              `json:"-"`
 }
 
@@ -445,6 +505,20 @@ func (m *ErrorDetail) GetConditionFailed() *ConditionFailedError {
 	return nil
 }
 
+func (m *ErrorDetail) GetQuotaExceeded() *QuotaExceededError {
+	if m != nil {
+		return m.QuotaExceeded
+	}
+	return nil
+}
+
+func (m *ErrorDetail) GetStoreBackpressure() *StoreBackpressureError {
+	if m != nil {
+		return m.StoreBackpressure
+	}
+	return nil
+}
+
 // Error is a generic representation including a string message
 // and information about retryability.
 type Error struct {
@@ -1477,6 +1551,203 @@ func (m *ConditionFailedError) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *QuotaExceededError) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Prefix.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quota = string(data[index:postIndex])
+			index = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Usage |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *StoreBackpressureError) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreID", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.StoreID |= (StoreID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
 func (m *ErrorDetail) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
@@ -1820,6 +2091,60 @@ func (m *ErrorDetail) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaExceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuotaExceeded == nil {
+				m.QuotaExceeded = &QuotaExceededError{}
+			}
+			if err := m.QuotaExceeded.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreBackpressure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StoreBackpressure == nil {
+				m.StoreBackpressure = &StoreBackpressureError{}
+			}
+			if err := m.StoreBackpressure.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -2003,6 +2328,12 @@ func (this *ErrorDetail) GetValue() interface{} {
 	if this.ConditionFailed != nil {
 		return this.ConditionFailed
 	}
+	if this.QuotaExceeded != nil {
+		return this.QuotaExceeded
+	}
+	if this.StoreBackpressure != nil {
+		return this.StoreBackpressure
+	}
 	return nil
 }
 
@@ -2032,6 +2363,10 @@ func (this *ErrorDetail) SetValue(value interface{}) bool {
 		this.OpRequiresTxn = vt
 	case *ConditionFailedError:
 		this.ConditionFailed = vt
+	case *QuotaExceededError:
+		this.QuotaExceeded = vt
+	case *StoreBackpressureError:
+		this.StoreBackpressure = vt
 	default:
 		return false
 	}
@@ -2193,6 +2528,33 @@ func (m *ConditionFailedError) Size() (n int) {
 	return n
 }
 
+func (m *QuotaExceededError) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Prefix.Size()
+	n += 1 + l + sovErrors(uint64(l))
+	l = len(m.Quota)
+	n += 1 + l + sovErrors(uint64(l))
+	n += 1 + sovErrors(uint64(m.Limit))
+	n += 1 + sovErrors(uint64(m.Usage))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreBackpressureError) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovErrors(uint64(m.StoreID))
+	l = len(m.Reason)
+	n += 1 + l + sovErrors(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ErrorDetail) Size() (n int) {
 	var l int
 	_ = l
@@ -2244,6 +2606,14 @@ func (m *ErrorDetail) Size() (n int) {
 		l = m.ConditionFailed.Size()
 		n += 1 + l + sovErrors(uint64(l))
 	}
+	if m.QuotaExceeded != nil {
+		l = m.QuotaExceeded.Size()
+		n += 1 + l + sovErrors(uint64(l))
+	}
+	if m.StoreBackpressure != nil {
+		l = m.StoreBackpressure.Size()
+		n += 1 + l + sovErrors(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2693,6 +3063,73 @@ func (m *ConditionFailedError) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *QuotaExceededError) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *QuotaExceededError) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintErrors(data, i, uint64(m.Prefix.Size()))
+	n18, err := m.Prefix.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n18
+	data[i] = 0x12
+	i++
+	i = encodeVarintErrors(data, i, uint64(len(m.Quota)))
+	i += copy(data[i:], m.Quota)
+	data[i] = 0x18
+	i++
+	i = encodeVarintErrors(data, i, uint64(m.Limit))
+	data[i] = 0x20
+	i++
+	i = encodeVarintErrors(data, i, uint64(m.Usage))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StoreBackpressureError) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StoreBackpressureError) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintErrors(data, i, uint64(m.StoreID))
+	data[i] = 0x12
+	i++
+	i = encodeVarintErrors(data, i, uint64(len(m.Reason)))
+	i += copy(data[i:], m.Reason)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *ErrorDetail) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -2712,121 +3149,141 @@ func (m *ErrorDetail) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.NotLeader.Size()))
-		n18, err := m.NotLeader.MarshalTo(data[i:])
+		n19, err := m.NotLeader.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	if m.RangeNotFound != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.RangeNotFound.Size()))
-		n19, err := m.RangeNotFound.MarshalTo(data[i:])
+		n20, err := m.RangeNotFound.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	if m.RangeKeyMismatch != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.RangeKeyMismatch.Size()))
-		n20, err := m.RangeKeyMismatch.MarshalTo(data[i:])
+		n21, err := m.RangeKeyMismatch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	if m.ReadWithinUncertaintyInterval != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.ReadWithinUncertaintyInterval.Size()))
-		n21, err := m.ReadWithinUncertaintyInterval.MarshalTo(data[i:])
+		n22, err := m.ReadWithinUncertaintyInterval.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if m.TransactionAborted != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.TransactionAborted.Size()))
-		n22, err := m.TransactionAborted.MarshalTo(data[i:])
+		n23, err := m.TransactionAborted.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	if m.TransactionPush != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.TransactionPush.Size()))
-		n23, err := m.TransactionPush.MarshalTo(data[i:])
+		n24, err := m.TransactionPush.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n24
 	}
 	if m.TransactionRetry != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.TransactionRetry.Size()))
-		n24, err := m.TransactionRetry.MarshalTo(data[i:])
+		n25, err := m.TransactionRetry.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	if m.TransactionStatus != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.TransactionStatus.Size()))
-		n25, err := m.TransactionStatus.MarshalTo(data[i:])
+		n26, err := m.TransactionStatus.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n26
 	}
 	if m.WriteIntent != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.WriteIntent.Size()))
-		n26, err := m.WriteIntent.MarshalTo(data[i:])
+		n27, err := m.WriteIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n27
 	}
 	if m.WriteTooOld != nil {
 		data[i] = 0x52
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.WriteTooOld.Size()))
-		n27, err := m.WriteTooOld.MarshalTo(data[i:])
+		n28, err := m.WriteTooOld.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n28
 	}
 	if m.OpRequiresTxn != nil {
 		data[i] = 0x5a
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.OpRequiresTxn.Size()))
-		n28, err := m.OpRequiresTxn.MarshalTo(data[i:])
+		n29, err := m.OpRequiresTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n29
 	}
 	if m.ConditionFailed != nil {
 		data[i] = 0x62
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.ConditionFailed.Size()))
-		n29, err := m.ConditionFailed.MarshalTo(data[i:])
+		n30, err := m.ConditionFailed.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
+	}
+	if m.QuotaExceeded != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintErrors(data, i, uint64(m.QuotaExceeded.Size()))
+		n31, err := m.QuotaExceeded.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.StoreBackpressure != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintErrors(data, i, uint64(m.StoreBackpressure.Size()))
+		n32, err := m.StoreBackpressure.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -2868,11 +3325,11 @@ func (m *Error) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintErrors(data, i, uint64(m.Detail.Size()))
-		n30, err := m.Detail.MarshalTo(data[i:])
+		n33, err := m.Detail.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n33
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -203,7 +203,11 @@ type InternalPushTxnRequest struct {
 	// This is done in the event of a writer conflicting with PusheeTxn.
 	// Readers set this to false and instead attempt to move PusheeTxn's
 	// commit timestamp forward.
-	Abort            bool   `protobuf:"varint,3,opt" json:"Abort"`
+	Abort bool `protobuf:"varint,3,opt" json:"Abort"`
+	// Set to true to push PusheeTxn regardless of priorities. This is
+	// done by a waiting pusher to break a deadlock in which it and
+	// PusheeTxn are waiting on each other.
+	Force            bool   `protobuf:"varint,4,opt,name=force" json:"force"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -225,6 +229,13 @@ func (m *InternalPushTxnRequest) GetAbort() bool {
 	return false
 }
 
+func (m *InternalPushTxnRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 // An InternalPushTxnResponse is the return value from the
 // InternalPushTxn() method. It returns success and the resulting
 // state of PusheeTxn if the conflict was resolved in favor of the
@@ -339,10 +350,15 @@ func (*InternalTruncateLogResponse) ProtoMessage()    {}
 
 // An InternalLeaderLeaseRequest is arguments to the InternalLeaderLease()
 // method. It is sent by the store on behalf of one of its ranges upon receipt
-// of a leader election event for that range.
+// of a leader election event for that range, or by the current lease holder
+// to transfer the lease to another replica.
 type InternalLeaderLeaseRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Lease            Lease  `protobuf:"bytes,2,opt,name=lease" json:"lease"`
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Lease         Lease `protobuf:"bytes,2,opt,name=lease" json:"lease"`
+	// Transfer is set when the current holder of the lease hands it to
+	// another replica before it expires. Unlike regular lease requests,
+	// transfers must be proposed by the current lease holder.
+	Transfer         bool   `protobuf:"varint,3,opt,name=transfer" json:"transfer"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -357,6 +373,13 @@ func (m *InternalLeaderLeaseRequest) GetLease() Lease {
 	return Lease{}
 }
 
+func (m *InternalLeaderLeaseRequest) GetTransfer() bool {
+	if m != nil {
+		return m.Transfer
+	}
+	return false
+}
+
 // An InternalLeaderLeaseResponse is the response to an InternalLeaderLease()
 // operation.
 type InternalLeaderLeaseResponse struct {
@@ -368,6 +391,92 @@ func (m *InternalLeaderLeaseResponse) Reset()         { *m = InternalLeaderLease
 func (m *InternalLeaderLeaseResponse) String() string { return proto1.CompactTextString(m) }
 func (*InternalLeaderLeaseResponse) ProtoMessage()    {}
 
+// An InternalCloseTimestampRequest is arguments to the
+// InternalCloseTimestamp() method. It is proposed periodically by the
+// holder of the leader lease to inform all replicas that no further
+// writes will be accepted at or below the closed timestamp, allowing
+// them to serve FOLLOWER reads at those timestamps. The request header
+// spans the range as it was known to the proposer.
+type InternalCloseTimestampRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ClosedTimestamp  Timestamp `protobuf:"bytes,2,opt,name=closed_timestamp" json:"closed_timestamp"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *InternalCloseTimestampRequest) Reset()         { *m = InternalCloseTimestampRequest{} }
+func (m *InternalCloseTimestampRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalCloseTimestampRequest) ProtoMessage()    {}
+
+func (m *InternalCloseTimestampRequest) GetClosedTimestamp() Timestamp {
+	if m != nil {
+		return m.ClosedTimestamp
+	}
+	return Timestamp{}
+}
+
+// An InternalCloseTimestampResponse is the response to an
+// InternalCloseTimestamp() operation.
+type InternalCloseTimestampResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalCloseTimestampResponse) Reset()         { *m = InternalCloseTimestampResponse{} }
+func (m *InternalCloseTimestampResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalCloseTimestampResponse) ProtoMessage()    {}
+
+// An InternalQueryTxnRequest is arguments to the InternalQueryTxn()
+// method. It's sent by pushers waiting in a push txn queue to fetch
+// the current state of a transaction and the set of transactions
+// waiting on it, which is used to detect deadlocks. Like
+// InternalPushTxn, this RPC is addressed to the range which owns the
+// txn record.
+type InternalQueryTxnRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	QueriedTxn       Transaction `protobuf:"bytes,2,opt,name=queried_txn" json:"queried_txn"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *InternalQueryTxnRequest) Reset()         { *m = InternalQueryTxnRequest{} }
+func (m *InternalQueryTxnRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalQueryTxnRequest) ProtoMessage()    {}
+
+func (m *InternalQueryTxnRequest) GetQueriedTxn() Transaction {
+	if m != nil {
+		return m.QueriedTxn
+	}
+	return Transaction{}
+}
+
+// An InternalQueryTxnResponse is the return value from the
+// InternalQueryTxn() method. It returns the persisted transaction
+// record if one exists and the IDs of all transactions which are
+// directly or transitively waiting to push the queried transaction.
+type InternalQueryTxnResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	QueriedTxn       *Transaction `protobuf:"bytes,2,opt,name=queried_txn" json:"queried_txn,omitempty"`
+	WaitingTxns      [][]byte     `protobuf:"bytes,3,rep,name=waiting_txns" json:"waiting_txns,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *InternalQueryTxnResponse) Reset()         { *m = InternalQueryTxnResponse{} }
+func (m *InternalQueryTxnResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalQueryTxnResponse) ProtoMessage()    {}
+
+func (m *InternalQueryTxnResponse) GetQueriedTxn() *Transaction {
+	if m != nil {
+		return m.QueriedTxn
+	}
+	return nil
+}
+
+func (m *InternalQueryTxnResponse) GetWaitingTxns() [][]byte {
+	if m != nil {
+		return m.WaitingTxns
+	}
+	return nil
+}
+
 // An InternalRequestUnion contains exactly one of the optional requests.
 // Non-internal values added to RequestUnion must be added here.
 type InternalRequestUnion struct {
@@ -609,20 +718,22 @@ func (m *InternalBatchResponse) GetResponses() []InternalResponseUnion {
 // mutating commands. Note that any entry added here must be handled
 // in storage/engine/db.cc in GetResponseHeader().
 type ReadWriteCmdResponse struct {
-	Put                   *PutResponse                   `protobuf:"bytes,1,opt,name=put" json:"put,omitempty"`
-	ConditionalPut        *ConditionalPutResponse        `protobuf:"bytes,2,opt,name=conditional_put" json:"conditional_put,omitempty"`
-	Increment             *IncrementResponse             `protobuf:"bytes,3,opt,name=increment" json:"increment,omitempty"`
-	Delete                *DeleteResponse                `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
-	DeleteRange           *DeleteRangeResponse           `protobuf:"bytes,5,opt,name=delete_range" json:"delete_range,omitempty"`
-	EndTransaction        *EndTransactionResponse        `protobuf:"bytes,6,opt,name=end_transaction" json:"end_transaction,omitempty"`
-	InternalHeartbeatTxn  *InternalHeartbeatTxnResponse  `protobuf:"bytes,10,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
-	InternalPushTxn       *InternalPushTxnResponse       `protobuf:"bytes,11,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
-	InternalResolveIntent *InternalResolveIntentResponse `protobuf:"bytes,12,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
-	InternalMerge         *InternalMergeResponse         `protobuf:"bytes,13,opt,name=internal_merge" json:"internal_merge,omitempty"`
-	InternalTruncateLog   *InternalTruncateLogResponse   `protobuf:"bytes,14,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
-	InternalGc            *InternalGCResponse            `protobuf:"bytes,15,opt,name=internal_gc" json:"internal_gc,omitempty"`
-	InternalLeaderLease   *InternalLeaderLeaseResponse   `protobuf:"bytes,16,opt,name=internal_leader_lease" json:"internal_leader_lease,omitempty"`
-	XXX_unrecognized      []byte                         `json:"-"`
+	Put                    *PutResponse                    `protobuf:"bytes,1,opt,name=put" json:"put,omitempty"`
+	ConditionalPut         *ConditionalPutResponse         `protobuf:"bytes,2,opt,name=conditional_put" json:"conditional_put,omitempty"`
+	Increment              *IncrementResponse              `protobuf:"bytes,3,opt,name=increment" json:"increment,omitempty"`
+	Delete                 *DeleteResponse                 `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
+	DeleteRange            *DeleteRangeResponse            `protobuf:"bytes,5,opt,name=delete_range" json:"delete_range,omitempty"`
+	EndTransaction         *EndTransactionResponse         `protobuf:"bytes,6,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	InternalHeartbeatTxn   *InternalHeartbeatTxnResponse   `protobuf:"bytes,10,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
+	InternalPushTxn        *InternalPushTxnResponse        `protobuf:"bytes,11,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
+	InternalResolveIntent  *InternalResolveIntentResponse  `protobuf:"bytes,12,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
+	InternalMerge          *InternalMergeResponse          `protobuf:"bytes,13,opt,name=internal_merge" json:"internal_merge,omitempty"`
+	InternalTruncateLog    *InternalTruncateLogResponse    `protobuf:"bytes,14,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
+	InternalGc             *InternalGCResponse             `protobuf:"bytes,15,opt,name=internal_gc" json:"internal_gc,omitempty"`
+	InternalLeaderLease    *InternalLeaderLeaseResponse    `protobuf:"bytes,16,opt,name=internal_leader_lease" json:"internal_leader_lease,omitempty"`
+	InternalCloseTimestamp *InternalCloseTimestampResponse `protobuf:"bytes,17,opt,name=internal_close_timestamp" json:"internal_close_timestamp,omitempty"`
+	Ingest                 *IngestResponse                 `protobuf:"bytes,18,opt,name=ingest" json:"ingest,omitempty"`
+	XXX_unrecognized       []byte                          `json:"-"`
 }
 
 func (m *ReadWriteCmdResponse) Reset()         { *m = ReadWriteCmdResponse{} }
@@ -720,6 +831,20 @@ func (m *ReadWriteCmdResponse) GetInternalLeaderLease() *InternalLeaderLeaseResp
 	return nil
 }
 
+func (m *ReadWriteCmdResponse) GetInternalCloseTimestamp() *InternalCloseTimestampResponse {
+	if m != nil {
+		return m.InternalCloseTimestamp
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetIngest() *IngestResponse {
+	if m != nil {
+		return m.Ingest
+	}
+	return nil
+}
+
 // An InternalRaftCommandUnion is the union of all commands which can be
 // sent via raft.
 type InternalRaftCommandUnion struct {
@@ -735,17 +860,19 @@ type InternalRaftCommandUnion struct {
 	EndTransaction *EndTransactionRequest `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
 	// Other requests. Allow a gap in tag numbers so the previous list can
 	// be copy/pasted from RequestUnion.
-	Batch                 *BatchRequest                 `protobuf:"bytes,30,opt,name=batch" json:"batch,omitempty"`
-	InternalRangeLookup   *InternalRangeLookupRequest   `protobuf:"bytes,31,opt,name=internal_range_lookup" json:"internal_range_lookup,omitempty"`
-	InternalHeartbeatTxn  *InternalHeartbeatTxnRequest  `protobuf:"bytes,32,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
-	InternalPushTxn       *InternalPushTxnRequest       `protobuf:"bytes,33,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
-	InternalResolveIntent *InternalResolveIntentRequest `protobuf:"bytes,34,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
-	InternalMergeResponse *InternalMergeRequest         `protobuf:"bytes,35,opt,name=internal_merge_response" json:"internal_merge_response,omitempty"`
-	InternalTruncateLog   *InternalTruncateLogRequest   `protobuf:"bytes,36,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
-	InternalGC            *InternalGCRequest            `protobuf:"bytes,37,opt,name=internal_gc" json:"internal_gc,omitempty"`
-	InternalLease         *InternalLeaderLeaseRequest   `protobuf:"bytes,38,opt,name=internal_lease" json:"internal_lease,omitempty"`
-	InternalBatch         *InternalBatchRequest         `protobuf:"bytes,39,opt,name=internal_batch" json:"internal_batch,omitempty"`
-	XXX_unrecognized      []byte                        `json:"-"`
+	Batch                  *BatchRequest                  `protobuf:"bytes,30,opt,name=batch" json:"batch,omitempty"`
+	InternalRangeLookup    *InternalRangeLookupRequest    `protobuf:"bytes,31,opt,name=internal_range_lookup" json:"internal_range_lookup,omitempty"`
+	InternalHeartbeatTxn   *InternalHeartbeatTxnRequest   `protobuf:"bytes,32,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
+	InternalPushTxn        *InternalPushTxnRequest        `protobuf:"bytes,33,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
+	InternalResolveIntent  *InternalResolveIntentRequest  `protobuf:"bytes,34,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
+	InternalMergeResponse  *InternalMergeRequest          `protobuf:"bytes,35,opt,name=internal_merge_response" json:"internal_merge_response,omitempty"`
+	InternalTruncateLog    *InternalTruncateLogRequest    `protobuf:"bytes,36,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
+	InternalGC             *InternalGCRequest             `protobuf:"bytes,37,opt,name=internal_gc" json:"internal_gc,omitempty"`
+	InternalLease          *InternalLeaderLeaseRequest    `protobuf:"bytes,38,opt,name=internal_lease" json:"internal_lease,omitempty"`
+	InternalBatch          *InternalBatchRequest          `protobuf:"bytes,39,opt,name=internal_batch" json:"internal_batch,omitempty"`
+	InternalCloseTimestamp *InternalCloseTimestampRequest `protobuf:"bytes,40,opt,name=internal_close_timestamp" json:"internal_close_timestamp,omitempty"`
+	Ingest                 *IngestRequest                 `protobuf:"bytes,42,opt,name=ingest" json:"ingest,omitempty"`
+	XXX_unrecognized       []byte                         `json:"-"`
 }
 
 func (m *InternalRaftCommandUnion) Reset()         { *m = InternalRaftCommandUnion{} }
@@ -885,6 +1012,20 @@ func (m *InternalRaftCommandUnion) GetInternalBatch() *InternalBatchRequest {
 	return nil
 }
 
+func (m *InternalRaftCommandUnion) GetInternalCloseTimestamp() *InternalCloseTimestampRequest {
+	if m != nil {
+		return m.InternalCloseTimestamp
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetIngest() *IngestRequest {
+	if m != nil {
+		return m.Ingest
+	}
+	return nil
+}
+
 // An InternalRaftCommand is a command which can be serialized and
 // sent via raft.
 type InternalRaftCommand struct {
@@ -1863,6 +2004,23 @@ func (m *InternalPushTxnRequest) Unmarshal(data []byte) error {
 				}
 			}
 			m.Abort = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -2481,6 +2639,23 @@ func (m *InternalLeaderLeaseRequest) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Transfer = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -2570,7 +2745,7 @@ func (m *InternalLeaderLeaseResponse) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *InternalRequestUnion) Unmarshal(data []byte) error {
+func (m *InternalCloseTimestampRequest) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -2591,7 +2766,7 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2609,16 +2784,13 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contains == nil {
-				m.Contains = &ContainsRequest{}
-			}
-			if err := m.Contains.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.RequestHeader.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2636,43 +2808,55 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Get == nil {
-				m.Get = &GetRequest{}
-			}
-			if err := m.Get.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ClosedTimestamp.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalCloseTimestampResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
-				return err
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			index = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2690,43 +2874,55 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConditionalPut == nil {
-				m.ConditionalPut = &ConditionalPutRequest{}
-			}
-			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ResponseHeader.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[index]
-				index++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Increment == nil {
-				m.Increment = &IncrementRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalQueryTxnRequest) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
-				return err
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			index = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2744,16 +2940,13 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteRequest{}
-			}
-			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.RequestHeader.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriedTxn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2771,20 +2964,364 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteRange == nil {
-				m.DeleteRange = &DeleteRangeRequest{}
-			}
-			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.QueriedTxn.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if index >= l {
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalQueryTxnResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriedTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueriedTxn == nil {
+				m.QueriedTxn = &Transaction{}
+			}
+			if err := m.QueriedTxn.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingTxns", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WaitingTxns = append(m.WaitingTxns, make([]byte, postIndex-index))
+			copy(m.WaitingTxns[len(m.WaitingTxns)-1], data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
+func (m *InternalRequestUnion) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contains == nil {
+				m.Contains = &ContainsRequest{}
+			}
+			if err := m.Contains.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Get == nil {
+				m.Get = &GetRequest{}
+			}
+			if err := m.Get.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutRequest{}
+			}
+			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConditionalPut == nil {
+				m.ConditionalPut = &ConditionalPutRequest{}
+			}
+			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Increment == nil {
+				m.Increment = &IncrementRequest{}
+			}
+			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
+			}
+			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeRequest{}
+			}
+			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
@@ -3796,6 +4333,60 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalCloseTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalCloseTimestamp == nil {
+				m.InternalCloseTimestamp = &InternalCloseTimestampResponse{}
+			}
+			if err := m.InternalCloseTimestamp.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ingest == nil {
+				m.Ingest = &IngestResponse{}
+			}
+			if err := m.Ingest.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -4351,6 +4942,60 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalCloseTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalCloseTimestamp == nil {
+				m.InternalCloseTimestamp = &InternalCloseTimestampRequest{}
+			}
+			if err := m.InternalCloseTimestamp.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ingest == nil {
+				m.Ingest = &IngestRequest{}
+			}
+			if err := m.Ingest.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -5266,6 +5911,12 @@ func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.InternalLeaderLease != nil {
 		return this.InternalLeaderLease
 	}
+	if this.InternalCloseTimestamp != nil {
+		return this.InternalCloseTimestamp
+	}
+	if this.Ingest != nil {
+		return this.Ingest
+	}
 	return nil
 }
 
@@ -5297,6 +5948,10 @@ func (this *ReadWriteCmdResponse) SetValue(value interface{}) bool {
 		this.InternalGc = vt
 	case *InternalLeaderLeaseResponse:
 		this.InternalLeaderLease = vt
+	case *InternalCloseTimestampResponse:
+		this.InternalCloseTimestamp = vt
+	case *IngestResponse:
+		this.Ingest = vt
 	default:
 		return false
 	}
@@ -5360,6 +6015,12 @@ func (this *InternalRaftCommandUnion) GetValue() interface{} {
 	if this.InternalBatch != nil {
 		return this.InternalBatch
 	}
+	if this.InternalCloseTimestamp != nil {
+		return this.InternalCloseTimestamp
+	}
+	if this.Ingest != nil {
+		return this.Ingest
+	}
 	return nil
 }
 
@@ -5403,6 +6064,10 @@ func (this *InternalRaftCommandUnion) SetValue(value interface{}) bool {
 		this.InternalLease = vt
 	case *InternalBatchRequest:
 		this.InternalBatch = vt
+	case *InternalCloseTimestampRequest:
+		this.InternalCloseTimestamp = vt
+	case *IngestRequest:
+		this.Ingest = vt
 	default:
 		return false
 	}
@@ -5510,6 +6175,7 @@ func (m *InternalPushTxnRequest) Size() (n int) {
 	l = m.PusheeTxn.Size()
 	n += 1 + l + sovInternal(uint64(l))
 	n += 2
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5607,6 +6273,7 @@ func (m *InternalLeaderLeaseRequest) Size() (n int) {
 	n += 1 + l + sovInternal(uint64(l))
 	l = m.Lease.Size()
 	n += 1 + l + sovInternal(uint64(l))
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5624,6 +6291,64 @@ func (m *InternalLeaderLeaseResponse) Size() (n int) {
 	return n
 }
 
+func (m *InternalCloseTimestampRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	l = m.ClosedTimestamp.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalCloseTimestampResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalQueryTxnRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	l = m.QueriedTxn.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InternalQueryTxnResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovInternal(uint64(l))
+	if m.QueriedTxn != nil {
+		l = m.QueriedTxn.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.WaitingTxns) > 0 {
+		for _, b := range m.WaitingTxns {
+			l = len(b)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *InternalRequestUnion) Size() (n int) {
 	var l int
 	_ = l
@@ -5819,6 +6544,14 @@ func (m *ReadWriteCmdResponse) Size() (n int) {
 		l = m.InternalLeaderLease.Size()
 		n += 2 + l + sovInternal(uint64(l))
 	}
+	if m.InternalCloseTimestamp != nil {
+		l = m.InternalCloseTimestamp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.Ingest != nil {
+		l = m.Ingest.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5904,6 +6637,14 @@ func (m *InternalRaftCommandUnion) Size() (n int) {
 		l = m.InternalBatch.Size()
 		n += 2 + l + sovInternal(uint64(l))
 	}
+	if m.InternalCloseTimestamp != nil {
+		l = m.InternalCloseTimestamp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.Ingest != nil {
+		l = m.Ingest.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6334,6 +7075,14 @@ func (m *InternalPushTxnRequest) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0
 	}
 	i++
+	data[i] = 0x20
+	i++
+	if m.Force {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6595,6 +7344,14 @@ func (m *InternalLeaderLeaseRequest) MarshalTo(data []byte) (n int, err error) {
 		return 0, err
 	}
 	i += n22
+	data[i] = 0x18
+	i++
+	if m.Transfer {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6630,6 +7387,156 @@ func (m *InternalLeaderLeaseResponse) MarshalTo(data []byte) (n int, err error)
 	return i, nil
 }
 
+func (m *InternalCloseTimestampRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalCloseTimestampRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
+	n24, err := m.RequestHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n24
+	data[i] = 0x12
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ClosedTimestamp.Size()))
+	n25, err := m.ClosedTimestamp.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n25
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalCloseTimestampResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalCloseTimestampResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
+	n26, err := m.ResponseHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalQueryTxnRequest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalQueryTxnRequest) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
+	n27, err := m.RequestHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n27
+	data[i] = 0x12
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.QueriedTxn.Size()))
+	n28, err := m.QueriedTxn.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InternalQueryTxnResponse) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InternalQueryTxnResponse) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
+	n29, err := m.ResponseHeader.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
+	if m.QueriedTxn != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.QueriedTxn.Size()))
+		n30, err := m.QueriedTxn.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if len(m.WaitingTxns) > 0 {
+		for _, b := range m.WaitingTxns {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintInternal(data, i, uint64(len(b)))
+			i += copy(data[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *InternalRequestUnion) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -6649,91 +7556,91 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Contains.Size()))
-		n24, err := m.Contains.MarshalTo(data[i:])
+		n31, err := m.Contains.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n31
 	}
 	if m.Get != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n25, err := m.Get.MarshalTo(data[i:])
+		n32, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n32
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n26, err := m.Put.MarshalTo(data[i:])
+		n33, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n33
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n27, err := m.ConditionalPut.MarshalTo(data[i:])
+		n34, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n34
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n28, err := m.Increment.MarshalTo(data[i:])
+		n35, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n35
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n29, err := m.Delete.MarshalTo(data[i:])
+		n36, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n36
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n30, err := m.DeleteRange.MarshalTo(data[i:])
+		n37, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n37
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n31, err := m.Scan.MarshalTo(data[i:])
+		n38, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n38
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n32, err := m.EndTransaction.MarshalTo(data[i:])
+		n39, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n39
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0xf2
@@ -6741,11 +7648,11 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n33, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n40, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n40
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0xfa
@@ -6753,11 +7660,11 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n34, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n41, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n41
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -6784,91 +7691,91 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Contains.Size()))
-		n35, err := m.Contains.MarshalTo(data[i:])
+		n42, err := m.Contains.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n42
 	}
 	if m.Get != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n36, err := m.Get.MarshalTo(data[i:])
+		n43, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n43
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n37, err := m.Put.MarshalTo(data[i:])
+		n44, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n44
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n38, err := m.ConditionalPut.MarshalTo(data[i:])
+		n45, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n45
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n39, err := m.Increment.MarshalTo(data[i:])
+		n46, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n46
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n40, err := m.Delete.MarshalTo(data[i:])
+		n47, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n47
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n41, err := m.DeleteRange.MarshalTo(data[i:])
+		n48, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n48
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n42, err := m.Scan.MarshalTo(data[i:])
+		n49, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n49
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n43, err := m.EndTransaction.MarshalTo(data[i:])
+		n50, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n50
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0xf2
@@ -6876,11 +7783,11 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n44, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n51, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n51
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0xfa
@@ -6888,11 +7795,11 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n45, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n52, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n52
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -6918,11 +7825,11 @@ func (m *InternalBatchRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.RequestHeader.Size()))
-	n46, err := m.RequestHeader.MarshalTo(data[i:])
+	n53, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n46
+	i += n53
 	if len(m.Requests) > 0 {
 		for _, msg := range m.Requests {
 			data[i] = 0x12
@@ -6959,11 +7866,11 @@ func (m *InternalBatchResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.ResponseHeader.Size()))
-	n47, err := m.ResponseHeader.MarshalTo(data[i:])
+	n54, err := m.ResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n47
+	i += n54
 	if len(m.Responses) > 0 {
 		for _, msg := range m.Responses {
 			data[i] = 0x12
@@ -7001,121 +7908,121 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n48, err := m.Put.MarshalTo(data[i:])
+		n55, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n55
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n49, err := m.ConditionalPut.MarshalTo(data[i:])
+		n56, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n56
 	}
 	if m.Increment != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n50, err := m.Increment.MarshalTo(data[i:])
+		n57, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n57
 	}
 	if m.Delete != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n51, err := m.Delete.MarshalTo(data[i:])
+		n58, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n58
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n52, err := m.DeleteRange.MarshalTo(data[i:])
+		n59, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n59
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n53, err := m.EndTransaction.MarshalTo(data[i:])
+		n60, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n60
 	}
 	if m.InternalHeartbeatTxn != nil {
 		data[i] = 0x52
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalHeartbeatTxn.Size()))
-		n54, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
+		n61, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n61
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0x5a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n55, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n62, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n62
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0x62
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n56, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n63, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n63
 	}
 	if m.InternalMerge != nil {
 		data[i] = 0x6a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalMerge.Size()))
-		n57, err := m.InternalMerge.MarshalTo(data[i:])
+		n64, err := m.InternalMerge.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n64
 	}
 	if m.InternalTruncateLog != nil {
 		data[i] = 0x72
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalTruncateLog.Size()))
-		n58, err := m.InternalTruncateLog.MarshalTo(data[i:])
+		n65, err := m.InternalTruncateLog.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n65
 	}
 	if m.InternalGc != nil {
 		data[i] = 0x7a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalGc.Size()))
-		n59, err := m.InternalGc.MarshalTo(data[i:])
+		n66, err := m.InternalGc.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n66
 	}
 	if m.InternalLeaderLease != nil {
 		data[i] = 0x82
@@ -7123,11 +8030,35 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalLeaderLease.Size()))
-		n60, err := m.InternalLeaderLease.MarshalTo(data[i:])
+		n67, err := m.InternalLeaderLease.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n67
+	}
+	if m.InternalCloseTimestamp != nil {
+		data[i] = 0x8a
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.InternalCloseTimestamp.Size()))
+		n68, err := m.InternalCloseTimestamp.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if m.Ingest != nil {
+		data[i] = 0x92
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.Ingest.Size()))
+		n69, err := m.Ingest.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7154,91 +8085,91 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Contains.Size()))
-		n61, err := m.Contains.MarshalTo(data[i:])
+		n70, err := m.Contains.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n70
 	}
 	if m.Get != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n62, err := m.Get.MarshalTo(data[i:])
+		n71, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n71
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n63, err := m.Put.MarshalTo(data[i:])
+		n72, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n72
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n64, err := m.ConditionalPut.MarshalTo(data[i:])
+		n73, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n73
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n65, err := m.Increment.MarshalTo(data[i:])
+		n74, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n74
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n66, err := m.Delete.MarshalTo(data[i:])
+		n75, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n75
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n67, err := m.DeleteRange.MarshalTo(data[i:])
+		n76, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n76
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n68, err := m.Scan.MarshalTo(data[i:])
+		n77, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n77
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n69, err := m.EndTransaction.MarshalTo(data[i:])
+		n78, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n78
 	}
 	if m.Batch != nil {
 		data[i] = 0xf2
@@ -7246,11 +8177,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Batch.Size()))
-		n70, err := m.Batch.MarshalTo(data[i:])
+		n79, err := m.Batch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n79
 	}
 	if m.InternalRangeLookup != nil {
 		data[i] = 0xfa
@@ -7258,11 +8189,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalRangeLookup.Size()))
-		n71, err := m.InternalRangeLookup.MarshalTo(data[i:])
+		n80, err := m.InternalRangeLookup.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n80
 	}
 	if m.InternalHeartbeatTxn != nil {
 		data[i] = 0x82
@@ -7270,11 +8201,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalHeartbeatTxn.Size()))
-		n72, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
+		n81, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n81
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0x8a
@@ -7282,11 +8213,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n73, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n82, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n82
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0x92
@@ -7294,11 +8225,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n74, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n83, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n83
 	}
 	if m.InternalMergeResponse != nil {
 		data[i] = 0x9a
@@ -7306,11 +8237,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalMergeResponse.Size()))
-		n75, err := m.InternalMergeResponse.MarshalTo(data[i:])
+		n84, err := m.InternalMergeResponse.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n84
 	}
 	if m.InternalTruncateLog != nil {
 		data[i] = 0xa2
@@ -7318,11 +8249,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalTruncateLog.Size()))
-		n76, err := m.InternalTruncateLog.MarshalTo(data[i:])
+		n85, err := m.InternalTruncateLog.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n85
 	}
 	if m.InternalGC != nil {
 		data[i] = 0xaa
@@ -7330,11 +8261,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalGC.Size()))
-		n77, err := m.InternalGC.MarshalTo(data[i:])
+		n86, err := m.InternalGC.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n86
 	}
 	if m.InternalLease != nil {
 		data[i] = 0xb2
@@ -7342,11 +8273,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalLease.Size()))
-		n78, err := m.InternalLease.MarshalTo(data[i:])
+		n87, err := m.InternalLease.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n87
 	}
 	if m.InternalBatch != nil {
 		data[i] = 0xba
@@ -7354,11 +8285,35 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalBatch.Size()))
-		n79, err := m.InternalBatch.MarshalTo(data[i:])
+		n88, err := m.InternalBatch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n88
+	}
+	if m.InternalCloseTimestamp != nil {
+		data[i] = 0xc2
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.InternalCloseTimestamp.Size()))
+		n89, err := m.InternalCloseTimestamp.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n89
+	}
+	if m.Ingest != nil {
+		data[i] = 0xd2
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.Ingest.Size()))
+		n90, err := m.Ingest.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n90
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7390,11 +8345,11 @@ func (m *InternalRaftCommand) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintInternal(data, i, uint64(m.Cmd.Size()))
-	n80, err := m.Cmd.MarshalTo(data[i:])
+	n91, err := m.Cmd.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n80
+	i += n91
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
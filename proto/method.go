@@ -67,6 +67,15 @@ const (
 	AdminSplit
 	// AdminMerge is called to coordinate a merge of two adjacent ranges.
 	AdminMerge
+	// AdminTransferLease is called to move a range's leader lease to
+	// another replica.
+	AdminTransferLease
+	// Ingest adds the contents of an SSTable built outside of the
+	// cluster to an empty span of a single range.
+	Ingest
+	// Export returns the MVCC versions of the keys in a span of a
+	// single range, written in a time interval, as an SSTable.
+	Export
 	// InternalRangeLookup looks up range descriptors, containing the
 	// locations of replicas for the range containing the specified key.
 	InternalRangeLookup
@@ -89,6 +98,12 @@ const (
 	// an error code either indicating the pusher must retry or abort and
 	// restart the transaction.
 	InternalPushTxn
+	// InternalQueryTxn fetches the current state of a transaction along
+	// with the transactions waiting to push it. It's used by pushers
+	// waiting on the transaction to detect deadlocks. As with
+	// InternalPushTxn, args.Key should be set to the key of the queried
+	// transaction.
+	InternalQueryTxn
 	// InternalResolveIntent resolves existing write intents for a key or
 	// key range.
 	InternalResolveIntent
@@ -104,6 +119,9 @@ const (
 	InternalTruncateLog
 	// InternalLeaderLease requests a leader lease for a replica.
 	InternalLeaderLease
+	// InternalCloseTimestamp informs the replicas of a range that the
+	// leader will not accept any further writes at or below a timestamp.
+	InternalCloseTimestamp
 	// InternalBatch implements batch processing of commands. This is a
 	// superset of the Batch method.
 	InternalBatch
@@ -111,25 +129,30 @@ const (
 
 // AllMethods is a map from string to method enum.
 var AllMethods = map[string]Method{
-	Contains.String():              Contains,
-	Get.String():                   Get,
-	Put.String():                   Put,
-	ConditionalPut.String():        ConditionalPut,
-	Increment.String():             Increment,
-	Delete.String():                Delete,
-	DeleteRange.String():           DeleteRange,
-	Scan.String():                  Scan,
-	EndTransaction.String():        EndTransaction,
-	Batch.String():                 Batch,
-	AdminSplit.String():            AdminSplit,
-	AdminMerge.String():            AdminMerge,
-	InternalRangeLookup.String():   InternalRangeLookup,
-	InternalHeartbeatTxn.String():  InternalHeartbeatTxn,
-	InternalGC.String():            InternalGC,
-	InternalPushTxn.String():       InternalPushTxn,
-	InternalResolveIntent.String(): InternalResolveIntent,
-	InternalMerge.String():         InternalMerge,
-	InternalTruncateLog.String():   InternalTruncateLog,
-	InternalLeaderLease.String():   InternalLeaderLease,
-	InternalBatch.String():         InternalBatch,
+	Contains.String():               Contains,
+	Get.String():                    Get,
+	Put.String():                    Put,
+	ConditionalPut.String():         ConditionalPut,
+	Increment.String():              Increment,
+	Delete.String():                 Delete,
+	DeleteRange.String():            DeleteRange,
+	Scan.String():                   Scan,
+	EndTransaction.String():         EndTransaction,
+	Batch.String():                  Batch,
+	AdminSplit.String():             AdminSplit,
+	AdminMerge.String():             AdminMerge,
+	AdminTransferLease.String():     AdminTransferLease,
+	Ingest.String():                 Ingest,
+	Export.String():                 Export,
+	InternalRangeLookup.String():    InternalRangeLookup,
+	InternalHeartbeatTxn.String():   InternalHeartbeatTxn,
+	InternalGC.String():             InternalGC,
+	InternalPushTxn.String():        InternalPushTxn,
+	InternalQueryTxn.String():       InternalQueryTxn,
+	InternalResolveIntent.String():  InternalResolveIntent,
+	InternalMerge.String():          InternalMerge,
+	InternalTruncateLog.String():    InternalTruncateLog,
+	InternalLeaderLease.String():    InternalLeaderLease,
+	InternalCloseTimestamp.String(): InternalCloseTimestamp,
+	InternalBatch.String():          InternalBatch,
 }
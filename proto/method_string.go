@@ -4,9 +4,9 @@ package proto
 
 import "fmt"
 
-const _Method_name = "ContainsGetPutConditionalPutIncrementDeleteDeleteRangeScanEndTransactionReapQueueEnqueueUpdateEnqueueMessageBatchAdminSplitAdminMergeInternalRangeLookupInternalHeartbeatTxnInternalGCInternalPushTxnInternalResolveIntentInternalMergeInternalTruncateLogInternalLeaderLeaseInternalBatch"
+const _Method_name = "ContainsGetPutConditionalPutIncrementDeleteDeleteRangeScanEndTransactionReapQueueEnqueueUpdateEnqueueMessageBatchAdminSplitAdminMergeAdminTransferLeaseIngestExportInternalRangeLookupInternalHeartbeatTxnInternalGCInternalPushTxnInternalQueryTxnInternalResolveIntentInternalMergeInternalTruncateLogInternalLeaderLeaseInternalCloseTimestampInternalBatch"
 
-var _Method_index = [...]uint16{0, 8, 11, 14, 28, 37, 43, 54, 58, 72, 81, 94, 108, 113, 123, 133, 152, 172, 182, 197, 218, 231, 250, 269, 282}
+var _Method_index = [...]uint16{0, 8, 11, 14, 28, 37, 43, 54, 58, 72, 81, 94, 108, 113, 123, 133, 151, 157, 163, 182, 202, 212, 227, 243, 264, 277, 296, 315, 337, 350}
 
 func (i Method) String() string {
 	if i < 0 || i+1 >= Method(len(_Method_index)) {
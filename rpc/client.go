@@ -38,6 +38,11 @@ const (
 	// the longest NTP allows for a remote clock reading. After 1.5 seconds, we
 	// assume that the offset from the clock is infinite.
 	maximumClockReadingDelay = 1500 * time.Millisecond
+
+	// latencyEWMAWeight is the weight given to each new heartbeat round
+	// trip in the exponentially weighted moving average of a client's
+	// latency.
+	latencyEWMAWeight = 0.3
 )
 
 var (
@@ -74,6 +79,7 @@ type Client struct {
 	lAddr        net.Addr   // Local address of client
 	healthy      bool
 	offset       proto.RemoteOffset // Latest measured clock offset from the server
+	latency      time.Duration      // Moving average of heartbeat round trips; 0 if unknown
 	clock        *hlc.Clock
 	remoteClocks *RemoteClockMonitor
 	cached       bool
@@ -127,7 +133,7 @@ func (c *Client) connect(opts *util.RetryOptions, context *Context) {
 	retryOpts.Stopper = context.stopper
 
 	err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
-		conn, err := tlsDialHTTP(c.addr.Network(), c.addr.String(), context.tlsConfig)
+		conn, err := tlsDialHTTP(c.addr.Network(), c.addr.String(), context.TLSConfig())
 		if err != nil {
 			log.Info(err)
 			return util.RetryContinue, nil
@@ -195,6 +201,28 @@ func (c *Client) RemoteOffset() proto.RemoteOffset {
 	return c.offset
 }
 
+// Latency returns the exponentially weighted moving average of the
+// heartbeat round-trip times to the server, or zero if none has been
+// measured yet.
+func (c *Client) Latency() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.latency
+}
+
+// Latency returns the moving average of heartbeat round-trip times
+// measured by the cached client for the specified address, or zero if
+// there is no such client or it hasn't measured any yet.
+func Latency(addr net.Addr) time.Duration {
+	clientMu.Lock()
+	c, ok := clients[addr.String()]
+	clientMu.Unlock()
+	if !ok {
+		return 0
+	}
+	return c.Latency()
+}
+
 // Close removes the client from the clients map and closes
 // the Closed channel.
 func (c *Client) Close() {
@@ -247,6 +275,9 @@ func (c *Client) heartbeat() error {
 		log.V(1).Infof("client %s heartbeat: %v", c.Addr(), call.Error)
 		c.mu.Lock()
 		c.healthy = true
+		if call.Error == nil {
+			c.updateLatency(time.Duration(receiveTime - sendTime))
+		}
 		c.offset.MeasuredAt = receiveTime
 		if receiveTime-sendTime > maximumClockReadingDelay.Nanoseconds() {
 			c.offset = proto.InfiniteOffset
@@ -279,3 +310,13 @@ func (c *Client) heartbeat() error {
 	<-call.Done
 	return call.Error
 }
+
+// updateLatency folds a heartbeat round-trip time into the moving
+// average of the client's latency. c.mu must be held.
+func (c *Client) updateLatency(rtt time.Duration) {
+	if c.latency == 0 {
+		c.latency = rtt
+		return
+	}
+	c.latency = time.Duration(latencyEWMAWeight*float64(rtt) + (1-latencyEWMAWeight)*float64(c.latency))
+}
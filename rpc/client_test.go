@@ -129,6 +129,10 @@ func TestOffsetMeasurement(t *testing.T) {
 	if o := c.RemoteOffset(); !o.Equal(expectedOffset) {
 		t.Errorf("expected offset %v, actual %v", expectedOffset, o)
 	}
+	// Each round trip takes one clock advancement.
+	if l := c.Latency(); l != 10 {
+		t.Errorf("expected latency 10ns, actual %s", l)
+	}
 
 	// Ensure the offsets map was updated properly too.
 	context.RemoteClocks.mu.Lock()
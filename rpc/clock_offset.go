@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 )
@@ -45,6 +46,22 @@ type RemoteClockMonitor struct {
 	mu      sync.Mutex
 	// Wall time in nanoseconds when we last monitored cluster offset.
 	lastMonitoredAt int64
+	// The outcome of the most recent cluster offset measurement.
+	status ClockOffsetStatus
+}
+
+// ClockOffsetStatus describes the outcome of the most recent
+// measurement of this node's offset from the cluster time.
+type ClockOffsetStatus struct {
+	// Interval is the measured offset interval.
+	Interval ClusterOffsetInterval `json:"interval"`
+	// Healthy is false if the offset could not be determined or may
+	// exceed the maximum clock offset.
+	Healthy bool `json:"healthy"`
+	// Error describes why the offset is unhealthy, if it is.
+	Error string `json:"error,omitempty"`
+	// MeasuredAt is the wall time in nanoseconds of the measurement.
+	MeasuredAt int64 `json:"measuredAt"`
 }
 
 // ClusterOffsetInterval is the best interval we can construct to estimate this
@@ -98,6 +115,7 @@ func newRemoteClockMonitor(clock *hlc.Clock) *RemoteClockMonitor {
 	return &RemoteClockMonitor{
 		offsets: map[string]proto.RemoteOffset{},
 		lClock:  clock,
+		status:  ClockOffsetStatus{Healthy: true},
 	}
 }
 
@@ -141,39 +159,83 @@ func (r *RemoteClockMonitor) UpdateOffset(addr string, offset proto.RemoteOffset
 
 // MonitorRemoteOffsets periodically checks that the offset of this server's
 // clock from the true cluster time is within MaxOffset. If the offset exceeds
-// MaxOffset, then this method will trigger a fatal error, causing the node to
-// suicide.
-func (r *RemoteClockMonitor) MonitorRemoteOffsets() {
+// MaxOffset and degrade is false, then this method will trigger a fatal
+// error, causing the node to suicide. If degrade is true, the monitor is
+// marked unhealthy instead (see Err) until the offset returns to normal.
+func (r *RemoteClockMonitor) MonitorRemoteOffsets(degrade bool) {
 	log.V(1).Infof("monitoring cluster offset")
 	for {
 		time.Sleep(monitorInterval)
-		offsetInterval, err := r.findOffsetInterval()
-		// By the contract of the hlc, if the value is 0, then safety checking
-		// of the max offset is disabled. However we may still want to
-		// propagate the information to a status node.
-		// TODO(embark): once there is a framework for collecting timeseries
-		// data about the db, propagate the offset status to that.
-		// Don't forget to protect r.offsets through the Mutex if those
-		// Fatalf's below ever turn into something less destructive.
-		if r.lClock.MaxOffset() != 0 {
-			if err != nil {
-				log.Fatalf("clock offset from the cluster time "+
-					"for remote clocks %v could not be determined: %s",
-					r.offsets, err)
-			}
+		r.checkRemoteOffsets(degrade)
+	}
+}
+
+// checkRemoteOffsets measures the cluster offset once, updating the
+// monitor's status.
+func (r *RemoteClockMonitor) checkRemoteOffsets(degrade bool) {
+	offsetInterval, err := r.findOffsetInterval()
+	// By the contract of the hlc, if the value is 0, then safety checking
+	// of the max offset is disabled. The measured interval is still
+	// reported through the status.
+	maxOffset := r.lClock.MaxOffset()
+	if maxOffset == 0 {
+		err = nil
+	} else if err != nil {
+		err = util.Errorf("clock offset from the cluster time "+
+			"could not be determined: %s", err)
+	} else if !isHealthyOffsetInterval(offsetInterval, maxOffset) {
+		err = util.Errorf("clock offset from the cluster time "+
+			"is in interval: %v, which indicates that the true offset "+
+			"is greater than %s", offsetInterval, maxOffset)
+	}
 
-			if !isHealthyOffsetInterval(offsetInterval, r.lClock.MaxOffset()) {
-				log.Fatalf("clock offset from the cluster time "+
-					"for remote clocks: %v is in interval: %v, which "+
-					"indicates that the true offset is greater than %d",
-					r.offsets, offsetInterval, r.lClock.MaxOffset())
-			}
-			log.V(1).Infof("healthy cluster offset: %v", offsetInterval)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		if !degrade {
+			log.Fatalf("%s; remote clocks: %v", err, r.offsets)
+		}
+		if r.status.Healthy {
+			log.Errorf("%s; remote clocks: %v; node is unhealthy until the "+
+				"offset returns to normal", err, r.offsets)
 		}
-		r.mu.Lock()
-		r.lastMonitoredAt = r.lClock.PhysicalNow()
-		r.mu.Unlock()
+	} else if !r.status.Healthy {
+		log.Infof("cluster offset returned to normal: %v", offsetInterval)
+	} else {
+		log.V(1).Infof("healthy cluster offset: %v", offsetInterval)
+	}
+	r.lastMonitoredAt = r.lClock.PhysicalNow()
+	r.status = ClockOffsetStatus{
+		Interval:   offsetInterval,
+		Healthy:    err == nil,
+		MeasuredAt: r.lastMonitoredAt,
+	}
+	if err != nil {
+		r.status.Error = err.Error()
+	}
+}
+
+// Status returns the outcome of the most recent cluster offset
+// measurement.
+func (r *RemoteClockMonitor) Status() ClockOffsetStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+// Err returns an error if the most recent cluster offset measurement
+// found this node's clock offset to be unhealthy, and nil otherwise
+// or if r is nil.
+func (r *RemoteClockMonitor) Err() error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.status.Healthy {
+		return nil
 	}
+	return util.Errorf("clock offset is unhealthy: %s", r.status.Error)
 }
 
 // isHealthyOffsetInterval returns true if the ClusterOffsetInterval indicates
@@ -388,6 +388,63 @@ func TestIsHealthyOffsetInterval(t *testing.T) {
 	assertIntervalHealth(false, interval, maxOffset, t)
 }
 
+// TestCheckRemoteOffsetsDegrade verifies that, when degrading instead of
+// exiting, an unhealthy cluster offset marks the monitor unhealthy and
+// that it recovers once the offset returns to normal.
+func TestCheckRemoteOffsetsDegrade(t *testing.T) {
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+	clock.SetMaxOffset(10 * time.Nanosecond)
+	monitor := newRemoteClockMonitor(clock)
+	if err := monitor.Err(); err != nil {
+		t.Fatalf("expected new monitor to be healthy: %s", err)
+	}
+
+	// All remote clocks agree this clock is 50ns off.
+	monitor.offsets = map[string]proto.RemoteOffset{
+		"0": {Offset: 50, Error: 1},
+		"1": {Offset: 50, Error: 1},
+		"2": {Offset: 50, Error: 1},
+	}
+	monitor.checkRemoteOffsets(true)
+	if err := monitor.Err(); err == nil {
+		t.Error("expected unhealthy cluster offset")
+	}
+	if status := monitor.Status(); status.Healthy || status.Interval.Lowerbound != 39 {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	// The remote clocks disagree; no interval can be determined.
+	monitor.offsets = map[string]proto.RemoteOffset{
+		"0": {Offset: 0, Error: 1},
+		"1": {Offset: 50, Error: 1},
+		"2": {Offset: 100, Error: 1},
+	}
+	monitor.checkRemoteOffsets(true)
+	if err := monitor.Err(); err == nil {
+		t.Error("expected undeterminable cluster offset to be unhealthy")
+	}
+
+	// The offset returns to normal.
+	monitor.offsets = map[string]proto.RemoteOffset{
+		"0": {Offset: 0, Error: 1},
+		"1": {Offset: 1, Error: 1},
+		"2": {Offset: 2, Error: 1},
+	}
+	monitor.checkRemoteOffsets(true)
+	if err := monitor.Err(); err != nil {
+		t.Errorf("expected monitor to recover: %s", err)
+	}
+	if status := monitor.Status(); !status.Healthy || status.Error != "" {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	// A nil monitor is always healthy.
+	if err := (*RemoteClockMonitor)(nil).Err(); err != nil {
+		t.Errorf("expected nil monitor to be healthy: %s", err)
+	}
+}
+
 func assertMajorityIntervalError(clocks *RemoteClockMonitor, t *testing.T) {
 	interval, err := clocks.findOffsetInterval()
 	expectedErr := MajorityIntervalNotFoundError{}
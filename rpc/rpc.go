@@ -14,6 +14,10 @@ type Context struct {
 	stopper      *util.Stopper
 	RemoteClocks *RemoteClockMonitor
 	DisableCache bool // Disable client cache when calling NewClient()
+	// TLSConfigFn, if set, returns the TLS config of each new
+	// connection in place of the config supplied on creation, allowing
+	// certificates to be rotated.
+	TLSConfigFn func() *tls.Config
 }
 
 // NewContext creates an rpc Context with the supplied values.
@@ -35,5 +39,15 @@ func (c *Context) Copy() *Context {
 		stopper:      c.stopper,
 		RemoteClocks: newRemoteClockMonitor(c.localClock),
 		DisableCache: c.DisableCache,
+		TLSConfigFn:  c.TLSConfigFn,
 	}
 }
+
+// TLSConfig returns the TLS config for a new connection, or nil if
+// TLS is disabled.
+func (c *Context) TLSConfig() *tls.Config {
+	if c.TLSConfigFn != nil {
+		return c.TLSConfigFn()
+	}
+	return c.tlsConfig
+}
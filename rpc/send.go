@@ -24,6 +24,7 @@ import (
 	"math/rand"
 	"net"
 	"net/rpc"
+	"sort"
 	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -40,6 +41,10 @@ const (
 	OrderStable = iota
 	// OrderRandom randomly orders available endpoints.
 	OrderRandom
+	// OrderByLatency orders endpoints by increasing heartbeat latency.
+	// Endpoints whose latency hasn't been measured yet follow in the
+	// order provided; known-unhealthy endpoints come last.
+	OrderByLatency
 )
 
 // An Options structure describes the algorithm for sending RPCs to
@@ -116,33 +121,10 @@ func Send(opts Options, method string, addrs []net.Addr, getArgs func(addr net.A
 	}
 
 	var clients []*Client
-	switch opts.Ordering {
-	case OrderStable:
-		for _, addr := range addrs {
-			clients = append(clients, NewClient(addr, nil, context))
-		}
-	case OrderRandom:
-		// Randomly permute order, but keep known-unhealthy clients last.
-		var healthy, unhealthy []*Client
-		for _, addr := range addrs {
-			client := NewClient(addr, nil, context)
-			if client.IsHealthy() {
-				healthy = append(healthy, client)
-			} else {
-				unhealthy = append(unhealthy, client)
-			}
-		}
-		for _, idx := range rand.Perm(len(healthy)) {
-			clients = append(clients, healthy[idx])
-		}
-		for _, idx := range rand.Perm(len(unhealthy)) {
-			clients = append(clients, unhealthy[idx])
-		}
+	for _, addr := range addrs {
+		clients = append(clients, NewClient(addr, nil, context))
 	}
-	// TODO(spencer): going to need to also sort by affinity; closest
-	// ping time should win. Makes sense to have the rpc client/server
-	// heartbeat measure ping times. With a bit of seasoning, each
-	// node will be able to order the healthy replicas based on latency.
+	orderClients(opts.Ordering, clients)
 
 	replies := []interface{}(nil)
 	helperChan := make(chan interface{}, len(clients))
@@ -205,6 +187,52 @@ func Send(opts Options, method string, addrs []net.Addr, getArgs func(addr net.A
 	}
 }
 
+// orderClients rearranges clients in place according to the ordering
+// policy.
+func orderClients(ordering OrderingPolicy, clients []*Client) {
+	switch ordering {
+	case OrderRandom:
+		// Randomly permute order, but keep known-unhealthy clients last.
+		var healthy, unhealthy []*Client
+		for _, client := range clients {
+			if client.IsHealthy() {
+				healthy = append(healthy, client)
+			} else {
+				unhealthy = append(unhealthy, client)
+			}
+		}
+		i := 0
+		for _, idx := range rand.Perm(len(healthy)) {
+			clients[i] = healthy[idx]
+			i++
+		}
+		for _, idx := range rand.Perm(len(unhealthy)) {
+			clients[i] = unhealthy[idx]
+			i++
+		}
+	case OrderByLatency:
+		sort.Stable(clientsByLatency(clients))
+	}
+}
+
+// clientsByLatency implements sort.Interface, ordering healthy clients
+// by increasing latency, with unmeasured latencies after measured ones
+// and unhealthy clients last.
+type clientsByLatency []*Client
+
+func (cs clientsByLatency) Len() int      { return len(cs) }
+func (cs clientsByLatency) Swap(i, j int) { cs[i], cs[j] = cs[j], cs[i] }
+func (cs clientsByLatency) Less(i, j int) bool {
+	if hi, hj := cs[i].IsHealthy(), cs[j].IsHealthy(); hi != hj {
+		return hi
+	}
+	li, lj := cs[i].Latency(), cs[j].Latency()
+	if li == 0 || lj == 0 {
+		return lj == 0 && li != 0
+	}
+	return li < lj
+}
+
 // sendOne invokes the specified RPC on the supplied client when the
 // client is ready. On success, the reply is sent on the channel;
 // otherwise an error is sent.
@@ -205,6 +205,26 @@ func TestClientNotReady(t *testing.T) {
 	}
 }
 
+// TestOrderByLatency verifies that OrderByLatency orders clients by
+// increasing latency, followed by those without measured latency and
+// unhealthy ones.
+func TestOrderByLatency(t *testing.T) {
+	unhealthy := &Client{latency: 1 * time.Millisecond}
+	unmeasured1 := &Client{healthy: true}
+	far := &Client{healthy: true, latency: 100 * time.Millisecond}
+	unmeasured2 := &Client{healthy: true}
+	near := &Client{healthy: true, latency: 2 * time.Millisecond}
+
+	clients := []*Client{unhealthy, unmeasured1, far, unmeasured2, near}
+	orderClients(OrderByLatency, clients)
+	expected := []*Client{near, far, unmeasured1, unmeasured2, unhealthy}
+	for i := range expected {
+		if clients[i] != expected[i] {
+			t.Errorf("%d: expected client %p, got %p", i, expected[i], clients[i])
+		}
+	}
+}
+
 // TestComplexScenarios verifies various complex success/failure scenarios by
 // mocking sendOne.
 func TestComplexScenarios(t *testing.T) {
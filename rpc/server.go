@@ -18,6 +18,7 @@
 package rpc
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net"
@@ -25,7 +26,9 @@ import (
 	"net/rpc"
 	"sync"
 
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/rpc/codec"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
 )
@@ -107,7 +110,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func (s *Server) Listen() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	ln, err := tlsListen(s.addr.Network(), s.addr.String(), s.context.tlsConfig)
+	ln, err := tlsListen(s.addr.Network(), s.addr.String(), s.context.TLSConfig)
 	if err != nil {
 		return err
 	}
@@ -201,7 +204,11 @@ func (s *Server) Close() {
 // serveConn synchronously serves a single connection. When the
 // connection is closed, close callbacks are invoked.
 func (s *Server) serveConn(conn net.Conn) {
-	s.ServeCodec(codec.NewServerCodec(conn))
+	serverCodec := codec.NewServerCodec(conn)
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		serverCodec = &authenticatedCodec{ServerCodec: serverCodec, conn: tlsConn}
+	}
+	s.ServeCodec(serverCodec)
 	s.mu.Lock()
 	if s.closeCallbacks != nil {
 		for _, cb := range s.closeCallbacks {
@@ -211,3 +218,25 @@ func (s *Server) serveConn(conn net.Conn) {
 	s.mu.Unlock()
 	conn.Close()
 }
+
+// authenticatedCodec wraps a server codec for a TLS connection. The
+// user of each request read from the connection is bound to the
+// user of the client certificate.
+type authenticatedCodec struct {
+	rpc.ServerCodec
+	conn *tls.Conn
+}
+
+// ReadRequestBody reads the request body and, if it's a KV request,
+// authenticates it. An error is returned to the caller in place of
+// executing the request if authentication fails.
+func (c *authenticatedCodec) ReadRequestBody(x interface{}) error {
+	if err := c.ServerCodec.ReadRequestBody(x); err != nil {
+		return err
+	}
+	if args, ok := x.(proto.Request); ok {
+		state := c.conn.ConnectionState()
+		return security.AuthenticateRequest(&state, args.Header())
+	}
+	return nil
+}
@@ -32,16 +32,37 @@ import (
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
-// tlsListen wraps either net.Listen or crypto/tls.Listen, depending on the contents of
-// the passed TLS Config.
-func tlsListen(network, address string, config *tls.Config) (net.Listener, error) {
-	if config == nil {
+// tlsListen wraps either net.Listen or a listener which performs TLS
+// handshakes, depending on the contents of the TLS Config returned by
+// configFn. The config is fetched anew for each accepted connection.
+func tlsListen(network, address string, configFn func() *tls.Config) (net.Listener, error) {
+	if configFn() == nil {
 		if network != "unix" {
 			log.Warningf("listening via %s to %s without TLS", network, address)
 		}
 		return net.Listen(network, address)
 	}
-	return tls.Listen(network, address, config)
+	ln, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return &tlsListener{Listener: ln, configFn: configFn}, nil
+}
+
+// A tlsListener is like the listener returned by crypto/tls.Listen,
+// but serves each connection with the current TLS config.
+type tlsListener struct {
+	net.Listener
+	configFn func() *tls.Config
+}
+
+// Accept waits for and returns the next incoming TLS connection.
+func (l *tlsListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return tls.Server(conn, l.configFn()), nil
 }
 
 // tlsDial wraps either net.Dial or crypto/tls.Dial, depending on the contents of
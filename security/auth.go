@@ -0,0 +1,75 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package security
+
+import (
+	"crypto/tls"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+const (
+	// NodeUser is the CommonName of node certificates. Nodes forward
+	// requests on behalf of clients, so requests presenting a node
+	// certificate keep the user they claim.
+	NodeUser = "node"
+)
+
+// GetCertificateUser returns the user name from the first verified
+// peer certificate of the TLS connection state. The user name is the
+// certificate's CommonName.
+func GetCertificateUser(tlsState *tls.ConnectionState) (string, error) {
+	if tlsState == nil {
+		return "", util.Errorf("request is not using TLS")
+	}
+	if len(tlsState.PeerCertificates) == 0 {
+		return "", util.Errorf("no client certificates in request")
+	}
+	if len(tlsState.VerifiedChains) == 0 {
+		return "", util.Errorf("client certificate could not be verified")
+	}
+	user := tlsState.PeerCertificates[0].Subject.CommonName
+	if user == "" {
+		return "", util.Errorf("client certificate has no CommonName")
+	}
+	return user, nil
+}
+
+// AuthenticateRequest binds the user of a request received over a TLS
+// connection to the user of the client certificate. A request which
+// claims a different user than its certificate is rejected; one
+// which claims no user is assigned the certificate's user. Requests
+// presenting a node certificate are left untouched. A nil tlsState
+// indicates an insecure connection, on which the requested user is
+// trusted as is.
+func AuthenticateRequest(tlsState *tls.ConnectionState, header *proto.RequestHeader) error {
+	if tlsState == nil {
+		return nil
+	}
+	user, err := GetCertificateUser(tlsState)
+	if err != nil {
+		return err
+	}
+	if user == NodeUser {
+		return nil
+	}
+	if header.User != "" && header.User != user {
+		return util.Errorf("requested user is %q, but certificate is for %q", header.User, user)
+	}
+	header.User = user
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package security_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+)
+
+// makeTLSState returns a TLS connection state with a single verified
+// peer certificate for the specified CommonName.
+func makeTLSState(commonName string) *tls.ConnectionState {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	return &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert},
+		VerifiedChains:   [][]*x509.Certificate{{cert}},
+	}
+}
+
+func TestAuthenticateRequest(t *testing.T) {
+	testCases := []struct {
+		tlsState       *tls.ConnectionState
+		user           string
+		expUser        string
+		expectsSuccess bool
+	}{
+		// Insecure connections trust the requested user.
+		{nil, "foo", "foo", true},
+		{nil, "", "", true},
+		// No client certificate.
+		{&tls.ConnectionState{}, "foo", "", false},
+		// Unverified client certificate.
+		{&tls.ConnectionState{PeerCertificates: makeTLSState("foo").PeerCertificates}, "foo", "", false},
+		// Client certificate without CommonName.
+		{makeTLSState(""), "foo", "", false},
+		// Node certificates may act on behalf of any user.
+		{makeTLSState(security.NodeUser), "foo", "foo", true},
+		{makeTLSState(security.NodeUser), "", "", true},
+		// Client certificates determine the user.
+		{makeTLSState("foo"), "", "foo", true},
+		{makeTLSState("foo"), "foo", "foo", true},
+		{makeTLSState("foo"), "root", "", false},
+	}
+
+	for i, tc := range testCases {
+		header := &proto.RequestHeader{User: tc.user}
+		err := security.AuthenticateRequest(tc.tlsState, header)
+		if (err == nil) != tc.expectsSuccess {
+			t.Errorf("%d: expected success=%t; got %v", i, tc.expectsSuccess, err)
+			continue
+		}
+		if err == nil && header.User != tc.expUser {
+			t.Errorf("%d: expected user %q; got %q", i, tc.expUser, header.User)
+		}
+	}
+}
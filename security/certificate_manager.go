@@ -0,0 +1,207 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// certExpirationWarning is how long before its expiration a loaded
+// certificate starts being logged as about to expire.
+const certExpirationWarning = 30 * 24 * time.Hour
+
+// CertificateInfo describes a loaded certificate. It's reported via
+// the status server so that expiring certificates can be rotated in
+// time.
+type CertificateInfo struct {
+	File       string    `json:"file"`
+	CommonName string    `json:"commonName"`
+	NotBefore  time.Time `json:"notBefore"`
+	NotAfter   time.Time `json:"notAfter"`
+}
+
+// A CertificateManager loads the certificates in a certs directory
+// into TLS configs and reloads them on demand, allowing certificates,
+// including the CA certificate, to be rotated without restarting. A
+// manager for a node serves the node certificate and presents it to
+// other nodes; the certs directory must contain the same files as for
+// LoadTLSConfigFromDir. A manager for a client presents the client
+// certificate chosen as by LoadClientTLSConfigFromDir.
+//
+// Loaded TLS configs are never modified; each load replaces them, so
+// users must fetch the current config for each new connection. To
+// rotate the CA, ca.crt should contain both the old and new CA
+// certificates while certificates signed by the new CA are
+// distributed.
+type CertificateManager struct {
+	certsDir string
+	user     string // Client certificate user, for client managers
+	isNode   bool
+
+	mu           sync.RWMutex // Protects the fields below
+	certInfos    []CertificateInfo
+	caInfos      []CertificateInfo
+	serverConfig *tls.Config // nil for client managers
+	clientConfig *tls.Config
+}
+
+// NewCertificateManager creates a CertificateManager for a node and
+// loads the certificates in certsDir.
+func NewCertificateManager(certsDir string) (*CertificateManager, error) {
+	cm := &CertificateManager{certsDir: certsDir, isNode: true}
+	if err := cm.LoadCertificates(); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// NewClientCertificateManager creates a CertificateManager for a
+// client acting as user and loads the certificates in certsDir.
+func NewClientCertificateManager(certsDir, user string) (*CertificateManager, error) {
+	cm := &CertificateManager{certsDir: certsDir, user: user}
+	if err := cm.LoadCertificates(); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// LoadCertificates (re)loads the CA certificate and the node or
+// client certificate and key from the certs directory. On error, the
+// previously loaded certificates remain in use.
+func (cm *CertificateManager) LoadCertificates() error {
+	caPEM, err := readFileFn(path.Join(cm.certsDir, "ca.crt"))
+	if err != nil {
+		return err
+	}
+	caInfos, err := certificateInfo("ca.crt", caPEM)
+	if err != nil {
+		return err
+	}
+	caPool := x509.NewCertPool()
+	if ok := caPool.AppendCertsFromPEM(caPEM); !ok {
+		return util.Error("failed to parse PEM data to pool")
+	}
+
+	var certPEM, keyPEM []byte
+	var certFile string
+	if cm.isNode {
+		certFile = "node.crt"
+		if certPEM, err = readFileFn(path.Join(cm.certsDir, certFile)); err != nil {
+			return err
+		}
+		if keyPEM, err = readFileFn(path.Join(cm.certsDir, "node.key")); err != nil {
+			return err
+		}
+	} else if certPEM, keyPEM, certFile, err = readClientCertFromDir(cm.certsDir, cm.user); err != nil {
+		return err
+	}
+
+	var certs []tls.Certificate
+	var certInfos []CertificateInfo
+	if certPEM != nil {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return util.Errorf("error loading certificate %s: %s", certFile, err)
+		}
+		if certInfos, err = certificateInfo(certFile, certPEM); err != nil {
+			return err
+		}
+		certs = append(certs, cert)
+	}
+	for _, info := range append(certInfos, caInfos...) {
+		if expiresIn := info.NotAfter.Sub(time.Now()); expiresIn < certExpirationWarning {
+			log.Warningf("certificate %s for %q expires in %s", info.File, info.CommonName, expiresIn)
+		}
+	}
+
+	var serverConfig *tls.Config
+	if cm.isNode {
+		serverConfig = newServerTLSConfig(caPool)
+		serverConfig.Certificates = certs
+	}
+	clientConfig := newClientTLSConfig(caPool, certs)
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.certInfos = certInfos
+	cm.caInfos = caInfos
+	cm.serverConfig = serverConfig
+	cm.clientConfig = clientConfig
+	return nil
+}
+
+// certificateInfo returns a CertificateInfo for each certificate in
+// the supplied PEM data.
+func certificateInfo(file string, certPEM []byte) ([]CertificateInfo, error) {
+	var infos []CertificateInfo
+	for {
+		var block *pem.Block
+		block, certPEM = pem.Decode(certPEM)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, util.Errorf("error parsing certificate %s: %s", file, err)
+		}
+		infos = append(infos, CertificateInfo{
+			File:       file,
+			CommonName: cert.Subject.CommonName,
+			NotBefore:  cert.NotBefore,
+			NotAfter:   cert.NotAfter,
+		})
+	}
+	if len(infos) == 0 {
+		return nil, util.Errorf("no certificates found in %s", file)
+	}
+	return infos, nil
+}
+
+// Certificates returns a description of the currently loaded
+// certificates.
+func (cm *CertificateManager) Certificates() []CertificateInfo {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return append(append([]CertificateInfo(nil), cm.certInfos...), cm.caInfos...)
+}
+
+// TLSConfig returns the current TLS config of a node, which presents
+// the node certificate both when serving and when connecting to other
+// nodes. Returns nil for client managers.
+func (cm *CertificateManager) TLSConfig() *tls.Config {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.serverConfig
+}
+
+// ClientTLSConfig returns the current TLS config for connecting to
+// servers.
+func (cm *CertificateManager) ClientTLSConfig() *tls.Config {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.clientConfig
+}
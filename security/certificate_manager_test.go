@@ -0,0 +1,225 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package security_test
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// removeCerts removes the certificates and keys with the given
+// prefixes from certsDir.
+func removeCerts(t *testing.T, certsDir string, prefixes ...string) {
+	for _, prefix := range prefixes {
+		for _, file := range []string{prefix + ".crt", prefix + ".key"} {
+			if err := os.Remove(path.Join(certsDir, file)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+// verifiedBy returns whether the certificate presented by config
+// verifies against the CA certificates in pool.
+func verifiedBy(t *testing.T, config *tls.Config, pool *x509.CertPool) bool {
+	cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err == nil
+}
+
+// TestCertificateManagerReload verifies that a rotated node certificate
+// and CA certificate are served after reloading and that a failed
+// reload keeps the previous certificates.
+func TestCertificateManagerReload(t *testing.T) {
+	// Do not mock cert access for this test.
+	security.ResetReadFileFn()
+	defer security.ResetTest()
+	certsDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(certsDir)
+
+	if err := security.RunCreateCACert(certsDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.RunCreateNodeCert(certsDir, []string{"127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	cm, err := security.NewCertificateManager(certsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	origConfig := cm.TLSConfig()
+	infos := cm.Certificates()
+	if len(infos) != 2 || infos[0].CommonName != security.NodeUser || infos[0].NotAfter.IsZero() {
+		t.Fatalf("unexpected certificates: %+v", infos)
+	}
+
+	// Rotate the node certificate.
+	removeCerts(t, certsDir, "node")
+	if err := security.RunCreateNodeCert(certsDir, []string{"127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cm.LoadCertificates(); err != nil {
+		t.Fatal(err)
+	}
+	config := cm.TLSConfig()
+	if bytes.Equal(origConfig.Certificates[0].Certificate[0], config.Certificates[0].Certificate[0]) {
+		t.Fatal("expected rotated certificate to be served")
+	}
+
+	// Rotate the CA and node certificates.
+	removeCerts(t, certsDir, "ca", "node")
+	if err := security.RunCreateCACert(certsDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.RunCreateNodeCert(certsDir, []string{"127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cm.LoadCertificates(); err != nil {
+		t.Fatal(err)
+	}
+	newConfig := cm.TLSConfig()
+	if verifiedBy(t, newConfig, config.RootCAs) {
+		t.Fatal("expected certificate signed by the new CA not to verify against the old CA")
+	}
+	if !verifiedBy(t, newConfig, newConfig.RootCAs) || !verifiedBy(t, newConfig, newConfig.ClientCAs) {
+		t.Fatal("expected certificate signed by the new CA to verify against the reloaded CA")
+	}
+
+	// A corrupt certificate fails to load and the previous one remains.
+	if err := ioutil.WriteFile(path.Join(certsDir, "node.crt"), []byte("garbage"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := cm.LoadCertificates(); err == nil {
+		t.Fatal("expected error loading corrupt certificate")
+	}
+	if cm.TLSConfig() != newConfig {
+		t.Fatal("expected previous certificate to remain in use")
+	}
+}
+
+// TestClientCertificateReload verifies that the client TLS config of a
+// context presents a rotated client certificate and verifies servers
+// against a rotated CA certificate once certificates are reloaded.
+func TestClientCertificateReload(t *testing.T) {
+	// Do not mock cert access for this test.
+	security.ResetReadFileFn()
+	defer security.ResetTest()
+	certsDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(certsDir)
+
+	const user = "testuser"
+	if err := security.RunCreateCACert(certsDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.RunCreateClientCert(certsDir, user); err != nil {
+		t.Fatal(err)
+	}
+	ctx := &base.Context{Certs: certsDir, User: user}
+	origConfig, err := ctx.GetClientTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(origConfig.Certificates) != 1 {
+		t.Fatalf("expected client certificate to be presented, got %d certificates", len(origConfig.Certificates))
+	}
+
+	// Rotate the CA and client certificates.
+	removeCerts(t, certsDir, "ca", "client."+user)
+	if err := security.RunCreateCACert(certsDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.RunCreateClientCert(certsDir, user); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ReloadCertificates(); err != nil {
+		t.Fatal(err)
+	}
+	config, err := ctx.GetClientTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(origConfig.Certificates[0].Certificate[0], config.Certificates[0].Certificate[0]) {
+		t.Fatal("expected rotated client certificate to be presented")
+	}
+	if verifiedBy(t, config, origConfig.RootCAs) || !verifiedBy(t, config, config.RootCAs) {
+		t.Fatal("expected servers to be verified against the reloaded CA")
+	}
+}
+
+// TestNodeClientCertificate verifies that a node presents its node
+// certificate to other nodes even when the certs directory also holds
+// a client certificate for the context's user, which clients present.
+func TestNodeClientCertificate(t *testing.T) {
+	// Do not mock cert access for this test.
+	security.ResetReadFileFn()
+	defer security.ResetTest()
+	certsDir := util.CreateTempDir(t, "certs_test")
+	defer util.CleanupDir(certsDir)
+
+	const rootUser = "root"
+	if err := security.RunCreateCACert(certsDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.RunCreateNodeCert(certsDir, []string{"127.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.RunCreateClientCert(certsDir, rootUser); err != nil {
+		t.Fatal(err)
+	}
+
+	presented := func(config *tls.Config) string {
+		cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert.Subject.CommonName
+	}
+
+	clientCtx := &base.Context{Certs: certsDir, User: rootUser}
+	config, err := clientCtx.GetClientTLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cn := presented(config); cn != rootUser {
+		t.Errorf("expected client to present the %q certificate; got %q", rootUser, cn)
+	}
+
+	nodeCtx := &base.Context{Certs: certsDir, User: rootUser}
+	if _, err := nodeCtx.GetServerTLSConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if config, err = nodeCtx.GetClientTLSConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if cn := presented(config); cn != security.NodeUser {
+		t.Errorf("expected node to present the %q certificate; got %q", security.NodeUser, cn)
+	}
+}
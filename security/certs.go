@@ -82,6 +82,26 @@ func writeCertificateAndKey(certsDir string, prefix string,
 	return nil
 }
 
+// loadCACertAndKey loads the CA certificate and private key from the
+// certs directory.
+func loadCACertAndKey(certsDir string) (*x509.Certificate, crypto.PrivateKey, error) {
+	caCertPath := path.Join(certsDir, "ca.crt")
+	caKeyPath := path.Join(certsDir, "ca.key")
+	// LoadX509KeyPair does a bunch of validation, including len(Certificates) != 0.
+	caCert, err := tls.LoadX509KeyPair(caCertPath, caKeyPath)
+	if err != nil {
+		return nil, nil, util.Errorf("error loading CA certificate %s and key %s: %s",
+			caCertPath, caKeyPath, err)
+	}
+
+	// Extract x509 certificate from tls cert.
+	x509Cert, err := x509.ParseCertificate(caCert.Certificate[0])
+	if err != nil {
+		return nil, nil, util.Errorf("error parsing CA certificate %s: %s", caCertPath, err)
+	}
+	return x509Cert, caCert.PrivateKey, nil
+}
+
 // RunCreateCACert is the entry-point from the command-line interface
 // to generate CA cert and key.
 func RunCreateCACert(certsDir string) error {
@@ -115,28 +135,52 @@ func RunCreateNodeCert(certsDir string, hosts []string) error {
 		return util.Errorf("no hosts specified. Need at least one")
 	}
 
-	// Load the CA certificate.
-	caCertPath := path.Join(certsDir, "ca.crt")
-	caKeyPath := path.Join(certsDir, "ca.key")
-	// LoadX509KeyPair does a bunch of validation, including len(Certificates) != 0.
-	caCert, err := tls.LoadX509KeyPair(caCertPath, caKeyPath)
+	caCert, caKey, err := loadCACertAndKey(certsDir)
 	if err != nil {
-		return util.Errorf("error loading CA certificate %s and key %s: %s",
-			caCertPath, caKeyPath, err)
+		return err
 	}
 
-	// Extract x509 certificate from tls cert.
-	x509Cert, err := x509.ParseCertificate(caCert.Certificate[0])
+	// Generate certificate.
+	certificate, key, err := GenerateNodeCert(caCert, caKey, hosts)
 	if err != nil {
-		return util.Errorf("error parsing CA certificate %s: %s", caCertPath, err)
+		return util.Errorf("error creating node certificate and key: %s", err)
+	}
+
+	err = writeCertificateAndKey(certsDir, "node", certificate, key)
+	return err
+}
+
+// RunCreateClientCert is the entry-point from the command-line interface
+// to generate a client cert and key for the specified user. The files
+// are named after the user: client.<user>.crt and client.<user>.key.
+func RunCreateClientCert(certsDir string, user string) error {
+	if certsDir == "" {
+		return util.Errorf("no certs directory specified, use -certs")
+	}
+	if user == "" {
+		return util.Errorf("no user specified")
+	}
+	if user == NodeUser {
+		return util.Errorf("user %q is reserved for node certificates", user)
+	}
+
+	caCert, caKey, err := loadCACertAndKey(certsDir)
+	if err != nil {
+		return err
 	}
 
 	// Generate certificate.
-	certificate, key, err := GenerateNodeCert(x509Cert, caCert.PrivateKey, hosts)
+	certificate, key, err := GenerateClientCert(caCert, caKey, user)
 	if err != nil {
-		return util.Errorf("error creating node certificate and key: %s", err)
+		return util.Errorf("error creating client certificate and key: %s", err)
 	}
 
-	err = writeCertificateAndKey(certsDir, "node", certificate, key)
+	err = writeCertificateAndKey(certsDir, clientCertPrefix(user), certificate, key)
 	return err
 }
+
+// clientCertPrefix returns the file name prefix of the certificate and
+// key for the specified user.
+func clientCertPrefix(user string) string {
+	return "client." + user
+}
@@ -18,6 +18,7 @@
 package security_test
 
 import (
+	"crypto/x509"
 	"net/http"
 	"testing"
 
@@ -62,6 +63,36 @@ func TestGenerateCerts(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
+
+	// Client certs need a user, which mustn't be the node user.
+	for _, user := range []string{"", security.NodeUser} {
+		if err := security.RunCreateClientCert(certsDir, user); err == nil {
+			t.Fatalf("Expected error for user %q, but got none", user)
+		}
+	}
+	err = security.RunCreateClientCert(certsDir, "foo")
+	if err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+
+	// The client cert is presented for its user only; other users fall
+	// back to the node cert.
+	for user, expCN := range map[string]string{"foo": "foo", "": security.NodeUser, "bar": security.NodeUser} {
+		config, err := security.LoadClientTLSConfigFromDir(certsDir, user)
+		if err != nil {
+			t.Fatalf("Expected success, got %v", err)
+		}
+		if len(config.Certificates) != 1 {
+			t.Fatalf("Expected 1 certificate for user %q, got %d", user, len(config.Certificates))
+		}
+		cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cn := cert.Subject.CommonName; cn != expCN {
+			t.Errorf("Expected certificate for %q, got %q", expCN, cn)
+		}
+	}
 }
 
 // This is a fairly high-level test of CA and node certificates.
@@ -88,7 +119,7 @@ func TestUseCerts(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
-	_, err = security.LoadClientTLSConfigFromDir(certsDir)
+	_, err = security.LoadClientTLSConfigFromDir(certsDir, "")
 	if err != nil {
 		t.Fatalf("Expected success, got %v", err)
 	}
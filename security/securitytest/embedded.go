@@ -123,7 +123,7 @@ func test_certs_ca_key() (*asset, error) {
 	return a, nil
 }
 
-var _test_certs_node_crt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x92\xcd\x72\xa2\x40\x14\x85\xf7\x3c\xc5\xbc\xc0\x14\xa8\x24\xe2\x22\x8b\x7b\xfb\x07\x1b\x6d\x48\x23\x88\x64\x87\x18\x1b\x10\x7f\x48\xc6\x69\xf4\xe9\x47\xad\x4c\xcd\x62\x2a\x77\xf9\x2d\xbe\x73\xea\xd6\xf9\x79\x3f\x64\xbe\x08\x7f\x10\x16\x27\x82\x0b\x02\x09\x7b\x50\x4b\x0a\x81\xfa\x4a\x08\x2c\x3a\x0d\x46\x20\x68\xa1\xd2\x40\xe4\xbf\xa5\xec\xa3\x90\x76\xa3\xf9\xc0\x44\x82\x73\x9b\xc2\x0c\xb5\xee\xaa\x5d\x13\xbd\x2a\x45\xa1\x81\x54\xc6\xc2\x58\x0c\x72\xba\x54\x6a\xc6\x4c\x4b\xd7\xc3\xf0\xa3\xdc\x4f\xaa\x7c\xa8\xcd\xb4\x2a\x43\x99\xa4\x26\xa4\xa2\x97\x0d\x18\x49\xe1\x29\x7b\xb0\xfc\xce\xcc\x5f\x66\x65\x5f\xa6\xef\x44\x59\x02\x09\xea\xf2\x2b\x59\xe0\xbf\x16\x12\xd1\x84\xc4\x02\x48\x4e\x83\xcb\xb6\x6c\x52\x3e\x91\x05\xd2\xeb\x7e\x5e\xe6\x98\xce\x2e\xd7\x43\x4b\xdb\x55\xc1\x73\xbb\xb0\xcb\xa8\xad\xc7\xd1\xf8\x1c\x10\x67\xe0\x79\xc5\x75\x97\x39\xdd\xbb\xe3\x38\xae\x08\x2c\x7b\xeb\x69\x77\xc8\x90\x06\xd5\x78\x2d\xab\x7a\x45\xcf\x4f\x75\x9f\xc1\x71\xe0\x9a\x15\x85\x08\x75\xb8\x9c\x2a\x0f\x61\xeb\x31\x04\x49\x20\x02\x33\x55\xb7\xb6\xb1\xd3\x22\xe6\xc6\xe2\x90\x8b\x99\xc9\x11\x55\x3a\x05\xc3\x7c\x42\x3e\x7d\x50\x29\x47\x23\x89\x04\xcf\x87\x41\xba\x61\x86\xa1\x6d\x14\x97\x77\x81\x12\xc6\xd7\x0f\x41\x8c\x28\x6f\x4f\xcc\x45\xb0\xf6\x27\x4d\x9e\xf5\xc7\xf5\x28\x74\x2a\x13\xdb\x00\x80\x12\x8e\x37\x59\xe7\x2f\xa4\x3b\x79\x24\x3b\x25\x48\xa6\x88\x20\x67\xfe\x3c\xd2\xd9\xeb\xaa\x10\x9f\x1f\xbf\xa8\x15\x19\x6e\x8a\xa0\xf6\x76\xe5\xa2\x3e\xf5\xe5\x69\x26\xdf\x7c\x1a\xbc\xd5\x17\xe2\x08\xa8\x01\x3b\x7a\x18\x0e\xcb\xe7\x25\x87\xf7\xe5\xee\x14\xc4\xbd\xbb\x27\x87\x76\x95\xd8\x61\x73\xd9\x1c\x27\xa9\xb5\xec\xdc\xdd\x9c\x6c\xf8\x42\xbf\xbc\x58\x8f\x69\xb0\x90\xfe\x3f\x97\x3f\x01\x00\x00\xff\xff\xb9\x94\x37\x92\x4b\x02\x00\x00")
+var _test_certs_node_crt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x75\x92\xdb\x92\xa2\x30\x10\x86\xef\x79\x8a\x7d\x01\x6b\x38\xc8\xaa\x97\x9d\x03\x18\xa9\x44\x02\x0c\x12\xef\x3c\x11\x94\x29\x75\x06\x35\x23\x4f\x3f\xc1\xad\xda\x9b\xad\xed\xcb\xaf\xfa\xef\xfe\xba\xaa\x47\x23\x5b\x88\xc6\x4c\xfc\xc2\x34\x2b\x58\xc4\x30\x14\x74\x80\x23\x87\x33\x86\xda\x02\x5b\x70\xd0\x60\x18\x02\xcd\x32\xe0\xa7\x2d\xf1\xa1\x52\xf9\x58\xd1\x46\x24\x9d\xc0\x6e\xfa\xa4\x06\x6b\xc5\x92\xcb\x9a\xf5\x27\x97\xda\x5e\x13\x11\x9a\x3b\x1c\x41\x0c\xde\x3b\xc5\x0d\x5f\x48\x7f\x76\xda\x04\x8b\x87\x5a\x89\x0b\x47\xe3\x8a\x14\xd4\xe3\x44\x3e\x79\xc1\x0c\x27\x60\x96\xe5\x65\x60\xfe\x8b\x91\x3f\xcc\xb1\xd0\xb0\x9e\xe6\xff\x1d\x24\x5d\x83\x8d\x22\xa5\x94\x84\x9a\xec\xbe\xf5\xb3\x0f\x1e\xb5\x86\x1a\x35\x1f\x6c\x1c\xab\x83\x41\xfe\x55\x23\x20\x77\x44\x6a\xa0\xbf\xf7\xbb\x73\xc0\x3c\x54\x17\xb1\x96\xd3\xf0\x18\xc4\x50\xe2\xae\x0b\x43\x19\x96\x7e\xb3\x4a\xfd\x47\x40\xae\xea\xde\x3b\xf7\xc9\x51\xde\x44\x9d\xc6\x5d\x18\xdd\x92\xf3\x4d\x88\x25\xc6\x75\xf0\xc6\x96\xa2\x01\xf9\x54\x07\xbf\x67\xea\xc3\x4c\xee\xaa\x2b\x75\x22\xb6\xc3\x76\xa2\xad\x51\xe6\xa6\x20\xe7\x6f\x0e\x02\x49\x40\x57\x9a\x23\x77\x38\x61\xbf\x90\x72\xc5\x91\x8c\x31\xee\x62\x90\xef\x11\x32\x1c\x21\xad\xbf\x90\xa6\x11\xb2\x76\x70\x02\x8e\xb4\x28\xe7\x99\x3d\xba\x9e\x52\xc7\x02\x4b\x2e\xaf\x30\x95\xb2\xe0\xc8\x66\x57\xdf\x0f\xe5\x47\xdd\x26\x9e\xf5\x7b\xb6\xa3\xb5\x01\x80\x02\x12\x3b\xe8\xb3\x69\x4f\xcb\x54\x0e\x4b\x05\x03\x82\x22\x07\x8e\xe0\x1f\x27\x0b\x4d\x12\x91\xea\x45\xd8\x7b\x73\xb4\x42\xde\x3a\xaf\x3e\xd7\xe3\x38\xad\x1a\xd2\xca\x32\xd8\x1c\xaf\xd1\x3a\x2d\xb0\x61\x0d\x70\xef\xaa\xbc\x2a\x37\x25\x69\xcf\x5f\xee\xd4\x09\xc4\x56\x14\xf5\xf5\x11\x1f\xea\x76\xf6\xf4\xbc\x25\xca\xf2\xe7\xec\x52\xf7\x97\xfa\xdb\x79\xbd\x0a\x15\xe4\xdf\xf7\xf9\x01\xba\x4f\x80\xb7\x5b\x02\x00\x00")
 
 func test_certs_node_crt_bytes() ([]byte, error) {
 	return bindata_read(
@@ -138,7 +138,7 @@ func test_certs_node_crt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "test_certs/node.crt", size: 587, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	info := bindata_file_info{name: "test_certs/node.crt", size: 603, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
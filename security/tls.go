@@ -88,12 +88,21 @@ func LoadTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
 		return nil, err
 	}
 
+	config := newServerTLSConfig(certPool)
+	config.Certificates = []tls.Certificate{cert}
+	return config, nil
+}
+
+// newServerTLSConfig creates a TLSConfig for nodes, which verifies
+// certificates against the CA certificates in certPool. The caller
+// sets the node certificate.
+func newServerTLSConfig(certPool *x509.CertPool) *tls.Config {
 	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		// TODO(marc): no client certs for now. Even specifying VerifyClientCertIfGiven
-		// causes issues with various browsers. We should switch to
-		// tls.RequireAndVerifyClientCert once client certs are properly set.
-		ClientAuth: tls.NoClientCert,
+		// Verify client certificates when presented; they determine the
+		// user of KV requests. Certificates aren't required since browsers
+		// accessing the admin UI don't have them; KV requests lacking one
+		// are rejected by AuthenticateRequest.
+		ClientAuth: tls.VerifyClientCertIfGiven,
 		RootCAs:    certPool,
 		ClientCAs:  certPool,
 
@@ -106,7 +115,7 @@ func LoadTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
 
 		// Should we disable session resumption? This may break forward secrecy.
 		// SessionTicketsDisabled: true,
-	}, nil
+	}
 }
 
 // LoadInsecureTLSConfig creates a TLSConfig that disables TLS.
@@ -115,18 +124,49 @@ func LoadInsecureTLSConfig() *tls.Config {
 }
 
 // LoadClientTLSConfigFromDir creates a client TLSConfig by loading the root CA certs from the
-// specified directory. The directory must contain ca.crt.
-func LoadClientTLSConfigFromDir(certDir string) (*tls.Config, error) {
+// specified directory. The directory must contain ca.crt. The client certificate and key
+// for user, client.<user>.crt and client.<user>.key, are presented to servers if present.
+// Otherwise, the node certificate and key are presented if present; nodes may issue
+// requests on behalf of any user.
+func LoadClientTLSConfigFromDir(certDir, user string) (*tls.Config, error) {
 	caPEM, err := readFileFn(path.Join(certDir, "ca.crt"))
 	if err != nil {
 		return nil, err
 	}
-	return LoadClientTLSConfig(caPEM)
+	certPEM, keyPEM, _, err := readClientCertFromDir(certDir, user)
+	if err != nil {
+		return nil, err
+	}
+	return LoadClientTLSConfig(certPEM, keyPEM, caPEM)
+}
+
+// readClientCertFromDir reads the certificate and key presented by
+// clients acting as user, as chosen by LoadClientTLSConfigFromDir,
+// along with the name of the certificate file. Returns nils if the
+// directory holds no suitable certificate.
+func readClientCertFromDir(certDir, user string) (certPEM, keyPEM []byte, certFile string, err error) {
+	prefixes := []string{"node"}
+	if user != "" {
+		prefixes = append([]string{clientCertPrefix(user)}, prefixes...)
+	}
+	for _, prefix := range prefixes {
+		certFile = prefix + ".crt"
+		if certPEM, err = readFileFn(path.Join(certDir, certFile)); err != nil {
+			continue
+		}
+		if keyPEM, err = readFileFn(path.Join(certDir, prefix+".key")); err != nil {
+			return nil, nil, "", err
+		}
+		return certPEM, keyPEM, certFile, nil
+	}
+	return nil, nil, "", nil
 }
 
 // LoadClientTLSConfig creates a client TLSConfig from the supplied byte strings containing
-// the certificate of the cluster CA.
-func LoadClientTLSConfig(caPEM []byte) (*tls.Config, error) {
+// - the certificate of the client (may be nil),
+// - the private key of the client (may be nil),
+// - the certificate of the cluster CA.
+func LoadClientTLSConfig(certPEM, keyPEM, caPEM []byte) (*tls.Config, error) {
 	certPool := x509.NewCertPool()
 
 	if ok := certPool.AppendCertsFromPEM(caPEM); !ok {
@@ -134,14 +174,31 @@ func LoadClientTLSConfig(caPEM []byte) (*tls.Config, error) {
 		return nil, err
 	}
 
+	var certs []tls.Certificate
+	if certPEM != nil {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	return newClientTLSConfig(certPool, certs), nil
+}
+
+// newClientTLSConfig creates a client TLSConfig which presents the
+// supplied certificates, if any, and verifies server certificates
+// against the CA certificates in certPool.
+func newClientTLSConfig(certPool *x509.CertPool, certs []tls.Certificate) *tls.Config {
 	return &tls.Config{
+		Certificates: certs,
+		// Server certificates are verified against the CA, including
+		// their hostnames and IP addresses.
 		RootCAs: certPool,
-		// TODO(marc): remove once we have a certificate deployment story in place.
-		InsecureSkipVerify: true,
 
 		// Use only TLS v1.2
 		MinVersion: tls.VersionTLS12,
-	}, nil
+	}
 }
 
 // LoadInsecureClientTLSConfig creates a TLSConfig that disables TLS.
@@ -148,6 +148,7 @@ func GenerateNodeCert(caCert *x509.Certificate, caKey crypto.PrivateKey, hosts [
 	// Set node-specific fields.
 	// Nodes needs SSL for both server and client authentication.
 	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	template.Subject.CommonName = NodeUser
 	if hosts != nil {
 		for _, h := range hosts {
 			if ip := net.ParseIP(h); ip != nil {
@@ -165,3 +166,32 @@ func GenerateNodeCert(caCert *x509.Certificate, caKey crypto.PrivateKey, hosts [
 
 	return certBytes, privateKey, nil
 }
+
+// GenerateClientCert generates a client certificate for the specified
+// user and returns the cert bytes as well as the private key used to
+// generate the certificate. The user name is stored in the
+// CommonName. The CA cert and private key should be passed in.
+func GenerateClientCert(caCert *x509.Certificate, caKey crypto.PrivateKey, user string) (
+	[]byte, crypto.PrivateKey, error) {
+	privateKey, publicKey, err := generateKeyPair()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := newTemplate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Set client-specific fields.
+	// Client certificates are only good for client authentication.
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	template.Subject.CommonName = user
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, caCert, publicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certBytes, privateKey, nil
+}
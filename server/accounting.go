@@ -19,15 +19,19 @@ package server
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
 )
 
 // An acctHandler implements the adminHandler interface.
 type acctHandler struct {
-	db *client.KV // Key-value database client
+	db    *client.KV                // Key-value database client
+	usage *storage.AcctUsageTracker // Aggregates gossiped accounting stats
 }
 
 // Put writes an accounting config for the specified key prefix (which is
@@ -51,6 +55,15 @@ func (ah *acctHandler) Get(path string, r *http.Request) (body []byte, contentTy
 	return getConfig(ah.db, engine.KeyConfigAccountingPrefix, &proto.AcctConfig{}, path, r)
 }
 
+// GetUsage retrieves the usage of the accounting prefix specified by
+// path, aggregated over the stats gossiped by all stores. The leading
+// "/" path delimiter is stripped; the usage of the default prefix is
+// retrieved if path is "/".
+func (ah *acctHandler) GetUsage(path string, r *http.Request) (body []byte, contentType string, err error) {
+	prefix := proto.Key(strings.TrimPrefix(path, "/"))
+	return util.MarshalResponse(r, ah.usage.Usage(prefix), util.AllEncodings)
+}
+
 // Delete removes the accouting config specified by key.
 func (ah *acctHandler) Delete(path string, r *http.Request) error {
 	return deleteConfig(ah.db, engine.KeyConfigAccountingPrefix, path, r)
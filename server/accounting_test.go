@@ -61,17 +61,33 @@ func ExampleSetAndGetAccts() {
 	// accounting config for key prefix "":
 	// cluster_id: test
 	//
+	// accounting usage for key prefix "":
+	// bytes: 0
+	// keys: 0
+	//
 	// set accounting config for key prefix "db1"
 	// accounting config for key prefix "db1":
 	// cluster_id: test
 	//
+	// accounting usage for key prefix "db1":
+	// bytes: 0
+	// keys: 0
+	//
 	// set accounting config for key prefix "db+2"
 	// accounting config for key prefix "db+2":
 	// cluster_id: test
 	//
+	// accounting usage for key prefix "db+2":
+	// bytes: 0
+	// keys: 0
+	//
 	// set accounting config for key prefix "%FE"
 	// accounting config for key prefix "%FE":
 	// cluster_id: test
+	//
+	// accounting usage for key prefix "%FE":
+	// bytes: 0
+	// keys: 0
 }
 
 // ExampleLsAccts creates a series of acct configs and verifies
@@ -218,10 +234,16 @@ func ExampleAcctContentTypes() {
 	}
 	// Output:
 	// {
-	//   "cluster_id": "test"
+	//   "cluster_id": "test",
+	//   "max_bytes": 0,
+	//   "max_keys": 0,
+	//   "max_qps": 0
 	// }
 	// {
-	//   "cluster_id": "test"
+	//   "cluster_id": "test",
+	//   "max_bytes": 0,
+	//   "max_keys": 0,
+	//   "max_qps": 0
 	// }
 	// cluster_id: test
 	//
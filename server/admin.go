@@ -30,8 +30,11 @@ import (
 	_ "net/http/pprof"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
 )
 
@@ -48,12 +51,23 @@ const (
 	healthPath = adminEndpoint + "health"
 	// quitPath is the quit endpoint.
 	quitPath = adminEndpoint + "quit"
+	// drainTimeoutParam is the quit endpoint's query parameter bounding
+	// the time spent draining the server before it's stopped.
+	drainTimeoutParam = "drain-timeout"
 	// acctPathPrefix is the prefix for accounting configuration changes.
 	acctPathPrefix = adminEndpoint + "acct"
+	// acctUsagePathPrefix is the prefix for accounting usage.
+	acctUsagePathPrefix = adminEndpoint + "acct-usage"
 	// permPathPrefix is the prefix for permission configuration changes.
 	permPathPrefix = adminEndpoint + "perms"
 	// zonePathPrefix is the prefix for zone configuration changes.
 	zonePathPrefix = adminEndpoint + "zones"
+	// ptsPathPrefix is the prefix for protected timestamp records.
+	ptsPathPrefix = adminEndpoint + "pts"
+	// decommissionPathPrefix is the prefix for decommissioning nodes.
+	decommissionPathPrefix = adminEndpoint + "decommission"
+	// storesPathPrefix is the prefix for adding and removing stores.
+	storesPathPrefix = adminEndpoint + "stores"
 )
 
 // An actionHandler is an interface which provides Get, Put & Delete
@@ -67,22 +81,34 @@ type actionHandler interface {
 // A adminServer provides a RESTful HTTP API to administration of
 // the cockroach cluster.
 type adminServer struct {
-	db      *client.KV    // Key-value database client
-	stopper *util.Stopper // Used to shutdown the server
+	db      *client.KV                  // Key-value database client
+	stopper *util.Stopper               // Used to shutdown the server
+	drain   func(timeout time.Duration) // Drains the server before shutdown
 	acct    *acctHandler
 	perm    *permHandler
 	zone    *zoneHandler
+	pts     *ptsHandler
+	decom   *decommissionHandler
+	stores  *storeHandler
 }
 
 // newAdminServer allocates and returns a new REST server for
-// administrative APIs.
-func newAdminServer(db *client.KV, stopper *util.Stopper) *adminServer {
+// administrative APIs. Accounting usage is aggregated from the stats
+// gossiped on g, against whose zone configs new protected timestamps
+// are validated. If not nil, drain is invoked on quit before the
+// stopper is stopped. Stores are added and removed through stores.
+func newAdminServer(db *client.KV, g *gossip.Gossip, stopper *util.Stopper,
+	drain func(timeout time.Duration), stores *storeHandler) *adminServer {
 	return &adminServer{
 		db:      db,
 		stopper: stopper,
-		acct:    &acctHandler{db: db},
+		drain:   drain,
+		acct:    &acctHandler{db: db, usage: storage.NewAcctUsageTracker(g)},
 		perm:    &permHandler{db: db},
 		zone:    &zoneHandler{db: db},
+		pts:     &ptsHandler{db: db, gossip: g},
+		decom:   &decommissionHandler{db: db, gossip: g},
+		stores:  stores,
 	}
 }
 
@@ -93,13 +119,19 @@ func (s *adminServer) registerHandlers(mux *http.ServeMux) {
 	// get exported variables and pprof tools.
 	mux.HandleFunc(acctPathPrefix, s.handleAcctAction)
 	mux.HandleFunc(acctPathPrefix+"/", s.handleAcctAction)
+	mux.HandleFunc(acctUsagePathPrefix+"/", s.handleAcctUsage)
 	mux.HandleFunc(debugEndpoint, s.handleDebug)
+	mux.HandleFunc(decommissionPathPrefix+"/", s.handleDecommissionAction)
 	mux.HandleFunc(healthPath, s.handleHealth)
 	mux.HandleFunc(quitPath, s.handleQuit)
 	mux.HandleFunc(permPathPrefix, s.handlePermAction)
 	mux.HandleFunc(permPathPrefix+"/", s.handlePermAction)
 	mux.HandleFunc(zonePathPrefix, s.handleZoneAction)
 	mux.HandleFunc(zonePathPrefix+"/", s.handleZoneAction)
+	mux.HandleFunc(ptsPathPrefix, s.handlePTSAction)
+	mux.HandleFunc(ptsPathPrefix+"/", s.handlePTSAction)
+	mux.HandleFunc(storesPathPrefix, s.handleStoresAction)
+	mux.HandleFunc(storesPathPrefix+"/", s.handleStoresAction)
 }
 
 // handleHealth responds to health requests from monitoring services.
@@ -108,9 +140,21 @@ func (s *adminServer) handleHealth(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintln(w, "ok")
 }
 
-// handleQuit is the shutdown hook. The server is first placed into a
-// draining mode, followed by exit.
+// handleQuit is the shutdown hook. The server is first drained for up
+// to the duration specified by the drain-timeout query parameter,
+// followed by exit.
 func (s *adminServer) handleQuit(w http.ResponseWriter, r *http.Request) {
+	timeout := defaultDrainTimeout
+	if v := r.URL.Query().Get(drainTimeoutParam); v != "" {
+		var err error
+		if timeout, err = time.ParseDuration(v); err != nil {
+			http.Error(w, fmt.Sprintf("invalid %s %q: %s", drainTimeoutParam, v, err), http.StatusBadRequest)
+			return
+		}
+	}
+	if s.drain != nil {
+		s.drain(timeout)
+	}
 	w.Header().Set("Content-Type", "text/plain")
 	fmt.Fprintln(w, "ok")
 	go s.stopper.Stop()
@@ -129,6 +173,26 @@ func (s *adminServer) handleAcctAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.acct, w, r, acctPathPrefix)
 }
 
+// handleAcctUsage responds with the usage of an accounting prefix.
+func (s *adminServer) handleAcctUsage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	path, err := unescapePath(r.URL.Path, acctUsagePathPrefix)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	b, contentType, err := s.acct.GetUsage(path, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	fmt.Fprintf(w, "%s", string(b))
+}
+
 // handlePermAction handles actions for perm configuration by method.
 func (s *adminServer) handlePermAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.perm, w, r, permPathPrefix)
@@ -139,6 +203,23 @@ func (s *adminServer) handleZoneAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.zone, w, r, zonePathPrefix)
 }
 
+// handlePTSAction handles actions for protected timestamp records by
+// method.
+func (s *adminServer) handlePTSAction(w http.ResponseWriter, r *http.Request) {
+	s.handleRESTAction(s.pts, w, r, ptsPathPrefix)
+}
+
+// handleDecommissionAction handles actions for decommissioning nodes
+// by method.
+func (s *adminServer) handleDecommissionAction(w http.ResponseWriter, r *http.Request) {
+	s.handleRESTAction(s.decom, w, r, decommissionPathPrefix)
+}
+
+// handleStoresAction handles actions for the node's stores by method.
+func (s *adminServer) handleStoresAction(w http.ResponseWriter, r *http.Request) {
+	s.handleRESTAction(s.stores, w, r, storesPathPrefix)
+}
+
 // handleRESTAction handles RESTful admin actions.
 func (s *adminServer) handleRESTAction(handler actionHandler, w http.ResponseWriter, r *http.Request, prefix string) {
 	switch r.Method {
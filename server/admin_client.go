@@ -47,9 +47,12 @@ func sendAdminRequest(ctx *Context, req *http.Request) ([]byte, error) {
 	return b, nil
 }
 
-// SendQuit requests the admin quit path to drain and shutdown the server.
+// SendQuit requests the admin quit path to drain and shutdown the
+// server. The server spends at most the context's drain timeout
+// draining.
 func SendQuit(ctx *Context) error {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s%s", ctx.RequestScheme(), ctx.Addr, quitPath), nil)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s%s?%s=%s", ctx.RequestScheme(), ctx.Addr, quitPath,
+		drainTimeoutParam, ctx.DrainTimeout), nil)
 	if err != nil {
 		return util.Errorf("unable to create request to admin REST endpoint: %s", err)
 	}
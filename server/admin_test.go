@@ -43,7 +43,7 @@ func startAdminServer() (string, *util.Stopper) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	admin := newAdminServer(db, stopper)
+	admin := newAdminServer(db, nil, stopper, nil, nil)
 	mux := http.NewServeMux()
 	admin.registerHandlers(mux)
 	httpServer := httptest.NewTLSServer(mux)
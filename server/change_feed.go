@@ -0,0 +1,137 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// changeFeedEncodings are the encodings accepted for change feed
+// requests.
+var changeFeedEncodings = []util.EncodingType{util.JSONEncoding, util.ProtoEncoding}
+
+// A changeFeedServer serves change feeds of the spans replicated on the
+// node's stores. A feed is requested by posting a ChangeFeedRequest to
+// client.KVFeedEndpoint; the response streams ChangeFeedEvents, each
+// protobuf-encoded and prefixed by its varint-encoded length.
+type changeFeedServer struct {
+	lSender *kv.LocalSender
+	stopper *util.Stopper
+}
+
+// newChangeFeedServer returns a changeFeedServer for the stores of the
+// local sender.
+func newChangeFeedServer(lSender *kv.LocalSender, stopper *util.Stopper) *changeFeedServer {
+	return &changeFeedServer{lSender: lSender, stopper: stopper}
+}
+
+// ServeHTTP subscribes to a change feed and streams its events until
+// the client disconnects, the feed fails or the server stops. A failed
+// feed ends with an event holding its error.
+func (s *changeFeedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	reqBody, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	args := &proto.ChangeFeedRequest{}
+	if err := util.UnmarshalRequest(r, reqBody, args, changeFeedEncodings); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := security.AuthenticateRequest(r.TLS, &args.RequestHeader); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	// The stopper waits for its workers before closing the engines.
+	select {
+	case <-s.stopper.ShouldStop():
+		http.Error(w, "server is stopping", http.StatusServiceUnavailable)
+		return
+	default:
+	}
+	s.stopper.AddWorker()
+	defer s.stopper.SetStopped()
+
+	var feed *storage.ChangeFeed
+	s.lSender.VisitStores(func(store *storage.Store) error {
+		if feed == nil {
+			feed, _ = store.SubscribeChangeFeed(args.Key, args.EndKey, args.Timestamp)
+		}
+		return nil
+	})
+	if feed == nil {
+		http.Error(w, fmt.Sprintf("span %q-%q is not fully replicated on any store of this node",
+			args.Key, args.EndKey), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set(util.ContentTypeHeader, util.ProtoContentType)
+	flusher, _ := w.(http.Flusher)
+	send := func(e *proto.ChangeFeedEvent) error {
+		b, err := gogoproto.Marshal(e)
+		if err != nil {
+			return err
+		}
+		var lenBuf [binary.MaxVarintLen64]byte
+		if _, err := w.Write(lenBuf[:binary.PutUvarint(lenBuf[:], uint64(len(b)))]); err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	defer close(finished)
+	var closed <-chan bool
+	if cn, ok := w.(http.CloseNotifier); ok {
+		closed = cn.CloseNotify()
+	}
+	go func() {
+		defer close(done)
+		select {
+		case <-closed:
+		case <-s.stopper.ShouldStop():
+		case <-finished:
+		}
+	}()
+
+	if err := feed.Run(done, send); err != nil {
+		log.Warningf("change feed of %q-%q failed: %s", args.Key, args.EndKey, err)
+		e := &proto.ChangeFeedEvent{}
+		e.SetGoError(err)
+		send(e)
+	}
+}
@@ -27,15 +27,16 @@ import (
 // TODO:(bram) change this api to not require a file, just set (no file),
 //   get(true/false), ls, rm
 
-// A getAcctCmd command displays the acct config for the specified
-// prefix.
+// A getAcctCmd command displays the acct config and usage for the
+// specified prefix.
 var getAcctCmd = &commander.Command{
 	UsageLine: "get-acct [options] <key-prefix>",
 	Short:     "fetches and displays an accounting config",
 	Long: `
-Fetches and displays the accounting configuration for <key-prefix>. The key
-prefix should be escaped via URL query escaping if it contains
-non-ascii bytes or spaces.
+Fetches and displays the accounting configuration for <key-prefix>,
+followed by the bytes and live keys currently stored under the prefix,
+as measured against its quotas. The key prefix should be escaped via
+URL query escaping if it contains non-ascii bytes or spaces.
 `,
 	Run:  runGetAcct,
 	Flag: *flag.CommandLine,
@@ -121,10 +122,17 @@ spaces.
 The accounting config format has the following YAML schema:
 
   cluster_id: cluster
+  max_bytes: <quota on bytes of keys and values>
+  max_keys: <quota on number of live keys>
+  max_qps: <limit on requests per second, per store>
 
-For example:
+Quotas and limits which are omitted or zero are unlimited. Writes to
+a prefix which is at or over one of its quotas are rejected. For
+example:
 
   cluster_id: test
+  max_bytes: 1073741824
+  max_qps: 1000
 `,
 	Run:  runSetAcct,
 	Flag: *flag.CommandLine,
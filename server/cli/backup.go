@@ -0,0 +1,447 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/gob"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	commander "code.google.com/p/go-commander"
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/storage/engine"
+)
+
+// backupManifestName is the name of the file describing a backup
+// within its directory. It is written last, once all of the backup's
+// SSTables are complete.
+const backupManifestName = "BACKUP"
+
+// backupConfigPrefixes are the prefixes of the configs saved with
+// each backup.
+var backupConfigPrefixes = []proto.Key{
+	engine.KeyConfigAccountingPrefix,
+	engine.KeyConfigPermissionPrefix,
+	engine.KeyConfigZonePrefix,
+}
+
+// Flags for the backup command.
+var (
+	backupAllVersions bool
+	backupIncremental string
+)
+
+func init() {
+	flag.BoolVar(&backupAllVersions, "all-versions", false, "when run as the backup "+
+		"command, back up all versions of each key instead of only the most recent.")
+	flag.StringVar(&backupIncremental, "incremental-from", "", "when run as the backup "+
+		"command, the directory of a previous backup of the same key span. Only "+
+		"versions written since that backup are backed up.")
+}
+
+// A backupFile describes an SSTable exported from a single range.
+type backupFile struct {
+	Name     string
+	StartKey proto.Key
+	EndKey   proto.Key
+}
+
+// A backupManifest describes the contents of a backup directory.
+type backupManifest struct {
+	StartKey proto.Key
+	EndKey   proto.Key
+	// StartTime is zero for full backups. Incremental backups hold the
+	// versions written after their predecessor's EndTime.
+	StartTime   proto.Timestamp
+	EndTime     proto.Timestamp
+	AllVersions bool
+	Files       []backupFile
+	// Ranges holds the descriptors of the ranges overlapping the span.
+	Ranges []proto.RangeDescriptor
+	// Configs holds the accounting, permission and zone configs as of
+	// EndTime.
+	Configs []proto.KeyValue
+}
+
+// readBackupManifest reads the gob-encoded manifest of the backup in
+// dir.
+func readBackupManifest(dir string) (*backupManifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, backupManifestName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read backup manifest: %s", err)
+	}
+	m := &backupManifest{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(m); err != nil {
+		return nil, fmt.Errorf("unable to decode backup manifest in %s: %s", dir, err)
+	}
+	return m, nil
+}
+
+// A backupCmd command backs up a key span to a local directory.
+var backupCmd = &commander.Command{
+	UsageLine: "backup [options] <dir> [<start-key> [<end-key>]]",
+	Short:     "backs up a key span to a directory\n",
+	Long: `
+Backs up the key/value pairs from <start-key> up to <end-key> into
+<dir>, which must not already hold a backup. If no <start-key> is
+specified then all (non-system) keys are backed up. If no <end-key>
+is specified then all keys greater than or equal to <start-key> are
+backed up.
+
+The backup is consistent as of a single timestamp, chosen with
+-as-of. Each range is exported to an SSTable holding the most recent
+version of each live key or, with -all-versions, all versions of
+each key. The range descriptors and the accounting, permission and
+zone configs are saved with the backup. The versions being backed up
+are kept from garbage collection while the backup runs by a protected
+timestamp, which expires after -protect-for should the backup fail.
+
+With -incremental-from, only versions written since the previous
+backup in the named directory are exported, including deletions.
+Restore such a backup together with all of its predecessors.
+`,
+	Run:  runBackup,
+	Flag: *flag.CommandLine,
+}
+
+func runBackup(cmd *commander.Command, args []string) {
+	if len(args) == 0 || len(args) > 3 {
+		cmd.Usage()
+		return
+	}
+	m := &backupManifest{
+		StartKey:    engine.KeySystemMax,
+		EndKey:      proto.KeyMax,
+		EndTime:     proto.Timestamp{WallTime: time.Now().UnixNano()},
+		AllVersions: backupAllVersions,
+	}
+	if len(args) >= 2 {
+		m.StartKey = proto.Key(args[1])
+	}
+	if len(args) >= 3 {
+		m.EndKey = proto.Key(args[2])
+	}
+	if asOf != 0 {
+		m.EndTime = proto.Timestamp{WallTime: asOf}
+	}
+	if backupIncremental != "" {
+		prev, err := readBackupManifest(backupIncremental)
+		if err != nil {
+			fmt.Fprintf(osStderr, "backup failed: %s\n", err)
+			osExit(1)
+			return
+		}
+		if !prev.StartKey.Equal(m.StartKey) || !prev.EndKey.Equal(m.EndKey) {
+			fmt.Fprintf(osStderr, "backup failed: span %s-%s differs from the previous backup's %s-%s\n",
+				m.StartKey, m.EndKey, prev.StartKey, prev.EndKey)
+			osExit(1)
+			return
+		}
+		m.StartTime = prev.EndTime
+	}
+
+	kv, err := makeKVClient()
+	if err != nil {
+		fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
+		osExit(1)
+		return
+	}
+	if err := backup(kv, args[0], m); err != nil {
+		fmt.Fprintf(osStderr, "backup failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
+
+// backup exports each range overlapping the manifest's span to an
+// SSTable in dir, then saves the configs and the manifest.
+func backup(kv *client.KV, dir string, m *backupManifest) error {
+	if m.StartKey.Less(engine.KeySystemMax) {
+		return fmt.Errorf("unable to back up system keys")
+	}
+	if !m.StartTime.Less(m.EndTime) {
+		return fmt.Errorf("backup time %s must follow the previous backup's %s", m.EndTime, m.StartTime)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(dir, backupManifestName)); err == nil {
+		return fmt.Errorf("%s already holds a backup", dir)
+	}
+
+	// Keep the exported versions from garbage collection while the
+	// backup runs. The record expires in case the backup doesn't finish.
+	// It's created through the admin endpoint, which refuses to protect
+	// versions which may already have been garbage collected, as those
+	// written since an incremental backup's predecessor may have been.
+	pts := &proto.ProtectedTimestamp{Key: m.StartKey, EndKey: m.EndKey, Timestamp: m.EndTime}
+	if !m.StartTime.Equal(proto.ZeroTimestamp) {
+		pts.Timestamp = m.StartTime
+	}
+	if protectFor != 0 {
+		pts.Expiration = proto.Timestamp{WallTime: time.Now().Add(protectFor).UnixNano()}
+	}
+	ptsID := fmt.Sprintf("backup-%d", m.EndTime.WallTime)
+	if err := server.ProtectTimestamp(Context, ptsID, pts); err != nil {
+		return fmt.Errorf("unable to protect backup timestamp: %s", err)
+	}
+	defer kv.Run(client.Delete(engine.ProtectedTimestampKey(ptsID)))
+
+	descs, err := server.ScanRangeDescriptors(kv)
+	if err != nil {
+		return fmt.Errorf("unable to look up ranges: %s", err)
+	}
+	for _, desc := range descs {
+		start, end := desc.StartKey, desc.EndKey
+		if !start.Less(m.EndKey) || !m.StartKey.Less(end) {
+			continue
+		}
+		if start.Less(m.StartKey) {
+			start = m.StartKey
+		}
+		if m.EndKey.Less(end) {
+			end = m.EndKey
+		}
+		m.Ranges = append(m.Ranges, desc)
+
+		call := client.Export(start, end, m.StartTime, m.EndTime, m.AllVersions)
+		if err := kv.Run(call); err != nil {
+			return fmt.Errorf("unable to export %s-%s: %s", start, end, err)
+		}
+		data := call.Reply.(*proto.ExportResponse).Data
+		if len(data) == 0 {
+			continue
+		}
+		f := backupFile{
+			Name:     fmt.Sprintf("%d.sst", len(m.Files)),
+			StartKey: start,
+			EndKey:   end,
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, f.Name), data, 0644); err != nil {
+			return err
+		}
+		m.Files = append(m.Files, f)
+	}
+
+	for _, prefix := range backupConfigPrefixes {
+		call := client.Scan(prefix, prefix.PrefixEnd(), 0)
+		call.Args.Header().Timestamp = m.EndTime
+		if err := kv.Run(call); err != nil {
+			return fmt.Errorf("unable to read configs: %s", err)
+		}
+		m.Configs = append(m.Configs, call.Reply.(*proto.ScanResponse).Rows...)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(m); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, backupManifestName), buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("backed up %d ranges in %d files\n", len(m.Ranges), len(m.Files))
+	return nil
+}
+
+// A restoreCmd command restores a key span from backups.
+var restoreCmd = &commander.Command{
+	UsageLine: "restore [options] <dir> [<incremental-dir>...]",
+	Short:     "restores a key span from backups\n",
+	Long: `
+Restores the key span backed up in <dir>, followed by the incremental
+backups taken on top of it, in order. Ranges are split to match the
+range boundaries of the backup and the data is ingested directly into
+the ranges' stores, so the restored span must not hold any existing
+data, as in an empty cluster. Every backed up version is restored with
+its original timestamp, so reads as of times the backups cover see
+the data as it was. The backed up configs are written back once the
+data has been restored.
+`,
+	Run:  runRestore,
+	Flag: *flag.CommandLine,
+}
+
+func runRestore(cmd *commander.Command, args []string) {
+	if len(args) == 0 {
+		cmd.Usage()
+		return
+	}
+	var chain []*backupManifest
+	for _, dir := range args {
+		m, err := readBackupManifest(dir)
+		if err != nil {
+			fmt.Fprintf(osStderr, "restore failed: %s\n", err)
+			osExit(1)
+			return
+		}
+		chain = append(chain, m)
+	}
+
+	kv, err := makeKVClient()
+	if err != nil {
+		fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
+		osExit(1)
+		return
+	}
+	if err := restore(kv, args, chain); err != nil {
+		fmt.Fprintf(osStderr, "restore failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
+
+// restore restores the chain of backups read from dirs: a full
+// backup followed by incremental backups, each taken on top of its
+// predecessor.
+func restore(kv *client.KV, dirs []string, chain []*backupManifest) error {
+	base := chain[0]
+	if !base.StartTime.Equal(proto.ZeroTimestamp) {
+		return fmt.Errorf("%s holds an incremental backup; restore it after its full backup", dirs[0])
+	}
+	for i := 1; i < len(chain); i++ {
+		m, prev := chain[i], chain[i-1]
+		if !m.StartTime.Equal(prev.EndTime) || !m.StartKey.Equal(base.StartKey) || !m.EndKey.Equal(base.EndKey) {
+			return fmt.Errorf("%s does not hold an incremental backup taken after %s", dirs[i], dirs[i-1])
+		}
+	}
+	last := chain[len(chain)-1]
+
+	// Recreate the range boundaries of the most recent backup.
+	descs, err := server.ScanRangeDescriptors(kv)
+	if err != nil {
+		return fmt.Errorf("unable to look up ranges: %s", err)
+	}
+	startKeys := map[string]struct{}{}
+	for _, desc := range descs {
+		startKeys[string(desc.StartKey)] = struct{}{}
+	}
+	for _, desc := range last.Ranges {
+		key := desc.StartKey
+		if _, ok := startKeys[string(key)]; ok || !base.StartKey.Less(key) || !key.Less(base.EndKey) {
+			continue
+		}
+		req := &proto.AdminSplitRequest{
+			RequestHeader: proto.RequestHeader{
+				Key: key,
+			},
+			SplitKey: key,
+		}
+		if err := kv.Run(client.Call{Args: req, Reply: &proto.AdminSplitResponse{}}); err != nil {
+			return fmt.Errorf("unable to split at %s: %s", key, err)
+		}
+	}
+
+	// Restore the span of each range in turn.
+	if descs, err = server.ScanRangeDescriptors(kv); err != nil {
+		return fmt.Errorf("unable to look up ranges: %s", err)
+	}
+	ranges := 0
+	for _, desc := range descs {
+		start, end := desc.StartKey, desc.EndKey
+		if !start.Less(base.EndKey) || !base.StartKey.Less(end) {
+			continue
+		}
+		if start.Less(base.StartKey) {
+			start = base.StartKey
+		}
+		if base.EndKey.Less(end) {
+			end = base.EndKey
+		}
+		ok, err := restoreSpan(kv, dirs, chain, start, end)
+		if err != nil {
+			return err
+		}
+		if ok {
+			ranges++
+		}
+	}
+
+	for _, c := range last.Configs {
+		if err := kv.Run(client.Put(c.Key, c.Value.Bytes)); err != nil {
+			return fmt.Errorf("unable to restore config %s: %s", c.Key, err)
+		}
+	}
+	fmt.Printf("restored %d ranges\n", ranges)
+	return nil
+}
+
+// restoreSpan merges the contents of the backups' SSTables within
+// [start, end), with later backups taking precedence, and ingests the
+// result. The span must lie within a single range. Returns whether
+// there was any data to ingest.
+func restoreSpan(kv *client.KV, dirs []string, chain []*backupManifest, start, end proto.Key) (bool, error) {
+	encStart, encEnd := engine.MVCCEncodeKey(start), engine.MVCCEncodeKey(end)
+	merged := engine.NewInMem(proto.Attributes{}, 1<<20)
+	defer merged.Close()
+	for i, m := range chain {
+		for _, f := range m.Files {
+			if !f.StartKey.Less(end) || !start.Less(f.EndKey) {
+				continue
+			}
+			data, err := ioutil.ReadFile(filepath.Join(dirs[i], f.Name))
+			if err != nil {
+				return false, err
+			}
+			if err := copySSTable(merged, data, encStart, encEnd); err != nil {
+				return false, fmt.Errorf("%s: %s", filepath.Join(dirs[i], f.Name), err)
+			}
+		}
+	}
+
+	w, err := engine.NewSSTWriter()
+	if err != nil {
+		return false, err
+	}
+	defer w.Close()
+	empty := true
+	if err := merged.Iterate(encStart, encEnd, func(raw proto.RawKeyValue) (bool, error) {
+		empty = false
+		return false, w.Add(raw.Key, raw.Value)
+	}); err != nil {
+		return false, err
+	}
+	if empty {
+		return false, nil
+	}
+	data, err := w.Finish()
+	if err != nil {
+		return false, err
+	}
+	if err := kv.Run(client.Ingest(start, end, data)); err != nil {
+		return false, fmt.Errorf("unable to ingest %s-%s: %s", start, end, err)
+	}
+	return true, nil
+}
+
+// copySSTable writes the raw key/value pairs of the SSTable within
+// [start, end) to the engine.
+func copySSTable(e engine.Engine, data []byte, start, end proto.EncodedKey) error {
+	tmp := engine.NewInMem(proto.Attributes{}, 1<<20)
+	defer tmp.Close()
+	if err := tmp.IngestExternalFile(data); err != nil {
+		return err
+	}
+	return tmp.Iterate(start, end, func(raw proto.RawKeyValue) (bool, error) {
+		return false, e.Put(raw.Key, raw.Value)
+	})
+}
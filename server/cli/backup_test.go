@@ -0,0 +1,74 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// getValue reads the value of key through the KV client of the
+// current context, as of timestamp unless it's zero.
+func getValue(t *testing.T, key proto.Key, timestamp proto.Timestamp) *proto.Value {
+	kv, err := makeKVClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := client.Get(key)
+	if !timestamp.Equal(proto.ZeroTimestamp) {
+		call = call.AsOf(timestamp)
+	}
+	if err := kv.Run(call); err != nil {
+		t.Fatal(err)
+	}
+	return call.Reply.(*proto.GetResponse).Value
+}
+
+// TestBackupRestoreAllVersions verifies that a backup of all versions
+// restores each version with its original timestamp, so that reads
+// as of an earlier time see the earlier value.
+func TestBackupRestoreAllVersions(t *testing.T) {
+	dir := util.CreateTempDir(t, "backup_test")
+	defer util.CleanupDir(dir)
+	defer func() { backupAllVersions = false }()
+
+	c := newCLITest()
+	c.Run("put a 1")
+	v1 := getValue(t, proto.Key("a"), proto.ZeroTimestamp)
+	c.Run("put a 2")
+	v2 := getValue(t, proto.Key("a"), proto.ZeroTimestamp)
+	c.Run("backup -all-versions " + dir)
+	c.Run("quit")
+
+	// Restore the backup into an empty cluster.
+	c = newCLITest()
+	c.Run("restore " + dir)
+	defer c.Run("quit")
+	for _, exp := range []*proto.Value{v1, v2} {
+		v := getValue(t, proto.Key("a"), *exp.Timestamp)
+		if v == nil || !bytes.Equal(v.Bytes, exp.Bytes) || !v.Timestamp.Equal(*exp.Timestamp) {
+			t.Errorf("expected %q at %s; got %+v", exp.Bytes, exp.Timestamp, v)
+		}
+	}
+	before := proto.Timestamp{WallTime: v1.Timestamp.WallTime - 1}
+	if v := getValue(t, proto.Key("a"), before); v != nil {
+		t.Errorf("expected no value at %s; got %+v", before, v)
+	}
+}
@@ -54,7 +54,7 @@ func runCreateCACert(cmd *commander.Command, args []string) {
 // in the cert directory.
 var createNodeCertCmd = &commander.Command{
 	UsageLine: "create-node-cert [options] <host 1> <host 2> ... <host N>",
-	Short:     "create node cert and key\n",
+	Short:     "create node cert and key",
 	Long: `
 Generates a new key pair, a new node certificate and writes them to
 individual files in the directory specified by -certs (required).
@@ -75,3 +75,33 @@ func runCreateNodeCert(cmd *commander.Command, args []string) {
 		return
 	}
 }
+
+// A createClientCert command generates a client certificate and stores it
+// in the cert directory.
+var createClientCertCmd = &commander.Command{
+	UsageLine: "create-client-cert [options] <username>",
+	Short:     "create client cert and key\n",
+	Long: `
+Generates a new key pair, a new client certificate for <username> and
+writes them to individual files in the directory specified by -certs
+(required). The certs directory should contain a CA cert and key.
+Servers authenticate requests presenting the certificate as <username>.
+`,
+	Run:  runCreateClientCert,
+	Flag: *flag.CommandLine,
+}
+
+// runCreateClientCert generates key pair and client certificate and writes
+// them to their corresponding files.
+func runCreateClientCert(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	err := security.RunCreateClientCert(Context.Certs, args[0])
+	if err != nil {
+		fmt.Fprintf(osStderr, "failed to generate client certificate: %s\n", err)
+		osExit(1)
+		return
+	}
+}
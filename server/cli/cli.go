@@ -71,10 +71,16 @@ var allCmds = &commander.Commander{
 		startCmd,
 		exterminateCmd,
 		quitCmd,
+		decommissionCmd,
+		recommissionCmd,
+		addStoreCmd,
+		removeStoreCmd,
+		keepStoreCmd,
 
 		// Certificate commands.
 		createCACertCmd,
 		createNodeCertCmd,
+		createClientCertCmd,
 
 		// Key/value commands.
 		getCmd,
@@ -82,11 +88,15 @@ var allCmds = &commander.Commander{
 		incCmd,
 		delCmd,
 		scanCmd,
+		importCmd,
+		backupCmd,
+		restoreCmd,
 
 		// Range commands.
 		lsRangesCmd,
 		splitRangeCmd,
 		mergeRangeCmd,
+		transferLeaseCmd,
 
 		// Accounting commands.
 		getAcctCmd,
@@ -106,6 +116,14 @@ var allCmds = &commander.Commander{
 		rmZoneCmd,
 		setZoneCmd,
 
+		// Protected timestamp commands.
+		protectTSCmd,
+		lsProtectedTSCmd,
+		releaseTSCmd,
+
+		// Gossip commands.
+		gossipGraphCmd,
+
 		// Miscellaneous commands.
 		// TODO(pmattis): stats
 		listParamsCmd,
@@ -19,6 +19,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"strings"
@@ -142,3 +143,110 @@ func ExampleSplitMergeRanges() {
 	// quit
 	// node drained and shutdown: ok
 }
+
+func ExampleTransferLease() {
+	c := newCLITest()
+
+	c.Run("transfer-lease a 1")
+	c.Run("transfer-lease a x")
+	c.Run("quit")
+
+	// Output:
+	// transfer-lease a 1
+	// transfer-lease a x
+	// invalid store id: strconv.ParseInt: parsing "x": invalid syntax
+	// quit
+	// node drained and shutdown: ok
+}
+
+func ExampleImport() {
+	c := newCLITest()
+
+	files := map[string]string{
+		"import_test.csv":      "a,1\nb,2\nc,3\nd,4\n",
+		"import_test.dump":     "\"e\"\t5\n\"f\"\t6\n",
+		"import_unsorted.dump": "\"h\"\t7\n\"g\"\t8\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(name, []byte(data), 0644); err != nil {
+			log.Fatal(err)
+		}
+		defer os.Remove(name)
+	}
+
+	c.Run("split-range c c")
+	c.Run("import import_test.csv")
+	c.Run("import import_test.dump")
+	c.Run("import import_unsorted.dump")
+	c.Run("scan")
+	c.Run("quit")
+
+	// Output:
+	// split-range c c
+	// import import_test.csv
+	// imported 4 keys in 2 files
+	// import import_test.dump
+	// imported 2 keys in 1 files
+	// import import_unsorted.dump
+	// import failed: keys must be sorted in increasing order: "g" follows "h"
+	// scan
+	// "a"	1
+	// "b"	2
+	// "c"	3
+	// "d"	4
+	// "e"	5
+	// "f"	6
+	// quit
+	// node drained and shutdown: ok
+}
+
+func ExampleBackupRestore() {
+	defer os.RemoveAll("backup_full")
+	defer os.RemoveAll("backup_incremental")
+
+	c := newCLITest()
+	c.Run("put a 1 b 2")
+	c.Run("split-range c c")
+	c.Run("backup backup_full")
+	c.Run("backup backup_full")
+	c.Run("put c 3")
+	c.Run("del a")
+	c.Run("backup -incremental-from=backup_full backup_incremental")
+	c.Run("quit")
+
+	// Restore the backups into an empty cluster.
+	c = newCLITest()
+	c.Run("restore backup_incremental")
+	c.Run("restore backup_full backup_incremental")
+	c.Run("scan")
+	c.Run("ls-ranges")
+	c.Run("quit")
+
+	// Output:
+	// put a 1 b 2
+	// split-range c c
+	// backup backup_full
+	// backed up 2 ranges in 1 files
+	// backup backup_full
+	// backup failed: backup_full already holds a backup
+	// put c 3
+	// del a
+	// backup -incremental-from=backup_full backup_incremental
+	// backed up 2 ranges in 2 files
+	// quit
+	// node drained and shutdown: ok
+	// restore backup_incremental
+	// restore failed: backup_incremental holds an incremental backup; restore it after its full backup
+	// restore backup_full backup_incremental
+	// restored 2 ranges
+	// scan
+	// "b"	2
+	// "c"	3
+	// ls-ranges
+	// ""-"c" [1]
+	// 	0: node-id=1 store-id=1 attrs=[]
+	// "c"-"\xff\xff" [2]
+	// 	0: node-id=1 store-id=1 attrs=[]
+	// quit
+	// node drained and shutdown: ok
+}
@@ -37,6 +37,10 @@ func initFlags(ctx *server.Context) {
 	flag.StringVar(&ctx.Certs, "certs", ctx.Certs, "directory containing RSA key and x509 certs. "+
 		"This flag is required if -insecure=false.")
 
+	flag.StringVar(&ctx.User, "user", ctx.User, "when run as the client, the user to issue "+
+		"requests as. Unless -insecure=true, the certs directory must contain the user's "+
+		"client certificate (see create-client-cert).")
+
 	flag.StringVar(&ctx.Stores, "stores", ctx.Stores, "specify a comma-separated list of stores, "+
 		"specified by a colon-separated list of device attributes followed by '=' and "+
 		"either a filepath for a persistent store or an integer size in bytes for an "+
@@ -59,15 +63,27 @@ func initFlags(ctx *server.Context) {
 	flag.DurationVar(&ctx.MaxOffset, "max-offset", ctx.MaxOffset, "specify "+
 		"the maximum clock offset for the cluster. Clock offset is measured on all "+
 		"node-to-node links and if any node notices it has clock offset in excess "+
-		"of -max-offset, it will commit suicide. Setting this value too high may "+
-		"decrease transaction performance in the presence of contention.")
+		"of -max-offset, it will commit suicide, unless -degrade-on-clock-offset is "+
+		"set. Setting this value too high may decrease transaction performance in "+
+		"the presence of contention.")
+
+	flag.BoolVar(&ctx.DegradeOnClockOffset, "degrade-on-clock-offset", ctx.DegradeOnClockOffset, "mark "+
+		"the node unhealthy instead of exiting when its clock offset exceeds "+
+		"-max-offset. An unhealthy node gives up its leader leases and stops serving "+
+		"reads until the offset returns to normal.")
 
 	// Gossip flags.
 	flag.StringVar(&ctx.GossipBootstrap, "gossip", ctx.GossipBootstrap, "specify a "+
 		"comma-separated list of gossip addresses or resolvers for gossip bootstrap. "+
 		"Each item in the list has an optional type: [type=]<address>. "+
 		"Unspecified type means ip address or dns. Type can also be a load balancer (\"lb\"), "+
-		"a unix socket (\"unix\") or, for single-node systems, \"self\".")
+		"a unix socket (\"unix\"), a DNS name with SRV records for the bootstrap hosts "+
+		"(\"srv\"), a file listing host:port entries one per line (\"file\") or, for "+
+		"single-node systems, \"self\".")
+
+	flag.BoolVar(&ctx.GossipSelfJoin, "self-join", ctx.GossipSelfJoin, "keep retrying "+
+		"the gossip bootstrap addresses until the node joins the gossip network. Allows "+
+		"nodes found through service discovery to be started in any order.")
 
 	flag.DurationVar(&ctx.GossipInterval, "gossip-interval", ctx.GossipInterval,
 		"approximate interval (time.Duration) for gossiping new information to peers.")
@@ -87,6 +103,22 @@ func initFlags(ctx *server.Context) {
 		"--scan_interval to adjust the target for the duration of a single scan "+
 		"through a store's ranges. The scan is slowed as necessary to approximately"+
 		"achieve this duration.")
+
+	flag.DurationVar(&ctx.ClosedTimestampInterval, "closed-timestamp-interval", ctx.ClosedTimestampInterval,
+		"specify the interval at which range leaders close out timestamps. Follower "+
+			"replicas may serve reads at timestamps older than this interval. Zero, "+
+			"the default, disables follower reads.")
+
+	flag.DurationVar(&ctx.TimeUntilStoreDead, "time-until-store-dead", ctx.TimeUntilStoreDead,
+		"specify the duration after which the stores of a node which has stopped "+
+			"heartbeating are considered dead and their replicas are replaced on "+
+			"other stores.")
+
+	flag.DurationVar(&ctx.DrainTimeout, "drain-timeout", ctx.DrainTimeout,
+		"specify the maximum duration a node spends draining before it "+
+			"shuts down. While draining, new client requests are rejected, "+
+			"in-flight requests are completed and leader leases are "+
+			"transferred to other nodes.")
 }
 
 func init() {
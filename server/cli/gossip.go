@@ -0,0 +1,56 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"flag"
+
+	commander "code.google.com/p/go-commander"
+	"github.com/cockroachdb/cockroach/server"
+)
+
+// gossipGraphDOT prints the gossip graph in the Graphviz DOT language.
+var gossipGraphDOT bool
+
+func init() {
+	flag.BoolVar(&gossipGraphDOT, "dot", false, "when run as the gossip-graph "+
+		"command, print the graph in the Graphviz DOT language instead of JSON.")
+}
+
+// A gossipGraphCmd command prints the gossip connectivity graph.
+var gossipGraphCmd = &commander.Command{
+	UsageLine: "gossip-graph [options]",
+	Short:     "prints the gossip network connectivity graph",
+	Long: `
+Prints the connectivity graph of the gossip network as seen by the node
+at -addr: each node's gossip peers and the maximum number of hops to
+its furthest info. Nodes exceeding the tolerated number of hops are
+flagged as stragglers, and the graph is flagged as partitioned if it
+has split or nodes have become unreachable. Specify -dot to print the
+graph in the Graphviz DOT language.
+`,
+	Run:  runGossipGraph,
+	Flag: *flag.CommandLine,
+}
+
+// runGossipGraph fetches the graph from the status server.
+func runGossipGraph(cmd *commander.Command, args []string) {
+	if len(args) != 0 {
+		cmd.Usage()
+		return
+	}
+	server.RunGossipGraph(Context, gossipGraphDOT)
+}
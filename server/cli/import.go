@@ -0,0 +1,263 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	commander "code.google.com/p/go-commander"
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/storage/engine"
+)
+
+// importFileSize is the approximate size of the key/value data
+// ingested with each request.
+const importFileSize = 32 << 20 // 32M
+
+// An importCmd command bulk loads key/value pairs from a file.
+var importCmd = &commander.Command{
+	UsageLine: "import [options] <file>",
+	Short:     "bulk loads key/value pairs from a file\n",
+	Long: `
+Bulk loads the key/value pairs in <file> into empty key spans of the
+cluster. Files with a .csv extension hold one key,value record per
+line. Other files are treated as key/value dumps, holding one
+tab-separated key and value per line, with the key quoted as output
+by the scan command. Keys must be sorted in increasing order.
+
+The pairs are written to SSTables, split at range boundaries, which
+are ingested directly into the ranges' stores. Ingestion is not
+transactional: each SSTable is ingested independently and the spans
+it covers must not hold any existing data.
+`,
+	Run:  runImport,
+	Flag: *flag.CommandLine,
+}
+
+// importReader reads key/value pairs from an import file.
+type importReader interface {
+	// Next returns the next pair, or io.EOF once the file is exhausted.
+	Next() (proto.Key, []byte, error)
+}
+
+// csvImportReader reads key,value records from a CSV file.
+type csvImportReader struct {
+	r *csv.Reader
+}
+
+func (ir *csvImportReader) Next() (proto.Key, []byte, error) {
+	record, err := ir.r.Read()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(record) != 2 {
+		return nil, nil, fmt.Errorf("expected key,value record; got %q", record)
+	}
+	return proto.Key(record[0]), []byte(record[1]), nil
+}
+
+// dumpImportReader reads tab-separated quoted keys and values from a
+// key/value dump.
+type dumpImportReader struct {
+	s *bufio.Scanner
+}
+
+func (ir *dumpImportReader) Next() (proto.Key, []byte, error) {
+	if !ir.s.Scan() {
+		if err := ir.s.Err(); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, io.EOF
+	}
+	line := ir.s.Text()
+	i := strings.IndexByte(line, '\t')
+	if i == -1 {
+		return nil, nil, fmt.Errorf("expected tab-separated key and value; got %q", line)
+	}
+	key, err := strconv.Unquote(line[:i])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid key %s: %s", line[:i], err)
+	}
+	return proto.Key(key), []byte(line[i+1:]), nil
+}
+
+// newImportReader returns an importReader for the file, choosing the
+// format by its extension.
+func newImportReader(f *os.File) importReader {
+	if filepath.Ext(f.Name()) == ".csv" {
+		r := csv.NewReader(f)
+		r.FieldsPerRecord = -1
+		return &csvImportReader{r: r}
+	}
+	return &dumpImportReader{s: bufio.NewScanner(f)}
+}
+
+// lookupRangeEndKeys returns the sorted end keys of all ranges.
+func lookupRangeEndKeys(kv *client.KV) ([]proto.Key, error) {
+	descs, err := server.ScanRangeDescriptors(kv)
+	if err != nil {
+		return nil, err
+	}
+	endKeys := make([]proto.Key, len(descs))
+	for i := range descs {
+		endKeys[i] = descs[i].EndKey
+	}
+	return endKeys, nil
+}
+
+// An importBatch accumulates sorted key/value pairs in an SSTable
+// which is ingested once full.
+type importBatch struct {
+	kv        *client.KV
+	timestamp proto.Timestamp
+	w         *engine.SSTWriter
+	first     proto.Key
+	last      proto.Key
+	size      int
+	keys      int
+	files     int
+}
+
+// add adds the pair to the current SSTable, starting one if necessary.
+func (b *importBatch) add(key proto.Key, value []byte) error {
+	if b.w == nil {
+		w, err := engine.NewSSTWriter()
+		if err != nil {
+			return err
+		}
+		b.w, b.first = w, key
+	}
+	v := proto.Value{Bytes: value}
+	v.InitChecksum(key)
+	if err := engine.MVCCSSTPut(b.w, key, b.timestamp, v); err != nil {
+		return err
+	}
+	b.last = key
+	b.size += len(key) + len(value)
+	b.keys++
+	return nil
+}
+
+// flush ingests the current SSTable, if any, covering the span from
+// its first key through its last.
+func (b *importBatch) flush() error {
+	if b.w == nil {
+		return nil
+	}
+	data, err := b.w.Finish()
+	b.w.Close()
+	b.w = nil
+	b.size = 0
+	if err != nil {
+		return err
+	}
+	if err := b.kv.Run(client.Ingest(b.first, b.last.Next(), data)); err != nil {
+		return fmt.Errorf("unable to ingest %s-%s: %s", b.first, b.last, err)
+	}
+	b.files++
+	return nil
+}
+
+func runImport(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		fmt.Fprintf(osStderr, "unable to open import file: %s\n", err)
+		osExit(1)
+		return
+	}
+	defer f.Close()
+
+	kv, err := makeKVClient()
+	if err != nil {
+		fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
+		osExit(1)
+		return
+	}
+	if err := importFile(kv, newImportReader(f)); err != nil {
+		fmt.Fprintf(osStderr, "import failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
+
+// importFile reads all pairs from the reader and ingests them in
+// SSTables which are cut at range boundaries and once they reach
+// importFileSize.
+func importFile(kv *client.KV, r importReader) error {
+	endKeys, err := lookupRangeEndKeys(kv)
+	if err != nil {
+		return fmt.Errorf("unable to look up ranges: %s", err)
+	}
+	b := &importBatch{
+		kv:        kv,
+		timestamp: proto.Timestamp{WallTime: time.Now().UnixNano()},
+	}
+	defer func() {
+		if b.w != nil {
+			b.w.Close()
+		}
+	}()
+	var prev proto.Key
+	for {
+		key, value, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if key.Less(engine.KeySystemMax) {
+			return fmt.Errorf("unable to import system key %s", key)
+		}
+		if prev != nil && !prev.Less(key) {
+			return fmt.Errorf("keys must be sorted in increasing order: %s follows %s", key, prev)
+		}
+		prev = key
+		// Cut the SSTable if the key belongs to the next range.
+		crossed := false
+		for len(endKeys) > 0 && !key.Less(endKeys[0]) {
+			endKeys = endKeys[1:]
+			crossed = true
+		}
+		if crossed || b.size >= importFileSize {
+			if err := b.flush(); err != nil {
+				return err
+			}
+		}
+		if err := b.add(key, value); err != nil {
+			return err
+		}
+	}
+	if err := b.flush(); err != nil {
+		return err
+	}
+	fmt.Printf("imported %d keys in %d files\n", b.keys, b.files)
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
@@ -38,14 +39,42 @@ import (
 var osExit = os.Exit
 var osStderr = os.Stderr
 
+// asOf is the wall time, in nanoseconds since the epoch, as of which
+// the get, scan and backup commands read and the protect-ts command
+// protects.
+var asOf int64
+
+func init() {
+	flag.Int64Var(&asOf, "as-of", 0, "when run as the get, scan or backup command, the "+
+		"wall time in nanoseconds since the epoch as of which to read, or as the protect-ts "+
+		"command, to protect. Reads older than the GC TTL of the zone fail unless protected. "+
+		"Defaults to the current time.")
+}
+
+// ttl is the duration after which values set by the put command
+// expire.
+var ttl time.Duration
+
+func init() {
+	flag.DurationVar(&ttl, "ttl", 0, "when run as the put command, the duration after which "+
+		"the values expire. Zero never expires.")
+}
+
+// asOfCall returns the call set to read as of the -as-of flag, if set.
+func asOfCall(call client.Call) client.Call {
+	if asOf == 0 {
+		return call
+	}
+	return call.AsOf(proto.Timestamp{WallTime: asOf})
+}
+
 func makeKVClient() (*client.KV, error) {
 	httpSender, err := client.NewHTTPSender(util.EnsureHost(Context.Addr), &Context.Context)
 	if err != nil {
 		return nil, err
 	}
 	kv := client.NewKV(nil, httpSender)
-	// TODO(pmattis): Initialize this to something more reasonable
-	kv.User = "root"
+	kv.User = Context.User
 	return kv, nil
 }
 
@@ -54,7 +83,8 @@ var getCmd = &commander.Command{
 	UsageLine: "get [options] <key>",
 	Short:     "gets the value for a key",
 	Long: `
-Fetches and display the value for <key>.
+Fetches and display the value for <key>, as of the time specified
+with -as-of if set.
 `,
 	Run:  runGet,
 	Flag: *flag.CommandLine,
@@ -72,7 +102,7 @@ func runGet(cmd *commander.Command, args []string) {
 		return
 	}
 	key := proto.Key(args[0])
-	call := client.Get(key)
+	call := asOfCall(client.Get(key))
 	resp := call.Reply.(*proto.GetResponse)
 	if err := kv.Run(call); err != nil {
 		fmt.Fprintf(osStderr, "get failed: %s\n", err)
@@ -98,7 +128,7 @@ var putCmd = &commander.Command{
 	Long: `
 Sets the value for one or more keys. Keys and values must be provided
 in pairs on the command line. All of the key/value pairs are set within
-a transaction.
+a transaction. If -ttl is set, the values expire after that duration.
 `,
 	Run:  runPut,
 	Flag: *flag.CommandLine,
@@ -128,12 +158,20 @@ func runPut(cmd *commander.Command, args []string) {
 		osExit(1)
 		return
 	}
+	var expiration proto.Timestamp
+	if ttl != 0 {
+		expiration.WallTime = time.Now().Add(ttl).UnixNano()
+	}
 	opts := &client.TransactionOptions{Name: "test", Isolation: proto.SERIALIZABLE}
 	err = kv.RunTransaction(opts, func(txn *client.Txn) error {
 		for i := 0; i < len(args); i += 2 {
 			key := proto.Key(args[i])
 			value := []byte(args[i+1])
-			txn.Prepare(client.Put(key, value))
+			call := client.Put(key, value)
+			if ttl != 0 {
+				call = call.ExpireAt(expiration)
+			}
+			txn.Prepare(call)
 		}
 		return nil
 	})
@@ -251,7 +289,8 @@ var scanCmd = &commander.Command{
 Fetches and display the key/value pairs for a range. If no <start-key>
 is specified then all (non-system) key/value pairs are retrieved. If no
 <end-key> is specified then all keys greater than or equal to <start-key>
-are retrieved.
+are retrieved. The key/value pairs are read as of the time specified
+with -as-of if set.
 
 Caveat: Currently only retrieves up to 1000 keys.
 `,
@@ -289,7 +328,7 @@ func runScan(cmd *commander.Command, args []string) {
 		return
 	}
 	// TODO(pmattis): Add a flag for the number of results to scan.
-	call := client.Scan(startKey, endKey, 1000)
+	call := asOfCall(client.Scan(startKey, endKey, 1000))
 	resp := call.Reply.(*proto.ScanResponse)
 	if err := kv.Run(call); err != nil {
 		fmt.Fprintf(osStderr, "scan failed: %s\n", err)
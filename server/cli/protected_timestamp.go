@@ -0,0 +1,120 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"flag"
+	"time"
+
+	commander "code.google.com/p/go-commander"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/server"
+)
+
+// protectFor is the duration after which protected timestamps created
+// by the protect-ts and backup commands expire.
+var protectFor time.Duration
+
+func init() {
+	flag.DurationVar(&protectFor, "protect-for", 24*time.Hour, "when run as the protect-ts "+
+		"or backup command, the duration after which the protected timestamp expires. "+
+		"Zero never expires.")
+}
+
+// A protectTSCmd command creates a protected timestamp record.
+var protectTSCmd = &commander.Command{
+	UsageLine: "protect-ts [options] <id> <start-key> <end-key>",
+	Short:     "protects a key span from garbage collection as of a timestamp",
+	Long: `
+Creates a protected timestamp record with the ID <id> for the keys from
+<start-key> up to <end-key>. The versions of these keys read as of the
+time specified with -as-of, or the current time if unset, are kept from
+garbage collection until the record is released or expires after the
+-protect-for duration.
+`,
+	Run:  runProtectTS,
+	Flag: *flag.CommandLine,
+}
+
+// runProtectTS invokes the REST API with POST action and the ID as
+// path.
+func runProtectTS(cmd *commander.Command, args []string) {
+	if len(args) != 3 {
+		cmd.Usage()
+		return
+	}
+	now := time.Now()
+	timestamp := proto.Timestamp{WallTime: now.UnixNano()}
+	if asOf != 0 {
+		timestamp.WallTime = asOf
+	}
+	var expiration proto.Timestamp
+	if protectFor != 0 {
+		expiration.WallTime = now.Add(protectFor).UnixNano()
+	}
+	server.RunProtectTimestamp(Context, args[0], proto.Key(args[1]), proto.Key(args[2]), timestamp, expiration)
+}
+
+// A lsProtectedTSCmd command lists protected timestamp records.
+var lsProtectedTSCmd = &commander.Command{
+	UsageLine: "ls-protected-ts [options] [id-regexp]",
+	Short:     "list protected timestamps by ID",
+	Long: `
+List protected timestamp records with their spans, timestamps and
+expirations. If a regular expression is given, only the records with
+matching IDs are listed.
+`,
+	Run:  runLsProtectedTS,
+	Flag: *flag.CommandLine,
+}
+
+// runLsProtectedTS invokes the REST API with GET action and no path,
+// which fetches the IDs of all records, then fetches each matching
+// record.
+func runLsProtectedTS(cmd *commander.Command, args []string) {
+	if len(args) > 1 {
+		cmd.Usage()
+		return
+	}
+	pattern := ""
+	if len(args) == 1 {
+		pattern = args[0]
+	}
+	server.RunLsProtectedTimestamps(Context, pattern)
+}
+
+// A releaseTSCmd command releases a protected timestamp record.
+var releaseTSCmd = &commander.Command{
+	UsageLine: "release-ts [options] <id>",
+	Short:     "release a protected timestamp by ID",
+	Long: `
+Release the protected timestamp record with the ID <id>, allowing the
+versions it protected to be garbage collected. No action is taken if
+no record exists with the ID.
+`,
+	Run:  runReleaseTS,
+	Flag: *flag.CommandLine,
+}
+
+// runReleaseTS invokes the REST API with DELETE action and the ID as
+// path.
+func runReleaseTS(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	server.RunReleaseProtectedTimestamp(Context, args[0])
+}
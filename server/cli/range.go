@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
 
 	commander "code.google.com/p/go-commander"
 	"github.com/cockroachdb/cockroach/client"
@@ -159,3 +160,46 @@ func runMergeRange(cmd *commander.Command, args []string) {
 		os.Exit(1)
 	}
 }
+
+// A transferLeaseCmd command moves the leader lease of a range.
+var transferLeaseCmd = &commander.Command{
+	UsageLine: "transfer-lease [options] <key> <store-id>",
+	Short:     "transfers the leader lease of a range\n",
+	Long: `
+Transfers the leader lease of the range containing <key> to the replica
+located on store <store-id>. The store must hold a replica of the range.
+`,
+	Run:  runTransferLease,
+	Flag: *flag.CommandLine,
+}
+
+func runTransferLease(cmd *commander.Command, args []string) {
+	if len(args) != 2 {
+		cmd.Usage()
+		return
+	}
+	storeID, err := strconv.ParseInt(args[1], 10, 32)
+	if err != nil {
+		fmt.Fprintf(osStderr, "invalid store id: %s\n", err)
+		osExit(1)
+		return
+	}
+
+	kv, err := makeKVClient()
+	if err != nil {
+		fmt.Fprintf(osStderr, "failed to initialize KV client: %s", err)
+		osExit(1)
+		return
+	}
+	req := &proto.AdminTransferLeaseRequest{
+		RequestHeader: proto.RequestHeader{
+			Key: proto.Key(args[0]),
+		},
+		StoreID: int32(storeID),
+	}
+	resp := &proto.AdminTransferLeaseResponse{}
+	if err := kv.Run(client.Call{Args: req, Reply: resp}); err != nil {
+		fmt.Fprintf(osStderr, "transfer lease failed: %s\n", err)
+		osExit(1)
+	}
+}
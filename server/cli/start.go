@@ -98,6 +98,10 @@ number of bytes. Although the paths should be specified to correspond
 uniquely to physical devices, this requirement isn't strictly
 enforced.
 
+The certificates and keys in the -certs directory, including the CA
+certificate, are reloaded when the node receives SIGHUP, allowing them
+to be rotated without a restart.
+
 For example:
 
   cockroach start -gossip=host1:port1,host2:port2 -stores=ssd=/mnt/ssd1,ssd=/mnt/ssd2
@@ -144,6 +148,16 @@ func runStart(cmd *commander.Command, args []string) {
 		return
 	}
 
+	// Reload certificates on SIGHUP so that they may be rotated
+	// without restarting the node.
+	reloadCh := make(chan os.Signal, 1)
+	signal.Notify(reloadCh, syscall.SIGHUP)
+	go func() {
+		for range reloadCh {
+			reloadCertificates()
+		}
+	}()
+
 	signalCh := make(chan os.Signal, 1)
 	signal.Notify(signalCh, os.Interrupt, os.Kill)
 	// TODO(spencer): move this behind a build tag.
@@ -173,6 +187,21 @@ func runStart(cmd *commander.Command, args []string) {
 	}
 }
 
+// reloadCertificates reloads the node and client certificates and the
+// CA certificate from the certs directory. On failure, the node keeps
+// using the previous certificates.
+func reloadCertificates() {
+	if Context.GetCertificateManager() == nil {
+		log.Warningf("not reloading certificates: running in insecure mode")
+		return
+	}
+	if err := Context.ReloadCertificates(); err != nil {
+		log.Errorf("failed to reload certificates: %s", err)
+		return
+	}
+	log.Infof("reloaded certificates from %s", Context.Certs)
+}
+
 // A exterminateCmd command shuts down the node server.
 var exterminateCmd = &commander.Command{
 	UsageLine: "exterminate",
@@ -217,11 +246,13 @@ func runExterminate(cmd *commander.Command, args []string) {
 // A quitCmd command shuts down the node server.
 var quitCmd = &commander.Command{
 	UsageLine: "quit",
-	Short:     "drain and shutdown node\n",
+	Short:     "drain and shutdown node",
 	Long: `
-Shutdown the server. The first stage is drain, where any new requests
-will be ignored by the server. When all extant requests have been
-completed, the server exits.
+Shutdown the server. The first stage is drain, where any new client
+requests are rejected by the server, in-flight requests are completed
+and leader leases are transferred to other nodes. Once drained, or
+after -drain-timeout has elapsed, the server flushes its stores and
+exits.
 `,
 	Run:  runQuit,
 	Flag: *flag.CommandLine,
@@ -231,3 +262,119 @@ completed, the server exits.
 func runQuit(cmd *commander.Command, args []string) {
 	server.SendQuit(Context)
 }
+
+// A decommissionCmd command moves all replicas off a node.
+var decommissionCmd = &commander.Command{
+	UsageLine: "decommission [options] <node-id>",
+	Short:     "move all replicas and leader leases off a node",
+	Long: `
+Marks the node with ID <node-id> as decommissioning. New replicas are
+no longer allocated to its stores, and its replicas and leader leases
+are moved to other nodes. Progress is reported until no replicas remain
+on the node, at which point it can be shut down without any range
+losing quorum.
+`,
+	Run:  runDecommission,
+	Flag: *flag.CommandLine,
+}
+
+// runDecommission invokes the REST API with POST action and the node
+// ID as path, then polls for progress.
+func runDecommission(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	server.RunDecommission(Context, args[0])
+}
+
+// A recommissionCmd command cancels decommissioning of a node.
+var recommissionCmd = &commander.Command{
+	UsageLine: "recommission [options] <node-id>",
+	Short:     "cancel decommissioning of a node",
+	Long: `
+Cancels decommissioning of the node with ID <node-id>, allowing its
+stores to be allocated replicas and leader leases again.
+`,
+	Run:  runRecommission,
+	Flag: *flag.CommandLine,
+}
+
+// runRecommission invokes the REST API with DELETE action and the node
+// ID as path.
+func runRecommission(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	server.RunRecommission(Context, args[0])
+}
+
+// An addStoreCmd command adds a store to a running node.
+var addStoreCmd = &commander.Command{
+	UsageLine: "add-store [options] <attrs>=<dir>",
+	Short:     "add a store to a running node",
+	Long: `
+Adds a store to the running node, given in the same format as a single
+store of the -stores flag. An empty store is bootstrapped and replicas
+start being allocated to it once its descriptor has been gossiped.
+`,
+	Run:  runAddStore,
+	Flag: *flag.CommandLine,
+}
+
+// runAddStore accesses the stores admin path to add a store.
+func runAddStore(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	server.RunAddStore(Context, args[0])
+}
+
+// A removeStoreCmd command removes a store from a running node.
+var removeStoreCmd = &commander.Command{
+	UsageLine: "remove-store [options] <store-id>",
+	Short:     "move all replicas off a store and remove it from its node",
+	Long: `
+Marks the store with ID <store-id> for removal from the running node.
+Its replicas and leader leases are moved to other stores, after which
+the store is detached from the node and its engine closed. Progress is
+reported until the store has been removed. Until it's detached, the
+removal may be cancelled with keep-store.
+`,
+	Run:  runRemoveStore,
+	Flag: *flag.CommandLine,
+}
+
+// runRemoveStore accesses the stores admin path to remove a store.
+func runRemoveStore(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	server.RunRemoveStore(Context, args[0])
+}
+
+// A keepStoreCmd command cancels removal of a store.
+var keepStoreCmd = &commander.Command{
+	UsageLine: "keep-store [options] <store-id>",
+	Short:     "cancel removal of a store\n",
+	Long: `
+Cancels removal of the store with ID <store-id> if it hasn't been
+detached from its node yet, allowing it to be allocated replicas and
+leader leases again.
+`,
+	Run:  runKeepStore,
+	Flag: *flag.CommandLine,
+}
+
+// runKeepStore accesses the stores admin path to cancel removal of a
+// store.
+func runKeepStore(cmd *commander.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	server.RunKeepStore(Context, args[0])
+}
@@ -67,9 +67,22 @@ func runGetConfig(ctx *Context, prefix, keyPrefix string) {
 	fmt.Fprintf(os.Stdout, "%s config for key prefix %q:\n%s\n", friendlyName, keyPrefix, string(b))
 }
 
-// RunGetAcct gets the account from the given key.
+// RunGetAcct gets the account from the given key, followed by the
+// usage of the account.
 func RunGetAcct(ctx *Context, keyPrefix string) {
 	runGetConfig(ctx, acctPathPrefix, keyPrefix)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s%s/%s", ctx.RequestScheme(), ctx.Addr, acctUsagePathPrefix, keyPrefix), nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	req.Header.Add("Accept", "text/yaml")
+	b, err := sendAdminRequest(ctx, req)
+	if err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "accounting usage for key prefix %q:\n%s\n", keyPrefix, string(b))
 }
 
 // RunGetPerm gets the permission from the given key.
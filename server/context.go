@@ -42,6 +42,16 @@ const (
 	// defaultScanInterval is the default value for the scan interval.
 	// command line flag.
 	defaultScanInterval = 10 * time.Minute
+	// defaultClosedTimestampInterval is the default value for the closed
+	// timestamp interval command line flag. Closing timestamps costs a
+	// raft command per range each interval, so it's off by default.
+	defaultClosedTimestampInterval = 0
+	// defaultTimeUntilStoreDead is the default value for the time until
+	// store dead command line flag.
+	defaultTimeUntilStoreDead = 5 * time.Minute
+	// defaultDrainTimeout is the default value for the drain timeout
+	// command line flag.
+	defaultDrainTimeout = 10 * time.Second
 )
 
 // Context holds parameters needed to setup a server.
@@ -75,6 +85,12 @@ type Context struct {
 	// Maximum clock offset for the cluster.
 	MaxOffset time.Duration
 
+	// DegradeOnClockOffset marks the node unhealthy instead of exiting
+	// when its clock offset from the cluster exceeds MaxOffset or can't
+	// be determined. An unhealthy node gives up its leader leases and
+	// stops serving reads until the offset returns to normal.
+	DegradeOnClockOffset bool
+
 	// GossipBootstrap is a comma-separated list of node addresses that
 	// act as bootstrap hosts for connecting to the gossip network.
 	GossipBootstrap string
@@ -83,6 +99,11 @@ type Context struct {
 	// communicated between hosts on the gossip network.
 	GossipInterval time.Duration
 
+	// GossipSelfJoin keeps the node cycling through the gossip bootstrap
+	// resolvers until it joins the gossip network. This allows nodes
+	// found through service discovery to be started in any order.
+	GossipSelfJoin bool
+
 	// Enables linearizable behaviour of operations on this node by making sure
 	// that no commit timestamp is reported back to the client until all other
 	// node clocks have necessarily passed it.
@@ -111,16 +132,36 @@ type Context struct {
 	// ScanInterval determines a duration during which each range should be
 	// visited approximately once by the range scanner.
 	ScanInterval time.Duration
+
+	// ClosedTimestampInterval determines how often range leaders close
+	// out timestamps, allowing follower replicas to serve FOLLOWER reads
+	// at timestamps older than the interval. Zero disables follower
+	// reads.
+	ClosedTimestampInterval time.Duration
+
+	// TimeUntilStoreDead is the duration after which the stores of a
+	// node which has stopped gossiping liveness heartbeats are
+	// considered dead and their replicas are replaced on other stores.
+	TimeUntilStoreDead time.Duration
+
+	// DrainTimeout bounds the time a server waits while draining for
+	// in-flight requests to complete and for its leader leases to be
+	// transferred before it stops. When quitting a node, it's passed to
+	// the node being shut down.
+	DrainTimeout time.Duration
 }
 
 // NewContext returns a Context with default values.
 func NewContext() *Context {
 	ctx := &Context{
-		Addr:           defaultAddr,
-		MaxOffset:      defaultMaxOffset,
-		GossipInterval: defaultGossipInterval,
-		CacheSize:      defaultCacheSize,
-		ScanInterval:   defaultScanInterval,
+		Addr:                    defaultAddr,
+		MaxOffset:               defaultMaxOffset,
+		GossipInterval:          defaultGossipInterval,
+		CacheSize:               defaultCacheSize,
+		ScanInterval:            defaultScanInterval,
+		ClosedTimestampInterval: defaultClosedTimestampInterval,
+		TimeUntilStoreDead:      defaultTimeUntilStoreDead,
+		DrainTimeout:            defaultDrainTimeout,
 	}
 	// Initializes base context defaults.
 	ctx.InitDefaults()
@@ -0,0 +1,250 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// decommissionPollInterval is the interval at which the decommission
+// command polls for progress.
+const decommissionPollInterval = 1 * time.Second
+
+// A decommissionHandler implements the actionHandler interface for
+// decommissioning nodes, which are identified by node ID. Nodes are
+// marked as decommissioning in gossip; the replicate queues of all
+// stores then move their replicas and leader leases away.
+type decommissionHandler struct {
+	db     *client.KV     // Key-value database client
+	gossip *gossip.Gossip // Used to mark nodes as decommissioning
+}
+
+// A StoreDecommissionStatus reports the replicas and leader leases
+// remaining on a store of a decommissioning node.
+type StoreDecommissionStatus struct {
+	StoreID      proto.StoreID `json:"storeID"`
+	Replicas     int           `json:"replicas"`
+	LeaderLeases int32         `json:"leaderLeases"`
+}
+
+// A DecommissionStatus reports the progress of decommissioning a node.
+type DecommissionStatus struct {
+	NodeID          proto.NodeID `json:"nodeID"`
+	Decommissioning bool         `json:"decommissioning"`
+	// Stores lists the node's stores which still hold replicas.
+	Stores []StoreDecommissionStatus `json:"stores"`
+	// UnsafeRanges lists the IDs of ranges which would lose quorum if
+	// the node were shut down.
+	UnsafeRanges []int64 `json:"unsafeRanges"`
+	// Done is set once the node is decommissioning and holds no
+	// replicas, at which point it can be safely shut down.
+	Done bool `json:"done"`
+}
+
+// parseNodeID parses the node ID from the path.
+func parseNodeID(path string) (proto.NodeID, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(path, "/"), 10, 32)
+	if err != nil || id <= 0 {
+		return 0, util.Errorf("invalid node ID %q", strings.TrimPrefix(path, "/"))
+	}
+	return proto.NodeID(id), nil
+}
+
+// Put marks the node specified by path as decommissioning. The body is
+// ignored.
+func (dh *decommissionHandler) Put(path string, body []byte, r *http.Request) error {
+	nodeID, err := parseNodeID(path)
+	if err != nil {
+		return err
+	}
+	if _, err := dh.gossip.GetNodeIDAddress(nodeID); err != nil {
+		return util.Errorf("unknown node %d: %s", nodeID, err)
+	}
+	return dh.gossip.AddInfo(gossip.MakeDecommissionKey(nodeID), true, 0*time.Second)
+}
+
+// Get reports the decommissioning progress of the node specified by
+// path.
+func (dh *decommissionHandler) Get(path string, r *http.Request) (body []byte, contentType string, err error) {
+	nodeID, err := parseNodeID(path)
+	if err != nil {
+		return nil, "", err
+	}
+	descs, err := ScanRangeDescriptors(dh.db)
+	if err != nil {
+		return nil, "", err
+	}
+	status := computeDecommissionStatus(nodeID, storage.IsDecommissioning(dh.gossip, nodeID), descs)
+	for i := range status.Stores {
+		key := gossip.MakeMaxAvailCapacityKey(nodeID, status.Stores[i].StoreID)
+		if val, err := dh.gossip.GetInfo(key); err == nil {
+			if storeDesc, ok := val.(proto.StoreDescriptor); ok {
+				status.Stores[i].LeaderLeases = storeDesc.LeaseCount
+			}
+		}
+	}
+	return util.MarshalResponse(r, status, []util.EncodingType{util.JSONEncoding})
+}
+
+// Delete cancels decommissioning of the node specified by path.
+func (dh *decommissionHandler) Delete(path string, r *http.Request) error {
+	nodeID, err := parseNodeID(path)
+	if err != nil {
+		return err
+	}
+	return dh.gossip.RemoveInfo(gossip.MakeDecommissionKey(nodeID))
+}
+
+// computeDecommissionStatus counts the replicas remaining on each
+// store of the node from the descriptors of all ranges and finds the
+// ranges which would lose quorum without the node.
+func computeDecommissionStatus(nodeID proto.NodeID, decommissioning bool,
+	descs []proto.RangeDescriptor) *DecommissionStatus {
+	status := &DecommissionStatus{
+		NodeID:          nodeID,
+		Decommissioning: decommissioning,
+	}
+	replicas := map[proto.StoreID]int{}
+	var storeIDs []proto.StoreID
+	for _, desc := range descs {
+		var onNode int
+		for _, replica := range desc.Replicas {
+			if replica.NodeID != nodeID {
+				continue
+			}
+			onNode++
+			if _, ok := replicas[replica.StoreID]; !ok {
+				storeIDs = append(storeIDs, replica.StoreID)
+			}
+			replicas[replica.StoreID]++
+		}
+		if onNode > 0 && len(desc.Replicas)-onNode < len(desc.Replicas)/2+1 {
+			status.UnsafeRanges = append(status.UnsafeRanges, desc.RaftID)
+		}
+	}
+	for _, storeID := range storeIDs {
+		status.Stores = append(status.Stores, StoreDecommissionStatus{
+			StoreID:  storeID,
+			Replicas: replicas[storeID],
+		})
+	}
+	status.Done = decommissioning && len(status.Stores) == 0
+	return status
+}
+
+// ScanRangeDescriptors returns the descriptors of all ranges, sorted
+// by key, read from the meta2 range addressing records.
+func ScanRangeDescriptors(db *client.KV) ([]proto.RangeDescriptor, error) {
+	var descs []proto.RangeDescriptor
+	startKey := engine.KeyMeta2Prefix
+	for {
+		call := client.Scan(startKey, engine.KeyMeta2Prefix.PrefixEnd(), 1000)
+		resp := call.Reply.(*proto.ScanResponse)
+		if err := db.Run(call); err != nil {
+			return nil, err
+		}
+		for _, r := range resp.Rows {
+			desc := proto.RangeDescriptor{}
+			if err := gogoproto.Unmarshal(r.Value.Bytes, &desc); err != nil {
+				return nil, util.Errorf("%s: unable to unmarshal range descriptor: %s", r.Key, err)
+			}
+			descs = append(descs, desc)
+		}
+		if len(resp.Rows) < 1000 {
+			return descs, nil
+		}
+		startKey = resp.Rows[len(resp.Rows)-1].Key.Next()
+	}
+}
+
+// RunDecommission marks the node as decommissioning and waits for its
+// replicas to be moved to other nodes, reporting progress as it goes.
+// It only returns once the node can be shut down without any range
+// losing quorum.
+func RunDecommission(ctx *Context, nodeID string) {
+	url := fmt.Sprintf("%s://%s%s/%s", ctx.RequestScheme(), ctx.Addr, decommissionPathPrefix, nodeID)
+	req, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	for {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			log.Errorf("unable to create request to admin REST endpoint: %s", err)
+			return
+		}
+		b, err := sendAdminRequest(ctx, req)
+		if err != nil {
+			log.Errorf("admin REST request failed: %s", err)
+			return
+		}
+		status := &DecommissionStatus{}
+		if err = json.Unmarshal(b, status); err != nil {
+			log.Errorf("unable to parse admin REST response: %s", err)
+			return
+		}
+		if status.Done {
+			fmt.Fprintf(os.Stdout, "node %d decommissioned and can be shut down\n", status.NodeID)
+			return
+		}
+		if !status.Decommissioning {
+			fmt.Fprintf(os.Stderr, "node %d is no longer decommissioning\n", status.NodeID)
+			return
+		}
+		fmt.Fprintf(os.Stdout, "node %d:", status.NodeID)
+		for _, s := range status.Stores {
+			fmt.Fprintf(os.Stdout, " store %d: %d replicas, %d leader leases;",
+				s.StoreID, s.Replicas, s.LeaderLeases)
+		}
+		fmt.Fprintf(os.Stdout, " %d ranges would lose quorum\n", len(status.UnsafeRanges))
+		time.Sleep(decommissionPollInterval)
+	}
+}
+
+// RunRecommission cancels decommissioning of the node.
+func RunRecommission(ctx *Context, nodeID string) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s://%s%s/%s", ctx.RequestScheme(), ctx.Addr,
+		decommissionPathPrefix, nodeID), nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "node %s is no longer decommissioning\n", nodeID)
+}
@@ -0,0 +1,56 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// TestComputeDecommissionStatus verifies the counting of remaining
+// replicas and the detection of ranges which would lose quorum.
+func TestComputeDecommissionStatus(t *testing.T) {
+	descs := []proto.RangeDescriptor{
+		{RaftID: 1, Replicas: []proto.Replica{{NodeID: 1, StoreID: 1}, {NodeID: 2, StoreID: 2}, {NodeID: 3, StoreID: 4}}},
+		{RaftID: 2, Replicas: []proto.Replica{{NodeID: 2, StoreID: 3}}},
+		{RaftID: 3, Replicas: []proto.Replica{{NodeID: 1, StoreID: 1}, {NodeID: 2, StoreID: 3}}},
+		{RaftID: 4, Replicas: []proto.Replica{{NodeID: 1, StoreID: 1}, {NodeID: 3, StoreID: 4}}},
+	}
+
+	status := computeDecommissionStatus(2, true, descs)
+	expStores := []StoreDecommissionStatus{{StoreID: 2, Replicas: 1}, {StoreID: 3, Replicas: 2}}
+	if !reflect.DeepEqual(status.Stores, expStores) {
+		t.Errorf("expected stores %+v; got %+v", expStores, status.Stores)
+	}
+	if expUnsafe := []int64{2, 3}; !reflect.DeepEqual(status.UnsafeRanges, expUnsafe) {
+		t.Errorf("expected unsafe ranges %v; got %v", expUnsafe, status.UnsafeRanges)
+	}
+	if status.Done {
+		t.Error("expected decommissioning not to be done while replicas remain")
+	}
+
+	// Once all replicas have moved, decommissioning is done.
+	status = computeDecommissionStatus(2, true, descs[3:])
+	if len(status.Stores) != 0 || len(status.UnsafeRanges) != 0 || !status.Done {
+		t.Errorf("expected decommissioning to be done; got %+v", status)
+	}
+	// A node which isn't decommissioning is never done.
+	if status = computeDecommissionStatus(2, false, descs[3:]); status.Done {
+		t.Errorf("expected node which isn't decommissioning not to be done; got %+v", status)
+	}
+}
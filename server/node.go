@@ -19,7 +19,9 @@ package server
 
 import (
 	"container/list"
+	"math"
 	"net"
+	"strconv"
 	"sync"
 	"time"
 
@@ -31,9 +33,11 @@ import (
 	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
 	"golang.org/x/net/context"
 )
 
@@ -43,8 +47,22 @@ const (
 	gossipGroupLimit = 100
 	// gossipInterval is the interval for gossiping storage-related info.
 	gossipInterval = 1 * time.Minute
+	// clockOffsetSeriesPrefix prefixes the names of the time series
+	// recording the node's clock offset from the cluster.
+	clockOffsetSeriesPrefix = "cr.node.clock-offset."
+	// leaseDrainRetryInterval is the interval at which a draining node
+	// retries transferring the leader leases it still holds.
+	leaseDrainRetryInterval = 100 * time.Millisecond
+	// unhealthyLeaseTransferInterval is the interval at which a node
+	// whose clock offset is unhealthy transfers away the leader leases
+	// it still holds.
+	unhealthyLeaseTransferInterval = 100 * time.Millisecond
 )
 
+// storeRemovalPollInterval is the interval at which a store being
+// removed is checked for remaining replicas.
+var storeRemovalPollInterval = 10 * time.Second
+
 // A Node manages a map of stores (by store ID) for which it serves
 // traffic. A node is the top-level data structure. There is one node
 // instance per process. A node accepts incoming RPCs and services
@@ -64,6 +82,10 @@ type Node struct {
 	// by the completedScan mutex.
 	completedScan *sync.Cond
 	scanCount     int64
+	// removals holds a channel for each store being removed, closed
+	// when the removal is cancelled.
+	removalMu sync.Mutex
+	removals  map[proto.StoreID]chan struct{}
 }
 
 // nodeServer is a type alias to separate RPC methods
@@ -178,6 +200,7 @@ func NewNode(ctx storage.StoreContext) *Node {
 		ctx:           ctx,
 		lSender:       kv.NewLocalSender(),
 		completedScan: sync.NewCond(&sync.Mutex{}),
+		removals:      map[proto.StoreID]chan struct{}{},
 	}
 }
 
@@ -246,6 +269,8 @@ func (n *Node) start(rpcServer *rpc.Server, engines []engine.Engine,
 	n.startedAt = n.ctx.Clock.Now().WallTime
 	n.startStoresScanner(stopper)
 	n.startGossip(stopper)
+	n.startClockOffsetRecorder(stopper)
+	n.startUnhealthyLeaseTransfer(stopper)
 	log.Infof("Started node with %v engine(s) and attributes %v", engines, attrs.Attrs)
 	return nil
 }
@@ -266,7 +291,7 @@ func (n *Node) initStores(engines []engine.Engine, stopper *util.Stopper) error
 		s := storage.NewStore(n.ctx, e, &n.Descriptor)
 		// Initialize each store in turn, handling un-bootstrapped errors by
 		// adding the store to the bootstraps list.
-		if err := s.Start(stopper); err != nil {
+		if err := s.Start(newStoreStopper(stopper)); err != nil {
 			if _, ok := err.(*storage.NotBootstrappedError); ok {
 				log.Infof("store %s not bootstrapped", s)
 				bootstraps.PushBack(s)
@@ -349,10 +374,10 @@ func (n *Node) bootstrapStores(bootstraps *list.List, stopper *util.Stopper) {
 	}
 	for e := bootstraps.Front(); e != nil; e = e.Next() {
 		s := e.Value.(*storage.Store)
-		if err := s.Bootstrap(sIdent, stopper); err != nil {
+		if err := s.Bootstrap(sIdent, s.Stopper()); err != nil {
 			log.Fatal(err)
 		}
-		if err := s.Start(stopper); err != nil {
+		if err := s.Start(s.Stopper()); err != nil {
 			log.Fatal(err)
 		}
 		n.lSender.AddStore(s)
@@ -361,6 +386,166 @@ func (n *Node) bootstrapStores(bootstraps *list.List, stopper *util.Stopper) {
 	}
 }
 
+// newStoreStopper returns a stopper for a single store, allowing the
+// store to be stopped when it's removed from the running node. The
+// store's stopper is stopped along with the node's stopper.
+func newStoreStopper(stopper *util.Stopper) *util.Stopper {
+	storeStopper := util.NewStopper()
+	stopper.RunWorker(func() {
+		select {
+		case <-stopper.ShouldStop():
+			storeStopper.Stop()
+		case <-storeStopper.IsStopped():
+		}
+	})
+	return storeStopper
+}
+
+// addStore adds a store backed by the engine to the running node. An
+// empty engine is bootstrapped with a newly allocated store ID; an
+// engine which already holds a store must belong to this node. The
+// store's descriptor is gossiped right away so that the allocator
+// starts placing replicas on it.
+func (n *Node) addStore(e engine.Engine, stopper *util.Stopper) (*storage.Store, error) {
+	s := storage.NewStore(n.ctx, e, &n.Descriptor)
+	storeStopper := newStoreStopper(stopper)
+	if err := n.startStore(s, storeStopper); err != nil {
+		storeStopper.Stop()
+		return nil, err
+	}
+	n.lSender.AddStore(s)
+	s.GossipCapacity()
+	log.Infof("added store %s", s)
+	return s, nil
+}
+
+// startStore starts the store, bootstrapping it first if necessary,
+// and verifies that it belongs to this node and isn't already part of
+// it.
+func (n *Node) startStore(s *storage.Store, stopper *util.Stopper) error {
+	if err := s.Start(stopper); err != nil {
+		if _, ok := err.(*storage.NotBootstrappedError); !ok {
+			return util.Errorf("failed to start store: %s", err)
+		}
+		storeID, err := allocateStoreIDs(n.Descriptor.NodeID, 1, n.ctx.DB)
+		if err != nil {
+			return err
+		}
+		sIdent := proto.StoreIdent{
+			ClusterID: n.ClusterID,
+			NodeID:    n.Descriptor.NodeID,
+			StoreID:   storeID,
+		}
+		if err := s.Bootstrap(sIdent, stopper); err != nil {
+			return err
+		}
+		if err := s.Start(stopper); err != nil {
+			return util.Errorf("failed to start store: %s", err)
+		}
+		log.Infof("bootstrapped store %s", s)
+	}
+	if s.Ident.ClusterID != n.ClusterID {
+		return util.Errorf("store %s cluster ID doesn't match node cluster %q", s, n.ClusterID)
+	}
+	if _, err := n.lSender.GetStore(s.Ident.StoreID); err == nil {
+		return util.Errorf("store %s already belongs to node %d", s, n.Descriptor.NodeID)
+	}
+	return nil
+}
+
+// removeStore marks the store as being removed in gossip, which has
+// the replicate queues move its replicas and leader leases to other
+// stores. Once no range has a replica on the store, the store is
+// detached from the node and stopped, closing its engine, unless the
+// removal is cancelled first.
+func (n *Node) removeStore(storeID proto.StoreID, stopper *util.Stopper) error {
+	s, err := n.lSender.GetStore(storeID)
+	if err != nil {
+		return err
+	}
+	n.removalMu.Lock()
+	defer n.removalMu.Unlock()
+	if _, ok := n.removals[storeID]; ok {
+		return util.Errorf("store %d is already being removed", storeID)
+	}
+	if err := n.ctx.Gossip.AddInfo(gossip.MakeStoreDecommissionKey(storeID), true, 0*time.Second); err != nil {
+		return err
+	}
+	cancel := make(chan struct{})
+	n.removals[storeID] = cancel
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(storeRemovalPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if !stopper.StartTask() {
+					continue
+				}
+				detached := n.maybeDetachStore(s)
+				stopper.FinishTask()
+				if detached {
+					return
+				}
+			case <-cancel:
+				return
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+	return nil
+}
+
+// cancelStoreRemoval cancels the removal of a store which hasn't been
+// detached from the node yet, allowing it to be allocated replicas and
+// leader leases again.
+func (n *Node) cancelStoreRemoval(storeID proto.StoreID) error {
+	n.removalMu.Lock()
+	defer n.removalMu.Unlock()
+	cancel, ok := n.removals[storeID]
+	if !ok {
+		return util.Errorf("store %d isn't being removed", storeID)
+	}
+	close(cancel)
+	delete(n.removals, storeID)
+	return n.ctx.Gossip.RemoveInfo(gossip.MakeStoreDecommissionKey(storeID))
+}
+
+// maybeDetachStore detaches the store from the node and stops it if
+// it's being removed and no range has a replica on it anymore. The
+// store's entries are then removed from gossip. Returns true if the
+// store was detached.
+func (n *Node) maybeDetachStore(s *storage.Store) bool {
+	n.removalMu.Lock()
+	defer n.removalMu.Unlock()
+	if _, ok := n.removals[s.StoreID()]; !ok {
+		return false
+	}
+	descs, err := ScanRangeDescriptors(n.ctx.DB)
+	if err != nil {
+		log.Warningf("store %d: unable to scan range descriptors: %s", s.StoreID(), err)
+		return false
+	}
+	if replicas := countStoreReplicas(s.StoreID(), descs); replicas > 0 {
+		log.V(1).Infof("store %d: waiting for %d replicas to be removed", s.StoreID(), replicas)
+		return false
+	}
+	n.lSender.RemoveStore(s)
+	delete(n.removals, s.StoreID())
+	for _, key := range []string{
+		gossip.MakeMaxAvailCapacityKey(n.Descriptor.NodeID, s.StoreID()),
+		gossip.MakeStoreDecommissionKey(s.StoreID()),
+	} {
+		if err := n.ctx.Gossip.RemoveInfo(key); err != nil {
+			log.Warningf("store %d: unable to remove %q from gossip: %s", s.StoreID(), key, err)
+		}
+	}
+	s.Stopper().Stop()
+	log.Infof("removed store %s", s)
+	return true
+}
+
 // connectGossip connects to gossip network and reads cluster ID. If
 // this node is already part of a cluster, the cluster ID is verified
 // for a match. If not part of a cluster, the cluster ID is set. The
@@ -387,10 +572,14 @@ func (n *Node) connectGossip() {
 }
 
 // startGossip loops on a periodic ticker to gossip node-related
-// information. Starts a goroutine to loop until the node is closed.
+// information. Liveness heartbeats are gossiped on a separate, more
+// frequent ticker. Starts a goroutine to loop until the node is closed.
 func (n *Node) startGossip(stopper *util.Stopper) {
+	n.gossipLiveness()
 	stopper.RunWorker(func() {
 		ticker := time.NewTicker(gossipInterval)
+		livenessTicker := time.NewTicker(storage.LivenessHeartbeatInterval)
+		defer livenessTicker.Stop()
 		for {
 			select {
 			case <-ticker.C:
@@ -398,6 +587,73 @@ func (n *Node) startGossip(stopper *util.Stopper) {
 					n.gossipCapacities()
 					stopper.FinishTask()
 				}
+			case <-livenessTicker.C:
+				n.gossipLiveness()
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// gossipLiveness gossips a liveness heartbeat for the node.
+func (n *Node) gossipLiveness() {
+	if err := storage.GossipLiveness(n.ctx.Gossip, n.Descriptor.NodeID, n.ctx.Clock.PhysicalNow()); err != nil {
+		log.Warningf("unable to gossip liveness heartbeat for node %d: %s", n.Descriptor.NodeID, err)
+	}
+}
+
+// startClockOffsetRecorder loops on a periodic ticker to record the
+// node's clock offset from the cluster as time series. Starts a
+// goroutine to loop until the node is closed.
+func (n *Node) startClockOffsetRecorder(stopper *util.Stopper) {
+	if n.ctx.RemoteClocks == nil {
+		return
+	}
+	tsDB := ts.NewDB(n.ctx.DB)
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(time.Duration(ts.Resolution10s.SampleDuration()))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if stopper.StartTask() {
+					n.recordClockOffset(tsDB)
+					stopper.FinishTask()
+				}
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// startUnhealthyLeaseTransfer loops on a periodic ticker and, while
+// the node's clock offset from the cluster is unhealthy, transfers the
+// leader leases held by its stores to other replicas. The unhealthy
+// node refuses to serve with the leases, so their ranges would
+// otherwise be unavailable until the leases lapse. Starts a goroutine
+// to loop until the node is closed.
+func (n *Node) startUnhealthyLeaseTransfer(stopper *util.Stopper) {
+	if n.ctx.RemoteClocks == nil {
+		return
+	}
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(unhealthyLeaseTransferInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if n.ctx.RemoteClocks.Err() != nil && stopper.StartTask() {
+					n.lSender.VisitStores(func(s *storage.Store) error {
+						if failed := s.DrainLeaderLeases(); failed > 0 {
+							log.Warningf("store %d: unable to transfer %d leader leases off unhealthy node",
+								s.StoreID(), failed)
+						}
+						return nil
+					})
+					stopper.FinishTask()
+				}
 			case <-stopper.ShouldStop():
 				return
 			}
@@ -405,11 +661,82 @@ func (n *Node) startGossip(stopper *util.Stopper) {
 	})
 }
 
-// gossipCapacities calls capacity on each store and adds it to the
-// gossip network.
+// recordClockOffset stores the bounds of the most recently measured
+// clock offset interval and whether it was healthy (1) or not (0).
+func (n *Node) recordClockOffset(tsDB *ts.DB) {
+	status := n.ctx.RemoteClocks.Status()
+	values := map[string]int64{"healthy": 0}
+	if status.Healthy {
+		values["healthy"] = 1
+	}
+	// The bounds are meaningless if no interval could be determined.
+	if status.Interval.Lowerbound != math.MaxInt64 {
+		values["lowerbound-nanos"] = status.Interval.Lowerbound
+		values["upperbound-nanos"] = status.Interval.Upperbound
+	}
+	now := n.ctx.Clock.PhysicalNow()
+	source := strconv.Itoa(int(n.Descriptor.NodeID))
+	for name, value := range values {
+		data := proto.TimeSeriesData{
+			Name:   clockOffsetSeriesPrefix + name,
+			Source: source,
+			Datapoints: []*proto.TimeSeriesDatapoint{
+				{TimestampNanos: now, IntValue: gogoproto.Int64(value)},
+			},
+		}
+		if err := tsDB.StoreData(ts.Resolution10s, data); err != nil {
+			log.Warningf("unable to record clock offset: %s", err)
+		}
+	}
+}
+
+// gossipCapacities calls capacity and accounting stats on each store
+// and adds them to the gossip network.
 func (n *Node) gossipCapacities() {
 	n.lSender.VisitStores(func(s *storage.Store) error {
 		s.GossipCapacity()
+		s.GossipAcctStats()
+		return nil
+	})
+}
+
+// drain places the node's stores into draining mode, in which they
+// don't acquire leader leases, and transfers the leases they hold to
+// other replicas, retrying until all leases have been handed off or
+// the deadline passes. The stores' engines are flushed last.
+func (n *Node) drain(deadline time.Time) {
+	n.lSender.VisitStores(func(s *storage.Store) error {
+		s.SetDraining(true)
+		return nil
+	})
+
+	// Lease transfers go through raft and may block indefinitely if a
+	// range lacks a quorum, so they're bounded by the deadline.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			var failed int
+			n.lSender.VisitStores(func(s *storage.Store) error {
+				failed += s.DrainLeaderLeases()
+				return nil
+			})
+			if failed == 0 || !time.Now().Before(deadline) {
+				return
+			}
+			time.Sleep(leaseDrainRetryInterval)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(deadline.Sub(time.Now())):
+		log.Warningf("node %d: timed out transferring leader leases", n.Descriptor.NodeID)
+	}
+
+	n.lSender.VisitStores(func(s *storage.Store) error {
+		if err := s.Engine().Flush(); err != nil {
+			log.Warningf("store %d: unable to flush engine: %s", s.StoreID(), err)
+		}
 		return nil
 	})
 }
@@ -549,6 +876,21 @@ func (n *nodeServer) AdminMerge(args *proto.AdminMergeRequest, reply *proto.Admi
 	return n.executeCmd(args, reply)
 }
 
+// AdminTransferLease .
+func (n *nodeServer) AdminTransferLease(args *proto.AdminTransferLeaseRequest, reply *proto.AdminTransferLeaseResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+// Ingest .
+func (n *nodeServer) Ingest(args *proto.IngestRequest, reply *proto.IngestResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+// Export .
+func (n *nodeServer) Export(args *proto.ExportRequest, reply *proto.ExportResponse) error {
+	return n.executeCmd(args, reply)
+}
+
 // InternalRangeLookup .
 func (n *nodeServer) InternalRangeLookup(args *proto.InternalRangeLookupRequest, reply *proto.InternalRangeLookupResponse) error {
 	return n.executeCmd(args, reply)
@@ -569,6 +911,11 @@ func (n *nodeServer) InternalPushTxn(args *proto.InternalPushTxnRequest, reply *
 	return n.executeCmd(args, reply)
 }
 
+// InternalQueryTxn .
+func (n *nodeServer) InternalQueryTxn(args *proto.InternalQueryTxnRequest, reply *proto.InternalQueryTxnResponse) error {
+	return n.executeCmd(args, reply)
+}
+
 // InternalResolveIntent .
 func (n *nodeServer) InternalResolveIntent(args *proto.InternalResolveIntentRequest, reply *proto.InternalResolveIntentResponse) error {
 	return n.executeCmd(args, reply)
@@ -36,6 +36,7 @@ import (
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/leaktest"
 	gogoproto "github.com/gogo/protobuf/proto"
 	"golang.org/x/net/context"
 )
@@ -185,6 +186,102 @@ func TestBootstrapNewStore(t *testing.T) {
 	}
 }
 
+// TestAddRemoveStore verifies that stores can be added to a running
+// node, and that a store being removed has its replicas moved to the
+// node's other stores before it's detached, unless its removal is
+// cancelled.
+func TestAddRemoveStore(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(interval time.Duration) { storeRemovalPollInterval = interval }(storeRemovalPollInterval)
+	storeRemovalPollInterval = 10 * time.Millisecond
+
+	stopper := util.NewStopper()
+	e := engine.NewInMem(proto.Attributes{}, 1<<20)
+	_, err := BootstrapCluster("cluster-1", []engine.Engine{e}, stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stopper.Stop()
+
+	_, node, stopper := createAndStartTestNode(util.CreateTestAddr("tcp"), []engine.Engine{e}, nil, t)
+	defer stopper.Stop()
+	first, err := node.lSender.GetStore(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decommissionKey := gossip.MakeStoreDecommissionKey(first.StoreID())
+
+	// The first store holds the only replica of the first range, which
+	// has nowhere to go, so its removal can be cancelled.
+	if err := node.removeStore(first.StoreID(), stopper); err != nil {
+		t.Fatal(err)
+	}
+	if !storage.IsStoreDecommissioning(node.ctx.Gossip, first.StoreID()) {
+		t.Errorf("expected store %s to be marked for removal", first)
+	}
+	if err := node.removeStore(first.StoreID(), stopper); err == nil {
+		t.Errorf("expected second removal of store %s to fail", first)
+	}
+	if err := node.cancelStoreRemoval(first.StoreID()); err != nil {
+		t.Fatal(err)
+	}
+	if storage.IsStoreDecommissioning(node.ctx.Gossip, first.StoreID()) {
+		t.Errorf("expected removal of store %s to be cancelled", first)
+	}
+	if err := node.cancelStoreRemoval(first.StoreID()); err == nil {
+		t.Errorf("expected cancelling removal of store %s twice to fail", first)
+	}
+	if _, err := node.lSender.GetStore(first.StoreID()); err != nil {
+		t.Errorf("expected store %s to remain attached: %s", first, err)
+	}
+
+	s, err := node.addStore(engine.NewInMem(proto.Attributes{}, 1<<20), stopper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.IsStarted() {
+		t.Errorf("expected store %s to be started", s)
+	}
+	if count := node.lSender.GetStoreCount(); count != 2 {
+		t.Errorf("expected 2 stores; got %d", count)
+	}
+	if _, err := node.ctx.Gossip.GetInfo(gossip.MakeMaxAvailCapacityKey(node.Descriptor.NodeID, s.StoreID())); err != nil {
+		t.Errorf("expected store descriptor to be gossiped: %s", err)
+	}
+
+	// Once removed again, the first store's replica is moved to the new
+	// store, after which the first store is detached and stopped.
+	if err := node.removeStore(first.StoreID(), stopper); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		if _, err := node.lSender.GetStore(first.StoreID()); err != nil {
+			return nil
+		}
+		first.ForceReplicationScan(t)
+		return util.Errorf("store %s not yet detached", first)
+	})
+	if count := node.lSender.GetStoreCount(); count != 1 {
+		t.Errorf("expected 1 store; got %d", count)
+	}
+	if rng := s.LookupRange(engine.KeyMin, nil); rng == nil {
+		t.Errorf("expected the first range to have moved to store %s", s)
+	}
+	for _, key := range []string{
+		gossip.MakeMaxAvailCapacityKey(node.Descriptor.NodeID, first.StoreID()),
+		decommissionKey,
+	} {
+		if _, err := node.ctx.Gossip.GetInfo(key); err == nil {
+			t.Errorf("expected %q to be removed from gossip", key)
+		}
+	}
+	select {
+	case <-first.Stopper().IsStopped():
+	default:
+		t.Errorf("expected store %s to be stopped", first)
+	}
+}
+
 // TestNodeJoin verifies a new node is able to join a bootstrapped
 // cluster consisting of one node.
 func TestNodeJoin(t *testing.T) {
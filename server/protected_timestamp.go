@@ -0,0 +1,180 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// A ptsHandler implements the adminHandler interface for protected
+// timestamp records, which are keyed by ID.
+type ptsHandler struct {
+	db     *client.KV     // Key-value database client
+	gossip *gossip.Gossip // Zone configs for validating new records
+}
+
+// validateProtectedTimestamp returns an error if a given protected
+// timestamp record is invalid or protects versions which may already
+// have been garbage collected.
+func (ph *ptsHandler) validateProtectedTimestamp(pts gogoproto.Message) error {
+	now := proto.Timestamp{WallTime: time.Now().UnixNano()}
+	return storage.ValidateProtectedTimestamp(ph.gossip, pts.(*proto.ProtectedTimestamp), now)
+}
+
+// Put creates or updates the protected timestamp record with the ID
+// specified by path. The record is parsed from the input "body".
+func (ph *ptsHandler) Put(path string, body []byte, r *http.Request) error {
+	if len(path) <= 1 {
+		return util.Errorf("no protected timestamp ID specified")
+	}
+	return putConfig(ph.db, engine.KeyProtectedTimestampPrefix, &proto.ProtectedTimestamp{},
+		path, body, r, ph.validateProtectedTimestamp)
+}
+
+// Get retrieves the protected timestamp record with the ID specified by
+// path, or lists the IDs of all records if path is empty.
+func (ph *ptsHandler) Get(path string, r *http.Request) (body []byte, contentType string, err error) {
+	return getConfig(ph.db, engine.KeyProtectedTimestampPrefix, &proto.ProtectedTimestamp{}, path, r)
+}
+
+// Delete releases the protected timestamp record with the ID specified
+// by path.
+func (ph *ptsHandler) Delete(path string, r *http.Request) error {
+	return deleteConfig(ph.db, engine.KeyProtectedTimestampPrefix, path, r)
+}
+
+// ProtectTimestamp creates or updates the protected timestamp record
+// with the given ID through the admin REST endpoint, which validates
+// it.
+func ProtectTimestamp(ctx *Context, id string, pts *proto.ProtectedTimestamp) error {
+	body, err := gogoproto.Marshal(pts)
+	if err != nil {
+		return util.Errorf("unable to marshal protected timestamp: %s", err)
+	}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s://%s%s/%s", ctx.RequestScheme(), ctx.Addr, ptsPathPrefix,
+		url.QueryEscape(id)), bytes.NewReader(body))
+	if err != nil {
+		return util.Errorf("unable to create request to admin REST endpoint: %s", err)
+	}
+	req.Header.Add(util.ContentTypeHeader, util.ProtoContentType)
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		return util.Errorf("admin REST request failed: %s", err)
+	}
+	return nil
+}
+
+// RunProtectTimestamp creates a protected timestamp record with the
+// given ID, protecting [key, endKey) as of the timestamp until the
+// expiration, if non-zero.
+func RunProtectTimestamp(ctx *Context, id string, key, endKey proto.Key, timestamp, expiration proto.Timestamp) {
+	if err := ProtectTimestamp(ctx, id, &proto.ProtectedTimestamp{
+		Key:        key,
+		EndKey:     endKey,
+		Timestamp:  timestamp,
+		Expiration: expiration,
+	}); err != nil {
+		log.Error(err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "protected %q-%q as of %s with ID %q\n", key, endKey, timestamp, id)
+}
+
+// RunLsProtectedTimestamps lists the protected timestamp records whose
+// IDs match the optional regexp.
+func RunLsProtectedTimestamps(ctx *Context, pattern string) {
+	var re *regexp.Regexp
+	if len(pattern) > 0 {
+		var err error
+		if re, err = regexp.Compile(pattern); err != nil {
+			log.Errorf("invalid regular expression %q: %s", pattern, err)
+			return
+		}
+	}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s%s", ctx.RequestScheme(), ctx.Addr, ptsPathPrefix), nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	b, err := sendAdminRequest(ctx, req)
+	if err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	var ids []string
+	if err = json.Unmarshal(b, &ids); err != nil {
+		log.Errorf("unable to parse admin REST response: %s", err)
+		return
+	}
+	for _, escaped := range ids {
+		id, err := url.QueryUnescape(escaped)
+		if err != nil || (re != nil && !re.MatchString(id)) {
+			continue
+		}
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s://%s%s/%s", ctx.RequestScheme(), ctx.Addr, ptsPathPrefix, escaped), nil)
+		if err != nil {
+			log.Errorf("unable to create request to admin REST endpoint: %s", err)
+			return
+		}
+		req.Header.Add("Accept", util.ProtoContentType)
+		b, err := sendAdminRequest(ctx, req)
+		if err != nil {
+			log.Errorf("admin REST request failed: %s", err)
+			return
+		}
+		pts := &proto.ProtectedTimestamp{}
+		if err := gogoproto.Unmarshal(b, pts); err != nil {
+			log.Errorf("unable to parse admin REST response: %s", err)
+			return
+		}
+		expiration := "never"
+		if !pts.Expiration.Equal(proto.ZeroTimestamp) {
+			expiration = time.Unix(0, pts.Expiration.WallTime).UTC().String()
+		}
+		fmt.Fprintf(os.Stdout, "%s\t%q-%q\t%s\texpires %s\n", id, pts.Key, pts.EndKey, pts.Timestamp, expiration)
+	}
+}
+
+// RunReleaseProtectedTimestamp releases the protected timestamp record
+// with the given ID.
+func RunReleaseProtectedTimestamp(ctx *Context, id string) {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s://%s%s/%s", ctx.RequestScheme(), ctx.Addr, ptsPathPrefix,
+		url.QueryEscape(id)), nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "released protected timestamp %q\n", id)
+}
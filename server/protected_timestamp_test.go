@@ -0,0 +1,45 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// ExampleProtectAndReleaseTimestamps creates protected timestamp
+// records, lists them and releases one.
+func ExampleProtectAndReleaseTimestamps() {
+	_, stopper := startAdminServer()
+	defer stopper.Stop()
+
+	RunProtectTimestamp(testContext, "backup 1", proto.Key("a"), proto.Key("c"),
+		proto.Timestamp{WallTime: 1}, proto.ZeroTimestamp)
+	RunProtectTimestamp(testContext, "backup2", proto.Key("x"), proto.Key("z"),
+		proto.Timestamp{WallTime: 10}, proto.Timestamp{WallTime: 1E9})
+	// An invalid span isn't protected.
+	RunProtectTimestamp(testContext, "backup3", proto.Key("z"), proto.Key("x"),
+		proto.Timestamp{WallTime: 10}, proto.ZeroTimestamp)
+	RunLsProtectedTimestamps(testContext, "")
+	RunReleaseProtectedTimestamp(testContext, "backup 1")
+	RunLsProtectedTimestamps(testContext, "backup")
+	// Output:
+	// protected "a"-"c" as of 0.000000001,0 with ID "backup 1"
+	// protected "x"-"z" as of 0.000000010,0 with ID "backup2"
+	// backup 1	"a"-"c"	0.000000001,0	expires never
+	// backup2	"x"-"z"	0.000000010,0	expires 1970-01-01 00:00:01 +0000 UTC
+	// released protected timestamp "backup 1"
+	// backup2	"x"-"z"	0.000000010,0	expires 1970-01-01 00:00:01 +0000 UTC
+}
@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"code.google.com/p/snappy-go/snappy"
 
@@ -66,6 +67,7 @@ type Server struct {
 	status         *statusServer
 	structuredDB   structured.DB
 	structuredREST *structured.RESTServer
+	changeFeed     *changeFeedServer
 	raftTransport  multiraft.Transport
 	stopper        *util.Stopper
 }
@@ -99,13 +101,20 @@ func NewServer(ctx *Context, stopper *util.Stopper) (*Server, error) {
 	s.clock.SetMaxOffset(ctx.MaxOffset)
 
 	rpcContext := rpc.NewContext(s.clock, tlsConfig, stopper)
-	go rpcContext.RemoteClocks.MonitorRemoteOffsets()
+	if certManager := ctx.GetCertificateManager(); certManager != nil {
+		rpcContext.TLSConfigFn = certManager.TLSConfig
+	}
+	go rpcContext.RemoteClocks.MonitorRemoteOffsets(ctx.DegradeOnClockOffset)
 
 	s.rpc = rpc.NewServer(util.MakeRawAddr("tcp", addr), rpcContext)
 	s.stopper.AddCloser(s.rpc)
 	s.gossip = gossip.New(rpcContext, s.ctx.GossipInterval, s.ctx.GossipBootstrapResolvers)
+	s.gossip.SetSelfJoin(s.ctx.GossipSelfJoin)
 
-	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.clock}, s.gossip)
+	ds := kv.NewDistSender(&kv.DistSenderContext{
+		Clock:                   s.clock,
+		ClosedTimestampInterval: ctx.ClosedTimestampInterval,
+	}, s.gossip)
 	sender := kv.NewTxnCoordSender(ds, s.clock, ctx.Linearizable, s.stopper)
 	s.kv = client.NewKV(nil, sender)
 	s.kv.User = storage.UserRoot
@@ -123,16 +132,22 @@ func NewServer(ctx *Context, stopper *util.Stopper) (*Server, error) {
 	s.kvREST = kv.NewRESTServer(s.kv)
 	// TODO(bdarnell): make StoreConfig configurable.
 	nCtx := storage.StoreContext{
-		Clock:        s.clock,
-		DB:           s.kv,
-		Gossip:       s.gossip,
-		Transport:    s.raftTransport,
-		Context:      context.Background(),
-		ScanInterval: s.ctx.ScanInterval,
+		Clock:                   s.clock,
+		DB:                      s.kv,
+		Gossip:                  s.gossip,
+		Transport:               s.raftTransport,
+		Context:                 context.Background(),
+		ScanInterval:            s.ctx.ScanInterval,
+		ClosedTimestampInterval: s.ctx.ClosedTimestampInterval,
+		RemoteClocks:            rpcContext.RemoteClocks,
+		TimeUntilStoreDead:      s.ctx.TimeUntilStoreDead,
 	}
 	s.node = NewNode(nCtx)
-	s.admin = newAdminServer(s.kv, s.stopper)
-	s.status = newStatusServer(s.kv, s.gossip)
+	s.changeFeed = newChangeFeedServer(s.node.lSender, s.stopper)
+	stores := &storeHandler{ctx: ctx, db: s.kv, gossip: s.gossip, node: s.node, stopper: s.stopper}
+	s.admin = newAdminServer(s.kv, s.gossip, s.stopper, s.Drain, stores)
+	s.status = newStatusServer(s.kv, s.gossip, s.clock, rpcContext.RemoteClocks, ctx.GetCertificateManager(),
+		s.ctx.TimeUntilStoreDead)
 	s.structuredDB = structured.NewDB(s.kv)
 	s.structuredREST = structured.NewRESTServer(s.structuredDB)
 
@@ -183,14 +198,40 @@ func (s *Server) initHTTP() {
 	s.mux.Handle(structured.StructuredKeyPrefix, s.structuredREST)
 }
 
-// Stop stops the server.
+// Drain prepares the server for shutdown. New client requests are
+// rejected while those in flight are given a chance to complete, the
+// leader leases held by the node's stores are transferred to other
+// replicas and the stores' engines are flushed. Drain waits at most
+// timeout before returning; the server must be stopped afterwards.
+func (s *Server) Drain(timeout time.Duration) {
+	log.Infof("draining server for up to %s", timeout)
+	deadline := time.Now().Add(timeout)
+	if err := s.kvDB.Drain(deadline.Sub(time.Now())); err != nil {
+		log.Warningf("draining kv db server: %s", err)
+	}
+	if err := s.kvREST.Drain(deadline.Sub(time.Now())); err != nil {
+		log.Warningf("draining kv rest server: %s", err)
+	}
+	s.node.drain(deadline)
+}
+
+// Stop drains the server for up to the context's drain timeout and
+// then stops it.
 func (s *Server) Stop() {
+	s.Drain(s.ctx.DrainTimeout)
 	s.stopper.Stop()
 }
 
 // ServeHTTP is necessary to implement the http.Handler interface. It
 // will snappy a response if the appropriate request headers are set.
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Change feeds are long-lived streams which would block draining;
+	// they end when the server stops instead.
+	if r.URL.Path == client.KVFeedEndpoint {
+		s.changeFeed.ServeHTTP(w, r)
+		return
+	}
+
 	// Check if we're draining; if so return 503, service unavailable.
 	if !s.stopper.StartTask() {
 		http.Error(w, "service is draining", http.StatusServiceUnavailable)
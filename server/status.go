@@ -18,13 +18,20 @@
 package server
 
 import (
+	"fmt"
 	"net/http"
+	"os"
 	"runtime"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
@@ -38,6 +45,10 @@ const (
 	// statusGossipKeyPrefix exposes a view of the gossip network.
 	statusGossipKeyPrefix = statusKeyPrefix + "gossip"
 
+	// statusGossipGraphKey exposes the connectivity graph of the gossip
+	// network as JSON or, with ?format=dot, in the Graphviz DOT language.
+	statusGossipGraphKey = statusKeyPrefix + "gossip-graph"
+
 	// statusLocalKeyPrefix is the key prefix for all local status
 	// info. Unadorned, the URL exposes the status of the node serving
 	// the request.  This is equivalent to GETing
@@ -48,8 +59,13 @@ const (
 	// statusLocalStacksKey exposes stack traces of running goroutines.
 	statusLocalStacksKey = statusLocalKeyPrefix + "stacks"
 
+	// statusLocalCertsKey exposes the certificates loaded by the node,
+	// including their expiration.
+	statusLocalCertsKey = statusLocalKeyPrefix + "certs"
+
 	// statusNodesKeyPrefix exposes status for each of the nodes the cluster.
-	// GETing statusNodesKeyPrefix will list all nodes.
+	// GETing statusNodesKeyPrefix will list all nodes along with their
+	// liveness: live, suspect or dead.
 	// Individual node status can be queried at statusNodesKeyPrefix/NodeID.
 	statusNodesKeyPrefix = statusKeyPrefix + "nodes/"
 
@@ -62,15 +78,25 @@ const (
 
 // A statusServer provides a RESTful status API.
 type statusServer struct {
-	db     *client.KV
-	gossip *gossip.Gossip
+	db           *client.KV
+	gossip       *gossip.Gossip
+	clock        *hlc.Clock
+	remoteClocks *rpc.RemoteClockMonitor
+	certManager  *security.CertificateManager // nil if insecure
+	liveness     *storage.LivenessTracker
 }
 
-// newStatusServer allocates and returns a statusServer.
-func newStatusServer(db *client.KV, gossip *gossip.Gossip) *statusServer {
+// newStatusServer allocates and returns a statusServer. Nodes which
+// haven't heartbeated for timeUntilStoreDead are reported as dead.
+func newStatusServer(db *client.KV, gossip *gossip.Gossip, clock *hlc.Clock, remoteClocks *rpc.RemoteClockMonitor,
+	certManager *security.CertificateManager, timeUntilStoreDead time.Duration) *statusServer {
 	return &statusServer{
-		db:     db,
-		gossip: gossip,
+		db:           db,
+		gossip:       gossip,
+		clock:        clock,
+		remoteClocks: remoteClocks,
+		certManager:  certManager,
+		liveness:     storage.NewLivenessTracker(gossip, timeUntilStoreDead),
 	}
 }
 
@@ -79,8 +105,10 @@ func newStatusServer(db *client.KV, gossip *gossip.Gossip) *statusServer {
 func (s *statusServer) registerHandlers(mux *http.ServeMux) {
 	mux.HandleFunc(statusKeyPrefix, s.handleStatus)
 	mux.HandleFunc(statusGossipKeyPrefix, s.handleGossipStatus)
+	mux.HandleFunc(statusGossipGraphKey, s.handleGossipGraph)
 	mux.HandleFunc(statusLocalKeyPrefix, s.handleLocalStatus)
 	mux.HandleFunc(statusLocalStacksKey, s.handleLocalStacks)
+	mux.HandleFunc(statusLocalCertsKey, s.handleLocalCerts)
 	mux.HandleFunc(statusNodesKeyPrefix, s.handleNodeStatus)
 	mux.HandleFunc(statusStoresKeyPrefix, s.handleStoresStatus)
 	mux.HandleFunc(statusTransactionsKeyPrefix, s.handleTransactionStatus)
@@ -110,13 +138,37 @@ func (s *statusServer) handleGossipStatus(w http.ResponseWriter, r *http.Request
 	w.Write(b)
 }
 
+// handleGossipGraph handles GET requests for the gossip connectivity
+// graph.
+func (s *statusServer) handleGossipGraph(w http.ResponseWriter, r *http.Request) {
+	graph := s.gossip.Connectivity()
+	if r.URL.Query().Get("format") == "dot" {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte(graph.DOT()))
+		return
+	}
+	b, contentType, err := util.MarshalResponse(r, graph, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
 // handleLocalStatus handles GET requests for local-node status.
 func (s *statusServer) handleLocalStatus(w http.ResponseWriter, r *http.Request) {
 	local := struct {
-		BuildInfo util.BuildInfo `json:"buildInfo"`
+		BuildInfo   util.BuildInfo         `json:"buildInfo"`
+		ClockOffset *rpc.ClockOffsetStatus `json:"clockOffset,omitempty"`
 	}{
 		BuildInfo: util.GetBuildInfo(),
 	}
+	if s.remoteClocks != nil {
+		clockOffset := s.remoteClocks.Status()
+		local.ClockOffset = &clockOffset
+	}
 	b, contentType, err := util.MarshalResponse(r, local, []util.EncodingType{util.JSONEncoding})
 	if err != nil {
 		log.Error(err)
@@ -145,10 +197,44 @@ func (s *statusServer) handleLocalStacks(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// handleLocalCerts handles GET requests for the certificates loaded
+// by the node. Each certificate is listed with its expiration.
+func (s *statusServer) handleLocalCerts(w http.ResponseWriter, r *http.Request) {
+	certs := struct {
+		Certificates []security.CertificateInfo `json:"certificates"`
+	}{
+		Certificates: []security.CertificateInfo{},
+	}
+	if s.certManager != nil {
+		certs.Certificates = s.certManager.Certificates()
+	}
+	b, contentType, err := util.MarshalResponse(r, certs, []util.EncodingType{util.JSONEncoding})
+	if err != nil {
+		log.Error(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(b)
+}
+
 // handleNodeStatus handles GET requests for node status.
 func (s *statusServer) handleNodeStatus(w http.ResponseWriter, r *http.Request) {
 	// TODO(shawn) parse node-id in path
 	nodes := &status.NodeList{}
+	if nodeIDs := s.liveness.NodeIDs(); len(nodeIDs) > 0 {
+		now := s.clock.PhysicalNow()
+		for _, nodeID := range nodeIDs {
+			summary := status.NodeSummary{
+				ID:       nodeID.String(),
+				Liveness: s.liveness.Liveness(nodeID, now).String(),
+			}
+			if addr, err := s.gossip.GetNodeIDAddress(nodeID); err == nil {
+				summary.Addr = addr.String()
+			}
+			nodes.Nodes = append(nodes.Nodes, summary)
+		}
+	}
 	b, contentType, err := util.MarshalResponse(r, nodes, []util.EncodingType{util.JSONEncoding})
 	if err != nil {
 		log.Error(err)
@@ -170,3 +256,24 @@ func (s *statusServer) handleTransactionStatus(w http.ResponseWriter, r *http.Re
 	w.Header().Set("Content-Type", "application/json")
 	w.Write([]byte(`{"transactions": []}`))
 }
+
+// RunGossipGraph fetches the gossip connectivity graph from the status
+// server and prints it as JSON or, if dot is true, in the Graphviz DOT
+// language.
+func RunGossipGraph(ctx *Context, dot bool) {
+	url := fmt.Sprintf("%s://%s%s", ctx.RequestScheme(), ctx.Addr, statusGossipGraphKey)
+	if dot {
+		url += "?format=dot"
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		log.Errorf("unable to create request to status endpoint: %s", err)
+		return
+	}
+	b, err := sendAdminRequest(ctx, req)
+	if err != nil {
+		log.Errorf("status request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "%s", b)
+}
@@ -30,6 +30,8 @@ type NodeList struct {
 type NodeSummary struct {
 	ID   string `json:"id"`
 	Addr string `json:"addr"`
+	// Liveness is one of "live", "suspect" or "dead".
+	Liveness string `json:"liveness"`
 }
 
 // Node represents an individual node within the cluster.
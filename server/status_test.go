@@ -44,7 +44,7 @@ func startStatusServer() (*httptest.Server, *util.Stopper) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	status := newStatusServer(db, nil)
+	status := newStatusServer(db, nil, nil, nil, nil, 0)
 	mux := http.NewServeMux()
 	status.registerHandlers(mux)
 	httpServer := httptest.NewTLSServer(mux)
@@ -81,7 +81,9 @@ func TestStatusJson(t *testing.T) {
 
 	testCases := []TestCase{
 		{statusKeyPrefix, "{}"},
-		{statusNodesKeyPrefix, "\"nodes\": null"},
+		{statusNodesKeyPrefix, "\"nodes\": (null|\\[(?s).*\"liveness\": \"live\")"},
+		{statusLocalCertsKey, "\"commonName\": \"node\""},
+		{statusGossipGraphKey, "\"partitioned\": (true|false)"},
 	}
 	// Test the /_status/local/ endpoint only in a go release branch.
 	if !strings.HasPrefix(runtime.Version(), "devel") {
@@ -91,6 +93,14 @@ func TestStatusJson(t *testing.T) {
     "tag": "",
     "time": "",
     "dependencies": ""
+  },
+  "clockOffset": {
+    "interval": {
+      "Lowerbound": [0-9-]+,
+      "Upperbound": [0-9-]+
+    },
+    "healthy": true,
+    "measuredAt": [0-9]+
   }
 }`})
 	}
@@ -0,0 +1,233 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// storeSpecRE matches the specification of a single store, in the
+// same format as the -stores flag: <attrs>=<path>.
+var storeSpecRE = regexp.MustCompile(`^([^=]+)=([^,]+)$`)
+
+// A storeHandler implements the actionHandler interface for adding
+// stores to and removing them from the running node, identified by
+// store ID.
+type storeHandler struct {
+	ctx     *Context       // Used to initialize engines
+	db      *client.KV     // Key-value database client
+	gossip  *gossip.Gossip // Used to determine which stores are being removed
+	node    *Node
+	stopper *util.Stopper
+}
+
+// A StoreStatus reports the state of a store of the node.
+type StoreStatus struct {
+	StoreID proto.StoreID `json:"storeID"`
+	// Attached is set while the store is part of the node.
+	Attached bool `json:"attached"`
+	// Removing is set once the store has been marked for removal.
+	Removing bool `json:"removing"`
+	// Replicas counts the ranges with a replica on the store.
+	Replicas int `json:"replicas"`
+	// Done is set once the store has been detached from the node and
+	// holds no replicas, at which point its disk may be taken out.
+	Done bool `json:"done"`
+}
+
+// parseStoreID parses the store ID from the path.
+func parseStoreID(path string) (proto.StoreID, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(path, "/"), 10, 32)
+	if err != nil || id <= 0 {
+		return 0, util.Errorf("invalid store ID %q", strings.TrimPrefix(path, "/"))
+	}
+	return proto.StoreID(id), nil
+}
+
+// Put adds a store to the node. The body specifies the store's
+// attributes and directory in the format of the -stores flag. If the
+// path specifies a store instead, its removal is cancelled and the
+// body is ignored.
+func (sh *storeHandler) Put(path string, body []byte, r *http.Request) error {
+	if path != "" && path != "/" {
+		storeID, err := parseStoreID(path)
+		if err != nil {
+			return err
+		}
+		return sh.node.cancelStoreRemoval(storeID)
+	}
+	spec := strings.TrimSpace(string(body))
+	match := storeSpecRE.FindStringSubmatch(spec)
+	if match == nil {
+		return util.Errorf("invalid store specification %q", spec)
+	}
+	e, err := sh.ctx.initEngine(match[1], match[2])
+	if err != nil {
+		return util.Errorf("unable to init engine for store %q: %s", spec, err)
+	}
+	_, err = sh.node.addStore(e, sh.stopper)
+	return err
+}
+
+// Get reports the status of the store specified by path or, if the
+// path is empty, of all stores attached to the node.
+func (sh *storeHandler) Get(path string, r *http.Request) (body []byte, contentType string, err error) {
+	var storeIDs []proto.StoreID
+	if path == "" || path == "/" {
+		sh.node.lSender.VisitStores(func(s *storage.Store) error {
+			storeIDs = append(storeIDs, s.StoreID())
+			return nil
+		})
+	} else {
+		storeID, err := parseStoreID(path)
+		if err != nil {
+			return nil, "", err
+		}
+		storeIDs = append(storeIDs, storeID)
+	}
+	descs, err := ScanRangeDescriptors(sh.db)
+	if err != nil {
+		return nil, "", err
+	}
+	statuses := []StoreStatus{}
+	for _, storeID := range storeIDs {
+		_, err := sh.node.lSender.GetStore(storeID)
+		status := StoreStatus{
+			StoreID:  storeID,
+			Attached: err == nil,
+			Removing: storage.IsStoreDecommissioning(sh.gossip, storeID),
+			Replicas: countStoreReplicas(storeID, descs),
+		}
+		status.Done = !status.Attached && status.Replicas == 0
+		statuses = append(statuses, status)
+	}
+	if path == "" || path == "/" {
+		return util.MarshalResponse(r, statuses, []util.EncodingType{util.JSONEncoding})
+	}
+	return util.MarshalResponse(r, statuses[0], []util.EncodingType{util.JSONEncoding})
+}
+
+// Delete marks the store specified by path for removal. The store is
+// detached from the node once its replicas have been moved to other
+// stores.
+func (sh *storeHandler) Delete(path string, r *http.Request) error {
+	storeID, err := parseStoreID(path)
+	if err != nil {
+		return err
+	}
+	return sh.node.removeStore(storeID, sh.stopper)
+}
+
+// countStoreReplicas counts the ranges with a replica on the store.
+func countStoreReplicas(storeID proto.StoreID, descs []proto.RangeDescriptor) int {
+	var count int
+	for _, desc := range descs {
+		if _, replica := desc.FindReplica(storeID); replica != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// RunAddStore adds a store, given in the format of the -stores flag,
+// to the running node.
+func RunAddStore(ctx *Context, spec string) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s://%s%s", ctx.RequestScheme(), ctx.Addr, storesPathPrefix),
+		strings.NewReader(spec))
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "added store %s\n", spec)
+}
+
+// RunRemoveStore marks the store for removal and waits for its
+// replicas to be moved to other stores and for the store to be
+// detached from the node, reporting progress as it goes, unless the
+// removal is cancelled.
+func RunRemoveStore(ctx *Context, storeID string) {
+	url := fmt.Sprintf("%s://%s%s/%s", ctx.RequestScheme(), ctx.Addr, storesPathPrefix, storeID)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	for {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			log.Errorf("unable to create request to admin REST endpoint: %s", err)
+			return
+		}
+		b, err := sendAdminRequest(ctx, req)
+		if err != nil {
+			log.Errorf("admin REST request failed: %s", err)
+			return
+		}
+		status := &StoreStatus{}
+		if err = json.Unmarshal(b, status); err != nil {
+			log.Errorf("unable to parse admin REST response: %s", err)
+			return
+		}
+		if status.Done {
+			fmt.Fprintf(os.Stdout, "store %d removed\n", status.StoreID)
+			return
+		}
+		if !status.Removing {
+			fmt.Fprintf(os.Stdout, "removal of store %d cancelled\n", status.StoreID)
+			return
+		}
+		fmt.Fprintf(os.Stdout, "store %d: %d replicas\n", status.StoreID, status.Replicas)
+		time.Sleep(decommissionPollInterval)
+	}
+}
+
+// RunKeepStore cancels the removal of a store which hasn't been
+// detached from the node yet.
+func RunKeepStore(ctx *Context, storeID string) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s://%s%s/%s", ctx.RequestScheme(), ctx.Addr,
+		storesPathPrefix, storeID), nil)
+	if err != nil {
+		log.Errorf("unable to create request to admin REST endpoint: %s", err)
+		return
+	}
+	if _, err = sendAdminRequest(ctx, req); err != nil {
+		log.Errorf("admin REST request failed: %s", err)
+		return
+	}
+	fmt.Fprintf(os.Stdout, "store %s is no longer being removed\n", storeID)
+}
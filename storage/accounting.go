@@ -0,0 +1,190 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// AcctStats maps accounting prefixes to the MVCCStats aggregated over
+// the ranges under each prefix. Each store gossips the stats of the
+// ranges for which it holds the leader lease.
+type AcctStats map[string]proto.MVCCStats
+
+// AcctUsage is the usage of an accounting prefix, as measured against
+// the quotas of its accounting config.
+type AcctUsage struct {
+	Bytes int64 `json:"bytes" yaml:"bytes"`
+	Keys  int64 `json:"keys" yaml:"keys"`
+}
+
+// An AcctUsageTracker aggregates the accounting stats gossiped by all
+// stores into per-prefix usage.
+type AcctUsageTracker struct {
+	gossip *gossip.Gossip
+
+	mu        sync.Mutex
+	statsKeys stringSet // Tracks gossip keys used for accounting stats
+}
+
+// NewAcctUsageTracker creates an AcctUsageTracker which tracks the
+// accounting stats gossiped on g. A nil gossip tracks no usage.
+func NewAcctUsageTracker(g *gossip.Gossip) *AcctUsageTracker {
+	t := &AcctUsageTracker{gossip: g, statsKeys: stringSet{}}
+	if g != nil {
+		g.RegisterCallback(gossip.MakePrefixPattern(gossip.KeyAcctStatsPrefix), t.statsGossipUpdate)
+	}
+	return t
+}
+
+// statsGossipUpdate is a gossip callback triggered whenever accounting
+// stats are gossiped. It just tracks keys used for accounting stats.
+func (t *AcctUsageTracker) statsGossipUpdate(key string, contentsChanged bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.statsKeys[key] = struct{}{}
+}
+
+// Usage returns the usage of the accounting prefix summed over the
+// stats gossiped by all stores. Keys for stats which can no longer be
+// retrieved from gossip are garbage collected.
+func (t *AcctUsageTracker) Usage(prefix proto.Key) AcctUsage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var ms proto.MVCCStats
+	for key := range t.statsKeys {
+		info, err := t.gossip.GetInfo(key)
+		if err != nil {
+			delete(t.statsKeys, key)
+			continue
+		}
+		stats, ok := info.(AcctStats)
+		if !ok {
+			log.Errorf("gossiped info is not accounting stats: %+v", info)
+			continue
+		}
+		prefixMS := stats[string(prefix)]
+		ms.Accumulate(&prefixMS)
+	}
+	return AcctUsage{Bytes: ms.KeyBytes + ms.ValBytes, Keys: ms.LiveCount}
+}
+
+// A tokenBucket holds up to one second worth of requests at the
+// configured rate.
+type tokenBucket struct {
+	tokens float64
+	last   int64 // Wall time of last refill in nanoseconds
+}
+
+// acctLimiter enforces the quotas and request-rate limits of
+// accounting configs on the requests to a store's ranges.
+type acctLimiter struct {
+	usage *AcctUsageTracker
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket // Keyed by accounting prefix
+}
+
+// newAcctLimiter returns an acctLimiter which checks quotas against
+// the usage of the supplied tracker.
+func newAcctLimiter(usage *AcctUsageTracker) *acctLimiter {
+	return &acctLimiter{
+		usage:   usage,
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// isLimited returns whether the request is a client read or write,
+// subject to the limits of its accounting config. Internal requests
+// and transaction coordination, such as resolving intents, garbage
+// collection, heartbeats, pushes and commits, must always proceed so
+// that limited prefixes don't strand transactions or garbage.
+func isLimited(args proto.Request) bool {
+	switch args.(type) {
+	case *proto.ContainsRequest, *proto.GetRequest, *proto.PutRequest,
+		*proto.ConditionalPutRequest, *proto.IncrementRequest, *proto.DeleteRequest,
+		*proto.DeleteRangeRequest, *proto.ScanRequest, *proto.IngestRequest,
+		*proto.ExportRequest:
+		return true
+	}
+	return false
+}
+
+// increasesUsage returns whether the request may increase the bytes or
+// keys stored under its key.
+func increasesUsage(args proto.Request) bool {
+	switch args.(type) {
+	case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.IncrementRequest,
+		*proto.IngestRequest:
+		return true
+	}
+	return false
+}
+
+// check returns a QuotaExceededError if a request to the accounting
+// prefix exceeds the request-rate limit of config or if it may
+// increase usage of a prefix which is at or over one of its quotas.
+// Only client reads and writes are limited, and never those to system
+// keys. now is the wall time in nanoseconds.
+func (al *acctLimiter) check(prefix proto.Key, config *proto.AcctConfig, args proto.Request, now int64) error {
+	if !isLimited(args) || args.Header().Key.Less(engine.KeySystemMax) {
+		return nil
+	}
+	if config.MaxQPS > 0 && !al.allow(prefix, config.MaxQPS, now) {
+		return proto.NewQuotaExceededError(prefix, "max_qps", config.MaxQPS, config.MaxQPS)
+	}
+	if !increasesUsage(args) || (config.MaxBytes <= 0 && config.MaxKeys <= 0) {
+		return nil
+	}
+	usage := al.usage.Usage(prefix)
+	if config.MaxBytes > 0 && usage.Bytes >= config.MaxBytes {
+		return proto.NewQuotaExceededError(prefix, "max_bytes", config.MaxBytes, usage.Bytes)
+	}
+	if config.MaxKeys > 0 && usage.Keys >= config.MaxKeys {
+		return proto.NewQuotaExceededError(prefix, "max_keys", config.MaxKeys, usage.Keys)
+	}
+	return nil
+}
+
+// allow takes a token from the bucket of the accounting prefix,
+// returning false if none is available at the rate of qps.
+func (al *acctLimiter) allow(prefix proto.Key, qps int64, now int64) bool {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	b, ok := al.buckets[string(prefix)]
+	if !ok {
+		b = &tokenBucket{tokens: float64(qps), last: now}
+		al.buckets[string(prefix)] = b
+	}
+	if elapsed := now - b.last; elapsed > 0 {
+		b.tokens += float64(qps) * float64(elapsed) / float64(time.Second)
+		b.last = now
+	}
+	if b.tokens > float64(qps) {
+		b.tokens = float64(qps)
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
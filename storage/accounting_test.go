@@ -0,0 +1,158 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestAcctUsage verifies that usage is summed over the stats gossiped
+// by all stores and that expired stats are garbage collected.
+func TestAcctUsage(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	tracker := NewAcctUsageTracker(s.ctx.Gossip)
+	// Explicitly add keys rather than waiting for the goroutine callback.
+	tracker.mu.Lock()
+	tracker.statsKeys = stringSet{"k1": struct{}{}, "k2": struct{}{}, "k3": struct{}{}}
+	tracker.mu.Unlock()
+	s.ctx.Gossip.AddInfo("k1", AcctStats{
+		"db1": proto.MVCCStats{KeyBytes: 10, ValBytes: 20, LiveCount: 2},
+		"db2": proto.MVCCStats{KeyBytes: 1, ValBytes: 1, LiveCount: 1},
+	}, time.Hour)
+	s.ctx.Gossip.AddInfo("k2", AcctStats{
+		"db1": proto.MVCCStats{KeyBytes: 5, ValBytes: 5, LiveCount: 1},
+	}, time.Hour)
+
+	if usage, expUsage := tracker.Usage(proto.Key("db1")), (AcctUsage{Bytes: 40, Keys: 3}); usage != expUsage {
+		t.Errorf("expected usage %+v; got %+v", expUsage, usage)
+	}
+	if usage, expUsage := tracker.Usage(proto.Key("db3")), (AcctUsage{}); usage != expUsage {
+		t.Errorf("expected usage %+v; got %+v", expUsage, usage)
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	if _, ok := tracker.statsKeys["k3"]; ok {
+		t.Errorf("expected missing stats key to be removed: %+v", tracker.statsKeys)
+	}
+}
+
+// TestAcctLimiterQuotas verifies that writes which may increase usage
+// of a prefix at or over one of its quotas are rejected.
+func TestAcctLimiterQuotas(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	al := newAcctLimiter(NewAcctUsageTracker(s.ctx.Gossip))
+	al.usage.mu.Lock()
+	al.usage.statsKeys = stringSet{"k1": struct{}{}}
+	al.usage.mu.Unlock()
+	s.ctx.Gossip.AddInfo("k1", AcctStats{
+		"db1": proto.MVCCStats{KeyBytes: 50, ValBytes: 50, LiveCount: 10},
+	}, time.Hour)
+
+	prefix := proto.Key("db1")
+	put := &proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("db1/a")}}
+	del := &proto.DeleteRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("db1/a")}}
+	sysPut := &proto.PutRequest{RequestHeader: proto.RequestHeader{Key: engine.KeyConfigAccountingPrefix}}
+	ingest := &proto.IngestRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("db1/a")}}
+	resolve := &proto.InternalResolveIntentRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("db1/a")}}
+
+	testCases := []struct {
+		config proto.AcctConfig
+		args   proto.Request
+		quota  string // Empty if the request is allowed
+	}{
+		{proto.AcctConfig{}, put, ""},
+		{proto.AcctConfig{MaxBytes: 101}, put, ""},
+		{proto.AcctConfig{MaxBytes: 100}, put, "max_bytes"},
+		{proto.AcctConfig{MaxKeys: 11}, put, ""},
+		{proto.AcctConfig{MaxKeys: 10}, put, "max_keys"},
+		{proto.AcctConfig{MaxBytes: 100, MaxKeys: 10}, del, ""},
+		{proto.AcctConfig{MaxBytes: 100, MaxKeys: 10}, sysPut, ""},
+		{proto.AcctConfig{MaxBytes: 100}, ingest, "max_bytes"},
+		{proto.AcctConfig{MaxBytes: 100, MaxKeys: 10}, resolve, ""},
+	}
+	for i, test := range testCases {
+		err := al.check(prefix, &test.config, test.args, 0)
+		if test.quota == "" {
+			if err != nil {
+				t.Errorf("%d: expected success; got %s", i, err)
+			}
+			continue
+		}
+		qErr, ok := err.(*proto.QuotaExceededError)
+		if !ok {
+			t.Errorf("%d: expected quota exceeded error; got %v", i, err)
+		} else if qErr.Quota != test.quota || !qErr.Prefix.Equal(prefix) {
+			t.Errorf("%d: expected %s exceeded for %q; got %s", i, test.quota, prefix, qErr)
+		}
+	}
+}
+
+// TestAcctLimiterRateLimit verifies that requests to a prefix are
+// limited to MaxQPS per second, independently of other prefixes.
+func TestAcctLimiterRateLimit(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	al := newAcctLimiter(NewAcctUsageTracker(nil))
+	config := &proto.AcctConfig{MaxQPS: 2}
+	get := &proto.GetRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}}
+
+	expectAllowed := func(prefix string, now int64, allowed bool) {
+		err := al.check(proto.Key(prefix), config, get, now)
+		if allowed && err != nil {
+			t.Errorf("%q at %d: expected success; got %s", prefix, now, err)
+		} else if !allowed {
+			if qErr, ok := err.(*proto.QuotaExceededError); !ok || qErr.Quota != "max_qps" {
+				t.Errorf("%q at %d: expected max_qps exceeded; got %v", prefix, now, err)
+			}
+		}
+	}
+	expectAllowed("db1", 0, true)
+	expectAllowed("db1", 0, true)
+	expectAllowed("db1", 0, false)
+	// Other prefixes have their own limit.
+	expectAllowed("db2", 0, true)
+	// Half a second refills one request.
+	half := (500 * time.Millisecond).Nanoseconds()
+	expectAllowed("db1", half, true)
+	expectAllowed("db1", half, false)
+	// Idle time accumulates no more than one second worth of requests.
+	expectAllowed("db1", 10*time.Second.Nanoseconds(), true)
+	expectAllowed("db1", 10*time.Second.Nanoseconds(), true)
+	expectAllowed("db1", 10*time.Second.Nanoseconds(), false)
+
+	// Internal requests and transaction coordination are never limited.
+	for _, args := range []proto.Request{
+		&proto.EndTransactionRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}},
+		&proto.InternalResolveIntentRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}},
+		&proto.InternalGCRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}},
+		&proto.InternalHeartbeatTxnRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}},
+		&proto.InternalPushTxnRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}},
+	} {
+		if err := al.check(proto.Key("db1"), config, args, 10*time.Second.Nanoseconds()); err != nil {
+			t.Errorf("expected %s not to be limited; got %s", args.Method(), err)
+		}
+	}
+}
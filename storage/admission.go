@@ -0,0 +1,212 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// defaultL0FileSlowdown is the default number of level 0 files at
+	// which user writes are throttled.
+	defaultL0FileSlowdown = 20
+	// defaultL0FileStop is the default number of level 0 files at which
+	// user writes are rejected.
+	defaultL0FileStop = 36
+	// defaultPendingCompactionSlowdownBytes is the default estimate of
+	// pending compaction bytes at which user writes are throttled.
+	defaultPendingCompactionSlowdownBytes = 64 << 30 // 64G
+	// defaultPendingCompactionStopBytes is the default estimate of
+	// pending compaction bytes at which user writes are rejected.
+	defaultPendingCompactionStopBytes = 256 << 30 // 256G
+	// defaultSlowdownConcurrency is the default number of user writes
+	// which may execute concurrently while writes are throttled.
+	defaultSlowdownConcurrency = 4
+	// defaultAdmissionQueueTimeout is the default time a throttled
+	// user write waits to execute before it is rejected.
+	defaultAdmissionQueueTimeout = 2 * time.Second
+	// engineHealthInterval is the minimum interval between queries of
+	// the engine's health.
+	engineHealthInterval = 250 * time.Millisecond
+)
+
+// AdmissionOptions configures the store's admission control, which
+// throttles and then rejects user writes as the engine falls behind
+// on compactions. Zero values are replaced by defaults.
+type AdmissionOptions struct {
+	// L0FileSlowdown and L0FileStop are the numbers of level 0 files
+	// at which user writes are throttled and rejected respectively.
+	L0FileSlowdown, L0FileStop int64
+	// PendingCompactionSlowdownBytes and PendingCompactionStopBytes
+	// are the estimates of pending compaction bytes at which user
+	// writes are throttled and rejected respectively.
+	PendingCompactionSlowdownBytes, PendingCompactionStopBytes int64
+	// SlowdownConcurrency is the number of user writes which may
+	// execute concurrently while writes are throttled. Others queue.
+	SlowdownConcurrency int
+	// QueueTimeout is the time a queued user write waits to execute
+	// before it is rejected.
+	QueueTimeout time.Duration
+}
+
+func (ao *AdmissionOptions) setDefaults() {
+	if ao.L0FileSlowdown == 0 {
+		ao.L0FileSlowdown = defaultL0FileSlowdown
+	}
+	if ao.L0FileStop == 0 {
+		ao.L0FileStop = defaultL0FileStop
+	}
+	if ao.PendingCompactionSlowdownBytes == 0 {
+		ao.PendingCompactionSlowdownBytes = defaultPendingCompactionSlowdownBytes
+	}
+	if ao.PendingCompactionStopBytes == 0 {
+		ao.PendingCompactionStopBytes = defaultPendingCompactionStopBytes
+	}
+	if ao.SlowdownConcurrency == 0 {
+		ao.SlowdownConcurrency = defaultSlowdownConcurrency
+	}
+	if ao.QueueTimeout == 0 {
+		ao.QueueTimeout = defaultAdmissionQueueTimeout
+	}
+}
+
+// engineLoad classifies the engine's compaction backlog.
+type engineLoad int
+
+const (
+	loadNormal   engineLoad = iota // All writes are admitted
+	loadSlowdown                   // User writes are throttled
+	loadStop                       // User writes are rejected
+)
+
+// An admissionController admits commands to a store's ranges based on
+// the health of its engine. Reads, system keys and internal commands,
+// which resolve intents, truncate logs and keep leases and
+// transactions alive, are always admitted. Raft traffic bypasses the
+// controller entirely. User writes are throttled to a fixed
+// concurrency once the engine's backlog reaches the slowdown
+// thresholds and are rejected with a retryable StoreBackpressureError
+// once it reaches the stop thresholds or a throttled write times out.
+type admissionController struct {
+	opts     AdmissionOptions
+	engine   engine.Engine
+	interval time.Duration // Minimum interval between engine health queries
+	slots    chan struct{} // Semaphore limiting throttled user writes
+
+	mu        sync.Mutex
+	load      engineLoad
+	reason    string    // Describes the condition causing load
+	refreshed time.Time // Time of last engine health query
+}
+
+// newAdmissionController returns an admissionController for the
+// engine configured with opts, which must have defaults set.
+func newAdmissionController(eng engine.Engine, opts AdmissionOptions) *admissionController {
+	return &admissionController{
+		opts:     opts,
+		engine:   eng,
+		interval: engineHealthInterval,
+		slots:    make(chan struct{}, opts.SlowdownConcurrency),
+	}
+}
+
+// isUserWrite returns whether the request writes user data, making it
+// subject to throttling. Ingestion writes a whole SSTable's worth of
+// keys at once, so it's throttled along with transactional writes.
+func isUserWrite(args proto.Request) bool {
+	if !proto.IsTransactionWrite(args) && args.Method() != proto.Ingest {
+		return false
+	}
+	return !args.Header().Key.Less(engine.KeySystemMax)
+}
+
+// admit blocks until the request may execute, returning a function
+// which must be invoked once execution completes. A
+// StoreBackpressureError is returned if the request is rejected.
+func (ac *admissionController) admit(storeID proto.StoreID, args proto.Request) (func(), error) {
+	if !isUserWrite(args) {
+		return func() {}, nil
+	}
+	load, reason := ac.engineLoad()
+	switch load {
+	case loadNormal:
+		return func() {}, nil
+	case loadStop:
+		return nil, proto.NewStoreBackpressureError(storeID, reason)
+	}
+	select {
+	case ac.slots <- struct{}{}:
+	case <-time.After(ac.opts.QueueTimeout):
+		return nil, proto.NewStoreBackpressureError(storeID,
+			fmt.Sprintf("timed out after %s waiting to write: %s", ac.opts.QueueTimeout, reason))
+	}
+	release := func() { <-ac.slots }
+	// The engine may have fallen further behind while queued.
+	if load, reason = ac.engineLoad(); load == loadStop {
+		release()
+		return nil, proto.NewStoreBackpressureError(storeID, reason)
+	}
+	return release, nil
+}
+
+// engineLoad returns the engine's load and the condition causing it,
+// querying the engine's health at most once per interval.
+func (ac *admissionController) engineLoad() (engineLoad, string) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	if now := time.Now(); now.Sub(ac.refreshed) >= ac.interval {
+		ac.refreshed = now
+		h, err := ac.engine.Health()
+		if err != nil {
+			log.Warningf("unable to query engine health: %s", err)
+			return ac.load, ac.reason
+		}
+		load, reason := ac.classify(h)
+		if load != ac.load {
+			if load == loadNormal {
+				log.Infof("%s: admitting all writes", ac.engine)
+			} else {
+				log.Warningf("%s: applying backpressure to writes: %s", ac.engine, reason)
+			}
+		}
+		ac.load, ac.reason = load, reason
+	}
+	return ac.load, ac.reason
+}
+
+// classify returns the load corresponding to the engine's health and
+// a description of the condition causing it.
+func (ac *admissionController) classify(h engine.Health) (engineLoad, string) {
+	switch {
+	case h.L0FileCount >= ac.opts.L0FileStop:
+		return loadStop, fmt.Sprintf("%d level 0 files >= %d", h.L0FileCount, ac.opts.L0FileStop)
+	case h.PendingCompactionBytes >= ac.opts.PendingCompactionStopBytes:
+		return loadStop, fmt.Sprintf("%d pending compaction bytes >= %d",
+			h.PendingCompactionBytes, ac.opts.PendingCompactionStopBytes)
+	case h.L0FileCount >= ac.opts.L0FileSlowdown:
+		return loadSlowdown, fmt.Sprintf("%d level 0 files >= %d", h.L0FileCount, ac.opts.L0FileSlowdown)
+	case h.PendingCompactionBytes >= ac.opts.PendingCompactionSlowdownBytes:
+		return loadSlowdown, fmt.Sprintf("%d pending compaction bytes >= %d",
+			h.PendingCompactionBytes, ac.opts.PendingCompactionSlowdownBytes)
+	}
+	return loadNormal, ""
+}
@@ -0,0 +1,126 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// healthEngine wraps an engine, reporting the supplied health.
+type healthEngine struct {
+	engine.Engine
+	health engine.Health
+}
+
+func (e *healthEngine) Health() (engine.Health, error) {
+	return e.health, nil
+}
+
+func newTestAdmissionController(eng engine.Engine) *admissionController {
+	opts := AdmissionOptions{
+		L0FileSlowdown:      10,
+		L0FileStop:          20,
+		SlowdownConcurrency: 1,
+		QueueTimeout:        10 * time.Millisecond,
+	}
+	opts.setDefaults()
+	ac := newAdmissionController(eng, opts)
+	ac.interval = 0 // Query engine health on every admission
+	return ac
+}
+
+// TestAdmissionControllerLoad verifies that user writes are admitted,
+// throttled or rejected depending on the engine's health, while reads,
+// system keys and internal commands are always admitted.
+func TestAdmissionControllerLoad(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	eng := &healthEngine{Engine: engine.NewInMem(proto.Attributes{}, 1<<20)}
+	defer eng.Close()
+	ac := newTestAdmissionController(eng)
+
+	put := &proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}}
+	get := &proto.GetRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}}
+	sysPut := &proto.PutRequest{RequestHeader: proto.RequestHeader{Key: engine.KeyConfigAccountingPrefix}}
+	resolve := &proto.InternalResolveIntentRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}}
+
+	testCases := []struct {
+		health   engine.Health
+		args     proto.Request
+		admitted bool
+	}{
+		{engine.Health{}, put, true},
+		{engine.Health{L0FileCount: 10}, put, true},
+		{engine.Health{L0FileCount: 20}, put, false},
+		{engine.Health{PendingCompactionBytes: defaultPendingCompactionStopBytes}, put, false},
+		{engine.Health{L0FileCount: 20}, get, true},
+		{engine.Health{L0FileCount: 20}, sysPut, true},
+		{engine.Health{L0FileCount: 20}, resolve, true},
+	}
+	for i, test := range testCases {
+		eng.health = test.health
+		release, err := ac.admit(1, test.args)
+		if test.admitted {
+			if err != nil {
+				t.Errorf("%d: expected admission; got %s", i, err)
+				continue
+			}
+			release()
+		} else if _, ok := err.(*proto.StoreBackpressureError); !ok {
+			t.Errorf("%d: expected backpressure error; got %v", i, err)
+		}
+	}
+}
+
+// TestAdmissionControllerThrottle verifies that throttled user writes
+// queue for a limited number of slots and are rejected on timeout.
+func TestAdmissionControllerThrottle(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	eng := &healthEngine{Engine: engine.NewInMem(proto.Attributes{}, 1<<20)}
+	defer eng.Close()
+	ac := newTestAdmissionController(eng)
+	eng.health = engine.Health{L0FileCount: 10}
+	put := &proto.PutRequest{RequestHeader: proto.RequestHeader{Key: proto.Key("a")}}
+
+	release, err := ac.admit(1, put)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The single slot is taken, so the next write times out.
+	if _, err := ac.admit(1, put); err == nil {
+		t.Fatal("expected throttled write to time out")
+	} else if bpErr, ok := err.(*proto.StoreBackpressureError); !ok || !bpErr.CanRetry() {
+		t.Fatalf("expected retryable backpressure error; got %v", err)
+	}
+	// A queued write is admitted once the slot is released.
+	admitted := make(chan error, 1)
+	ac.opts.QueueTimeout = time.Minute
+	go func() {
+		release, err := ac.admit(1, put)
+		if err == nil {
+			release()
+		}
+		admitted <- err
+	}()
+	release()
+	if err := <-admitted; err != nil {
+		t.Errorf("expected queued write to be admitted; got %s", err)
+	}
+}
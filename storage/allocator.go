@@ -25,6 +25,11 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 )
 
+// withinNodeRebalanceThreshold is the minimum difference in the
+// fraction of available capacity between two stores of a node for a
+// replica to be moved from one to the other.
+const withinNodeRebalanceThreshold = 0.1
+
 // allocator makes allocation decisions based on a zone configuration,
 // existing range metadata and available stores. Configuration
 // settings and range metadata information is stored directly in the
@@ -85,3 +90,56 @@ func (a *allocator) allocate(required proto.Attributes, existingReplicas []proto
 	}
 	return nil, util.Errorf("unable to find an appropriate store for requested replica attributes")
 }
+
+// allocateWithinNode returns a store on the same node as the replica
+// to which the replica should be moved, or nil if it should stay. A
+// replica is moved off stores which are no longer available for
+// allocation, such as stores being removed from the node, and off
+// stores with sufficiently less available capacity than the target.
+// The store with the most available capacity not already holding a
+// replica of the range is picked.
+func (a *allocator) allocateWithinNode(required proto.Attributes, replica proto.Replica,
+	existingReplicas []proto.Replica) (*proto.StoreDescriptor, error) {
+	usedStores := make(map[proto.StoreID]struct{})
+	for _, r := range existingReplicas {
+		usedStores[r.StoreID] = struct{}{}
+	}
+
+	stores, err := a.storeFinder(required)
+	if err != nil {
+		return nil, err
+	}
+
+	var source, target *proto.StoreDescriptor
+	for _, s := range stores {
+		if s.Node.NodeID != replica.NodeID {
+			continue
+		}
+		if s.StoreID == replica.StoreID {
+			source = s
+		} else if _, ok := usedStores[s.StoreID]; !ok {
+			if target == nil || s.Capacity.PercentAvail() > target.Capacity.PercentAvail() {
+				target = s
+			}
+		}
+	}
+	if target == nil || (source != nil &&
+		target.Capacity.PercentAvail()-source.Capacity.PercentAvail() < withinNodeRebalanceThreshold) {
+		return nil, nil
+	}
+	return target, nil
+}
+
+// percentAvail returns the fraction of available capacity of each
+// store available for allocation, keyed by store ID.
+func (a *allocator) percentAvail() (map[proto.StoreID]float64, error) {
+	stores, err := a.storeFinder(proto.Attributes{})
+	if err != nil {
+		return nil, err
+	}
+	avail := make(map[proto.StoreID]float64, len(stores))
+	for _, s := range stores {
+		avail[s.StoreID] = s.Capacity.PercentAvail()
+	}
+	return avail, nil
+}
@@ -293,3 +293,81 @@ func TestExistingReplica(t *testing.T) {
 		t.Errorf("expected result to have node 3 and store 4: %+v", result)
 	}
 }
+
+var sameNodeStores = func(a proto.Attributes) ([]*proto.StoreDescriptor, error) {
+	node1 := proto.NodeDescriptor{
+		NodeID: 1,
+		Attrs:  proto.Attributes{Attrs: []string{"a"}},
+	}
+	return filterStores(a, []*proto.StoreDescriptor{
+		{
+			StoreID:  1,
+			Attrs:    proto.Attributes{Attrs: []string{"ssd"}},
+			Node:     node1,
+			Capacity: proto.StoreCapacity{Capacity: 100, Available: 10},
+		},
+		{
+			StoreID:  2,
+			Attrs:    proto.Attributes{Attrs: []string{"ssd"}},
+			Node:     node1,
+			Capacity: proto.StoreCapacity{Capacity: 100, Available: 50},
+		},
+		{
+			StoreID:  3,
+			Attrs:    proto.Attributes{Attrs: []string{"ssd"}},
+			Node:     node1,
+			Capacity: proto.StoreCapacity{Capacity: 100, Available: 15},
+		},
+		{
+			StoreID: 4,
+			Attrs:   proto.Attributes{Attrs: []string{"ssd"}},
+			Node: proto.NodeDescriptor{
+				NodeID: 2,
+				Attrs:  proto.Attributes{Attrs: []string{"a"}},
+			},
+			Capacity: proto.StoreCapacity{Capacity: 100, Available: 100},
+		},
+	})
+}
+
+func TestAllocateWithinNode(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var a = allocator{
+		storeFinder: sameNodeStores,
+		rand:        *rand.New(rand.NewSource(0)),
+	}
+	replica := func(storeID proto.StoreID) proto.Replica {
+		return proto.Replica{NodeID: 1, StoreID: storeID}
+	}
+	remote := proto.Replica{NodeID: 2, StoreID: 4}
+	testCases := []struct {
+		replica  proto.Replica
+		existing []proto.Replica
+		expected proto.StoreID // 0 if the replica should stay
+	}{
+		// The fullest store's replica moves to the emptiest store.
+		{replica(1), []proto.Replica{replica(1), remote}, 2},
+		// Stores holding a replica are skipped; the remaining store
+		// doesn't have enough more available capacity.
+		{replica(1), []proto.Replica{replica(1), replica(2), remote}, 0},
+		// The emptiest store's replica stays.
+		{replica(2), []proto.Replica{replica(2), remote}, 0},
+		// Replicas on stores not available for allocation are moved.
+		{replica(5), []proto.Replica{replica(5), remote}, 2},
+		// Replicas aren't moved to stores of other nodes.
+		{proto.Replica{NodeID: 3, StoreID: 6}, []proto.Replica{remote}, 0},
+	}
+	for i, test := range testCases {
+		result, err := a.allocateWithinNode(simpleZoneConfig.ReplicaAttrs[0], test.replica, test.existing)
+		if err != nil {
+			t.Fatalf("%d: unable to perform allocation: %v", i, err)
+		}
+		var storeID proto.StoreID
+		if result != nil {
+			storeID = result.StoreID
+		}
+		if storeID != test.expected {
+			t.Errorf("%d: expected store %d; got %d", i, test.expected, storeID)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+const (
+	// changeFeedBufferSize is the number of entries queued for each
+	// change feed. A feed whose consumer falls further behind fails.
+	changeFeedBufferSize = 4096
+	// defaultChangeFeedCheckpointInterval is the interval between the
+	// checkpoints of change feeds on stores which don't close
+	// timestamps. Their checkpoints never advance.
+	defaultChangeFeedCheckpointInterval = 1 * time.Second
+)
+
+// errChangeFeedOverflow is the error of a change feed whose consumer
+// fell too far behind the values committed in its span.
+var errChangeFeedOverflow = errors.New("change feed consumer fell behind")
+
+// A changeFeedEntry is queued for a change feed. It's either a
+// committed value, an intent change or a checkpoint holding the closed
+// timestamp of the feed's span.
+type changeFeedEntry struct {
+	value      *engine.MVCCCommittedValue
+	intent     *engine.MVCCIntent
+	checkpoint *proto.Timestamp
+}
+
+// A changeFeedRegistry holds the change feeds of a store. Raft commands
+// commit holding commitMu shared, so they don't serialize each other,
+// while feeds subscribe and queue checkpoints holding it exclusively.
+// Each committed value and intent change is thus either published to a
+// feed or visible in the snapshot the feed catches up from, and
+// precedes the checkpoints queued after its command committed.
+type changeFeedRegistry struct {
+	commitMu   sync.RWMutex
+	sync.Mutex // Protects feeds
+	feeds      map[*ChangeFeed]struct{}
+	numFeeds   int32 // Updated atomically with feeds; read without the lock
+}
+
+// newChangeFeedRegistry returns an empty changeFeedRegistry.
+func newChangeFeedRegistry() *changeFeedRegistry {
+	return &changeFeedRegistry{feeds: map[*ChangeFeed]struct{}{}}
+}
+
+// commit commits the batch of a raft command and publishes the values
+// and intent changes recorded by its commit log to the change feeds
+// whose spans contain them, if there are any.
+func (r *changeFeedRegistry) commit(batch engine.Engine, log *engine.MVCCCommitLog) error {
+	r.commitMu.RLock()
+	defer r.commitMu.RUnlock()
+	if err := batch.Commit(); err != nil {
+		return err
+	}
+	if atomic.LoadInt32(&r.numFeeds) == 0 || (len(log.Values) == 0 && len(log.Intents) == 0) {
+		return nil
+	}
+	r.Lock()
+	defer r.Unlock()
+	for f := range r.feeds {
+		for i := range log.Values {
+			if f.containsKey(log.Values[i].Key) {
+				r.sendLocked(f, changeFeedEntry{value: &log.Values[i]})
+			}
+		}
+		for i := range log.Intents {
+			if f.containsKey(log.Intents[i].Key) {
+				r.sendLocked(f, changeFeedEntry{intent: &log.Intents[i]})
+			}
+		}
+	}
+	return nil
+}
+
+// add registers the feed.
+func (r *changeFeedRegistry) add(f *ChangeFeed) {
+	r.Lock()
+	defer r.Unlock()
+	r.feeds[f] = struct{}{}
+	atomic.AddInt32(&r.numFeeds, 1)
+}
+
+// sendLocked queues the entry for the feed, failing the feed if its
+// queue is full.
+func (r *changeFeedRegistry) sendLocked(f *ChangeFeed, e changeFeedEntry) {
+	select {
+	case f.entries <- e:
+	default:
+		r.removeLocked(f, errChangeFeedOverflow)
+	}
+}
+
+// removeLocked unregisters the feed and closes its queue, failing the
+// feed with err if non-nil.
+func (r *changeFeedRegistry) removeLocked(f *ChangeFeed, err error) {
+	if _, ok := r.feeds[f]; !ok {
+		return
+	}
+	delete(r.feeds, f)
+	atomic.AddInt32(&r.numFeeds, -1)
+	f.err = err
+	close(f.entries)
+}
+
+// A ChangeFeed is a subscription to the values committed to a span of
+// keys replicated on a store after a start timestamp. Values are sent
+// in commit order for each key. Checkpoints report the timestamps up
+// to which all values committed in the span have been sent; they
+// follow the closed timestamps of the store's ranges covering the
+// span, held below the unresolved intents in the span, which the feed
+// tracks as they're written and resolved.
+type ChangeFeed struct {
+	store       *Store
+	key, endKey proto.Key
+	startTime   proto.Timestamp
+	resolved    proto.Timestamp            // Latest checkpoint sent
+	intents     map[string]proto.Timestamp // Unresolved intents by key
+	snapshot    engine.Engine              // Snapshot to catch up from
+	entries     chan changeFeedEntry
+	err         error // Set by the registry before closing entries
+}
+
+// SubscribeChangeFeed subscribes to the values committed to the keys in
+// [key, endKey) after startTime. The span must be covered by ranges
+// replicated on the store. The feed must be run or closed.
+func (s *Store) SubscribeChangeFeed(key, endKey proto.Key, startTime proto.Timestamp) (*ChangeFeed, error) {
+	if !key.Less(endKey) || key.Less(engine.KeyLocalMax) {
+		return nil, util.Errorf("invalid change feed span %q-%q", key, endKey)
+	}
+	if _, err := s.closedTimestamp(key, endKey); err != nil {
+		return nil, err
+	}
+	f := &ChangeFeed{
+		store:     s,
+		key:       key,
+		endKey:    endKey,
+		startTime: startTime,
+		resolved:  startTime,
+		intents:   map[string]proto.Timestamp{},
+		entries:   make(chan changeFeedEntry, changeFeedBufferSize),
+	}
+	s.changeFeeds.commitMu.Lock()
+	s.changeFeeds.add(f)
+	f.snapshot = s.engine.NewSnapshot()
+	s.changeFeeds.commitMu.Unlock()
+	return f, nil
+}
+
+// closedTimestamp returns the lowest closed timestamp of the store's
+// ranges covering [key, endKey), or an error if they don't cover the
+// span.
+func (s *Store) closedTimestamp(key, endKey proto.Key) (proto.Timestamp, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	closed := proto.MaxTimestamp
+	next := key
+	for n := sort.Search(len(s.rangesByKey), func(i int) bool {
+		return key.Less(s.rangesByKey[i].Desc().EndKey)
+	}); n < len(s.rangesByKey) && next.Less(endKey); n++ {
+		rng := s.rangesByKey[n]
+		if next.Less(rng.Desc().StartKey) {
+			break
+		}
+		if ts := rng.getClosedTimestamp(); ts.Less(closed) {
+			closed = ts
+		}
+		next = rng.Desc().EndKey
+	}
+	if next.Less(endKey) {
+		return proto.ZeroTimestamp, util.Errorf("span %q-%q is not fully replicated on store %d",
+			key, endKey, s.StoreID())
+	}
+	return closed, nil
+}
+
+// Run sends the feed's events until done is closed, send returns an
+// error or the feed fails, and closes the feed. The values committed
+// before the feed subscribed are sent first, followed by those
+// committed since, interleaved with checkpoints.
+func (f *ChangeFeed) Run(done <-chan struct{}, send func(*proto.ChangeFeedEvent) error) error {
+	defer f.Close()
+	err := engine.MVCCIterateCommitted(f.snapshot, f.key, f.endKey, f.startTime,
+		func(cv engine.MVCCCommittedValue) error {
+			return send(newChangeFeedValueEvent(&cv))
+		})
+	if err == nil {
+		err = engine.MVCCIterateIntents(f.snapshot, f.key, f.endKey, func(intent engine.MVCCIntent) error {
+			f.intents[string(intent.Key)] = intent.Timestamp
+			return nil
+		})
+	}
+	f.snapshot.Close()
+	f.snapshot = nil
+	if err != nil {
+		return err
+	}
+
+	interval := f.store.ctx.ClosedTimestampInterval
+	if interval == 0 {
+		interval = defaultChangeFeedCheckpointInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+			if err := f.checkpoint(); err != nil {
+				return err
+			}
+		case e, ok := <-f.entries:
+			if !ok {
+				return f.err
+			}
+			switch {
+			case e.value != nil:
+				if !f.startTime.Less(e.value.Timestamp) {
+					continue
+				}
+				if err := send(newChangeFeedValueEvent(e.value)); err != nil {
+					return err
+				}
+			case e.intent != nil:
+				if e.intent.Resolved {
+					delete(f.intents, string(e.intent.Key))
+				} else {
+					f.intents[string(e.intent.Key)] = e.intent.Timestamp
+				}
+			default:
+				if resolved := f.resolvedTimestamp(*e.checkpoint); f.resolved.Less(resolved) {
+					f.resolved = resolved
+					if err := send(&proto.ChangeFeedEvent{Resolved: &resolved}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+}
+
+// Close unsubscribes the feed.
+func (f *ChangeFeed) Close() {
+	f.store.changeFeeds.Lock()
+	f.store.changeFeeds.removeLocked(f, nil)
+	f.store.changeFeeds.Unlock()
+	if f.snapshot != nil {
+		f.snapshot.Close()
+		f.snapshot = nil
+	}
+}
+
+// containsKey returns whether the key is in the feed's span.
+func (f *ChangeFeed) containsKey(key proto.Key) bool {
+	return !key.Less(f.key) && key.Less(f.endKey)
+}
+
+// checkpoint queues a checkpoint of the feed holding the lowest closed
+// timestamp of the ranges covering the span. The closed timestamps are
+// read before the checkpoint is queued, excluding commits, so that the
+// values committed at or below them, and the intents written, precede
+// it.
+func (f *ChangeFeed) checkpoint() error {
+	closed, err := f.store.closedTimestamp(f.key, f.endKey)
+	if err != nil {
+		return err
+	}
+	r := f.store.changeFeeds
+	r.commitMu.Lock()
+	defer r.commitMu.Unlock()
+	r.Lock()
+	defer r.Unlock()
+	if _, ok := r.feeds[f]; ok {
+		r.sendLocked(f, changeFeedEntry{checkpoint: &closed})
+	}
+	return nil
+}
+
+// resolvedTimestamp returns the timestamp up to which all values in
+// the span have been sent, given its closed timestamp: the closed
+// timestamp, lowered below any unresolved intent, as those may still
+// commit.
+func (f *ChangeFeed) resolvedTimestamp(closed proto.Timestamp) proto.Timestamp {
+	resolved := closed
+	for _, ts := range f.intents {
+		if !resolved.Less(ts) {
+			resolved = ts.Prev()
+		}
+	}
+	return resolved
+}
+
+// newChangeFeedValueEvent returns the change feed event for the
+// committed value.
+func newChangeFeedValueEvent(cv *engine.MVCCCommittedValue) *proto.ChangeFeedEvent {
+	e := &proto.ChangeFeedEvent{Value: &proto.KeyValue{Key: cv.Key}}
+	if cv.Value != nil {
+		e.Value.Value = *cv.Value
+	} else {
+		ts := cv.Timestamp
+		e.Value.Value.Timestamp = &ts
+		e.Deleted = true
+	}
+	return e
+}
@@ -0,0 +1,198 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestChangeFeed verifies that a change feed on a follower receives the
+// values committed in its span, both before and after it subscribed,
+// followed by a checkpoint once the leader closes out their timestamps.
+func TestChangeFeed(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := storage.TestStoreContext
+	ctx.ClosedTimestampInterval = 10 * time.Millisecond
+	mtc := &multiTestContext{storeContext: &ctx}
+	mtc.Start(t, 2)
+	defer mtc.Stop()
+
+	raftID := int64(1)
+	mtc.replicateRange(raftID, 0, 1)
+
+	put := func(key, value string) proto.Timestamp {
+		args, reply := putArgs([]byte(key), []byte(value), raftID, mtc.stores[0].StoreID())
+		if err := mtc.stores[0].ExecuteCmd(args, reply); err != nil {
+			t.Fatal(err)
+		}
+		return reply.Timestamp
+	}
+	put("a", "1")
+
+	feed, err := mtc.stores[1].SubscribeChangeFeed(proto.Key("a"), proto.Key("c"), proto.ZeroTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := make(chan *proto.ChangeFeedEvent, 100)
+	done := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- feed.Run(done, func(e *proto.ChangeFeedEvent) error {
+			events <- e
+			return nil
+		})
+	}()
+	defer func() {
+		close(done)
+		if err := <-errCh; err != nil {
+			t.Error(err)
+		}
+	}()
+
+	put("d", "outside the span")
+	lastTS := put("b", "2")
+	mtc.manualClock.Increment(int64(100 * time.Millisecond))
+
+	expKeys := []string{"a", "b"}
+	expValues := []string{"1", "2"}
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e := <-events:
+			if e.Value != nil {
+				if len(expKeys) == 0 || string(e.Value.Key) != expKeys[0] ||
+					!bytes.Equal(e.Value.Value.Bytes, []byte(expValues[0])) {
+					t.Fatalf("unexpected value event %+v; expected %v", e, expKeys)
+				}
+				expKeys, expValues = expKeys[1:], expValues[1:]
+				continue
+			}
+			if e.Resolved != nil && !e.Resolved.Less(lastTS) {
+				if len(expKeys) != 0 {
+					t.Fatalf("checkpoint %s before values of keys %v", e.Resolved, expKeys)
+				}
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for change feed; missing keys %v", expKeys)
+		}
+	}
+}
+
+// TestChangeFeedIntent verifies that the checkpoints of a change feed
+// are held below an unresolved intent in its span, including one
+// written before the feed subscribed, until the intent commits.
+func TestChangeFeedIntent(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := storage.TestStoreContext
+	ctx.ClosedTimestampInterval = 10 * time.Millisecond
+	mtc := &multiTestContext{storeContext: &ctx}
+	mtc.Start(t, 1)
+	defer mtc.Stop()
+
+	store := mtc.stores[0]
+	pArgs, pReply := putArgs([]byte("b"), []byte("1"), 1, store.StoreID())
+	pArgs.Timestamp = mtc.clock.Now()
+	pArgs.Txn = &proto.Transaction{ID: []byte("txn1"), Timestamp: pArgs.Timestamp}
+	if err := store.ExecuteCmd(pArgs, pReply); err != nil {
+		t.Fatal(err)
+	}
+	intentTS := pReply.Timestamp
+
+	feed, err := store.SubscribeChangeFeed(proto.Key("a"), proto.Key("c"), proto.ZeroTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := make(chan *proto.ChangeFeedEvent, 100)
+	done := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- feed.Run(done, func(e *proto.ChangeFeedEvent) error {
+			events <- e
+			return nil
+		})
+	}()
+	defer func() {
+		close(done)
+		if err := <-errCh; err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// Wait for checkpoints to catch up to the intent, but not pass it.
+	mtc.manualClock.Increment(int64(100 * time.Millisecond))
+	timeout := time.After(5 * time.Second)
+	for caughtUp := false; !caughtUp; {
+		select {
+		case e := <-events:
+			if e.Value != nil {
+				t.Fatalf("unexpected value event %+v before the intent committed", e)
+			}
+			if e.Resolved != nil {
+				if !e.Resolved.Less(intentTS) {
+					t.Fatalf("checkpoint %s passed the intent at %s", e.Resolved, intentTS)
+				}
+				caughtUp = e.Resolved.Equal(intentTS.Prev())
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for checkpoint below the intent at %s", intentTS)
+		}
+	}
+
+	rArgs := &proto.InternalResolveIntentRequest{
+		RequestHeader: proto.RequestHeader{
+			Timestamp: intentTS,
+			Key:       pArgs.Key,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: store.StoreID()},
+			Txn:       pArgs.Txn,
+		},
+	}
+	rArgs.Txn.Status = proto.COMMITTED
+	rArgs.Txn.Timestamp = intentTS
+	if err := store.ExecuteCmd(rArgs, &proto.InternalResolveIntentResponse{}); err != nil {
+		t.Fatal(err)
+	}
+	mtc.manualClock.Increment(int64(100 * time.Millisecond))
+
+	sawValue := false
+	for {
+		select {
+		case e := <-events:
+			if e.Value != nil {
+				if string(e.Value.Key) != "b" || !bytes.Equal(e.Value.Value.Bytes, []byte("1")) {
+					t.Fatalf("unexpected value event %+v", e)
+				}
+				sawValue = true
+				continue
+			}
+			if e.Resolved != nil && !e.Resolved.Less(intentTS) {
+				if !sawValue {
+					t.Fatalf("checkpoint %s before the committed value", e.Resolved)
+				}
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for checkpoint past the committed intent at %s", intentTS)
+		}
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
@@ -500,6 +502,92 @@ func TestStoreRangeReplicate(t *testing.T) {
 	}
 }
 
+// TestStoreRangeMoveWithinNode verifies that the replicate queue moves
+// a replica off a store being removed to another store of the same
+// node, first adding the replica on the target store and then removing
+// the duplicate, while clients keep reading and writing the range.
+func TestStoreRangeMoveWithinNode(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	mtc := multiTestContext{}
+	mtc.Start(t, 1)
+	defer mtc.Stop()
+	mtc.addStoreOnNode(t, 0)
+
+	// Initialize the gossip network.
+	for _, s := range mtc.stores {
+		s.GossipCapacity()
+	}
+	mtc.stores[0].WaitForNodes(2)
+
+	// Keep incrementing and reading a key through the node's sender,
+	// which holds both stores. Requests reaching the replica which is
+	// handing off the leader lease are retried.
+	key := proto.Key("a")
+	stopLoad := make(chan struct{})
+	loadDone := make(chan error, 1)
+	var incs int64
+	go func() {
+		for {
+			select {
+			case <-stopLoad:
+				loadDone <- nil
+				return
+			default:
+			}
+			inc := client.Increment(key, 1)
+			if err := mtc.db.Run(inc); err != nil {
+				if _, ok := err.(*proto.NotLeaderError); ok {
+					continue
+				}
+				loadDone <- err
+				return
+			}
+			incs++
+			get := client.Get(key)
+			if err := mtc.db.Run(get); err != nil {
+				if _, ok := err.(*proto.NotLeaderError); ok {
+					continue
+				}
+				loadDone <- err
+				return
+			}
+			if v := get.Reply.(*proto.GetResponse).Value.GetInteger(); v != incs {
+				loadDone <- util.Errorf("read %d after %d increments", v, incs)
+				return
+			}
+		}
+	}()
+
+	// Remove the first store, and keep scanning both stores until the
+	// range has been moved.
+	if err := mtc.gossip.AddInfo(gossip.MakeStoreDecommissionKey(mtc.stores[0].StoreID()), true, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		for _, s := range mtc.stores {
+			s.ForceReplicationScan(t)
+		}
+		rng := mtc.stores[1].LookupRange(key, nil)
+		if rng == nil {
+			return util.Errorf("range not found on store %d", mtc.stores[1].StoreID())
+		}
+		replicas := rng.Desc().Replicas
+		if len(replicas) != 1 || replicas[0].StoreID != mtc.stores[1].StoreID() {
+			return util.Errorf("expected range only on store %d, got replicas %+v",
+				mtc.stores[1].StoreID(), replicas)
+		}
+		return nil
+	})
+
+	close(stopLoad)
+	if err := <-loadDone; err != nil {
+		t.Fatal(err)
+	}
+	if incs == 0 {
+		t.Error("expected client increments to succeed during the move")
+	}
+}
+
 // TestProgressWithDownNode verifies that a surviving quorum can make progress
 // with a downed node.
 func TestProgressWithDownNode(t *testing.T) {
@@ -621,3 +709,160 @@ func TestReplicateAddAndRemove(t *testing.T) {
 		// back up.
 	}
 }
+
+// TestTransferLeaderLease verifies that the leader lease can be moved
+// to another replica of the range through AdminTransferLease, after
+// which the previous holder redirects commands to the new one.
+func TestTransferLeaderLease(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	mtc := startMultiTestContext(t, 2)
+	defer mtc.Stop()
+
+	raftID := int64(1)
+	mtc.replicateRange(raftID, 0, 1)
+
+	// Issue a command on the first store so that it acquires the lease.
+	incArgs, incResp := incrementArgs([]byte("a"), 5, raftID, mtc.stores[0].StoreID())
+	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+		t.Fatal(err)
+	}
+
+	args := &proto.AdminTransferLeaseRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     proto.Key("a"),
+			RaftID:  raftID,
+			Replica: proto.Replica{StoreID: mtc.stores[0].StoreID()},
+		},
+		StoreID: int32(mtc.stores[1].StoreID()),
+	}
+	if err := mtc.stores[0].ExecuteCmd(args, &proto.AdminTransferLeaseResponse{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first store now redirects to the second.
+	incArgs, incResp = incrementArgs([]byte("a"), 11, raftID, mtc.stores[0].StoreID())
+	err := mtc.stores[0].ExecuteCmd(incArgs, incResp)
+	if nlErr, ok := err.(*proto.NotLeaderError); !ok {
+		t.Fatalf("expected not leader error; got %v", err)
+	} else if nlErr.Leader == nil || nlErr.Leader.StoreID != mtc.stores[1].StoreID() {
+		t.Errorf("expected store %d to be leader; got %+v", mtc.stores[1].StoreID(), nlErr.Leader)
+	}
+
+	// The second store holds the lease and serves the command.
+	util.SucceedsWithin(t, time.Second, func() error {
+		rng, err := mtc.stores[1].GetRange(raftID)
+		if err != nil {
+			return err
+		}
+		if held, expired := rng.HasLeaderLease(mtc.clock.Now()); !held || expired {
+			return util.Errorf("store %d does not hold the lease", mtc.stores[1].StoreID())
+		}
+		return nil
+	})
+	incArgs, incResp = incrementArgs([]byte("a"), 11, raftID, mtc.stores[1].StoreID())
+	if err := mtc.stores[1].ExecuteCmd(incArgs, incResp); err != nil {
+		t.Fatal(err)
+	}
+	if incResp.NewValue != 16 {
+		t.Errorf("expected 16; got %d", incResp.NewValue)
+	}
+}
+
+// TestDrainLeaderLeases verifies that a draining store transfers its
+// leader leases to other replicas and doesn't reacquire them.
+func TestDrainLeaderLeases(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	mtc := startMultiTestContext(t, 2)
+	defer mtc.Stop()
+
+	raftID := int64(1)
+	mtc.replicateRange(raftID, 0, 1)
+
+	// Issue a command on the first store so that it acquires the lease.
+	incArgs, incResp := incrementArgs([]byte("a"), 5, raftID, mtc.stores[0].StoreID())
+	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+		t.Fatal(err)
+	}
+	if count := mtc.stores[0].LeaderLeaseCount(); count != 1 {
+		t.Fatalf("expected store %d to hold 1 lease; got %d", mtc.stores[0].StoreID(), count)
+	}
+
+	mtc.stores[0].SetDraining(true)
+	if failed := mtc.stores[0].DrainLeaderLeases(); failed != 0 {
+		t.Fatalf("expected all leases to be transferred; %d failed", failed)
+	}
+	if count := mtc.stores[0].LeaderLeaseCount(); count != 0 {
+		t.Errorf("expected draining store to hold no leases; got %d", count)
+	}
+
+	// The draining store refuses commands rather than reacquiring the
+	// lease.
+	incArgs, incResp = incrementArgs([]byte("a"), 11, raftID, mtc.stores[0].StoreID())
+	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err == nil {
+		t.Fatal("expected draining store to refuse command")
+	} else if _, ok := err.(*proto.NotLeaderError); !ok {
+		t.Fatalf("expected not leader error; got %v", err)
+	}
+
+	// The second store holds the lease and serves the command.
+	util.SucceedsWithin(t, time.Second, func() error {
+		if count := mtc.stores[1].LeaderLeaseCount(); count != 1 {
+			return util.Errorf("store %d holds %d leases", mtc.stores[1].StoreID(), count)
+		}
+		return nil
+	})
+	incArgs, incResp = incrementArgs([]byte("a"), 11, raftID, mtc.stores[1].StoreID())
+	if err := mtc.stores[1].ExecuteCmd(incArgs, incResp); err != nil {
+		t.Fatal(err)
+	}
+	if incResp.NewValue != 16 {
+		t.Errorf("expected 16; got %d", incResp.NewValue)
+	}
+}
+
+// TestFollowerRead verifies that a follower serves FOLLOWER reads once
+// the leader has closed out the read timestamp.
+func TestFollowerRead(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := storage.TestStoreContext
+	ctx.ClosedTimestampInterval = 10 * time.Millisecond
+	mtc := &multiTestContext{storeContext: &ctx}
+	mtc.Start(t, 2)
+	defer mtc.Stop()
+
+	raftID := int64(1)
+	mtc.replicateRange(raftID, 0, 1)
+
+	// Issue a command on the first store so that it acquires the lease.
+	incArgs, incResp := incrementArgs([]byte("a"), 5, raftID, mtc.stores[0].StoreID())
+	if err := mtc.stores[0].ExecuteCmd(incArgs, incResp); err != nil {
+		t.Fatal(err)
+	}
+
+	// Read from the follower at a timestamp which the leader closes out
+	// once the clock has moved past it.
+	readTS := mtc.clock.Now()
+	mtc.manualClock.Increment(int64(100 * time.Millisecond))
+	util.SucceedsWithin(t, time.Second, func() error {
+		args, reply := getArgs([]byte("a"), raftID, mtc.stores[1].StoreID())
+		args.ReadConsistency = proto.FOLLOWER
+		args.Timestamp = readTS
+		if err := mtc.stores[1].ExecuteCmd(args, reply); err != nil {
+			return err
+		}
+		if v := reply.Value.GetInteger(); v != 5 {
+			t.Fatalf("expected 5; got %d", v)
+		}
+		return nil
+	})
+
+	// A read above the closed timestamp is redirected to the leader.
+	args, reply := getArgs([]byte("a"), raftID, mtc.stores[1].StoreID())
+	args.ReadConsistency = proto.FOLLOWER
+	args.Timestamp = mtc.clock.Now()
+	if err := mtc.stores[1].ExecuteCmd(args, reply); err == nil {
+		t.Fatal("expected follower read above the closed timestamp to fail")
+	} else if _, ok := err.(*proto.NotLeaderError); !ok {
+		t.Fatalf("expected not leader error; got %v", err)
+	}
+}
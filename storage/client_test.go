@@ -97,17 +97,18 @@ func createTestStoreWithEngine(t *testing.T, eng engine.Engine, clock *hlc.Clock
 }
 
 type multiTestContext struct {
-	t           *testing.T
-	manualClock *hlc.ManualClock
-	clock       *hlc.Clock
-	gossip      *gossip.Gossip
-	transport   multiraft.Transport
-	db          *client.KV
-	feed        *util.Feed
-	engines     []engine.Engine
-	senders     []*kv.LocalSender
-	stores      []*storage.Store
-	idents      []proto.StoreIdent
+	t            *testing.T
+	storeContext *storage.StoreContext
+	manualClock  *hlc.ManualClock
+	clock        *hlc.Clock
+	gossip       *gossip.Gossip
+	transport    multiraft.Transport
+	db           *client.KV
+	feed         *util.Feed
+	engines      []engine.Engine
+	senders      []*kv.LocalSender
+	stores       []*storage.Store
+	idents       []proto.StoreIdent
 	// We use multiple stoppers so we can restart different parts of the
 	// test individually. clientStopper is for 'db', transportStopper is
 	// for 'transport', and the 'stoppers' slice corresponds to the
@@ -177,6 +178,9 @@ func (m *multiTestContext) Stop() {
 
 func (m *multiTestContext) makeContext() storage.StoreContext {
 	ctx := storage.TestStoreContext
+	if m.storeContext != nil {
+		ctx = *m.storeContext
+	}
 	ctx.Clock = m.clock
 	ctx.DB = m.db
 	ctx.Gossip = m.gossip
@@ -187,7 +191,18 @@ func (m *multiTestContext) makeContext() storage.StoreContext {
 
 // AddStore creates a new store on the same Transport but doesn't create any ranges.
 func (m *multiTestContext) addStore(t *testing.T) {
+	m.addStoreOnNode(t, len(m.stores))
+}
+
+// addStoreOnNode creates a new store on the node of the store with the
+// given index, sharing its sender. An index past the existing stores
+// creates the store on a new node.
+func (m *multiTestContext) addStoreOnNode(t *testing.T, nodeIdx int) {
 	idx := len(m.stores)
+	nodeID := proto.NodeID(idx + 1)
+	if nodeIdx < idx {
+		nodeID = m.idents[nodeIdx].NodeID
+	}
 	var eng engine.Engine
 	var needBootstrap bool
 	if len(m.engines) > len(m.stores) {
@@ -206,10 +221,10 @@ func (m *multiTestContext) addStore(t *testing.T) {
 
 	stopper := util.NewStopper()
 	ctx := m.makeContext()
-	store := storage.NewStore(ctx, eng, &proto.NodeDescriptor{NodeID: proto.NodeID(idx + 1)})
+	store := storage.NewStore(ctx, eng, &proto.NodeDescriptor{NodeID: nodeID})
 	if needBootstrap {
 		err := store.Bootstrap(proto.StoreIdent{
-			NodeID:  proto.NodeID(idx + 1),
+			NodeID:  nodeID,
 			StoreID: proto.StoreID(idx + 1),
 		}, stopper)
 		if err != nil {
@@ -228,7 +243,11 @@ func (m *multiTestContext) addStore(t *testing.T) {
 	}
 	m.stores = append(m.stores, store)
 	if len(m.senders) == idx {
-		m.senders = append(m.senders, kv.NewLocalSender())
+		if nodeIdx < idx {
+			m.senders = append(m.senders, m.senders[nodeIdx])
+		} else {
+			m.senders = append(m.senders, kv.NewLocalSender())
+		}
 	}
 	m.senders[idx].AddStore(store)
 	// Save the store identities for later so we can use them in
@@ -251,7 +270,7 @@ func (m *multiTestContext) restartStore(i int) {
 	m.stoppers[i] = util.NewStopper()
 
 	ctx := m.makeContext()
-	m.stores[i] = storage.NewStore(ctx, m.engines[i], &proto.NodeDescriptor{NodeID: proto.NodeID(i + 1)})
+	m.stores[i] = storage.NewStore(ctx, m.engines[i], &proto.NodeDescriptor{NodeID: m.idents[i].NodeID})
 	if err := m.stores[i].Start(m.stoppers[i]); err != nil {
 		m.t.Fatal(err)
 	}
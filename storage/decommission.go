@@ -0,0 +1,49 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// IsDecommissioning returns true if the node with the given ID has
+// been marked as decommissioning in gossip.
+func IsDecommissioning(g *gossip.Gossip, nodeID proto.NodeID) bool {
+	val, err := g.GetInfo(gossip.MakeDecommissionKey(nodeID))
+	if err != nil {
+		return false
+	}
+	decommissioning, ok := val.(bool)
+	return ok && decommissioning
+}
+
+// IsStoreDecommissioning returns true if the store with the given ID
+// has been marked in gossip as being removed from its node.
+func IsStoreDecommissioning(g *gossip.Gossip, storeID proto.StoreID) bool {
+	val, err := g.GetInfo(gossip.MakeStoreDecommissionKey(storeID))
+	if err != nil {
+		return false
+	}
+	decommissioning, ok := val.(bool)
+	return ok && decommissioning
+}
+
+// isReplicaDecommissioning returns true if the replica's node is being
+// decommissioned or its store is being removed.
+func isReplicaDecommissioning(g *gossip.Gossip, replica proto.Replica) bool {
+	return IsDecommissioning(g, replica.NodeID) || IsStoreDecommissioning(g, replica.StoreID)
+}
@@ -0,0 +1,49 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestIsDecommissioning verifies that nodes are decommissioning while
+// marked in gossip.
+func TestIsDecommissioning(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	if IsDecommissioning(s.ctx.Gossip, 2) {
+		t.Error("expected node 2 not to be decommissioning")
+	}
+	if err := s.ctx.Gossip.AddInfo(gossip.MakeDecommissionKey(2), true, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !IsDecommissioning(s.ctx.Gossip, 2) || IsDecommissioning(s.ctx.Gossip, 1) {
+		t.Error("expected only node 2 to be decommissioning")
+	}
+
+	// Recommissioning the node removes the mark.
+	if err := s.ctx.Gossip.RemoveInfo(gossip.MakeDecommissionKey(2)); err != nil {
+		t.Fatal(err)
+	}
+	if IsDecommissioning(s.ctx.Gossip, 2) {
+		t.Error("expected node 2 to no longer be decommissioning")
+	}
+}
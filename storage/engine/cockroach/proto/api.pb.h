@@ -68,15 +68,24 @@ class AdminSplitRequest;
 class AdminSplitResponse;
 class AdminMergeRequest;
 class AdminMergeResponse;
+class AdminTransferLeaseRequest;
+class AdminTransferLeaseResponse;
+class IngestRequest;
+class IngestResponse;
+class ExportRequest;
+class ExportResponse;
+class ChangeFeedRequest;
+class ChangeFeedEvent;
 
 enum ReadConsistencyType {
   CONSISTENT = 0,
   CONSENSUS = 1,
-  INCONSISTENT = 2
+  INCONSISTENT = 2,
+  FOLLOWER = 3
 };
 bool ReadConsistencyType_IsValid(int value);
 const ReadConsistencyType ReadConsistencyType_MIN = CONSISTENT;
-const ReadConsistencyType ReadConsistencyType_MAX = INCONSISTENT;
+const ReadConsistencyType ReadConsistencyType_MAX = FOLLOWER;
 const int ReadConsistencyType_ARRAYSIZE = ReadConsistencyType_MAX + 1;
 
 const ::google::protobuf::EnumDescriptor* ReadConsistencyType_descriptor();
@@ -3010,6 +3019,750 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   void InitAsDefaultInstance();
   static AdminMergeResponse* default_instance_;
 };
+// -------------------------------------------------------------------
+
+class AdminTransferLeaseRequest : public ::google::protobuf::Message {
+ public:
+  AdminTransferLeaseRequest();
+  virtual ~AdminTransferLeaseRequest();
+
+  AdminTransferLeaseRequest(const AdminTransferLeaseRequest& from);
+
+  inline AdminTransferLeaseRequest& operator=(const AdminTransferLeaseRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminTransferLeaseRequest& default_instance();
+
+  void Swap(AdminTransferLeaseRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminTransferLeaseRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminTransferLeaseRequest& from);
+  void MergeFrom(const AdminTransferLeaseRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional int32 store_id = 2;
+  inline bool has_store_id() const;
+  inline void clear_store_id();
+  static const int kStoreIdFieldNumber = 2;
+  inline ::google::protobuf::int32 store_id() const;
+  inline void set_store_id(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminTransferLeaseRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_store_id();
+  inline void clear_has_store_id();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::int32 store_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminTransferLeaseRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminTransferLeaseResponse : public ::google::protobuf::Message {
+ public:
+  AdminTransferLeaseResponse();
+  virtual ~AdminTransferLeaseResponse();
+
+  AdminTransferLeaseResponse(const AdminTransferLeaseResponse& from);
+
+  inline AdminTransferLeaseResponse& operator=(const AdminTransferLeaseResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminTransferLeaseResponse& default_instance();
+
+  void Swap(AdminTransferLeaseResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  AdminTransferLeaseResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminTransferLeaseResponse& from);
+  void MergeFrom(const AdminTransferLeaseResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminTransferLeaseResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminTransferLeaseResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class IngestRequest : public ::google::protobuf::Message {
+ public:
+  IngestRequest();
+  virtual ~IngestRequest();
+
+  IngestRequest(const IngestRequest& from);
+
+  inline IngestRequest& operator=(const IngestRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const IngestRequest& default_instance();
+
+  void Swap(IngestRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  IngestRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const IngestRequest& from);
+  void MergeFrom(const IngestRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional bytes data = 2;
+  inline bool has_data() const;
+  inline void clear_data();
+  static const int kDataFieldNumber = 2;
+  inline const ::std::string& data() const;
+  inline void set_data(const ::std::string& value);
+  inline void set_data(const char* value);
+  inline void set_data(const void* value, size_t size);
+  inline ::std::string* mutable_data();
+  inline ::std::string* release_data();
+  inline void set_allocated_data(::std::string* data);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.IngestRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_data();
+  inline void clear_has_data();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::std::string* data_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static IngestRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class IngestResponse : public ::google::protobuf::Message {
+ public:
+  IngestResponse();
+  virtual ~IngestResponse();
+
+  IngestResponse(const IngestResponse& from);
+
+  inline IngestResponse& operator=(const IngestResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const IngestResponse& default_instance();
+
+  void Swap(IngestResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  IngestResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const IngestResponse& from);
+  void MergeFrom(const IngestResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.IngestResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static IngestResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ExportRequest : public ::google::protobuf::Message {
+ public:
+  ExportRequest();
+  virtual ~ExportRequest();
+
+  ExportRequest(const ExportRequest& from);
+
+  inline ExportRequest& operator=(const ExportRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ExportRequest& default_instance();
+
+  void Swap(ExportRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  ExportRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ExportRequest& from);
+  void MergeFrom(const ExportRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional .cockroach.proto.Timestamp start_time = 2;
+  inline bool has_start_time() const;
+  inline void clear_start_time();
+  static const int kStartTimeFieldNumber = 2;
+  inline const ::cockroach::proto::Timestamp& start_time() const;
+  inline ::cockroach::proto::Timestamp* mutable_start_time();
+  inline ::cockroach::proto::Timestamp* release_start_time();
+  inline void set_allocated_start_time(::cockroach::proto::Timestamp* start_time);
+
+  // optional bool all_versions = 3;
+  inline bool has_all_versions() const;
+  inline void clear_all_versions();
+  static const int kAllVersionsFieldNumber = 3;
+  inline bool all_versions() const;
+  inline void set_all_versions(bool value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ExportRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_start_time();
+  inline void clear_has_start_time();
+  inline void set_has_all_versions();
+  inline void clear_has_all_versions();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Timestamp* start_time_;
+  bool all_versions_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ExportRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ExportResponse : public ::google::protobuf::Message {
+ public:
+  ExportResponse();
+  virtual ~ExportResponse();
+
+  ExportResponse(const ExportResponse& from);
+
+  inline ExportResponse& operator=(const ExportResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ExportResponse& default_instance();
+
+  void Swap(ExportResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  ExportResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ExportResponse& from);
+  void MergeFrom(const ExportResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // optional bytes data = 2;
+  inline bool has_data() const;
+  inline void clear_data();
+  static const int kDataFieldNumber = 2;
+  inline const ::std::string& data() const;
+  inline void set_data(const ::std::string& value);
+  inline void set_data(const char* value);
+  inline void set_data(const void* value, size_t size);
+  inline ::std::string* mutable_data();
+  inline ::std::string* release_data();
+  inline void set_allocated_data(::std::string* data);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ExportResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_data();
+  inline void clear_has_data();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::std::string* data_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ExportResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ChangeFeedRequest : public ::google::protobuf::Message {
+ public:
+  ChangeFeedRequest();
+  virtual ~ChangeFeedRequest();
+
+  ChangeFeedRequest(const ChangeFeedRequest& from);
+
+  inline ChangeFeedRequest& operator=(const ChangeFeedRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ChangeFeedRequest& default_instance();
+
+  void Swap(ChangeFeedRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  ChangeFeedRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ChangeFeedRequest& from);
+  void MergeFrom(const ChangeFeedRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ChangeFeedRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ChangeFeedRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ChangeFeedEvent : public ::google::protobuf::Message {
+ public:
+  ChangeFeedEvent();
+  virtual ~ChangeFeedEvent();
+
+  ChangeFeedEvent(const ChangeFeedEvent& from);
+
+  inline ChangeFeedEvent& operator=(const ChangeFeedEvent& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ChangeFeedEvent& default_instance();
+
+  void Swap(ChangeFeedEvent* other);
+
+  // implements Message ----------------------------------------------
+
+  ChangeFeedEvent* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ChangeFeedEvent& from);
+  void MergeFrom(const ChangeFeedEvent& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.KeyValue value = 1;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 1;
+  inline const ::cockroach::proto::KeyValue& value() const;
+  inline ::cockroach::proto::KeyValue* mutable_value();
+  inline ::cockroach::proto::KeyValue* release_value();
+  inline void set_allocated_value(::cockroach::proto::KeyValue* value);
+
+  // optional bool deleted = 2;
+  inline bool has_deleted() const;
+  inline void clear_deleted();
+  static const int kDeletedFieldNumber = 2;
+  inline bool deleted() const;
+  inline void set_deleted(bool value);
+
+  // optional .cockroach.proto.Timestamp resolved = 3;
+  inline bool has_resolved() const;
+  inline void clear_resolved();
+  static const int kResolvedFieldNumber = 3;
+  inline const ::cockroach::proto::Timestamp& resolved() const;
+  inline ::cockroach::proto::Timestamp* mutable_resolved();
+  inline ::cockroach::proto::Timestamp* release_resolved();
+  inline void set_allocated_resolved(::cockroach::proto::Timestamp* resolved);
+
+  // optional .cockroach.proto.Error error = 4;
+  inline bool has_error() const;
+  inline void clear_error();
+  static const int kErrorFieldNumber = 4;
+  inline const ::cockroach::proto::Error& error() const;
+  inline ::cockroach::proto::Error* mutable_error();
+  inline ::cockroach::proto::Error* release_error();
+  inline void set_allocated_error(::cockroach::proto::Error* error);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ChangeFeedEvent)
+ private:
+  inline void set_has_value();
+  inline void clear_has_value();
+  inline void set_has_deleted();
+  inline void clear_has_deleted();
+  inline void set_has_resolved();
+  inline void clear_has_resolved();
+  inline void set_has_error();
+  inline void clear_has_error();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::KeyValue* value_;
+  ::cockroach::proto::Timestamp* resolved_;
+  ::cockroach::proto::Error* error_;
+  bool deleted_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ChangeFeedEvent* default_instance_;
+};
 // ===================================================================
 
 
@@ -4577,39 +5330,293 @@ inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value
 
 // -------------------------------------------------------------------
 
-// ScanRequest
+// ScanRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ScanRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ScanRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ScanRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ScanRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& ScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* ScanRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* ScanRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRequest.header)
+}
+
+// optional int64 max_results = 2;
+inline bool ScanRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ScanRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ScanRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+inline ::google::protobuf::int64 ScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.max_results)
+  return max_results_;
+}
+inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
+}
+
+// -------------------------------------------------------------------
+
+// ScanResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ScanResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ScanResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ScanResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ScanResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& ScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* ScanResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* ScanResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.header)
+}
+
+// repeated .cockroach.proto.KeyValue rows = 2;
+inline int ScanResponse::rows_size() const {
+  return rows_.size();
+}
+inline void ScanResponse::clear_rows() {
+  rows_.Clear();
+}
+inline const ::cockroach::proto::KeyValue& ScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.rows)
+  return rows_.Get(index);
+}
+inline ::cockroach::proto::KeyValue* ScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.rows)
+  return rows_.Mutable(index);
+}
+inline ::cockroach::proto::KeyValue* ScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanResponse.rows)
+  return rows_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+ScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanResponse.rows)
+  return rows_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+ScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanResponse.rows)
+  return &rows_;
+}
+
+// -------------------------------------------------------------------
+
+// EndTransactionRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool EndTransactionRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void EndTransactionRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void EndTransactionRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void EndTransactionRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
+}
+
+// optional bool commit = 2;
+inline bool EndTransactionRequest::has_commit() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void EndTransactionRequest::set_has_commit() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void EndTransactionRequest::clear_has_commit() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void EndTransactionRequest::clear_commit() {
+  commit_ = false;
+  clear_has_commit();
+}
+inline bool EndTransactionRequest::commit() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
+  return commit_;
+}
+inline void EndTransactionRequest::set_commit(bool value) {
+  set_has_commit();
+  commit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
+}
+
+// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+inline bool EndTransactionRequest::has_internal_commit_trigger() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void EndTransactionRequest::set_has_internal_commit_trigger() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void EndTransactionRequest::clear_internal_commit_trigger() {
+  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
+  clear_has_internal_commit_trigger();
+}
+inline const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
+}
+inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
+  set_has_internal_commit_trigger();
+  if (internal_commit_trigger_ == NULL) internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_;
+}
+inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
+  clear_has_internal_commit_trigger();
+  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
+  internal_commit_trigger_ = NULL;
+  return temp;
+}
+inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
+  delete internal_commit_trigger_;
+  internal_commit_trigger_ = internal_commit_trigger;
+  if (internal_commit_trigger) {
+    set_has_internal_commit_trigger();
+  } else {
+    clear_has_internal_commit_trigger();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+}
+
+// -------------------------------------------------------------------
+
+// EndTransactionResponse
 
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool ScanRequest::has_header() const {
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool EndTransactionResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ScanRequest::set_has_header() {
+inline void EndTransactionResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ScanRequest::clear_has_header() {
+inline void EndTransactionResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ScanRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+inline void EndTransactionResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& ScanRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.header)
+inline const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* ScanRequest::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
   set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRequest.header)
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* ScanRequest::release_header() {
+inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
   clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
+  ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4617,381 +5624,527 @@ inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
 }
 
-// optional int64 max_results = 2;
-inline bool ScanRequest::has_max_results() const {
+// optional int64 commit_wait = 2;
+inline bool EndTransactionResponse::has_commit_wait() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ScanRequest::set_has_max_results() {
+inline void EndTransactionResponse::set_has_commit_wait() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ScanRequest::clear_has_max_results() {
+inline void EndTransactionResponse::clear_has_commit_wait() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ScanRequest::clear_max_results() {
-  max_results_ = GOOGLE_LONGLONG(0);
-  clear_has_max_results();
+inline void EndTransactionResponse::clear_commit_wait() {
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_commit_wait();
 }
-inline ::google::protobuf::int64 ScanRequest::max_results() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.max_results)
-  return max_results_;
+inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
+  return commit_wait_;
 }
-inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
-  set_has_max_results();
-  max_results_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
+inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
+  set_has_commit_wait();
+  commit_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
+}
+
+// repeated bytes resolved = 3;
+inline int EndTransactionResponse::resolved_size() const {
+  return resolved_.size();
+}
+inline void EndTransactionResponse::clear_resolved() {
+  resolved_.Clear();
+}
+inline const ::std::string& EndTransactionResponse::resolved(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Get(index);
+}
+inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Mutable(index);
+}
+inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
+  resolved_.Mutable(index)->assign(value);
+}
+inline void EndTransactionResponse::set_resolved(int index, const char* value) {
+  resolved_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
+  resolved_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline ::std::string* EndTransactionResponse::add_resolved() {
+  return resolved_.Add();
+}
+inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::add_resolved(const char* value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
+  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+EndTransactionResponse::resolved() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+EndTransactionResponse::mutable_resolved() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
+  return &resolved_;
 }
 
 // -------------------------------------------------------------------
 
-// ScanResponse
+// RequestUnion
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool ScanResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void ScanResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void ScanResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+// optional .cockroach.proto.ContainsRequest contains = 1;
+inline bool RequestUnion::has_contains() const {
+  return value_case() == kContains;
 }
-inline void ScanResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void RequestUnion::set_has_contains() {
+  _oneof_case_[0] = kContains;
 }
-inline const ::cockroach::proto::ResponseHeader& ScanResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void RequestUnion::clear_contains() {
+  if (has_contains()) {
+    delete value_.contains_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::ResponseHeader* ScanResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.header)
-  return header_;
+inline const ::cockroach::proto::ContainsRequest& RequestUnion::contains() const {
+  return has_contains() ? *value_.contains_
+                      : ::cockroach::proto::ContainsRequest::default_instance();
 }
-inline ::cockroach::proto::ResponseHeader* ScanResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::ContainsRequest* RequestUnion::mutable_contains() {
+  if (!has_contains()) {
+    clear_value();
+    set_has_contains();
+    value_.contains_ = new ::cockroach::proto::ContainsRequest;
+  }
+  return value_.contains_;
 }
-inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::ContainsRequest* RequestUnion::release_contains() {
+  if (has_contains()) {
+    clear_has_value();
+    ::cockroach::proto::ContainsRequest* temp = value_.contains_;
+    value_.contains_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_contains(::cockroach::proto::ContainsRequest* contains) {
+  clear_value();
+  if (contains) {
+    set_has_contains();
+    value_.contains_ = contains;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.header)
 }
 
-// repeated .cockroach.proto.KeyValue rows = 2;
-inline int ScanResponse::rows_size() const {
-  return rows_.size();
+// optional .cockroach.proto.GetRequest get = 2;
+inline bool RequestUnion::has_get() const {
+  return value_case() == kGet;
 }
-inline void ScanResponse::clear_rows() {
-  rows_.Clear();
+inline void RequestUnion::set_has_get() {
+  _oneof_case_[0] = kGet;
 }
-inline const ::cockroach::proto::KeyValue& ScanResponse::rows(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.rows)
-  return rows_.Get(index);
+inline void RequestUnion::clear_get() {
+  if (has_get()) {
+    delete value_.get_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::KeyValue* ScanResponse::mutable_rows(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.rows)
-  return rows_.Mutable(index);
+inline const ::cockroach::proto::GetRequest& RequestUnion::get() const {
+  return has_get() ? *value_.get_
+                      : ::cockroach::proto::GetRequest::default_instance();
 }
-inline ::cockroach::proto::KeyValue* ScanResponse::add_rows() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.ScanResponse.rows)
-  return rows_.Add();
+inline ::cockroach::proto::GetRequest* RequestUnion::mutable_get() {
+  if (!has_get()) {
+    clear_value();
+    set_has_get();
+    value_.get_ = new ::cockroach::proto::GetRequest;
+  }
+  return value_.get_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
-ScanResponse::rows() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.ScanResponse.rows)
-  return rows_;
+inline ::cockroach::proto::GetRequest* RequestUnion::release_get() {
+  if (has_get()) {
+    clear_has_value();
+    ::cockroach::proto::GetRequest* temp = value_.get_;
+    value_.get_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_get(::cockroach::proto::GetRequest* get) {
+  clear_value();
+  if (get) {
+    set_has_get();
+    value_.get_ = get;
+  }
+}
+
+// optional .cockroach.proto.PutRequest put = 3;
+inline bool RequestUnion::has_put() const {
+  return value_case() == kPut;
+}
+inline void RequestUnion::set_has_put() {
+  _oneof_case_[0] = kPut;
+}
+inline void RequestUnion::clear_put() {
+  if (has_put()) {
+    delete value_.put_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutRequest& RequestUnion::put() const {
+  return has_put() ? *value_.put_
+                      : ::cockroach::proto::PutRequest::default_instance();
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
-ScanResponse::mutable_rows() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanResponse.rows)
-  return &rows_;
+inline ::cockroach::proto::PutRequest* RequestUnion::mutable_put() {
+  if (!has_put()) {
+    clear_value();
+    set_has_put();
+    value_.put_ = new ::cockroach::proto::PutRequest;
+  }
+  return value_.put_;
 }
-
-// -------------------------------------------------------------------
-
-// EndTransactionRequest
-
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool EndTransactionRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+inline ::cockroach::proto::PutRequest* RequestUnion::release_put() {
+  if (has_put()) {
+    clear_has_value();
+    ::cockroach::proto::PutRequest* temp = value_.put_;
+    value_.put_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void EndTransactionRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void RequestUnion::set_allocated_put(::cockroach::proto::PutRequest* put) {
+  clear_value();
+  if (put) {
+    set_has_put();
+    value_.put_ = put;
+  }
 }
-inline void EndTransactionRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+
+// optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+inline bool RequestUnion::has_conditional_put() const {
+  return value_case() == kConditionalPut;
 }
-inline void EndTransactionRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void RequestUnion::set_has_conditional_put() {
+  _oneof_case_[0] = kConditionalPut;
 }
-inline const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void RequestUnion::clear_conditional_put() {
+  if (has_conditional_put()) {
+    delete value_.conditional_put_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
-  return header_;
+inline const ::cockroach::proto::ConditionalPutRequest& RequestUnion::conditional_put() const {
+  return has_conditional_put() ? *value_.conditional_put_
+                      : ::cockroach::proto::ConditionalPutRequest::default_instance();
 }
-inline ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline ::cockroach::proto::ConditionalPutRequest* RequestUnion::mutable_conditional_put() {
+  if (!has_conditional_put()) {
+    clear_value();
+    set_has_conditional_put();
+    value_.conditional_put_ = new ::cockroach::proto::ConditionalPutRequest;
+  }
+  return value_.conditional_put_;
 }
-inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline ::cockroach::proto::ConditionalPutRequest* RequestUnion::release_conditional_put() {
+  if (has_conditional_put()) {
+    clear_has_value();
+    ::cockroach::proto::ConditionalPutRequest* temp = value_.conditional_put_;
+    value_.conditional_put_ = NULL;
+    return temp;
   } else {
-    clear_has_header();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
 }
-
-// optional bool commit = 2;
-inline bool EndTransactionRequest::has_commit() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+inline void RequestUnion::set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put) {
+  clear_value();
+  if (conditional_put) {
+    set_has_conditional_put();
+    value_.conditional_put_ = conditional_put;
+  }
 }
-inline void EndTransactionRequest::set_has_commit() {
-  _has_bits_[0] |= 0x00000002u;
+
+// optional .cockroach.proto.IncrementRequest increment = 5;
+inline bool RequestUnion::has_increment() const {
+  return value_case() == kIncrement;
 }
-inline void EndTransactionRequest::clear_has_commit() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void RequestUnion::set_has_increment() {
+  _oneof_case_[0] = kIncrement;
 }
-inline void EndTransactionRequest::clear_commit() {
-  commit_ = false;
-  clear_has_commit();
+inline void RequestUnion::clear_increment() {
+  if (has_increment()) {
+    delete value_.increment_;
+    clear_has_value();
+  }
 }
-inline bool EndTransactionRequest::commit() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
-  return commit_;
+inline const ::cockroach::proto::IncrementRequest& RequestUnion::increment() const {
+  return has_increment() ? *value_.increment_
+                      : ::cockroach::proto::IncrementRequest::default_instance();
 }
-inline void EndTransactionRequest::set_commit(bool value) {
-  set_has_commit();
-  commit_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
+inline ::cockroach::proto::IncrementRequest* RequestUnion::mutable_increment() {
+  if (!has_increment()) {
+    clear_value();
+    set_has_increment();
+    value_.increment_ = new ::cockroach::proto::IncrementRequest;
+  }
+  return value_.increment_;
 }
-
-// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-inline bool EndTransactionRequest::has_internal_commit_trigger() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+inline ::cockroach::proto::IncrementRequest* RequestUnion::release_increment() {
+  if (has_increment()) {
+    clear_has_value();
+    ::cockroach::proto::IncrementRequest* temp = value_.increment_;
+    value_.increment_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void EndTransactionRequest::set_has_internal_commit_trigger() {
-  _has_bits_[0] |= 0x00000004u;
+inline void RequestUnion::set_allocated_increment(::cockroach::proto::IncrementRequest* increment) {
+  clear_value();
+  if (increment) {
+    set_has_increment();
+    value_.increment_ = increment;
+  }
 }
-inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
-  _has_bits_[0] &= ~0x00000004u;
+
+// optional .cockroach.proto.DeleteRequest delete = 6;
+inline bool RequestUnion::has_delete_() const {
+  return value_case() == kDelete;
 }
-inline void EndTransactionRequest::clear_internal_commit_trigger() {
-  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
-  clear_has_internal_commit_trigger();
+inline void RequestUnion::set_has_delete_() {
+  _oneof_case_[0] = kDelete;
 }
-inline const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
+inline void RequestUnion::clear_delete_() {
+  if (has_delete_()) {
+    delete value_.delete__;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
-  set_has_internal_commit_trigger();
-  if (internal_commit_trigger_ == NULL) internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_;
+inline const ::cockroach::proto::DeleteRequest& RequestUnion::delete_() const {
+  return has_delete_() ? *value_.delete__
+                      : ::cockroach::proto::DeleteRequest::default_instance();
 }
-inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
-  clear_has_internal_commit_trigger();
-  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
-  internal_commit_trigger_ = NULL;
-  return temp;
+inline ::cockroach::proto::DeleteRequest* RequestUnion::mutable_delete_() {
+  if (!has_delete_()) {
+    clear_value();
+    set_has_delete_();
+    value_.delete__ = new ::cockroach::proto::DeleteRequest;
+  }
+  return value_.delete__;
 }
-inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
-  delete internal_commit_trigger_;
-  internal_commit_trigger_ = internal_commit_trigger;
-  if (internal_commit_trigger) {
-    set_has_internal_commit_trigger();
+inline ::cockroach::proto::DeleteRequest* RequestUnion::release_delete_() {
+  if (has_delete_()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRequest* temp = value_.delete__;
+    value_.delete__ = NULL;
+    return temp;
   } else {
-    clear_has_internal_commit_trigger();
+    return NULL;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
 }
-
-// -------------------------------------------------------------------
-
-// EndTransactionResponse
-
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool EndTransactionResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void EndTransactionResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void EndTransactionResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void RequestUnion::set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_) {
+  clear_value();
+  if (delete_) {
+    set_has_delete_();
+    value_.delete__ = delete_;
+  }
 }
-inline void EndTransactionResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+
+// optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+inline bool RequestUnion::has_delete_range() const {
+  return value_case() == kDeleteRange;
 }
-inline const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline void RequestUnion::set_has_delete_range() {
+  _oneof_case_[0] = kDeleteRange;
 }
-inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
-  return header_;
+inline void RequestUnion::clear_delete_range() {
+  if (has_delete_range()) {
+    delete value_.delete_range_;
+    clear_has_value();
+  }
 }
-inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline const ::cockroach::proto::DeleteRangeRequest& RequestUnion::delete_range() const {
+  return has_delete_range() ? *value_.delete_range_
+                      : ::cockroach::proto::DeleteRangeRequest::default_instance();
 }
-inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
+inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::mutable_delete_range() {
+  if (!has_delete_range()) {
+    clear_value();
+    set_has_delete_range();
+    value_.delete_range_ = new ::cockroach::proto::DeleteRangeRequest;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
+  return value_.delete_range_;
 }
-
-// optional int64 commit_wait = 2;
-inline bool EndTransactionResponse::has_commit_wait() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::release_delete_range() {
+  if (has_delete_range()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRangeRequest* temp = value_.delete_range_;
+    value_.delete_range_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void EndTransactionResponse::set_has_commit_wait() {
-  _has_bits_[0] |= 0x00000002u;
+inline void RequestUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range) {
+  clear_value();
+  if (delete_range) {
+    set_has_delete_range();
+    value_.delete_range_ = delete_range;
+  }
 }
-inline void EndTransactionResponse::clear_has_commit_wait() {
-  _has_bits_[0] &= ~0x00000002u;
+
+// optional .cockroach.proto.ScanRequest scan = 8;
+inline bool RequestUnion::has_scan() const {
+  return value_case() == kScan;
 }
-inline void EndTransactionResponse::clear_commit_wait() {
-  commit_wait_ = GOOGLE_LONGLONG(0);
-  clear_has_commit_wait();
+inline void RequestUnion::set_has_scan() {
+  _oneof_case_[0] = kScan;
 }
-inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
-  return commit_wait_;
+inline void RequestUnion::clear_scan() {
+  if (has_scan()) {
+    delete value_.scan_;
+    clear_has_value();
+  }
 }
-inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
-  set_has_commit_wait();
-  commit_wait_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
+inline const ::cockroach::proto::ScanRequest& RequestUnion::scan() const {
+  return has_scan() ? *value_.scan_
+                      : ::cockroach::proto::ScanRequest::default_instance();
 }
-
-// repeated bytes resolved = 3;
-inline int EndTransactionResponse::resolved_size() const {
-  return resolved_.size();
+inline ::cockroach::proto::ScanRequest* RequestUnion::mutable_scan() {
+  if (!has_scan()) {
+    clear_value();
+    set_has_scan();
+    value_.scan_ = new ::cockroach::proto::ScanRequest;
+  }
+  return value_.scan_;
 }
-inline void EndTransactionResponse::clear_resolved() {
-  resolved_.Clear();
+inline ::cockroach::proto::ScanRequest* RequestUnion::release_scan() {
+  if (has_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRequest* temp = value_.scan_;
+    value_.scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline const ::std::string& EndTransactionResponse::resolved(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Get(index);
+inline void RequestUnion::set_allocated_scan(::cockroach::proto::ScanRequest* scan) {
+  clear_value();
+  if (scan) {
+    set_has_scan();
+    value_.scan_ = scan;
+  }
 }
-inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Mutable(index);
+
+// optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+inline bool RequestUnion::has_end_transaction() const {
+  return value_case() == kEndTransaction;
 }
-inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
-  resolved_.Mutable(index)->assign(value);
+inline void RequestUnion::set_has_end_transaction() {
+  _oneof_case_[0] = kEndTransaction;
 }
-inline void EndTransactionResponse::set_resolved(int index, const char* value) {
-  resolved_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
+inline void RequestUnion::clear_end_transaction() {
+  if (has_end_transaction()) {
+    delete value_.end_transaction_;
+    clear_has_value();
+  }
 }
-inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
-  resolved_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
+inline const ::cockroach::proto::EndTransactionRequest& RequestUnion::end_transaction() const {
+  return has_end_transaction() ? *value_.end_transaction_
+                      : ::cockroach::proto::EndTransactionRequest::default_instance();
 }
-inline ::std::string* EndTransactionResponse::add_resolved() {
-  return resolved_.Add();
+inline ::cockroach::proto::EndTransactionRequest* RequestUnion::mutable_end_transaction() {
+  if (!has_end_transaction()) {
+    clear_value();
+    set_has_end_transaction();
+    value_.end_transaction_ = new ::cockroach::proto::EndTransactionRequest;
+  }
+  return value_.end_transaction_;
 }
-inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
+inline ::cockroach::proto::EndTransactionRequest* RequestUnion::release_end_transaction() {
+  if (has_end_transaction()) {
+    clear_has_value();
+    ::cockroach::proto::EndTransactionRequest* temp = value_.end_transaction_;
+    value_.end_transaction_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
 }
-inline void EndTransactionResponse::add_resolved(const char* value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
+inline void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction) {
+  clear_value();
+  if (end_transaction) {
+    set_has_end_transaction();
+    value_.end_transaction_ = end_transaction;
+  }
 }
-inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
-  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
+
+inline bool RequestUnion::has_value() {
+  return value_case() != VALUE_NOT_SET;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
-EndTransactionResponse::resolved() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_;
+inline void RequestUnion::clear_has_value() {
+  _oneof_case_[0] = VALUE_NOT_SET;
 }
-inline ::google::protobuf::RepeatedPtrField< ::std::string>*
-EndTransactionResponse::mutable_resolved() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
-  return &resolved_;
+inline RequestUnion::ValueCase RequestUnion::value_case() const {
+  return RequestUnion::ValueCase(_oneof_case_[0]);
 }
-
 // -------------------------------------------------------------------
 
-// RequestUnion
+// ResponseUnion
 
-// optional .cockroach.proto.ContainsRequest contains = 1;
-inline bool RequestUnion::has_contains() const {
+// optional .cockroach.proto.ContainsResponse contains = 1;
+inline bool ResponseUnion::has_contains() const {
   return value_case() == kContains;
 }
-inline void RequestUnion::set_has_contains() {
+inline void ResponseUnion::set_has_contains() {
   _oneof_case_[0] = kContains;
 }
-inline void RequestUnion::clear_contains() {
+inline void ResponseUnion::clear_contains() {
   if (has_contains()) {
     delete value_.contains_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::ContainsRequest& RequestUnion::contains() const {
+inline const ::cockroach::proto::ContainsResponse& ResponseUnion::contains() const {
   return has_contains() ? *value_.contains_
-                      : ::cockroach::proto::ContainsRequest::default_instance();
+                      : ::cockroach::proto::ContainsResponse::default_instance();
 }
-inline ::cockroach::proto::ContainsRequest* RequestUnion::mutable_contains() {
+inline ::cockroach::proto::ContainsResponse* ResponseUnion::mutable_contains() {
   if (!has_contains()) {
     clear_value();
     set_has_contains();
-    value_.contains_ = new ::cockroach::proto::ContainsRequest;
+    value_.contains_ = new ::cockroach::proto::ContainsResponse;
   }
   return value_.contains_;
 }
-inline ::cockroach::proto::ContainsRequest* RequestUnion::release_contains() {
+inline ::cockroach::proto::ContainsResponse* ResponseUnion::release_contains() {
   if (has_contains()) {
     clear_has_value();
-    ::cockroach::proto::ContainsRequest* temp = value_.contains_;
+    ::cockroach::proto::ContainsResponse* temp = value_.contains_;
     value_.contains_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_contains(::cockroach::proto::ContainsRequest* contains) {
+inline void ResponseUnion::set_allocated_contains(::cockroach::proto::ContainsResponse* contains) {
   clear_value();
   if (contains) {
     set_has_contains();
@@ -4999,42 +6152,42 @@ inline void RequestUnion::set_allocated_contains(::cockroach::proto::ContainsReq
   }
 }
 
-// optional .cockroach.proto.GetRequest get = 2;
-inline bool RequestUnion::has_get() const {
+// optional .cockroach.proto.GetResponse get = 2;
+inline bool ResponseUnion::has_get() const {
   return value_case() == kGet;
 }
-inline void RequestUnion::set_has_get() {
+inline void ResponseUnion::set_has_get() {
   _oneof_case_[0] = kGet;
 }
-inline void RequestUnion::clear_get() {
+inline void ResponseUnion::clear_get() {
   if (has_get()) {
     delete value_.get_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::GetRequest& RequestUnion::get() const {
+inline const ::cockroach::proto::GetResponse& ResponseUnion::get() const {
   return has_get() ? *value_.get_
-                      : ::cockroach::proto::GetRequest::default_instance();
+                      : ::cockroach::proto::GetResponse::default_instance();
 }
-inline ::cockroach::proto::GetRequest* RequestUnion::mutable_get() {
+inline ::cockroach::proto::GetResponse* ResponseUnion::mutable_get() {
   if (!has_get()) {
     clear_value();
     set_has_get();
-    value_.get_ = new ::cockroach::proto::GetRequest;
+    value_.get_ = new ::cockroach::proto::GetResponse;
   }
   return value_.get_;
 }
-inline ::cockroach::proto::GetRequest* RequestUnion::release_get() {
+inline ::cockroach::proto::GetResponse* ResponseUnion::release_get() {
   if (has_get()) {
     clear_has_value();
-    ::cockroach::proto::GetRequest* temp = value_.get_;
+    ::cockroach::proto::GetResponse* temp = value_.get_;
     value_.get_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_get(::cockroach::proto::GetRequest* get) {
+inline void ResponseUnion::set_allocated_get(::cockroach::proto::GetResponse* get) {
   clear_value();
   if (get) {
     set_has_get();
@@ -5042,42 +6195,42 @@ inline void RequestUnion::set_allocated_get(::cockroach::proto::GetRequest* get)
   }
 }
 
-// optional .cockroach.proto.PutRequest put = 3;
-inline bool RequestUnion::has_put() const {
+// optional .cockroach.proto.PutResponse put = 3;
+inline bool ResponseUnion::has_put() const {
   return value_case() == kPut;
 }
-inline void RequestUnion::set_has_put() {
+inline void ResponseUnion::set_has_put() {
   _oneof_case_[0] = kPut;
 }
-inline void RequestUnion::clear_put() {
+inline void ResponseUnion::clear_put() {
   if (has_put()) {
     delete value_.put_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::PutRequest& RequestUnion::put() const {
+inline const ::cockroach::proto::PutResponse& ResponseUnion::put() const {
   return has_put() ? *value_.put_
-                      : ::cockroach::proto::PutRequest::default_instance();
+                      : ::cockroach::proto::PutResponse::default_instance();
 }
-inline ::cockroach::proto::PutRequest* RequestUnion::mutable_put() {
+inline ::cockroach::proto::PutResponse* ResponseUnion::mutable_put() {
   if (!has_put()) {
     clear_value();
     set_has_put();
-    value_.put_ = new ::cockroach::proto::PutRequest;
+    value_.put_ = new ::cockroach::proto::PutResponse;
   }
   return value_.put_;
 }
-inline ::cockroach::proto::PutRequest* RequestUnion::release_put() {
+inline ::cockroach::proto::PutResponse* ResponseUnion::release_put() {
   if (has_put()) {
     clear_has_value();
-    ::cockroach::proto::PutRequest* temp = value_.put_;
+    ::cockroach::proto::PutResponse* temp = value_.put_;
     value_.put_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_put(::cockroach::proto::PutRequest* put) {
+inline void ResponseUnion::set_allocated_put(::cockroach::proto::PutResponse* put) {
   clear_value();
   if (put) {
     set_has_put();
@@ -5085,42 +6238,42 @@ inline void RequestUnion::set_allocated_put(::cockroach::proto::PutRequest* put)
   }
 }
 
-// optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-inline bool RequestUnion::has_conditional_put() const {
+// optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+inline bool ResponseUnion::has_conditional_put() const {
   return value_case() == kConditionalPut;
 }
-inline void RequestUnion::set_has_conditional_put() {
+inline void ResponseUnion::set_has_conditional_put() {
   _oneof_case_[0] = kConditionalPut;
 }
-inline void RequestUnion::clear_conditional_put() {
+inline void ResponseUnion::clear_conditional_put() {
   if (has_conditional_put()) {
     delete value_.conditional_put_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::ConditionalPutRequest& RequestUnion::conditional_put() const {
+inline const ::cockroach::proto::ConditionalPutResponse& ResponseUnion::conditional_put() const {
   return has_conditional_put() ? *value_.conditional_put_
-                      : ::cockroach::proto::ConditionalPutRequest::default_instance();
+                      : ::cockroach::proto::ConditionalPutResponse::default_instance();
 }
-inline ::cockroach::proto::ConditionalPutRequest* RequestUnion::mutable_conditional_put() {
+inline ::cockroach::proto::ConditionalPutResponse* ResponseUnion::mutable_conditional_put() {
   if (!has_conditional_put()) {
     clear_value();
     set_has_conditional_put();
-    value_.conditional_put_ = new ::cockroach::proto::ConditionalPutRequest;
+    value_.conditional_put_ = new ::cockroach::proto::ConditionalPutResponse;
   }
   return value_.conditional_put_;
 }
-inline ::cockroach::proto::ConditionalPutRequest* RequestUnion::release_conditional_put() {
+inline ::cockroach::proto::ConditionalPutResponse* ResponseUnion::release_conditional_put() {
   if (has_conditional_put()) {
     clear_has_value();
-    ::cockroach::proto::ConditionalPutRequest* temp = value_.conditional_put_;
+    ::cockroach::proto::ConditionalPutResponse* temp = value_.conditional_put_;
     value_.conditional_put_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put) {
+inline void ResponseUnion::set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put) {
   clear_value();
   if (conditional_put) {
     set_has_conditional_put();
@@ -5128,42 +6281,42 @@ inline void RequestUnion::set_allocated_conditional_put(::cockroach::proto::Cond
   }
 }
 
-// optional .cockroach.proto.IncrementRequest increment = 5;
-inline bool RequestUnion::has_increment() const {
+// optional .cockroach.proto.IncrementResponse increment = 5;
+inline bool ResponseUnion::has_increment() const {
   return value_case() == kIncrement;
 }
-inline void RequestUnion::set_has_increment() {
+inline void ResponseUnion::set_has_increment() {
   _oneof_case_[0] = kIncrement;
 }
-inline void RequestUnion::clear_increment() {
+inline void ResponseUnion::clear_increment() {
   if (has_increment()) {
     delete value_.increment_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::IncrementRequest& RequestUnion::increment() const {
+inline const ::cockroach::proto::IncrementResponse& ResponseUnion::increment() const {
   return has_increment() ? *value_.increment_
-                      : ::cockroach::proto::IncrementRequest::default_instance();
+                      : ::cockroach::proto::IncrementResponse::default_instance();
 }
-inline ::cockroach::proto::IncrementRequest* RequestUnion::mutable_increment() {
+inline ::cockroach::proto::IncrementResponse* ResponseUnion::mutable_increment() {
   if (!has_increment()) {
     clear_value();
     set_has_increment();
-    value_.increment_ = new ::cockroach::proto::IncrementRequest;
+    value_.increment_ = new ::cockroach::proto::IncrementResponse;
   }
   return value_.increment_;
 }
-inline ::cockroach::proto::IncrementRequest* RequestUnion::release_increment() {
+inline ::cockroach::proto::IncrementResponse* ResponseUnion::release_increment() {
   if (has_increment()) {
     clear_has_value();
-    ::cockroach::proto::IncrementRequest* temp = value_.increment_;
+    ::cockroach::proto::IncrementResponse* temp = value_.increment_;
     value_.increment_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_increment(::cockroach::proto::IncrementRequest* increment) {
+inline void ResponseUnion::set_allocated_increment(::cockroach::proto::IncrementResponse* increment) {
   clear_value();
   if (increment) {
     set_has_increment();
@@ -5171,42 +6324,42 @@ inline void RequestUnion::set_allocated_increment(::cockroach::proto::IncrementR
   }
 }
 
-// optional .cockroach.proto.DeleteRequest delete = 6;
-inline bool RequestUnion::has_delete_() const {
+// optional .cockroach.proto.DeleteResponse delete = 6;
+inline bool ResponseUnion::has_delete_() const {
   return value_case() == kDelete;
 }
-inline void RequestUnion::set_has_delete_() {
+inline void ResponseUnion::set_has_delete_() {
   _oneof_case_[0] = kDelete;
 }
-inline void RequestUnion::clear_delete_() {
+inline void ResponseUnion::clear_delete_() {
   if (has_delete_()) {
     delete value_.delete__;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::DeleteRequest& RequestUnion::delete_() const {
+inline const ::cockroach::proto::DeleteResponse& ResponseUnion::delete_() const {
   return has_delete_() ? *value_.delete__
-                      : ::cockroach::proto::DeleteRequest::default_instance();
+                      : ::cockroach::proto::DeleteResponse::default_instance();
 }
-inline ::cockroach::proto::DeleteRequest* RequestUnion::mutable_delete_() {
+inline ::cockroach::proto::DeleteResponse* ResponseUnion::mutable_delete_() {
   if (!has_delete_()) {
     clear_value();
     set_has_delete_();
-    value_.delete__ = new ::cockroach::proto::DeleteRequest;
+    value_.delete__ = new ::cockroach::proto::DeleteResponse;
   }
   return value_.delete__;
 }
-inline ::cockroach::proto::DeleteRequest* RequestUnion::release_delete_() {
+inline ::cockroach::proto::DeleteResponse* ResponseUnion::release_delete_() {
   if (has_delete_()) {
     clear_has_value();
-    ::cockroach::proto::DeleteRequest* temp = value_.delete__;
+    ::cockroach::proto::DeleteResponse* temp = value_.delete__;
     value_.delete__ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_) {
+inline void ResponseUnion::set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_) {
   clear_value();
   if (delete_) {
     set_has_delete_();
@@ -5214,42 +6367,42 @@ inline void RequestUnion::set_allocated_delete_(::cockroach::proto::DeleteReques
   }
 }
 
-// optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-inline bool RequestUnion::has_delete_range() const {
+// optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+inline bool ResponseUnion::has_delete_range() const {
   return value_case() == kDeleteRange;
 }
-inline void RequestUnion::set_has_delete_range() {
+inline void ResponseUnion::set_has_delete_range() {
   _oneof_case_[0] = kDeleteRange;
 }
-inline void RequestUnion::clear_delete_range() {
+inline void ResponseUnion::clear_delete_range() {
   if (has_delete_range()) {
     delete value_.delete_range_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::DeleteRangeRequest& RequestUnion::delete_range() const {
+inline const ::cockroach::proto::DeleteRangeResponse& ResponseUnion::delete_range() const {
   return has_delete_range() ? *value_.delete_range_
-                      : ::cockroach::proto::DeleteRangeRequest::default_instance();
+                      : ::cockroach::proto::DeleteRangeResponse::default_instance();
 }
-inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::mutable_delete_range() {
+inline ::cockroach::proto::DeleteRangeResponse* ResponseUnion::mutable_delete_range() {
   if (!has_delete_range()) {
     clear_value();
     set_has_delete_range();
-    value_.delete_range_ = new ::cockroach::proto::DeleteRangeRequest;
+    value_.delete_range_ = new ::cockroach::proto::DeleteRangeResponse;
   }
   return value_.delete_range_;
 }
-inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::release_delete_range() {
+inline ::cockroach::proto::DeleteRangeResponse* ResponseUnion::release_delete_range() {
   if (has_delete_range()) {
     clear_has_value();
-    ::cockroach::proto::DeleteRangeRequest* temp = value_.delete_range_;
+    ::cockroach::proto::DeleteRangeResponse* temp = value_.delete_range_;
     value_.delete_range_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range) {
+inline void ResponseUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range) {
   clear_value();
   if (delete_range) {
     set_has_delete_range();
@@ -5257,536 +6410,656 @@ inline void RequestUnion::set_allocated_delete_range(::cockroach::proto::DeleteR
   }
 }
 
-// optional .cockroach.proto.ScanRequest scan = 8;
-inline bool RequestUnion::has_scan() const {
+// optional .cockroach.proto.ScanResponse scan = 8;
+inline bool ResponseUnion::has_scan() const {
   return value_case() == kScan;
 }
-inline void RequestUnion::set_has_scan() {
+inline void ResponseUnion::set_has_scan() {
   _oneof_case_[0] = kScan;
 }
-inline void RequestUnion::clear_scan() {
-  if (has_scan()) {
-    delete value_.scan_;
-    clear_has_value();
-  }
+inline void ResponseUnion::clear_scan() {
+  if (has_scan()) {
+    delete value_.scan_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanResponse& ResponseUnion::scan() const {
+  return has_scan() ? *value_.scan_
+                      : ::cockroach::proto::ScanResponse::default_instance();
+}
+inline ::cockroach::proto::ScanResponse* ResponseUnion::mutable_scan() {
+  if (!has_scan()) {
+    clear_value();
+    set_has_scan();
+    value_.scan_ = new ::cockroach::proto::ScanResponse;
+  }
+  return value_.scan_;
+}
+inline ::cockroach::proto::ScanResponse* ResponseUnion::release_scan() {
+  if (has_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ScanResponse* temp = value_.scan_;
+    value_.scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_scan(::cockroach::proto::ScanResponse* scan) {
+  clear_value();
+  if (scan) {
+    set_has_scan();
+    value_.scan_ = scan;
+  }
+}
+
+// optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+inline bool ResponseUnion::has_end_transaction() const {
+  return value_case() == kEndTransaction;
+}
+inline void ResponseUnion::set_has_end_transaction() {
+  _oneof_case_[0] = kEndTransaction;
+}
+inline void ResponseUnion::clear_end_transaction() {
+  if (has_end_transaction()) {
+    delete value_.end_transaction_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EndTransactionResponse& ResponseUnion::end_transaction() const {
+  return has_end_transaction() ? *value_.end_transaction_
+                      : ::cockroach::proto::EndTransactionResponse::default_instance();
+}
+inline ::cockroach::proto::EndTransactionResponse* ResponseUnion::mutable_end_transaction() {
+  if (!has_end_transaction()) {
+    clear_value();
+    set_has_end_transaction();
+    value_.end_transaction_ = new ::cockroach::proto::EndTransactionResponse;
+  }
+  return value_.end_transaction_;
+}
+inline ::cockroach::proto::EndTransactionResponse* ResponseUnion::release_end_transaction() {
+  if (has_end_transaction()) {
+    clear_has_value();
+    ::cockroach::proto::EndTransactionResponse* temp = value_.end_transaction_;
+    value_.end_transaction_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction) {
+  clear_value();
+  if (end_transaction) {
+    set_has_end_transaction();
+    value_.end_transaction_ = end_transaction;
+  }
+}
+
+inline bool ResponseUnion::has_value() {
+  return value_case() != VALUE_NOT_SET;
+}
+inline void ResponseUnion::clear_has_value() {
+  _oneof_case_[0] = VALUE_NOT_SET;
+}
+inline ResponseUnion::ValueCase ResponseUnion::value_case() const {
+  return ResponseUnion::ValueCase(_oneof_case_[0]);
+}
+// -------------------------------------------------------------------
+
+// BatchRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool BatchRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void BatchRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void BatchRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void BatchRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& BatchRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BatchRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* BatchRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* BatchRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void BatchRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BatchRequest.header)
+}
+
+// repeated .cockroach.proto.RequestUnion requests = 2;
+inline int BatchRequest::requests_size() const {
+  return requests_.size();
+}
+inline void BatchRequest::clear_requests() {
+  requests_.Clear();
+}
+inline const ::cockroach::proto::RequestUnion& BatchRequest::requests(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BatchRequest.requests)
+  return requests_.Get(index);
+}
+inline ::cockroach::proto::RequestUnion* BatchRequest::mutable_requests(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchRequest.requests)
+  return requests_.Mutable(index);
+}
+inline ::cockroach::proto::RequestUnion* BatchRequest::add_requests() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.BatchRequest.requests)
+  return requests_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
+BatchRequest::requests() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.BatchRequest.requests)
+  return requests_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
+BatchRequest::mutable_requests() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BatchRequest.requests)
+  return &requests_;
+}
+
+// -------------------------------------------------------------------
+
+// BatchResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool BatchResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void BatchResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void BatchResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void BatchResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& BatchResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BatchResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline const ::cockroach::proto::ScanRequest& RequestUnion::scan() const {
-  return has_scan() ? *value_.scan_
-                      : ::cockroach::proto::ScanRequest::default_instance();
+inline ::cockroach::proto::ResponseHeader* BatchResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::ScanRequest* RequestUnion::mutable_scan() {
-  if (!has_scan()) {
-    clear_value();
-    set_has_scan();
-    value_.scan_ = new ::cockroach::proto::ScanRequest;
-  }
-  return value_.scan_;
+inline ::cockroach::proto::ResponseHeader* BatchResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline ::cockroach::proto::ScanRequest* RequestUnion::release_scan() {
-  if (has_scan()) {
-    clear_has_value();
-    ::cockroach::proto::ScanRequest* temp = value_.scan_;
-    value_.scan_ = NULL;
-    return temp;
+inline void BatchResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    return NULL;
-  }
-}
-inline void RequestUnion::set_allocated_scan(::cockroach::proto::ScanRequest* scan) {
-  clear_value();
-  if (scan) {
-    set_has_scan();
-    value_.scan_ = scan;
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BatchResponse.header)
 }
 
-// optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-inline bool RequestUnion::has_end_transaction() const {
-  return value_case() == kEndTransaction;
+// repeated .cockroach.proto.ResponseUnion responses = 2;
+inline int BatchResponse::responses_size() const {
+  return responses_.size();
 }
-inline void RequestUnion::set_has_end_transaction() {
-  _oneof_case_[0] = kEndTransaction;
+inline void BatchResponse::clear_responses() {
+  responses_.Clear();
 }
-inline void RequestUnion::clear_end_transaction() {
-  if (has_end_transaction()) {
-    delete value_.end_transaction_;
-    clear_has_value();
-  }
+inline const ::cockroach::proto::ResponseUnion& BatchResponse::responses(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.BatchResponse.responses)
+  return responses_.Get(index);
 }
-inline const ::cockroach::proto::EndTransactionRequest& RequestUnion::end_transaction() const {
-  return has_end_transaction() ? *value_.end_transaction_
-                      : ::cockroach::proto::EndTransactionRequest::default_instance();
+inline ::cockroach::proto::ResponseUnion* BatchResponse::mutable_responses(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchResponse.responses)
+  return responses_.Mutable(index);
 }
-inline ::cockroach::proto::EndTransactionRequest* RequestUnion::mutable_end_transaction() {
-  if (!has_end_transaction()) {
-    clear_value();
-    set_has_end_transaction();
-    value_.end_transaction_ = new ::cockroach::proto::EndTransactionRequest;
-  }
-  return value_.end_transaction_;
+inline ::cockroach::proto::ResponseUnion* BatchResponse::add_responses() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.BatchResponse.responses)
+  return responses_.Add();
 }
-inline ::cockroach::proto::EndTransactionRequest* RequestUnion::release_end_transaction() {
-  if (has_end_transaction()) {
-    clear_has_value();
-    ::cockroach::proto::EndTransactionRequest* temp = value_.end_transaction_;
-    value_.end_transaction_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
+BatchResponse::responses() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.BatchResponse.responses)
+  return responses_;
 }
-inline void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction) {
-  clear_value();
-  if (end_transaction) {
-    set_has_end_transaction();
-    value_.end_transaction_ = end_transaction;
-  }
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
+BatchResponse::mutable_responses() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BatchResponse.responses)
+  return &responses_;
 }
 
-inline bool RequestUnion::has_value() {
-  return value_case() != VALUE_NOT_SET;
-}
-inline void RequestUnion::clear_has_value() {
-  _oneof_case_[0] = VALUE_NOT_SET;
-}
-inline RequestUnion::ValueCase RequestUnion::value_case() const {
-  return RequestUnion::ValueCase(_oneof_case_[0]);
-}
 // -------------------------------------------------------------------
 
-// ResponseUnion
+// AdminSplitRequest
 
-// optional .cockroach.proto.ContainsResponse contains = 1;
-inline bool ResponseUnion::has_contains() const {
-  return value_case() == kContains;
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool AdminSplitRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseUnion::set_has_contains() {
-  _oneof_case_[0] = kContains;
+inline void AdminSplitRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseUnion::clear_contains() {
-  if (has_contains()) {
-    delete value_.contains_;
-    clear_has_value();
-  }
+inline void AdminSplitRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::ContainsResponse& ResponseUnion::contains() const {
-  return has_contains() ? *value_.contains_
-                      : ::cockroach::proto::ContainsResponse::default_instance();
+inline void AdminSplitRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::ContainsResponse* ResponseUnion::mutable_contains() {
-  if (!has_contains()) {
-    clear_value();
-    set_has_contains();
-    value_.contains_ = new ::cockroach::proto::ContainsResponse;
-  }
-  return value_.contains_;
+inline const ::cockroach::proto::RequestHeader& AdminSplitRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ContainsResponse* ResponseUnion::release_contains() {
-  if (has_contains()) {
-    clear_has_value();
-    ::cockroach::proto::ContainsResponse* temp = value_.contains_;
-    value_.contains_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::RequestHeader* AdminSplitRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitRequest.header)
+  return header_;
 }
-inline void ResponseUnion::set_allocated_contains(::cockroach::proto::ContainsResponse* contains) {
-  clear_value();
-  if (contains) {
-    set_has_contains();
-    value_.contains_ = contains;
+inline ::cockroach::proto::RequestHeader* AdminSplitRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminSplitRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitRequest.header)
 }
 
-// optional .cockroach.proto.GetResponse get = 2;
-inline bool ResponseUnion::has_get() const {
-  return value_case() == kGet;
-}
-inline void ResponseUnion::set_has_get() {
-  _oneof_case_[0] = kGet;
+// optional bytes split_key = 2;
+inline bool AdminSplitRequest::has_split_key() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResponseUnion::clear_get() {
-  if (has_get()) {
-    delete value_.get_;
-    clear_has_value();
-  }
+inline void AdminSplitRequest::set_has_split_key() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline const ::cockroach::proto::GetResponse& ResponseUnion::get() const {
-  return has_get() ? *value_.get_
-                      : ::cockroach::proto::GetResponse::default_instance();
+inline void AdminSplitRequest::clear_has_split_key() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline ::cockroach::proto::GetResponse* ResponseUnion::mutable_get() {
-  if (!has_get()) {
-    clear_value();
-    set_has_get();
-    value_.get_ = new ::cockroach::proto::GetResponse;
+inline void AdminSplitRequest::clear_split_key() {
+  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_->clear();
   }
-  return value_.get_;
+  clear_has_split_key();
 }
-inline ::cockroach::proto::GetResponse* ResponseUnion::release_get() {
-  if (has_get()) {
-    clear_has_value();
-    ::cockroach::proto::GetResponse* temp = value_.get_;
-    value_.get_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline const ::std::string& AdminSplitRequest::split_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitRequest.split_key)
+  return *split_key_;
 }
-inline void ResponseUnion::set_allocated_get(::cockroach::proto::GetResponse* get) {
-  clear_value();
-  if (get) {
-    set_has_get();
-    value_.get_ = get;
+inline void AdminSplitRequest::set_split_key(const ::std::string& value) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
   }
+  split_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminSplitRequest.split_key)
 }
-
-// optional .cockroach.proto.PutResponse put = 3;
-inline bool ResponseUnion::has_put() const {
-  return value_case() == kPut;
-}
-inline void ResponseUnion::set_has_put() {
-  _oneof_case_[0] = kPut;
-}
-inline void ResponseUnion::clear_put() {
-  if (has_put()) {
-    delete value_.put_;
-    clear_has_value();
+inline void AdminSplitRequest::set_split_key(const char* value) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
   }
+  split_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminSplitRequest.split_key)
 }
-inline const ::cockroach::proto::PutResponse& ResponseUnion::put() const {
-  return has_put() ? *value_.put_
-                      : ::cockroach::proto::PutResponse::default_instance();
+inline void AdminSplitRequest::set_split_key(const void* value, size_t size) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
+  }
+  split_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminSplitRequest.split_key)
 }
-inline ::cockroach::proto::PutResponse* ResponseUnion::mutable_put() {
-  if (!has_put()) {
-    clear_value();
-    set_has_put();
-    value_.put_ = new ::cockroach::proto::PutResponse;
+inline ::std::string* AdminSplitRequest::mutable_split_key() {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
   }
-  return value_.put_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitRequest.split_key)
+  return split_key_;
 }
-inline ::cockroach::proto::PutResponse* ResponseUnion::release_put() {
-  if (has_put()) {
-    clear_has_value();
-    ::cockroach::proto::PutResponse* temp = value_.put_;
-    value_.put_ = NULL;
-    return temp;
-  } else {
+inline ::std::string* AdminSplitRequest::release_split_key() {
+  clear_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
+  } else {
+    ::std::string* temp = split_key_;
+    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
 }
-inline void ResponseUnion::set_allocated_put(::cockroach::proto::PutResponse* put) {
-  clear_value();
-  if (put) {
-    set_has_put();
-    value_.put_ = put;
+inline void AdminSplitRequest::set_allocated_split_key(::std::string* split_key) {
+  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete split_key_;
+  }
+  if (split_key) {
+    set_has_split_key();
+    split_key_ = split_key;
+  } else {
+    clear_has_split_key();
+    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitRequest.split_key)
 }
 
-// optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-inline bool ResponseUnion::has_conditional_put() const {
-  return value_case() == kConditionalPut;
+// -------------------------------------------------------------------
+
+// AdminSplitResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AdminSplitResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseUnion::set_has_conditional_put() {
-  _oneof_case_[0] = kConditionalPut;
+inline void AdminSplitResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseUnion::clear_conditional_put() {
-  if (has_conditional_put()) {
-    delete value_.conditional_put_;
-    clear_has_value();
-  }
+inline void AdminSplitResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::ConditionalPutResponse& ResponseUnion::conditional_put() const {
-  return has_conditional_put() ? *value_.conditional_put_
-                      : ::cockroach::proto::ConditionalPutResponse::default_instance();
+inline void AdminSplitResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::ConditionalPutResponse* ResponseUnion::mutable_conditional_put() {
-  if (!has_conditional_put()) {
-    clear_value();
-    set_has_conditional_put();
-    value_.conditional_put_ = new ::cockroach::proto::ConditionalPutResponse;
-  }
-  return value_.conditional_put_;
+inline const ::cockroach::proto::ResponseHeader& AdminSplitResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ConditionalPutResponse* ResponseUnion::release_conditional_put() {
-  if (has_conditional_put()) {
-    clear_has_value();
-    ::cockroach::proto::ConditionalPutResponse* temp = value_.conditional_put_;
-    value_.conditional_put_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::ResponseHeader* AdminSplitResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitResponse.header)
+  return header_;
 }
-inline void ResponseUnion::set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put) {
-  clear_value();
-  if (conditional_put) {
-    set_has_conditional_put();
-    value_.conditional_put_ = conditional_put;
+inline ::cockroach::proto::ResponseHeader* AdminSplitResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminSplitResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitResponse.header)
 }
 
-// optional .cockroach.proto.IncrementResponse increment = 5;
-inline bool ResponseUnion::has_increment() const {
-  return value_case() == kIncrement;
+// -------------------------------------------------------------------
+
+// AdminMergeRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool AdminMergeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseUnion::set_has_increment() {
-  _oneof_case_[0] = kIncrement;
+inline void AdminMergeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseUnion::clear_increment() {
-  if (has_increment()) {
-    delete value_.increment_;
-    clear_has_value();
-  }
+inline void AdminMergeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::IncrementResponse& ResponseUnion::increment() const {
-  return has_increment() ? *value_.increment_
-                      : ::cockroach::proto::IncrementResponse::default_instance();
+inline void AdminMergeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::IncrementResponse* ResponseUnion::mutable_increment() {
-  if (!has_increment()) {
-    clear_value();
-    set_has_increment();
-    value_.increment_ = new ::cockroach::proto::IncrementResponse;
-  }
-  return value_.increment_;
+inline const ::cockroach::proto::RequestHeader& AdminMergeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminMergeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::IncrementResponse* ResponseUnion::release_increment() {
-  if (has_increment()) {
-    clear_has_value();
-    ::cockroach::proto::IncrementResponse* temp = value_.increment_;
-    value_.increment_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::RequestHeader* AdminMergeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminMergeRequest.header)
+  return header_;
 }
-inline void ResponseUnion::set_allocated_increment(::cockroach::proto::IncrementResponse* increment) {
-  clear_value();
-  if (increment) {
-    set_has_increment();
-    value_.increment_ = increment;
+inline ::cockroach::proto::RequestHeader* AdminMergeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminMergeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminMergeRequest.header)
 }
 
-// optional .cockroach.proto.DeleteResponse delete = 6;
-inline bool ResponseUnion::has_delete_() const {
-  return value_case() == kDelete;
+// -------------------------------------------------------------------
+
+// AdminMergeResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AdminMergeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseUnion::set_has_delete_() {
-  _oneof_case_[0] = kDelete;
+inline void AdminMergeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseUnion::clear_delete_() {
-  if (has_delete_()) {
-    delete value_.delete__;
-    clear_has_value();
-  }
+inline void AdminMergeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::DeleteResponse& ResponseUnion::delete_() const {
-  return has_delete_() ? *value_.delete__
-                      : ::cockroach::proto::DeleteResponse::default_instance();
+inline void AdminMergeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::DeleteResponse* ResponseUnion::mutable_delete_() {
-  if (!has_delete_()) {
-    clear_value();
-    set_has_delete_();
-    value_.delete__ = new ::cockroach::proto::DeleteResponse;
-  }
-  return value_.delete__;
+inline const ::cockroach::proto::ResponseHeader& AdminMergeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminMergeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::DeleteResponse* ResponseUnion::release_delete_() {
-  if (has_delete_()) {
-    clear_has_value();
-    ::cockroach::proto::DeleteResponse* temp = value_.delete__;
-    value_.delete__ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::ResponseHeader* AdminMergeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminMergeResponse.header)
+  return header_;
 }
-inline void ResponseUnion::set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_) {
-  clear_value();
-  if (delete_) {
-    set_has_delete_();
-    value_.delete__ = delete_;
+inline ::cockroach::proto::ResponseHeader* AdminMergeResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminMergeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminMergeResponse.header)
 }
 
-// optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-inline bool ResponseUnion::has_delete_range() const {
-  return value_case() == kDeleteRange;
+// -------------------------------------------------------------------
+
+// AdminTransferLeaseRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool AdminTransferLeaseRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseUnion::set_has_delete_range() {
-  _oneof_case_[0] = kDeleteRange;
+inline void AdminTransferLeaseRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseUnion::clear_delete_range() {
-  if (has_delete_range()) {
-    delete value_.delete_range_;
-    clear_has_value();
-  }
+inline void AdminTransferLeaseRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::DeleteRangeResponse& ResponseUnion::delete_range() const {
-  return has_delete_range() ? *value_.delete_range_
-                      : ::cockroach::proto::DeleteRangeResponse::default_instance();
+inline void AdminTransferLeaseRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::DeleteRangeResponse* ResponseUnion::mutable_delete_range() {
-  if (!has_delete_range()) {
-    clear_value();
-    set_has_delete_range();
-    value_.delete_range_ = new ::cockroach::proto::DeleteRangeResponse;
-  }
-  return value_.delete_range_;
+inline const ::cockroach::proto::RequestHeader& AdminTransferLeaseRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminTransferLeaseRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::DeleteRangeResponse* ResponseUnion::release_delete_range() {
-  if (has_delete_range()) {
-    clear_has_value();
-    ::cockroach::proto::DeleteRangeResponse* temp = value_.delete_range_;
-    value_.delete_range_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::RequestHeader* AdminTransferLeaseRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminTransferLeaseRequest.header)
+  return header_;
 }
-inline void ResponseUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range) {
-  clear_value();
-  if (delete_range) {
-    set_has_delete_range();
-    value_.delete_range_ = delete_range;
+inline ::cockroach::proto::RequestHeader* AdminTransferLeaseRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminTransferLeaseRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminTransferLeaseRequest.header)
 }
 
-// optional .cockroach.proto.ScanResponse scan = 8;
-inline bool ResponseUnion::has_scan() const {
-  return value_case() == kScan;
-}
-inline void ResponseUnion::set_has_scan() {
-  _oneof_case_[0] = kScan;
+// optional int32 store_id = 2;
+inline bool AdminTransferLeaseRequest::has_store_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResponseUnion::clear_scan() {
-  if (has_scan()) {
-    delete value_.scan_;
-    clear_has_value();
-  }
+inline void AdminTransferLeaseRequest::set_has_store_id() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline const ::cockroach::proto::ScanResponse& ResponseUnion::scan() const {
-  return has_scan() ? *value_.scan_
-                      : ::cockroach::proto::ScanResponse::default_instance();
+inline void AdminTransferLeaseRequest::clear_has_store_id() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline ::cockroach::proto::ScanResponse* ResponseUnion::mutable_scan() {
-  if (!has_scan()) {
-    clear_value();
-    set_has_scan();
-    value_.scan_ = new ::cockroach::proto::ScanResponse;
-  }
-  return value_.scan_;
+inline void AdminTransferLeaseRequest::clear_store_id() {
+  store_id_ = 0;
+  clear_has_store_id();
 }
-inline ::cockroach::proto::ScanResponse* ResponseUnion::release_scan() {
-  if (has_scan()) {
-    clear_has_value();
-    ::cockroach::proto::ScanResponse* temp = value_.scan_;
-    value_.scan_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::google::protobuf::int32 AdminTransferLeaseRequest::store_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminTransferLeaseRequest.store_id)
+  return store_id_;
 }
-inline void ResponseUnion::set_allocated_scan(::cockroach::proto::ScanResponse* scan) {
-  clear_value();
-  if (scan) {
-    set_has_scan();
-    value_.scan_ = scan;
-  }
+inline void AdminTransferLeaseRequest::set_store_id(::google::protobuf::int32 value) {
+  set_has_store_id();
+  store_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AdminTransferLeaseRequest.store_id)
 }
 
-// optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-inline bool ResponseUnion::has_end_transaction() const {
-  return value_case() == kEndTransaction;
+// -------------------------------------------------------------------
+
+// AdminTransferLeaseResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool AdminTransferLeaseResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseUnion::set_has_end_transaction() {
-  _oneof_case_[0] = kEndTransaction;
+inline void AdminTransferLeaseResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseUnion::clear_end_transaction() {
-  if (has_end_transaction()) {
-    delete value_.end_transaction_;
-    clear_has_value();
-  }
+inline void AdminTransferLeaseResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline const ::cockroach::proto::EndTransactionResponse& ResponseUnion::end_transaction() const {
-  return has_end_transaction() ? *value_.end_transaction_
-                      : ::cockroach::proto::EndTransactionResponse::default_instance();
+inline void AdminTransferLeaseResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline ::cockroach::proto::EndTransactionResponse* ResponseUnion::mutable_end_transaction() {
-  if (!has_end_transaction()) {
-    clear_value();
-    set_has_end_transaction();
-    value_.end_transaction_ = new ::cockroach::proto::EndTransactionResponse;
-  }
-  return value_.end_transaction_;
+inline const ::cockroach::proto::ResponseHeader& AdminTransferLeaseResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AdminTransferLeaseResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::EndTransactionResponse* ResponseUnion::release_end_transaction() {
-  if (has_end_transaction()) {
-    clear_has_value();
-    ::cockroach::proto::EndTransactionResponse* temp = value_.end_transaction_;
-    value_.end_transaction_ = NULL;
-    return temp;
-  } else {
-    return NULL;
-  }
+inline ::cockroach::proto::ResponseHeader* AdminTransferLeaseResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminTransferLeaseResponse.header)
+  return header_;
 }
-inline void ResponseUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction) {
-  clear_value();
-  if (end_transaction) {
-    set_has_end_transaction();
-    value_.end_transaction_ = end_transaction;
+inline ::cockroach::proto::ResponseHeader* AdminTransferLeaseResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void AdminTransferLeaseResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminTransferLeaseResponse.header)
 }
 
-inline bool ResponseUnion::has_value() {
-  return value_case() != VALUE_NOT_SET;
-}
-inline void ResponseUnion::clear_has_value() {
-  _oneof_case_[0] = VALUE_NOT_SET;
-}
-inline ResponseUnion::ValueCase ResponseUnion::value_case() const {
-  return ResponseUnion::ValueCase(_oneof_case_[0]);
-}
 // -------------------------------------------------------------------
 
-// BatchRequest
+// IngestRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool BatchRequest::has_header() const {
+inline bool IngestRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void BatchRequest::set_has_header() {
+inline void IngestRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void BatchRequest::clear_has_header() {
+inline void IngestRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void BatchRequest::clear_header() {
+inline void IngestRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& BatchRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.BatchRequest.header)
+inline const ::cockroach::proto::RequestHeader& IngestRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IngestRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* BatchRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* IngestRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IngestRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* BatchRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* IngestRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void BatchRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void IngestRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5794,74 +7067,165 @@ inline void BatchRequest::set_allocated_header(::cockroach::proto::RequestHeader
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BatchRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IngestRequest.header)
 }
 
-// repeated .cockroach.proto.RequestUnion requests = 2;
-inline int BatchRequest::requests_size() const {
-  return requests_.size();
+// optional bytes data = 2;
+inline bool IngestRequest::has_data() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void BatchRequest::clear_requests() {
-  requests_.Clear();
+inline void IngestRequest::set_has_data() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline const ::cockroach::proto::RequestUnion& BatchRequest::requests(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.BatchRequest.requests)
-  return requests_.Get(index);
+inline void IngestRequest::clear_has_data() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline ::cockroach::proto::RequestUnion* BatchRequest::mutable_requests(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchRequest.requests)
-  return requests_.Mutable(index);
+inline void IngestRequest::clear_data() {
+  if (data_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_->clear();
+  }
+  clear_has_data();
 }
-inline ::cockroach::proto::RequestUnion* BatchRequest::add_requests() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.BatchRequest.requests)
-  return requests_.Add();
+inline const ::std::string& IngestRequest::data() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IngestRequest.data)
+  return *data_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
-BatchRequest::requests() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.BatchRequest.requests)
-  return requests_;
+inline void IngestRequest::set_data(const ::std::string& value) {
+  set_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_ = new ::std::string;
+  }
+  data_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.IngestRequest.data)
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
-BatchRequest::mutable_requests() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BatchRequest.requests)
-  return &requests_;
+inline void IngestRequest::set_data(const char* value) {
+  set_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_ = new ::std::string;
+  }
+  data_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.IngestRequest.data)
+}
+inline void IngestRequest::set_data(const void* value, size_t size) {
+  set_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_ = new ::std::string;
+  }
+  data_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.IngestRequest.data)
+}
+inline ::std::string* IngestRequest::mutable_data() {
+  set_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IngestRequest.data)
+  return data_;
+}
+inline ::std::string* IngestRequest::release_data() {
+  clear_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = data_;
+    data_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void IngestRequest::set_allocated_data(::std::string* data) {
+  if (data_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete data_;
+  }
+  if (data) {
+    set_has_data();
+    data_ = data;
+  } else {
+    clear_has_data();
+    data_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IngestRequest.data)
 }
 
 // -------------------------------------------------------------------
 
-// BatchResponse
+// IngestResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool BatchResponse::has_header() const {
+inline bool IngestResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void IngestResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void IngestResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void IngestResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& IngestResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IngestResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* IngestResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IngestResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* IngestResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void IngestResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IngestResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// ExportRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ExportRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void BatchResponse::set_has_header() {
+inline void ExportRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void BatchResponse::clear_has_header() {
+inline void ExportRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void BatchResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+inline void ExportRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& BatchResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.BatchResponse.header)
+inline const ::cockroach::proto::RequestHeader& ExportRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ExportRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* BatchResponse::mutable_header() {
+inline ::cockroach::proto::RequestHeader* ExportRequest::mutable_header() {
   set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchResponse.header)
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ExportRequest.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* BatchResponse::release_header() {
+inline ::cockroach::proto::RequestHeader* ExportRequest::release_header() {
   clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
+  ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void BatchResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void ExportRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5869,74 +7233,109 @@ inline void BatchResponse::set_allocated_header(::cockroach::proto::ResponseHead
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.BatchResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ExportRequest.header)
 }
 
-// repeated .cockroach.proto.ResponseUnion responses = 2;
-inline int BatchResponse::responses_size() const {
-  return responses_.size();
+// optional .cockroach.proto.Timestamp start_time = 2;
+inline bool ExportRequest::has_start_time() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void BatchResponse::clear_responses() {
-  responses_.Clear();
+inline void ExportRequest::set_has_start_time() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline const ::cockroach::proto::ResponseUnion& BatchResponse::responses(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.BatchResponse.responses)
-  return responses_.Get(index);
+inline void ExportRequest::clear_has_start_time() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline ::cockroach::proto::ResponseUnion* BatchResponse::mutable_responses(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.BatchResponse.responses)
-  return responses_.Mutable(index);
+inline void ExportRequest::clear_start_time() {
+  if (start_time_ != NULL) start_time_->::cockroach::proto::Timestamp::Clear();
+  clear_has_start_time();
 }
-inline ::cockroach::proto::ResponseUnion* BatchResponse::add_responses() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.BatchResponse.responses)
-  return responses_.Add();
+inline const ::cockroach::proto::Timestamp& ExportRequest::start_time() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ExportRequest.start_time)
+  return start_time_ != NULL ? *start_time_ : *default_instance_->start_time_;
 }
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
-BatchResponse::responses() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.BatchResponse.responses)
-  return responses_;
+inline ::cockroach::proto::Timestamp* ExportRequest::mutable_start_time() {
+  set_has_start_time();
+  if (start_time_ == NULL) start_time_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ExportRequest.start_time)
+  return start_time_;
 }
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
-BatchResponse::mutable_responses() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.BatchResponse.responses)
-  return &responses_;
+inline ::cockroach::proto::Timestamp* ExportRequest::release_start_time() {
+  clear_has_start_time();
+  ::cockroach::proto::Timestamp* temp = start_time_;
+  start_time_ = NULL;
+  return temp;
+}
+inline void ExportRequest::set_allocated_start_time(::cockroach::proto::Timestamp* start_time) {
+  delete start_time_;
+  start_time_ = start_time;
+  if (start_time) {
+    set_has_start_time();
+  } else {
+    clear_has_start_time();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ExportRequest.start_time)
+}
+
+// optional bool all_versions = 3;
+inline bool ExportRequest::has_all_versions() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ExportRequest::set_has_all_versions() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ExportRequest::clear_has_all_versions() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ExportRequest::clear_all_versions() {
+  all_versions_ = false;
+  clear_has_all_versions();
+}
+inline bool ExportRequest::all_versions() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ExportRequest.all_versions)
+  return all_versions_;
+}
+inline void ExportRequest::set_all_versions(bool value) {
+  set_has_all_versions();
+  all_versions_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ExportRequest.all_versions)
 }
 
 // -------------------------------------------------------------------
 
-// AdminSplitRequest
+// ExportResponse
 
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool AdminSplitRequest::has_header() const {
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ExportResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminSplitRequest::set_has_header() {
+inline void ExportResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminSplitRequest::clear_has_header() {
+inline void ExportResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminSplitRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+inline void ExportResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& AdminSplitRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitRequest.header)
+inline const ::cockroach::proto::ResponseHeader& ExportResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ExportResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* AdminSplitRequest::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* ExportResponse::mutable_header() {
   set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitRequest.header)
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ExportResponse.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* AdminSplitRequest::release_header() {
+inline ::cockroach::proto::ResponseHeader* ExportResponse::release_header() {
   clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
+  ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void AdminSplitRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void ExportResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5944,120 +7343,120 @@ inline void AdminSplitRequest::set_allocated_header(::cockroach::proto::RequestH
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ExportResponse.header)
 }
 
-// optional bytes split_key = 2;
-inline bool AdminSplitRequest::has_split_key() const {
+// optional bytes data = 2;
+inline bool ExportResponse::has_data() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void AdminSplitRequest::set_has_split_key() {
+inline void ExportResponse::set_has_data() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void AdminSplitRequest::clear_has_split_key() {
+inline void ExportResponse::clear_has_data() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void AdminSplitRequest::clear_split_key() {
-  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_->clear();
+inline void ExportResponse::clear_data() {
+  if (data_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_->clear();
   }
-  clear_has_split_key();
+  clear_has_data();
 }
-inline const ::std::string& AdminSplitRequest::split_key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitRequest.split_key)
-  return *split_key_;
+inline const ::std::string& ExportResponse::data() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ExportResponse.data)
+  return *data_;
 }
-inline void AdminSplitRequest::set_split_key(const ::std::string& value) {
-  set_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_ = new ::std::string;
+inline void ExportResponse::set_data(const ::std::string& value) {
+  set_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_ = new ::std::string;
   }
-  split_key_->assign(value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.AdminSplitRequest.split_key)
+  data_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.ExportResponse.data)
 }
-inline void AdminSplitRequest::set_split_key(const char* value) {
-  set_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_ = new ::std::string;
+inline void ExportResponse::set_data(const char* value) {
+  set_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_ = new ::std::string;
   }
-  split_key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.AdminSplitRequest.split_key)
+  data_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.ExportResponse.data)
 }
-inline void AdminSplitRequest::set_split_key(const void* value, size_t size) {
-  set_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_ = new ::std::string;
+inline void ExportResponse::set_data(const void* value, size_t size) {
+  set_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_ = new ::std::string;
   }
-  split_key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.AdminSplitRequest.split_key)
+  data_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.ExportResponse.data)
 }
-inline ::std::string* AdminSplitRequest::mutable_split_key() {
-  set_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    split_key_ = new ::std::string;
+inline ::std::string* ExportResponse::mutable_data() {
+  set_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    data_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitRequest.split_key)
-  return split_key_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ExportResponse.data)
+  return data_;
 }
-inline ::std::string* AdminSplitRequest::release_split_key() {
-  clear_has_split_key();
-  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+inline ::std::string* ExportResponse::release_data() {
+  clear_has_data();
+  if (data_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
   } else {
-    ::std::string* temp = split_key_;
-    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    ::std::string* temp = data_;
+    data_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     return temp;
   }
 }
-inline void AdminSplitRequest::set_allocated_split_key(::std::string* split_key) {
-  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete split_key_;
+inline void ExportResponse::set_allocated_data(::std::string* data) {
+  if (data_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete data_;
   }
-  if (split_key) {
-    set_has_split_key();
-    split_key_ = split_key;
+  if (data) {
+    set_has_data();
+    data_ = data;
   } else {
-    clear_has_split_key();
-    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_data();
+    data_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitRequest.split_key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ExportResponse.data)
 }
 
 // -------------------------------------------------------------------
 
-// AdminSplitResponse
+// ChangeFeedRequest
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool AdminSplitResponse::has_header() const {
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ChangeFeedRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminSplitResponse::set_has_header() {
+inline void ChangeFeedRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminSplitResponse::clear_has_header() {
+inline void ChangeFeedRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminSplitResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+inline void ChangeFeedRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& AdminSplitResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminSplitResponse.header)
+inline const ::cockroach::proto::RequestHeader& ChangeFeedRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeFeedRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* AdminSplitResponse::mutable_header() {
+inline ::cockroach::proto::RequestHeader* ChangeFeedRequest::mutable_header() {
   set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminSplitResponse.header)
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeFeedRequest.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* AdminSplitResponse::release_header() {
+inline ::cockroach::proto::RequestHeader* ChangeFeedRequest::release_header() {
   clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
+  ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void AdminSplitResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void ChangeFeedRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -6065,97 +7464,158 @@ inline void AdminSplitResponse::set_allocated_header(::cockroach::proto::Respons
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminSplitResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeFeedRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// AdminMergeRequest
+// ChangeFeedEvent
 
-// optional .cockroach.proto.RequestHeader header = 1;
-inline bool AdminMergeRequest::has_header() const {
+// optional .cockroach.proto.KeyValue value = 1;
+inline bool ChangeFeedEvent::has_value() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void AdminMergeRequest::set_has_header() {
+inline void ChangeFeedEvent::set_has_value() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void AdminMergeRequest::clear_has_header() {
+inline void ChangeFeedEvent::clear_has_value() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void AdminMergeRequest::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void ChangeFeedEvent::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::KeyValue::Clear();
+  clear_has_value();
 }
-inline const ::cockroach::proto::RequestHeader& AdminMergeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminMergeRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::proto::KeyValue& ChangeFeedEvent::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeFeedEvent.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline ::cockroach::proto::RequestHeader* AdminMergeRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminMergeRequest.header)
-  return header_;
+inline ::cockroach::proto::KeyValue* ChangeFeedEvent::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::KeyValue;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeFeedEvent.value)
+  return value_;
 }
-inline ::cockroach::proto::RequestHeader* AdminMergeRequest::release_header() {
-  clear_has_header();
-  ::cockroach::proto::RequestHeader* temp = header_;
-  header_ = NULL;
+inline ::cockroach::proto::KeyValue* ChangeFeedEvent::release_value() {
+  clear_has_value();
+  ::cockroach::proto::KeyValue* temp = value_;
+  value_ = NULL;
   return temp;
 }
-inline void AdminMergeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ChangeFeedEvent::set_allocated_value(::cockroach::proto::KeyValue* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
   } else {
-    clear_has_header();
+    clear_has_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminMergeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeFeedEvent.value)
 }
 
-// -------------------------------------------------------------------
+// optional bool deleted = 2;
+inline bool ChangeFeedEvent::has_deleted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ChangeFeedEvent::set_has_deleted() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ChangeFeedEvent::clear_has_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ChangeFeedEvent::clear_deleted() {
+  deleted_ = false;
+  clear_has_deleted();
+}
+inline bool ChangeFeedEvent::deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeFeedEvent.deleted)
+  return deleted_;
+}
+inline void ChangeFeedEvent::set_deleted(bool value) {
+  set_has_deleted();
+  deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ChangeFeedEvent.deleted)
+}
 
-// AdminMergeResponse
+// optional .cockroach.proto.Timestamp resolved = 3;
+inline bool ChangeFeedEvent::has_resolved() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ChangeFeedEvent::set_has_resolved() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ChangeFeedEvent::clear_has_resolved() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ChangeFeedEvent::clear_resolved() {
+  if (resolved_ != NULL) resolved_->::cockroach::proto::Timestamp::Clear();
+  clear_has_resolved();
+}
+inline const ::cockroach::proto::Timestamp& ChangeFeedEvent::resolved() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeFeedEvent.resolved)
+  return resolved_ != NULL ? *resolved_ : *default_instance_->resolved_;
+}
+inline ::cockroach::proto::Timestamp* ChangeFeedEvent::mutable_resolved() {
+  set_has_resolved();
+  if (resolved_ == NULL) resolved_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeFeedEvent.resolved)
+  return resolved_;
+}
+inline ::cockroach::proto::Timestamp* ChangeFeedEvent::release_resolved() {
+  clear_has_resolved();
+  ::cockroach::proto::Timestamp* temp = resolved_;
+  resolved_ = NULL;
+  return temp;
+}
+inline void ChangeFeedEvent::set_allocated_resolved(::cockroach::proto::Timestamp* resolved) {
+  delete resolved_;
+  resolved_ = resolved;
+  if (resolved) {
+    set_has_resolved();
+  } else {
+    clear_has_resolved();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeFeedEvent.resolved)
+}
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool AdminMergeResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.proto.Error error = 4;
+inline bool ChangeFeedEvent::has_error() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void AdminMergeResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ChangeFeedEvent::set_has_error() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline void AdminMergeResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ChangeFeedEvent::clear_has_error() {
+  _has_bits_[0] &= ~0x00000008u;
 }
-inline void AdminMergeResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void ChangeFeedEvent::clear_error() {
+  if (error_ != NULL) error_->::cockroach::proto::Error::Clear();
+  clear_has_error();
 }
-inline const ::cockroach::proto::ResponseHeader& AdminMergeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.AdminMergeResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::cockroach::proto::Error& ChangeFeedEvent::error() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ChangeFeedEvent.error)
+  return error_ != NULL ? *error_ : *default_instance_->error_;
 }
-inline ::cockroach::proto::ResponseHeader* AdminMergeResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.AdminMergeResponse.header)
-  return header_;
+inline ::cockroach::proto::Error* ChangeFeedEvent::mutable_error() {
+  set_has_error();
+  if (error_ == NULL) error_ = new ::cockroach::proto::Error;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ChangeFeedEvent.error)
+  return error_;
 }
-inline ::cockroach::proto::ResponseHeader* AdminMergeResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::cockroach::proto::Error* ChangeFeedEvent::release_error() {
+  clear_has_error();
+  ::cockroach::proto::Error* temp = error_;
+  error_ = NULL;
   return temp;
 }
-inline void AdminMergeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ChangeFeedEvent::set_allocated_error(::cockroach::proto::Error* error) {
+  delete error_;
+  error_ = error;
+  if (error) {
+    set_has_error();
   } else {
-    clear_has_header();
+    clear_has_error();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AdminMergeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ChangeFeedEvent.error)
 }
 
 
@@ -136,8 +136,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GCPolicy));
   AcctConfig_descriptor_ = file->message_type(4);
-  static const int AcctConfig_offsets_[1] = {
+  static const int AcctConfig_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctConfig, cluster_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctConfig, max_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctConfig, max_keys_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctConfig, max_qps_),
   };
   AcctConfig_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -268,11 +271,12 @@ void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(NodeDescriptor));
   StoreDescriptor_descriptor_ = file->message_type(12);
-  static const int StoreDescriptor_offsets_[4] = {
+  static const int StoreDescriptor_offsets_[5] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreDescriptor, store_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreDescriptor, attrs_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreDescriptor, node_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreDescriptor, capacity_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreDescriptor, lease_count_),
   };
   StoreDescriptor_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -376,37 +380,42 @@ void protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto() {
     "y\022\034\n\007end_key\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\0220\n\010repli"
     "cas\030\004 \003(\0132\030.cockroach.proto.ReplicaB\004\310\336\037"
     "\000\"3\n\010GCPolicy\022\'\n\013ttl_seconds\030\001 \001(\005B\022\310\336\037\000"
-    "\342\336\037\nTTLSeconds\"E\n\nAcctConfig\0227\n\ncluster_"
-    "id\030\001 \001(\tB#\310\336\037\000\362\336\037\033yaml:\"cluster_id,omite"
-    "mpty\"\"h\n\nPermConfig\022+\n\004read\030\001 \003(\tB\035\310\336\037\000\362"
-    "\336\037\025yaml:\"read,omitempty\"\022-\n\005write\030\002 \003(\tB"
-    "\036\310\336\037\000\362\336\037\026yaml:\"write,omitempty\"\"\257\002\n\nZone"
-    "Config\022U\n\rreplica_attrs\030\001 \003(\0132\033.cockroac"
-    "h.proto.AttributesB!\310\336\037\000\362\336\037\031yaml:\"replic"
-    "as,omitempty\"\022A\n\017range_min_bytes\030\002 \001(\003B("
-    "\310\336\037\000\362\336\037 yaml:\"range_min_bytes,omitempty\""
-    "\022A\n\017range_max_bytes\030\003 \001(\003B(\310\336\037\000\362\336\037 yaml:"
-    "\"range_max_bytes,omitempty\"\022D\n\002gc\030\004 \001(\0132"
-    "\031.cockroach.proto.GCPolicyB\035\342\336\037\002GC\362\336\037\023ya"
-    "ml:\"gc,omitempty\"\"*\n\tRangeTree\022\035\n\010root_k"
-    "ey\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\"\226\001\n\rRangeTreeNode\022"
-    "\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\023\n\005black\030\002 \001(\010"
-    "B\004\310\336\037\000\022\037\n\nparent_key\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\022"
-    "\031\n\010left_key\030\004 \001(\014B\007\332\336\037\003Key\022\032\n\tright_key\030"
-    "\005 \001(\014B\007\332\336\037\003Key\"4\n\004Addr\022\025\n\007network\030\001 \001(\tB"
-    "\004\310\336\037\000\022\025\n\007address\030\002 \001(\tB\004\310\336\037\000\"@\n\rStoreCap"
-    "acity\022\026\n\010Capacity\030\001 \001(\003B\004\310\336\037\000\022\027\n\tAvailab"
-    "le\030\002 \001(\003B\004\310\336\037\000\"\233\001\n\016NodeDescriptor\022)\n\007nod"
-    "e_id\030\001 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006NodeID\022,\n\007"
-    "address\030\002 \001(\0132\025.cockroach.proto.AddrB\004\310\336"
-    "\037\000\0220\n\005attrs\030\003 \001(\0132\033.cockroach.proto.Attr"
-    "ibutesB\004\310\336\037\000\"\336\001\n\017StoreDescriptor\022,\n\010stor"
-    "e_id\030\001 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332\336\037\007StoreID\0220"
-    "\n\005attrs\030\002 \001(\0132\033.cockroach.proto.Attribut"
-    "esB\004\310\336\037\000\0223\n\004node\030\003 \001(\0132\037.cockroach.proto"
-    ".NodeDescriptorB\004\310\336\037\000\0226\n\010capacity\030\004 \001(\0132"
-    "\036.cockroach.proto.StoreCapacityB\004\310\336\037\000B\023Z"
-    "\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 1698);
+    "\342\336\037\nTTLSeconds\"\356\001\n\nAcctConfig\0227\n\ncluster"
+    "_id\030\001 \001(\tB#\310\336\037\000\362\336\037\033yaml:\"cluster_id,omit"
+    "empty\"\0225\n\tmax_bytes\030\002 \001(\003B\"\310\336\037\000\362\336\037\032yaml:"
+    "\"max_bytes,omitempty\"\0223\n\010max_keys\030\003 \001(\003B"
+    "!\310\336\037\000\362\336\037\031yaml:\"max_keys,omitempty\"\022;\n\007ma"
+    "x_qps\030\004 \001(\003B*\310\336\037\000\342\336\037\006MaxQPS\362\336\037\030yaml:\"max"
+    "_qps,omitempty\"\"h\n\nPermConfig\022+\n\004read\030\001 "
+    "\003(\tB\035\310\336\037\000\362\336\037\025yaml:\"read,omitempty\"\022-\n\005wr"
+    "ite\030\002 \003(\tB\036\310\336\037\000\362\336\037\026yaml:\"write,omitempty"
+    "\"\"\257\002\n\nZoneConfig\022U\n\rreplica_attrs\030\001 \003(\0132"
+    "\033.cockroach.proto.AttributesB!\310\336\037\000\362\336\037\031ya"
+    "ml:\"replicas,omitempty\"\022A\n\017range_min_byt"
+    "es\030\002 \001(\003B(\310\336\037\000\362\336\037 yaml:\"range_min_bytes,"
+    "omitempty\"\022A\n\017range_max_bytes\030\003 \001(\003B(\310\336\037"
+    "\000\362\336\037 yaml:\"range_max_bytes,omitempty\"\022D\n"
+    "\002gc\030\004 \001(\0132\031.cockroach.proto.GCPolicyB\035\342\336"
+    "\037\002GC\362\336\037\023yaml:\"gc,omitempty\"\"*\n\tRangeTree"
+    "\022\035\n\010root_key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\"\226\001\n\rRang"
+    "eTreeNode\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\023\n\005b"
+    "lack\030\002 \001(\010B\004\310\336\037\000\022\037\n\nparent_key\030\003 \001(\014B\013\310\336"
+    "\037\000\332\336\037\003Key\022\031\n\010left_key\030\004 \001(\014B\007\332\336\037\003Key\022\032\n\t"
+    "right_key\030\005 \001(\014B\007\332\336\037\003Key\"4\n\004Addr\022\025\n\007netw"
+    "ork\030\001 \001(\tB\004\310\336\037\000\022\025\n\007address\030\002 \001(\tB\004\310\336\037\000\"@"
+    "\n\rStoreCapacity\022\026\n\010Capacity\030\001 \001(\003B\004\310\336\037\000\022"
+    "\027\n\tAvailable\030\002 \001(\003B\004\310\336\037\000\"\233\001\n\016NodeDescrip"
+    "tor\022)\n\007node_id\030\001 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006"
+    "NodeID\022,\n\007address\030\002 \001(\0132\025.cockroach.prot"
+    "o.AddrB\004\310\336\037\000\0220\n\005attrs\030\003 \001(\0132\033.cockroach."
+    "proto.AttributesB\004\310\336\037\000\"\371\001\n\017StoreDescript"
+    "or\022,\n\010store_id\030\001 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332\336\037"
+    "\007StoreID\0220\n\005attrs\030\002 \001(\0132\033.cockroach.prot"
+    "o.AttributesB\004\310\336\037\000\0223\n\004node\030\003 \001(\0132\037.cockr"
+    "oach.proto.NodeDescriptorB\004\310\336\037\000\0226\n\010capac"
+    "ity\030\004 \001(\0132\036.cockroach.proto.StoreCapacit"
+    "yB\004\310\336\037\000\022\031\n\013lease_count\030\005 \001(\005B\004\310\336\037\000B\023Z\005pr"
+    "oto\340\342\036\001\310\342\036\001\320\342\036\001", 1895);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/config.proto", &protobuf_RegisterTypes);
   Attributes::default_instance_ = new Attributes();
@@ -1580,6 +1589,9 @@ void GCPolicy::Swap(GCPolicy* other) {
 
 #ifndef _MSC_VER
 const int AcctConfig::kClusterIdFieldNumber;
+const int AcctConfig::kMaxBytesFieldNumber;
+const int AcctConfig::kMaxKeysFieldNumber;
+const int AcctConfig::kMaxQpsFieldNumber;
 #endif  // !_MSC_VER
 
 AcctConfig::AcctConfig()
@@ -1602,6 +1614,9 @@ void AcctConfig::SharedCtor() {
   ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  max_bytes_ = GOOGLE_LONGLONG(0);
+  max_keys_ = GOOGLE_LONGLONG(0);
+  max_qps_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1640,11 +1655,28 @@ AcctConfig* AcctConfig::New() const {
 }
 
 void AcctConfig::Clear() {
-  if (has_cluster_id()) {
-    if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-      cluster_id_->clear();
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<AcctConfig*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 15) {
+    ZR_(max_bytes_, max_qps_);
+    if (has_cluster_id()) {
+      if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        cluster_id_->clear();
+      }
     }
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -1671,6 +1703,51 @@ bool AcctConfig::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_max_bytes;
+        break;
+      }
+
+      // optional int64 max_bytes = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_bytes_)));
+          set_has_max_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_max_keys;
+        break;
+      }
+
+      // optional int64 max_keys = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_max_keys:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_keys_)));
+          set_has_max_keys();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_max_qps;
+        break;
+      }
+
+      // optional int64 max_qps = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_max_qps:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_qps_)));
+          set_has_max_qps();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1710,6 +1787,21 @@ void AcctConfig::SerializeWithCachedSizes(
       1, this->cluster_id(), output);
   }
 
+  // optional int64 max_bytes = 2;
+  if (has_max_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_bytes(), output);
+  }
+
+  // optional int64 max_keys = 3;
+  if (has_max_keys()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->max_keys(), output);
+  }
+
+  // optional int64 max_qps = 4;
+  if (has_max_qps()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->max_qps(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1731,6 +1823,21 @@ void AcctConfig::SerializeWithCachedSizes(
         1, this->cluster_id(), target);
   }
 
+  // optional int64 max_bytes = 2;
+  if (has_max_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_bytes(), target);
+  }
+
+  // optional int64 max_keys = 3;
+  if (has_max_keys()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->max_keys(), target);
+  }
+
+  // optional int64 max_qps = 4;
+  if (has_max_qps()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->max_qps(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1750,6 +1857,27 @@ int AcctConfig::ByteSize() const {
           this->cluster_id());
     }
 
+    // optional int64 max_bytes = 2;
+    if (has_max_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_bytes());
+    }
+
+    // optional int64 max_keys = 3;
+    if (has_max_keys()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_keys());
+    }
+
+    // optional int64 max_qps = 4;
+    if (has_max_qps()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_qps());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -1780,6 +1908,15 @@ void AcctConfig::MergeFrom(const AcctConfig& from) {
     if (from.has_cluster_id()) {
       set_cluster_id(from.cluster_id());
     }
+    if (from.has_max_bytes()) {
+      set_max_bytes(from.max_bytes());
+    }
+    if (from.has_max_keys()) {
+      set_max_keys(from.max_keys());
+    }
+    if (from.has_max_qps()) {
+      set_max_qps(from.max_qps());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -1804,6 +1941,9 @@ bool AcctConfig::IsInitialized() const {
 void AcctConfig::Swap(AcctConfig* other) {
   if (other != this) {
     std::swap(cluster_id_, other->cluster_id_);
+    std::swap(max_bytes_, other->max_bytes_);
+    std::swap(max_keys_, other->max_keys_);
+    std::swap(max_qps_, other->max_qps_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -3999,6 +4139,7 @@ const int StoreDescriptor::kStoreIdFieldNumber;
 const int StoreDescriptor::kAttrsFieldNumber;
 const int StoreDescriptor::kNodeFieldNumber;
 const int StoreDescriptor::kCapacityFieldNumber;
+const int StoreDescriptor::kLeaseCountFieldNumber;
 #endif  // !_MSC_VER
 
 StoreDescriptor::StoreDescriptor()
@@ -4026,6 +4167,7 @@ void StoreDescriptor::SharedCtor() {
   attrs_ = NULL;
   node_ = NULL;
   capacity_ = NULL;
+  lease_count_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -4064,8 +4206,18 @@ StoreDescriptor* StoreDescriptor::New() const {
 }
 
 void StoreDescriptor::Clear() {
-  if (_has_bits_[0 / 32] & 15) {
-    store_id_ = 0;
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<StoreDescriptor*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 31) {
+    ZR_(store_id_, lease_count_);
     if (has_attrs()) {
       if (attrs_ != NULL) attrs_->::cockroach::proto::Attributes::Clear();
     }
@@ -4076,6 +4228,10 @@ void StoreDescriptor::Clear() {
       if (capacity_ != NULL) capacity_->::cockroach::proto::StoreCapacity::Clear();
     }
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -4139,6 +4295,21 @@ bool StoreDescriptor::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(40)) goto parse_lease_count;
+        break;
+      }
+
+      // optional int32 lease_count = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_lease_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &lease_count_)));
+          set_has_lease_count();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -4191,6 +4362,11 @@ void StoreDescriptor::SerializeWithCachedSizes(
       4, this->capacity(), output);
   }
 
+  // optional int32 lease_count = 5;
+  if (has_lease_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(5, this->lease_count(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -4227,6 +4403,11 @@ void StoreDescriptor::SerializeWithCachedSizes(
         4, this->capacity(), target);
   }
 
+  // optional int32 lease_count = 5;
+  if (has_lease_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(5, this->lease_count(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -4267,6 +4448,13 @@ int StoreDescriptor::ByteSize() const {
           this->capacity());
     }
 
+    // optional int32 lease_count = 5;
+    if (has_lease_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->lease_count());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -4306,6 +4494,9 @@ void StoreDescriptor::MergeFrom(const StoreDescriptor& from) {
     if (from.has_capacity()) {
       mutable_capacity()->::cockroach::proto::StoreCapacity::MergeFrom(from.capacity());
     }
+    if (from.has_lease_count()) {
+      set_lease_count(from.lease_count());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -4333,6 +4524,7 @@ void StoreDescriptor::Swap(StoreDescriptor* other) {
     std::swap(attrs_, other->attrs_);
     std::swap(node_, other->node_);
     std::swap(capacity_, other->capacity_);
+    std::swap(lease_count_, other->lease_count_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
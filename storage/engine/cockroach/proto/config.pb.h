@@ -504,16 +504,46 @@ class AcctConfig : public ::google::protobuf::Message {
   inline ::std::string* release_cluster_id();
   inline void set_allocated_cluster_id(::std::string* cluster_id);
 
+  // optional int64 max_bytes = 2;
+  inline bool has_max_bytes() const;
+  inline void clear_max_bytes();
+  static const int kMaxBytesFieldNumber = 2;
+  inline ::google::protobuf::int64 max_bytes() const;
+  inline void set_max_bytes(::google::protobuf::int64 value);
+
+  // optional int64 max_keys = 3;
+  inline bool has_max_keys() const;
+  inline void clear_max_keys();
+  static const int kMaxKeysFieldNumber = 3;
+  inline ::google::protobuf::int64 max_keys() const;
+  inline void set_max_keys(::google::protobuf::int64 value);
+
+  // optional int64 max_qps = 4;
+  inline bool has_max_qps() const;
+  inline void clear_max_qps();
+  static const int kMaxQpsFieldNumber = 4;
+  inline ::google::protobuf::int64 max_qps() const;
+  inline void set_max_qps(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.AcctConfig)
  private:
   inline void set_has_cluster_id();
   inline void clear_has_cluster_id();
+  inline void set_has_max_bytes();
+  inline void clear_has_max_bytes();
+  inline void set_has_max_keys();
+  inline void clear_has_max_keys();
+  inline void set_has_max_qps();
+  inline void clear_has_max_qps();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::std::string* cluster_id_;
+  ::google::protobuf::int64 max_bytes_;
+  ::google::protobuf::int64 max_keys_;
+  ::google::protobuf::int64 max_qps_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fconfig_2eproto();
@@ -1341,6 +1371,13 @@ class StoreDescriptor : public ::google::protobuf::Message {
   inline ::cockroach::proto::StoreCapacity* release_capacity();
   inline void set_allocated_capacity(::cockroach::proto::StoreCapacity* capacity);
 
+  // optional int32 lease_count = 5;
+  inline bool has_lease_count() const;
+  inline void clear_lease_count();
+  static const int kLeaseCountFieldNumber = 5;
+  inline ::google::protobuf::int32 lease_count() const;
+  inline void set_lease_count(::google::protobuf::int32 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.StoreDescriptor)
  private:
   inline void set_has_store_id();
@@ -1351,6 +1388,8 @@ class StoreDescriptor : public ::google::protobuf::Message {
   inline void clear_has_node();
   inline void set_has_capacity();
   inline void clear_has_capacity();
+  inline void set_has_lease_count();
+  inline void clear_has_lease_count();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1358,8 +1397,9 @@ class StoreDescriptor : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::cockroach::proto::Attributes* attrs_;
   ::cockroach::proto::NodeDescriptor* node_;
-  ::cockroach::proto::StoreCapacity* capacity_;
   ::google::protobuf::int32 store_id_;
+  ::google::protobuf::int32 lease_count_;
+  ::cockroach::proto::StoreCapacity* capacity_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fconfig_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fconfig_2eproto();
@@ -1839,6 +1879,78 @@ inline void AcctConfig::set_allocated_cluster_id(::std::string* cluster_id) {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.AcctConfig.cluster_id)
 }
 
+// optional int64 max_bytes = 2;
+inline bool AcctConfig::has_max_bytes() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AcctConfig::set_has_max_bytes() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AcctConfig::clear_has_max_bytes() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AcctConfig::clear_max_bytes() {
+  max_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_max_bytes();
+}
+inline ::google::protobuf::int64 AcctConfig::max_bytes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AcctConfig.max_bytes)
+  return max_bytes_;
+}
+inline void AcctConfig::set_max_bytes(::google::protobuf::int64 value) {
+  set_has_max_bytes();
+  max_bytes_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AcctConfig.max_bytes)
+}
+
+// optional int64 max_keys = 3;
+inline bool AcctConfig::has_max_keys() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void AcctConfig::set_has_max_keys() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void AcctConfig::clear_has_max_keys() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void AcctConfig::clear_max_keys() {
+  max_keys_ = GOOGLE_LONGLONG(0);
+  clear_has_max_keys();
+}
+inline ::google::protobuf::int64 AcctConfig::max_keys() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AcctConfig.max_keys)
+  return max_keys_;
+}
+inline void AcctConfig::set_max_keys(::google::protobuf::int64 value) {
+  set_has_max_keys();
+  max_keys_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AcctConfig.max_keys)
+}
+
+// optional int64 max_qps = 4;
+inline bool AcctConfig::has_max_qps() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void AcctConfig::set_has_max_qps() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void AcctConfig::clear_has_max_qps() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void AcctConfig::clear_max_qps() {
+  max_qps_ = GOOGLE_LONGLONG(0);
+  clear_has_max_qps();
+}
+inline ::google::protobuf::int64 AcctConfig::max_qps() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.AcctConfig.max_qps)
+  return max_qps_;
+}
+inline void AcctConfig::set_max_qps(::google::protobuf::int64 value) {
+  set_has_max_qps();
+  max_qps_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.AcctConfig.max_qps)
+}
+
 // -------------------------------------------------------------------
 
 // PermConfig
@@ -2955,6 +3067,30 @@ inline void StoreDescriptor::set_allocated_capacity(::cockroach::proto::StoreCap
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.StoreDescriptor.capacity)
 }
 
+// optional int32 lease_count = 5;
+inline bool StoreDescriptor::has_lease_count() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void StoreDescriptor::set_has_lease_count() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void StoreDescriptor::clear_has_lease_count() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void StoreDescriptor::clear_lease_count() {
+  lease_count_ = 0;
+  clear_has_lease_count();
+}
+inline ::google::protobuf::int32 StoreDescriptor::lease_count() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreDescriptor.lease_count)
+  return lease_count_;
+}
+inline void StoreDescriptor::set_lease_count(::google::protobuf::int32 value) {
+  set_has_lease_count();
+  lease_count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreDescriptor.lease_count)
+}
+
 
 // @@protoc_insertion_point(namespace_scope)
 
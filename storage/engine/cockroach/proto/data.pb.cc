@@ -54,18 +54,27 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* NodeList_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   NodeList_reflection_ = NULL;
+const ::google::protobuf::Descriptor* SequenceRange_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  SequenceRange_reflection_ = NULL;
 const ::google::protobuf::Descriptor* Transaction_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   Transaction_reflection_ = NULL;
 const ::google::protobuf::Descriptor* Lease_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   Lease_reflection_ = NULL;
+const ::google::protobuf::Descriptor* MVCCSequencedValue_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  MVCCSequencedValue_reflection_ = NULL;
 const ::google::protobuf::Descriptor* MVCCMetadata_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   MVCCMetadata_reflection_ = NULL;
 const ::google::protobuf::Descriptor* GCMetadata_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   GCMetadata_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ProtectedTimestamp_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ProtectedTimestamp_reflection_ = NULL;
 const ::google::protobuf::Descriptor* TimeSeriesDatapoint_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   TimeSeriesDatapoint_reflection_ = NULL;
@@ -105,12 +114,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(Timestamp));
   Value_descriptor_ = file->message_type(1);
-  static const int Value_offsets_[5] = {
+  static const int Value_offsets_[6] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, integer_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, checksum_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, tag_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Value, expiration_),
   };
   Value_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -271,8 +281,24 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(NodeList));
-  Transaction_descriptor_ = file->message_type(11);
-  static const int Transaction_offsets_[12] = {
+  SequenceRange_descriptor_ = file->message_type(11);
+  static const int SequenceRange_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequenceRange, start_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequenceRange, end_),
+  };
+  SequenceRange_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      SequenceRange_descriptor_,
+      SequenceRange::default_instance_,
+      SequenceRange_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequenceRange, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(SequenceRange, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(SequenceRange));
+  Transaction_descriptor_ = file->message_type(12);
+  static const int Transaction_offsets_[14] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, name_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, key_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, id_),
@@ -285,6 +311,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, orig_timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, max_timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, certain_nodes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, sequence_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, ignored_seqs_),
   };
   Transaction_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -297,7 +325,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(Transaction));
-  Lease_descriptor_ = file->message_type(12);
+  Lease_descriptor_ = file->message_type(13);
   static const int Lease_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, start_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, expiration_),
@@ -314,14 +342,33 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(Lease));
-  MVCCMetadata_descriptor_ = file->message_type(13);
-  static const int MVCCMetadata_offsets_[6] = {
+  MVCCSequencedValue_descriptor_ = file->message_type(14);
+  static const int MVCCSequencedValue_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCSequencedValue, sequence_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCSequencedValue, value_),
+  };
+  MVCCSequencedValue_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      MVCCSequencedValue_descriptor_,
+      MVCCSequencedValue::default_instance_,
+      MVCCSequencedValue_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCSequencedValue, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCSequencedValue, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(MVCCSequencedValue));
+  MVCCMetadata_descriptor_ = file->message_type(15);
+  static const int MVCCMetadata_offsets_[9] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, deleted_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, key_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, val_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, sequence_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, intent_history_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, expiration_),
   };
   MVCCMetadata_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -334,10 +381,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(MVCCMetadata));
-  GCMetadata_descriptor_ = file->message_type(14);
-  static const int GCMetadata_offsets_[2] = {
+  GCMetadata_descriptor_ = file->message_type(16);
+  static const int GCMetadata_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, last_scan_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, oldest_intent_nanos_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, next_expiration_nanos_),
   };
   GCMetadata_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -350,7 +398,25 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GCMetadata));
-  TimeSeriesDatapoint_descriptor_ = file->message_type(15);
+  ProtectedTimestamp_descriptor_ = file->message_type(17);
+  static const int ProtectedTimestamp_offsets_[4] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedTimestamp, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedTimestamp, end_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedTimestamp, timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedTimestamp, expiration_),
+  };
+  ProtectedTimestamp_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ProtectedTimestamp_descriptor_,
+      ProtectedTimestamp::default_instance_,
+      ProtectedTimestamp_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedTimestamp, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedTimestamp, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ProtectedTimestamp));
+  TimeSeriesDatapoint_descriptor_ = file->message_type(18);
   static const int TimeSeriesDatapoint_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesDatapoint, timestamp_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesDatapoint, int_value_),
@@ -367,7 +433,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(TimeSeriesDatapoint));
-  TimeSeriesData_descriptor_ = file->message_type(16);
+  TimeSeriesData_descriptor_ = file->message_type(19);
   static const int TimeSeriesData_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesData, name_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesData, source_),
@@ -384,7 +450,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(TimeSeriesData));
-  MVCCStats_descriptor_ = file->message_type(17);
+  MVCCStats_descriptor_ = file->message_type(20);
   static const int MVCCStats_offsets_[11] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStats, live_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStats, key_bytes_),
@@ -446,14 +512,20 @@ void protobuf_RegisterTypes(const ::std::string&) {
     InternalCommitTrigger_descriptor_, &InternalCommitTrigger::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     NodeList_descriptor_, &NodeList::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    SequenceRange_descriptor_, &SequenceRange::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     Transaction_descriptor_, &Transaction::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     Lease_descriptor_, &Lease::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    MVCCSequencedValue_descriptor_, &MVCCSequencedValue::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     MVCCMetadata_descriptor_, &MVCCMetadata::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     GCMetadata_descriptor_, &GCMetadata::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ProtectedTimestamp_descriptor_, &ProtectedTimestamp::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     TimeSeriesDatapoint_descriptor_, &TimeSeriesDatapoint::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -487,14 +559,20 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto() {
   delete InternalCommitTrigger_reflection_;
   delete NodeList::default_instance_;
   delete NodeList_reflection_;
+  delete SequenceRange::default_instance_;
+  delete SequenceRange_reflection_;
   delete Transaction::default_instance_;
   delete Transaction_reflection_;
   delete Lease::default_instance_;
   delete Lease_reflection_;
+  delete MVCCSequencedValue::default_instance_;
+  delete MVCCSequencedValue_reflection_;
   delete MVCCMetadata::default_instance_;
   delete MVCCMetadata_reflection_;
   delete GCMetadata::default_instance_;
   delete GCMetadata_reflection_;
+  delete ProtectedTimestamp::default_instance_;
+  delete ProtectedTimestamp_reflection_;
   delete TimeSeriesDatapoint::default_instance_;
   delete TimeSeriesDatapoint_reflection_;
   delete TimeSeriesData::default_instance_;
@@ -516,81 +594,97 @@ void protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto() {
     "proto\032\034cockroach/proto/config.proto\032\024gog"
     "oproto/gogo.proto\"A\n\tTimestamp\022\027\n\twall_t"
     "ime\030\001 \001(\003B\004\310\336\037\000\022\025\n\007logical\030\002 \001(\005B\004\310\336\037\000:\004"
-    "\230\240\037\000\"u\n\005Value\022\r\n\005bytes\030\001 \001(\014\022\017\n\007integer\030"
-    "\002 \001(\003\022\020\n\010checksum\030\003 \001(\007\022-\n\ttimestamp\030\004 \001"
-    "(\0132\032.cockroach.proto.Timestamp\022\013\n\003tag\030\005 "
-    "\001(\t\"I\n\tMVCCValue\022\025\n\007deleted\030\001 \001(\010B\004\310\336\037\000\022"
-    "%\n\005value\030\002 \001(\0132\026.cockroach.proto.Value\"Q"
-    "\n\010KeyValue\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022+\n\005"
-    "value\030\002 \001(\0132\026.cockroach.proto.ValueB\004\310\336\037"
-    "\000\"C\n\013RawKeyValue\022\037\n\003key\030\001 \001(\014B\022\310\336\037\000\332\336\037\nE"
-    "ncodedKey\022\023\n\005value\030\002 \001(\014B\004\310\336\037\000\"\214\001\n\nStore"
-    "Ident\022%\n\ncluster_id\030\001 \001(\tB\021\310\336\037\000\342\336\037\tClust"
-    "erID\022)\n\007node_id\030\002 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037"
-    "\006NodeID\022,\n\010store_id\030\003 \001(\005B\032\310\336\037\000\342\336\037\007Store"
-    "ID\332\336\037\007StoreID\"\206\001\n\014SplitTrigger\022<\n\014update"
-    "d_desc\030\001 \001(\0132 .cockroach.proto.RangeDesc"
-    "riptorB\004\310\336\037\000\0228\n\010new_desc\030\002 \001(\0132 .cockroa"
-    "ch.proto.RangeDescriptorB\004\310\336\037\000\"~\n\014MergeT"
-    "rigger\022<\n\014updated_desc\030\001 \001(\0132 .cockroach"
-    ".proto.RangeDescriptorB\004\310\336\037\000\0220\n\020subsumed"
-    "_raft_id\030\002 \001(\003B\026\310\336\037\000\342\336\037\016SubsumedRaftID\"\351"
-    "\001\n\025ChangeReplicasTrigger\022)\n\007node_id\030\001 \001("
-    "\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006NodeID\022,\n\010store_id\030"
-    "\002 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332\336\037\007StoreID\022=\n\013cha"
-    "nge_type\030\003 \001(\0162\".cockroach.proto.Replica"
-    "ChangeTypeB\004\310\336\037\000\0228\n\020updated_replicas\030\004 \003"
-    "(\0132\030.cockroach.proto.ReplicaB\004\310\336\037\000\"\346\001\n\025I"
-    "nternalCommitTrigger\0224\n\rsplit_trigger\030\001 "
-    "\001(\0132\035.cockroach.proto.SplitTrigger\0224\n\rme"
-    "rge_trigger\030\002 \001(\0132\035.cockroach.proto.Merg"
-    "eTrigger\022G\n\027change_replicas_trigger\030\003 \001("
-    "\0132&.cockroach.proto.ChangeReplicasTrigge"
-    "r\022\030\n\007intents\030\004 \003(\014B\007\332\336\037\003Key\"\035\n\010NodeList\022"
-    "\021\n\005nodes\030\001 \003(\005B\002\020\001\"\215\004\n\013Transaction\022\022\n\004na"
-    "me\030\001 \001(\tB\004\310\336\037\000\022\030\n\003key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key"
-    "\022\026\n\002id\030\003 \001(\014B\n\310\336\037\000\342\336\037\002ID\022\026\n\010priority\030\004 \001"
-    "(\005B\004\310\336\037\000\0227\n\tisolation\030\005 \001(\0162\036.cockroach."
-    "proto.IsolationTypeB\004\310\336\037\000\0228\n\006status\030\006 \001("
-    "\0162\".cockroach.proto.TransactionStatusB\004\310"
-    "\336\037\000\022\023\n\005epoch\030\007 \001(\005B\004\310\336\037\000\0222\n\016last_heartbe"
-    "at\030\010 \001(\0132\032.cockroach.proto.Timestamp\0223\n\t"
-    "timestamp\030\t \001(\0132\032.cockroach.proto.Timest"
-    "ampB\004\310\336\037\000\0228\n\016orig_timestamp\030\n \001(\0132\032.cock"
-    "roach.proto.TimestampB\004\310\336\037\000\0227\n\rmax_times"
-    "tamp\030\013 \001(\0132\032.cockroach.proto.TimestampB\004"
-    "\310\336\037\000\0226\n\rcertain_nodes\030\014 \001(\0132\031.cockroach."
-    "proto.NodeListB\004\310\336\037\000:\004\230\240\037\000\"\236\001\n\005Lease\022/\n\005"
-    "start\030\001 \001(\0132\032.cockroach.proto.TimestampB"
-    "\004\310\336\037\000\0224\n\nexpiration\030\002 \001(\0132\032.cockroach.pr"
-    "oto.TimestampB\004\310\336\037\000\022(\n\014raft_node_id\030\003 \001("
-    "\004B\022\310\336\037\000\342\336\037\nRaftNodeID:\004\230\240\037\000\"\336\001\n\014MVCCMeta"
-    "data\022)\n\003txn\030\001 \001(\0132\034.cockroach.proto.Tran"
-    "saction\0223\n\ttimestamp\030\002 \001(\0132\032.cockroach.p"
-    "roto.TimestampB\004\310\336\037\000\022\025\n\007deleted\030\003 \001(\010B\004\310"
-    "\336\037\000\022\027\n\tkey_bytes\030\004 \001(\003B\004\310\336\037\000\022\027\n\tval_byte"
-    "s\030\005 \001(\003B\004\310\336\037\000\022%\n\005value\030\006 \001(\0132\026.cockroach"
-    ".proto.Value\"H\n\nGCMetadata\022\035\n\017last_scan_"
-    "nanos\030\001 \001(\003B\004\310\336\037\000\022\033\n\023oldest_intent_nanos"
-    "\030\002 \001(\003\"\\\n\023TimeSeriesDatapoint\022\035\n\017timesta"
-    "mp_nanos\030\001 \001(\003B\004\310\336\037\000\022\021\n\tint_value\030\002 \001(\003\022"
-    "\023\n\013float_value\030\003 \001(\002\"t\n\016TimeSeriesData\022\022"
-    "\n\004name\030\001 \001(\tB\004\310\336\037\000\022\024\n\006source\030\002 \001(\tB\004\310\336\037\000"
-    "\0228\n\ndatapoints\030\003 \003(\0132$.cockroach.proto.T"
-    "imeSeriesDatapoint\"\300\002\n\tMVCCStats\022\030\n\nlive"
-    "_bytes\030\001 \001(\003B\004\310\336\037\000\022\027\n\tkey_bytes\030\002 \001(\003B\004\310"
-    "\336\037\000\022\027\n\tval_bytes\030\003 \001(\003B\004\310\336\037\000\022\032\n\014intent_b"
-    "ytes\030\004 \001(\003B\004\310\336\037\000\022\030\n\nlive_count\030\005 \001(\003B\004\310\336"
-    "\037\000\022\027\n\tkey_count\030\006 \001(\003B\004\310\336\037\000\022\027\n\tval_count"
-    "\030\007 \001(\003B\004\310\336\037\000\022\032\n\014intent_count\030\010 \001(\003B\004\310\336\037\000"
-    "\022\030\n\nintent_age\030\t \001(\003B\004\310\336\037\000\022(\n\014gc_bytes_a"
-    "ge\030\n \001(\003B\022\310\336\037\000\342\336\037\nGCBytesAge\022\037\n\021last_upd"
-    "ate_nanos\030\013 \001(\003B\004\310\336\037\000*>\n\021ReplicaChangeTy"
-    "pe\022\017\n\013ADD_REPLICA\020\000\022\022\n\016REMOVE_REPLICA\020\001\032"
-    "\004\210\243\036\000*5\n\rIsolationType\022\020\n\014SERIALIZABLE\020\000"
-    "\022\014\n\010SNAPSHOT\020\001\032\004\210\243\036\000*B\n\021TransactionStatu"
-    "s\022\013\n\007PENDING\020\000\022\r\n\tCOMMITTED\020\001\022\013\n\007ABORTED"
-    "\020\002\032\004\210\243\036\000B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 3149);
+    "\230\240\037\000\"\245\001\n\005Value\022\r\n\005bytes\030\001 \001(\014\022\017\n\007integer"
+    "\030\002 \001(\003\022\020\n\010checksum\030\003 \001(\007\022-\n\ttimestamp\030\004 "
+    "\001(\0132\032.cockroach.proto.Timestamp\022\013\n\003tag\030\005"
+    " \001(\t\022.\n\nexpiration\030\006 \001(\0132\032.cockroach.pro"
+    "to.Timestamp\"I\n\tMVCCValue\022\025\n\007deleted\030\001 \001"
+    "(\010B\004\310\336\037\000\022%\n\005value\030\002 \001(\0132\026.cockroach.prot"
+    "o.Value\"Q\n\010KeyValue\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336"
+    "\037\003Key\022+\n\005value\030\002 \001(\0132\026.cockroach.proto.V"
+    "alueB\004\310\336\037\000\"C\n\013RawKeyValue\022\037\n\003key\030\001 \001(\014B\022"
+    "\310\336\037\000\332\336\037\nEncodedKey\022\023\n\005value\030\002 \001(\014B\004\310\336\037\000\""
+    "\214\001\n\nStoreIdent\022%\n\ncluster_id\030\001 \001(\tB\021\310\336\037\000"
+    "\342\336\037\tClusterID\022)\n\007node_id\030\002 \001(\005B\030\310\336\037\000\342\336\037\006"
+    "NodeID\332\336\037\006NodeID\022,\n\010store_id\030\003 \001(\005B\032\310\336\037\000"
+    "\342\336\037\007StoreID\332\336\037\007StoreID\"\206\001\n\014SplitTrigger\022"
+    "<\n\014updated_desc\030\001 \001(\0132 .cockroach.proto."
+    "RangeDescriptorB\004\310\336\037\000\0228\n\010new_desc\030\002 \001(\0132"
+    " .cockroach.proto.RangeDescriptorB\004\310\336\037\000\""
+    "~\n\014MergeTrigger\022<\n\014updated_desc\030\001 \001(\0132 ."
+    "cockroach.proto.RangeDescriptorB\004\310\336\037\000\0220\n"
+    "\020subsumed_raft_id\030\002 \001(\003B\026\310\336\037\000\342\336\037\016Subsume"
+    "dRaftID\"\351\001\n\025ChangeReplicasTrigger\022)\n\007nod"
+    "e_id\030\001 \001(\005B\030\310\336\037\000\342\336\037\006NodeID\332\336\037\006NodeID\022,\n\010"
+    "store_id\030\002 \001(\005B\032\310\336\037\000\342\336\037\007StoreID\332\336\037\007Store"
+    "ID\022=\n\013change_type\030\003 \001(\0162\".cockroach.prot"
+    "o.ReplicaChangeTypeB\004\310\336\037\000\0228\n\020updated_rep"
+    "licas\030\004 \003(\0132\030.cockroach.proto.ReplicaB\004\310"
+    "\336\037\000\"\346\001\n\025InternalCommitTrigger\0224\n\rsplit_t"
+    "rigger\030\001 \001(\0132\035.cockroach.proto.SplitTrig"
+    "ger\0224\n\rmerge_trigger\030\002 \001(\0132\035.cockroach.p"
+    "roto.MergeTrigger\022G\n\027change_replicas_tri"
+    "gger\030\003 \001(\0132&.cockroach.proto.ChangeRepli"
+    "casTrigger\022\030\n\007intents\030\004 \003(\014B\007\332\336\037\003Key\"\035\n\010"
+    "NodeList\022\021\n\005nodes\030\001 \003(\005B\002\020\001\"7\n\rSequenceR"
+    "ange\022\023\n\005start\030\001 \001(\005B\004\310\336\037\000\022\021\n\003end\030\002 \001(\005B\004"
+    "\310\336\037\000\"\341\004\n\013Transaction\022\022\n\004name\030\001 \001(\tB\004\310\336\037\000"
+    "\022\030\n\003key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\026\n\002id\030\003 \001(\014B\n"
+    "\310\336\037\000\342\336\037\002ID\022\026\n\010priority\030\004 \001(\005B\004\310\336\037\000\0227\n\tis"
+    "olation\030\005 \001(\0162\036.cockroach.proto.Isolatio"
+    "nTypeB\004\310\336\037\000\0228\n\006status\030\006 \001(\0162\".cockroach."
+    "proto.TransactionStatusB\004\310\336\037\000\022\023\n\005epoch\030\007"
+    " \001(\005B\004\310\336\037\000\0222\n\016last_heartbeat\030\010 \001(\0132\032.coc"
+    "kroach.proto.Timestamp\0223\n\ttimestamp\030\t \001("
+    "\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\0228\n\016o"
+    "rig_timestamp\030\n \001(\0132\032.cockroach.proto.Ti"
+    "mestampB\004\310\336\037\000\0227\n\rmax_timestamp\030\013 \001(\0132\032.c"
+    "ockroach.proto.TimestampB\004\310\336\037\000\0226\n\rcertai"
+    "n_nodes\030\014 \001(\0132\031.cockroach.proto.NodeList"
+    "B\004\310\336\037\000\022\026\n\010sequence\030\r \001(\005B\004\310\336\037\000\022:\n\014ignore"
+    "d_seqs\030\016 \003(\0132\036.cockroach.proto.SequenceR"
+    "angeB\004\310\336\037\000:\004\230\240\037\000\"\236\001\n\005Lease\022/\n\005start\030\001 \001("
+    "\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\0224\n\ne"
+    "xpiration\030\002 \001(\0132\032.cockroach.proto.Timest"
+    "ampB\004\310\336\037\000\022(\n\014raft_node_id\030\003 \001(\004B\022\310\336\037\000\342\336\037"
+    "\nRaftNodeID:\004\230\240\037\000\"]\n\022MVCCSequencedValue\022"
+    "\026\n\010sequence\030\001 \001(\005B\004\310\336\037\000\022/\n\005value\030\002 \001(\0132\032"
+    ".cockroach.proto.MVCCValueB\004\310\336\037\000\"\351\002\n\014MVC"
+    "CMetadata\022)\n\003txn\030\001 \001(\0132\034.cockroach.proto"
+    ".Transaction\0223\n\ttimestamp\030\002 \001(\0132\032.cockro"
+    "ach.proto.TimestampB\004\310\336\037\000\022\025\n\007deleted\030\003 \001"
+    "(\010B\004\310\336\037\000\022\027\n\tkey_bytes\030\004 \001(\003B\004\310\336\037\000\022\027\n\tval"
+    "_bytes\030\005 \001(\003B\004\310\336\037\000\022%\n\005value\030\006 \001(\0132\026.cock"
+    "roach.proto.Value\022\026\n\010sequence\030\007 \001(\005B\004\310\336\037"
+    "\000\022A\n\016intent_history\030\010 \003(\0132#.cockroach.pr"
+    "oto.MVCCSequencedValueB\004\310\336\037\000\022.\n\nexpirati"
+    "on\030\t \001(\0132\032.cockroach.proto.Timestamp\"g\n\n"
+    "GCMetadata\022\035\n\017last_scan_nanos\030\001 \001(\003B\004\310\336\037"
+    "\000\022\033\n\023oldest_intent_nanos\030\002 \001(\003\022\035\n\025next_e"
+    "xpiration_nanos\030\003 \001(\003\"\267\001\n\022ProtectedTimes"
+    "tamp\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\034\n\007end_ke"
+    "y\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\0223\n\ttimestamp\030\003 \001(\0132"
+    "\032.cockroach.proto.TimestampB\004\310\336\037\000\0224\n\nexp"
+    "iration\030\004 \001(\0132\032.cockroach.proto.Timestam"
+    "pB\004\310\336\037\000\"\\\n\023TimeSeriesDatapoint\022\035\n\017timest"
+    "amp_nanos\030\001 \001(\003B\004\310\336\037\000\022\021\n\tint_value\030\002 \001(\003"
+    "\022\023\n\013float_value\030\003 \001(\002\"t\n\016TimeSeriesData\022"
+    "\022\n\004name\030\001 \001(\tB\004\310\336\037\000\022\024\n\006source\030\002 \001(\tB\004\310\336\037"
+    "\000\0228\n\ndatapoints\030\003 \003(\0132$.cockroach.proto."
+    "TimeSeriesDatapoint\"\300\002\n\tMVCCStats\022\030\n\nliv"
+    "e_bytes\030\001 \001(\003B\004\310\336\037\000\022\027\n\tkey_bytes\030\002 \001(\003B\004"
+    "\310\336\037\000\022\027\n\tval_bytes\030\003 \001(\003B\004\310\336\037\000\022\032\n\014intent_"
+    "bytes\030\004 \001(\003B\004\310\336\037\000\022\030\n\nlive_count\030\005 \001(\003B\004\310"
+    "\336\037\000\022\027\n\tkey_count\030\006 \001(\003B\004\310\336\037\000\022\027\n\tval_coun"
+    "t\030\007 \001(\003B\004\310\336\037\000\022\032\n\014intent_count\030\010 \001(\003B\004\310\336\037"
+    "\000\022\030\n\nintent_age\030\t \001(\003B\004\310\336\037\000\022(\n\014gc_bytes_"
+    "age\030\n \001(\003B\022\310\336\037\000\342\336\037\nGCBytesAge\022\037\n\021last_up"
+    "date_nanos\030\013 \001(\003B\004\310\336\037\000*>\n\021ReplicaChangeT"
+    "ype\022\017\n\013ADD_REPLICA\020\000\022\022\n\016REMOVE_REPLICA\020\001"
+    "\032\004\210\243\036\000*5\n\rIsolationType\022\020\n\014SERIALIZABLE\020"
+    "\000\022\014\n\010SNAPSHOT\020\001\032\004\210\243\036\000*B\n\021TransactionStat"
+    "us\022\013\n\007PENDING\020\000\022\r\n\tCOMMITTED\020\001\022\013\n\007ABORTE"
+    "D\020\002\032\004\210\243\036\000B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 3790);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/data.proto", &protobuf_RegisterTypes);
   Timestamp::default_instance_ = new Timestamp();
@@ -604,10 +698,13 @@ void protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto() {
   ChangeReplicasTrigger::default_instance_ = new ChangeReplicasTrigger();
   InternalCommitTrigger::default_instance_ = new InternalCommitTrigger();
   NodeList::default_instance_ = new NodeList();
+  SequenceRange::default_instance_ = new SequenceRange();
   Transaction::default_instance_ = new Transaction();
   Lease::default_instance_ = new Lease();
+  MVCCSequencedValue::default_instance_ = new MVCCSequencedValue();
   MVCCMetadata::default_instance_ = new MVCCMetadata();
   GCMetadata::default_instance_ = new GCMetadata();
+  ProtectedTimestamp::default_instance_ = new ProtectedTimestamp();
   TimeSeriesDatapoint::default_instance_ = new TimeSeriesDatapoint();
   TimeSeriesData::default_instance_ = new TimeSeriesData();
   MVCCStats::default_instance_ = new MVCCStats();
@@ -622,10 +719,13 @@ void protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto() {
   ChangeReplicasTrigger::default_instance_->InitAsDefaultInstance();
   InternalCommitTrigger::default_instance_->InitAsDefaultInstance();
   NodeList::default_instance_->InitAsDefaultInstance();
+  SequenceRange::default_instance_->InitAsDefaultInstance();
   Transaction::default_instance_->InitAsDefaultInstance();
   Lease::default_instance_->InitAsDefaultInstance();
+  MVCCSequencedValue::default_instance_->InitAsDefaultInstance();
   MVCCMetadata::default_instance_->InitAsDefaultInstance();
   GCMetadata::default_instance_->InitAsDefaultInstance();
+  ProtectedTimestamp::default_instance_->InitAsDefaultInstance();
   TimeSeriesDatapoint::default_instance_->InitAsDefaultInstance();
   TimeSeriesData::default_instance_->InitAsDefaultInstance();
   MVCCStats::default_instance_->InitAsDefaultInstance();
@@ -964,6 +1064,7 @@ const int Value::kIntegerFieldNumber;
 const int Value::kChecksumFieldNumber;
 const int Value::kTimestampFieldNumber;
 const int Value::kTagFieldNumber;
+const int Value::kExpirationFieldNumber;
 #endif  // !_MSC_VER
 
 Value::Value()
@@ -974,6 +1075,7 @@ Value::Value()
 
 void Value::InitAsDefaultInstance() {
   timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  expiration_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
 }
 
 Value::Value(const Value& from)
@@ -991,6 +1093,7 @@ void Value::SharedCtor() {
   checksum_ = 0u;
   timestamp_ = NULL;
   tag_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  expiration_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1008,6 +1111,7 @@ void Value::SharedDtor() {
   }
   if (this != default_instance_) {
     delete timestamp_;
+    delete expiration_;
   }
 }
 
@@ -1033,7 +1137,7 @@ Value* Value::New() const {
 }
 
 void Value::Clear() {
-  if (_has_bits_[0 / 32] & 31) {
+  if (_has_bits_[0 / 32] & 63) {
     if (has_bytes()) {
       if (bytes_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
         bytes_->clear();
@@ -1049,6 +1153,9 @@ void Value::Clear() {
         tag_->clear();
       }
     }
+    if (has_expiration()) {
+      if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -1132,6 +1239,19 @@ bool Value::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(50)) goto parse_expiration;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp expiration = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_expiration:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_expiration()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1193,6 +1313,12 @@ void Value::SerializeWithCachedSizes(
       5, this->tag(), output);
   }
 
+  // optional .cockroach.proto.Timestamp expiration = 6;
+  if (has_expiration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->expiration(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1238,6 +1364,13 @@ void Value::SerializeWithCachedSizes(
         5, this->tag(), target);
   }
 
+  // optional .cockroach.proto.Timestamp expiration = 6;
+  if (has_expiration()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->expiration(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1283,6 +1416,13 @@ int Value::ByteSize() const {
           this->tag());
     }
 
+    // optional .cockroach.proto.Timestamp expiration = 6;
+    if (has_expiration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->expiration());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -1325,6 +1465,9 @@ void Value::MergeFrom(const Value& from) {
     if (from.has_tag()) {
       set_tag(from.tag());
     }
+    if (from.has_expiration()) {
+      mutable_expiration()->::cockroach::proto::Timestamp::MergeFrom(from.expiration());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -1353,6 +1496,7 @@ void Value::Swap(Value* other) {
     std::swap(checksum_, other->checksum_);
     std::swap(timestamp_, other->timestamp_);
     std::swap(tag_, other->tag_);
+    std::swap(expiration_, other->expiration_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -4028,107 +4172,67 @@ void NodeList::Swap(NodeList* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int Transaction::kNameFieldNumber;
-const int Transaction::kKeyFieldNumber;
-const int Transaction::kIdFieldNumber;
-const int Transaction::kPriorityFieldNumber;
-const int Transaction::kIsolationFieldNumber;
-const int Transaction::kStatusFieldNumber;
-const int Transaction::kEpochFieldNumber;
-const int Transaction::kLastHeartbeatFieldNumber;
-const int Transaction::kTimestampFieldNumber;
-const int Transaction::kOrigTimestampFieldNumber;
-const int Transaction::kMaxTimestampFieldNumber;
-const int Transaction::kCertainNodesFieldNumber;
+const int SequenceRange::kStartFieldNumber;
+const int SequenceRange::kEndFieldNumber;
 #endif  // !_MSC_VER
 
-Transaction::Transaction()
+SequenceRange::SequenceRange()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(constructor:cockroach.proto.SequenceRange)
 }
 
-void Transaction::InitAsDefaultInstance() {
-  last_heartbeat_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  orig_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  max_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  certain_nodes_ = const_cast< ::cockroach::proto::NodeList*>(&::cockroach::proto::NodeList::default_instance());
+void SequenceRange::InitAsDefaultInstance() {
 }
 
-Transaction::Transaction(const Transaction& from)
+SequenceRange::SequenceRange(const SequenceRange& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.SequenceRange)
 }
 
-void Transaction::SharedCtor() {
-  ::google::protobuf::internal::GetEmptyString();
+void SequenceRange::SharedCtor() {
   _cached_size_ = 0;
-  name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  priority_ = 0;
-  isolation_ = 0;
-  status_ = 0;
-  epoch_ = 0;
-  last_heartbeat_ = NULL;
-  timestamp_ = NULL;
-  orig_timestamp_ = NULL;
-  max_timestamp_ = NULL;
-  certain_nodes_ = NULL;
+  start_ = 0;
+  end_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-Transaction::~Transaction() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.Transaction)
+SequenceRange::~SequenceRange() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.SequenceRange)
   SharedDtor();
 }
 
-void Transaction::SharedDtor() {
-  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete name_;
-  }
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete key_;
-  }
-  if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete id_;
-  }
+void SequenceRange::SharedDtor() {
   if (this != default_instance_) {
-    delete last_heartbeat_;
-    delete timestamp_;
-    delete orig_timestamp_;
-    delete max_timestamp_;
-    delete certain_nodes_;
   }
 }
 
-void Transaction::SetCachedSize(int size) const {
+void SequenceRange::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* Transaction::descriptor() {
+const ::google::protobuf::Descriptor* SequenceRange::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return Transaction_descriptor_;
+  return SequenceRange_descriptor_;
 }
 
-const Transaction& Transaction::default_instance() {
+const SequenceRange& SequenceRange::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-Transaction* Transaction::default_instance_ = NULL;
+SequenceRange* SequenceRange::default_instance_ = NULL;
 
-Transaction* Transaction::New() const {
-  return new Transaction;
+SequenceRange* SequenceRange::New() const {
+  return new SequenceRange;
 }
 
-void Transaction::Clear() {
+void SequenceRange::Clear() {
 #define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
-  &reinterpret_cast<Transaction*>(16)->f) - \
+  &reinterpret_cast<SequenceRange*>(16)->f) - \
    reinterpret_cast<char*>(16))
 
 #define ZR_(first, last) do {                              \
@@ -4137,41 +4241,7 @@ void Transaction::Clear() {
     ::memset(&first, 0, n);                                \
   } while (0)
 
-  if (_has_bits_[0 / 32] & 255) {
-    ZR_(priority_, epoch_);
-    if (has_name()) {
-      if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        name_->clear();
-      }
-    }
-    if (has_key()) {
-      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        key_->clear();
-      }
-    }
-    if (has_id()) {
-      if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-        id_->clear();
-      }
-    }
-    if (has_last_heartbeat()) {
-      if (last_heartbeat_ != NULL) last_heartbeat_->::cockroach::proto::Timestamp::Clear();
-    }
-  }
-  if (_has_bits_[8 / 32] & 3840) {
-    if (has_timestamp()) {
-      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-    }
-    if (has_orig_timestamp()) {
-      if (orig_timestamp_ != NULL) orig_timestamp_->::cockroach::proto::Timestamp::Clear();
-    }
-    if (has_max_timestamp()) {
-      if (max_timestamp_ != NULL) max_timestamp_->::cockroach::proto::Timestamp::Clear();
-    }
-    if (has_certain_nodes()) {
-      if (certain_nodes_ != NULL) certain_nodes_->::cockroach::proto::NodeList::Clear();
-    }
-  }
+  ZR_(start_, end_);
 
 #undef OFFSET_OF_FIELD_
 #undef ZR_
@@ -4180,112 +4250,465 @@ void Transaction::Clear() {
   mutable_unknown_fields()->Clear();
 }
 
-bool Transaction::MergePartialFromCodedStream(
+bool SequenceRange::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.SequenceRange)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional string name = 1;
+      // optional int32 start = 1;
       case 1: {
-        if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
-                input, this->mutable_name()));
-          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
-            this->name().data(), this->name().length(),
-            ::google::protobuf::internal::WireFormat::PARSE,
-            "name");
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &start_)));
+          set_has_start();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_key;
+        if (input->ExpectTag(16)) goto parse_end;
         break;
       }
 
-      // optional bytes key = 2;
+      // optional int32 end = 2;
       case 2: {
-        if (tag == 18) {
-         parse_key:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_key()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_id;
-        break;
-      }
-
-      // optional bytes id = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_id:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
-                input, this->mutable_id()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(32)) goto parse_priority;
-        break;
-      }
-
-      // optional int32 priority = 4;
-      case 4: {
-        if (tag == 32) {
-         parse_priority:
+        if (tag == 16) {
+         parse_end:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
                    ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
-                 input, &priority_)));
-          set_has_priority();
+                 input, &end_)));
+          set_has_end();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(40)) goto parse_isolation;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.IsolationType isolation = 5;
-      case 5: {
-        if (tag == 40) {
-         parse_isolation:
-          int value;
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          if (::cockroach::proto::IsolationType_IsValid(value)) {
-            set_isolation(static_cast< ::cockroach::proto::IsolationType >(value));
-          } else {
-            mutable_unknown_fields()->AddVarint(5, value);
-          }
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(48)) goto parse_status;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.SequenceRange)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.SequenceRange)
+  return false;
+#undef DO_
+}
 
-      // optional .cockroach.proto.TransactionStatus status = 6;
-      case 6: {
-        if (tag == 48) {
-         parse_status:
-          int value;
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          if (::cockroach::proto::TransactionStatus_IsValid(value)) {
-            set_status(static_cast< ::cockroach::proto::TransactionStatus >(value));
-          } else {
-            mutable_unknown_fields()->AddVarint(6, value);
-          }
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(56)) goto parse_epoch;
-        break;
-      }
+void SequenceRange::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.SequenceRange)
+  // optional int32 start = 1;
+  if (has_start()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->start(), output);
+  }
+
+  // optional int32 end = 2;
+  if (has_end()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(2, this->end(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.SequenceRange)
+}
+
+::google::protobuf::uint8* SequenceRange::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.SequenceRange)
+  // optional int32 start = 1;
+  if (has_start()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->start(), target);
+  }
+
+  // optional int32 end = 2;
+  if (has_end()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(2, this->end(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.SequenceRange)
+  return target;
+}
+
+int SequenceRange::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 start = 1;
+    if (has_start()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->start());
+    }
+
+    // optional int32 end = 2;
+    if (has_end()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->end());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void SequenceRange::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const SequenceRange* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const SequenceRange*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void SequenceRange::MergeFrom(const SequenceRange& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_start()) {
+      set_start(from.start());
+    }
+    if (from.has_end()) {
+      set_end(from.end());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void SequenceRange::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void SequenceRange::CopyFrom(const SequenceRange& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SequenceRange::IsInitialized() const {
+
+  return true;
+}
+
+void SequenceRange::Swap(SequenceRange* other) {
+  if (other != this) {
+    std::swap(start_, other->start_);
+    std::swap(end_, other->end_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata SequenceRange::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = SequenceRange_descriptor_;
+  metadata.reflection = SequenceRange_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int Transaction::kNameFieldNumber;
+const int Transaction::kKeyFieldNumber;
+const int Transaction::kIdFieldNumber;
+const int Transaction::kPriorityFieldNumber;
+const int Transaction::kIsolationFieldNumber;
+const int Transaction::kStatusFieldNumber;
+const int Transaction::kEpochFieldNumber;
+const int Transaction::kLastHeartbeatFieldNumber;
+const int Transaction::kTimestampFieldNumber;
+const int Transaction::kOrigTimestampFieldNumber;
+const int Transaction::kMaxTimestampFieldNumber;
+const int Transaction::kCertainNodesFieldNumber;
+const int Transaction::kSequenceFieldNumber;
+const int Transaction::kIgnoredSeqsFieldNumber;
+#endif  // !_MSC_VER
+
+Transaction::Transaction()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.Transaction)
+}
+
+void Transaction::InitAsDefaultInstance() {
+  last_heartbeat_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  orig_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  max_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  certain_nodes_ = const_cast< ::cockroach::proto::NodeList*>(&::cockroach::proto::NodeList::default_instance());
+}
+
+Transaction::Transaction(const Transaction& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Transaction)
+}
+
+void Transaction::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  name_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  priority_ = 0;
+  isolation_ = 0;
+  status_ = 0;
+  epoch_ = 0;
+  last_heartbeat_ = NULL;
+  timestamp_ = NULL;
+  orig_timestamp_ = NULL;
+  max_timestamp_ = NULL;
+  certain_nodes_ = NULL;
+  sequence_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+Transaction::~Transaction() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.Transaction)
+  SharedDtor();
+}
+
+void Transaction::SharedDtor() {
+  if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete name_;
+  }
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete id_;
+  }
+  if (this != default_instance_) {
+    delete last_heartbeat_;
+    delete timestamp_;
+    delete orig_timestamp_;
+    delete max_timestamp_;
+    delete certain_nodes_;
+  }
+}
+
+void Transaction::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* Transaction::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return Transaction_descriptor_;
+}
+
+const Transaction& Transaction::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+Transaction* Transaction::default_instance_ = NULL;
+
+Transaction* Transaction::New() const {
+  return new Transaction;
+}
+
+void Transaction::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<Transaction*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 255) {
+    ZR_(priority_, epoch_);
+    if (has_name()) {
+      if (name_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        name_->clear();
+      }
+    }
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    if (has_id()) {
+      if (id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        id_->clear();
+      }
+    }
+    if (has_last_heartbeat()) {
+      if (last_heartbeat_ != NULL) last_heartbeat_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
+  if (_has_bits_[8 / 32] & 7936) {
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    if (has_orig_timestamp()) {
+      if (orig_timestamp_ != NULL) orig_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    if (has_max_timestamp()) {
+      if (max_timestamp_ != NULL) max_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    if (has_certain_nodes()) {
+      if (certain_nodes_ != NULL) certain_nodes_->::cockroach::proto::NodeList::Clear();
+    }
+    sequence_ = 0;
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ignored_seqs_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool Transaction::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.Transaction)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional string name = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_name()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->name().data(), this->name().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "name");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_key;
+        break;
+      }
+
+      // optional bytes key = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_id;
+        break;
+      }
+
+      // optional bytes id = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_id:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_id()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_priority;
+        break;
+      }
+
+      // optional int32 priority = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_priority:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &priority_)));
+          set_has_priority();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_isolation;
+        break;
+      }
+
+      // optional .cockroach.proto.IsolationType isolation = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_isolation:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::IsolationType_IsValid(value)) {
+            set_isolation(static_cast< ::cockroach::proto::IsolationType >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(5, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_status;
+        break;
+      }
+
+      // optional .cockroach.proto.TransactionStatus status = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_status:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::TransactionStatus_IsValid(value)) {
+            set_status(static_cast< ::cockroach::proto::TransactionStatus >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(6, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(56)) goto parse_epoch;
+        break;
+      }
 
       // optional int32 epoch = 7;
       case 7: {
@@ -4298,68 +4721,966 @@ bool Transaction::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(66)) goto parse_last_heartbeat;
+        if (input->ExpectTag(66)) goto parse_last_heartbeat;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp last_heartbeat = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_last_heartbeat:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_last_heartbeat()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp timestamp = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_orig_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp orig_timestamp = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_orig_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_orig_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_max_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp max_timestamp = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_max_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_max_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_certain_nodes;
+        break;
+      }
+
+      // optional .cockroach.proto.NodeList certain_nodes = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_certain_nodes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_certain_nodes()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(104)) goto parse_sequence;
+        break;
+      }
+
+      // optional int32 sequence = 13;
+      case 13: {
+        if (tag == 104) {
+         parse_sequence:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &sequence_)));
+          set_has_sequence();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_ignored_seqs;
+        break;
+      }
+
+      // repeated .cockroach.proto.SequenceRange ignored_seqs = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_ignored_seqs:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_ignored_seqs()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_ignored_seqs;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.Transaction)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.Transaction)
+  return false;
+#undef DO_
+}
+
+void Transaction::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.Transaction)
+  // optional string name = 1;
+  if (has_name()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->name().data(), this->name().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "name");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->name(), output);
+  }
+
+  // optional bytes key = 2;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->key(), output);
+  }
+
+  // optional bytes id = 3;
+  if (has_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->id(), output);
+  }
+
+  // optional int32 priority = 4;
+  if (has_priority()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(4, this->priority(), output);
+  }
+
+  // optional .cockroach.proto.IsolationType isolation = 5;
+  if (has_isolation()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      5, this->isolation(), output);
+  }
+
+  // optional .cockroach.proto.TransactionStatus status = 6;
+  if (has_status()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      6, this->status(), output);
+  }
+
+  // optional int32 epoch = 7;
+  if (has_epoch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(7, this->epoch(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp last_heartbeat = 8;
+  if (has_last_heartbeat()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, this->last_heartbeat(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp timestamp = 9;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->timestamp(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp orig_timestamp = 10;
+  if (has_orig_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, this->orig_timestamp(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp max_timestamp = 11;
+  if (has_max_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, this->max_timestamp(), output);
+  }
+
+  // optional .cockroach.proto.NodeList certain_nodes = 12;
+  if (has_certain_nodes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, this->certain_nodes(), output);
+  }
+
+  // optional int32 sequence = 13;
+  if (has_sequence()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(13, this->sequence(), output);
+  }
+
+  // repeated .cockroach.proto.SequenceRange ignored_seqs = 14;
+  for (int i = 0; i < this->ignored_seqs_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->ignored_seqs(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.Transaction)
+}
+
+::google::protobuf::uint8* Transaction::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Transaction)
+  // optional string name = 1;
+  if (has_name()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->name().data(), this->name().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "name");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->name(), target);
+  }
+
+  // optional bytes key = 2;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->key(), target);
+  }
+
+  // optional bytes id = 3;
+  if (has_id()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->id(), target);
+  }
+
+  // optional int32 priority = 4;
+  if (has_priority()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(4, this->priority(), target);
+  }
+
+  // optional .cockroach.proto.IsolationType isolation = 5;
+  if (has_isolation()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      5, this->isolation(), target);
+  }
+
+  // optional .cockroach.proto.TransactionStatus status = 6;
+  if (has_status()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      6, this->status(), target);
+  }
+
+  // optional int32 epoch = 7;
+  if (has_epoch()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(7, this->epoch(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp last_heartbeat = 8;
+  if (has_last_heartbeat()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, this->last_heartbeat(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp timestamp = 9;
+  if (has_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->timestamp(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp orig_timestamp = 10;
+  if (has_orig_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, this->orig_timestamp(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp max_timestamp = 11;
+  if (has_max_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, this->max_timestamp(), target);
+  }
+
+  // optional .cockroach.proto.NodeList certain_nodes = 12;
+  if (has_certain_nodes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, this->certain_nodes(), target);
+  }
+
+  // optional int32 sequence = 13;
+  if (has_sequence()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(13, this->sequence(), target);
+  }
+
+  // repeated .cockroach.proto.SequenceRange ignored_seqs = 14;
+  for (int i = 0; i < this->ignored_seqs_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->ignored_seqs(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Transaction)
+  return target;
+}
+
+int Transaction::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional string name = 1;
+    if (has_name()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->name());
+    }
+
+    // optional bytes key = 2;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional bytes id = 3;
+    if (has_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->id());
+    }
+
+    // optional int32 priority = 4;
+    if (has_priority()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->priority());
+    }
+
+    // optional .cockroach.proto.IsolationType isolation = 5;
+    if (has_isolation()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->isolation());
+    }
+
+    // optional .cockroach.proto.TransactionStatus status = 6;
+    if (has_status()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->status());
+    }
+
+    // optional int32 epoch = 7;
+    if (has_epoch()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->epoch());
+    }
+
+    // optional .cockroach.proto.Timestamp last_heartbeat = 8;
+    if (has_last_heartbeat()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->last_heartbeat());
+    }
+
+  }
+  if (_has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    // optional .cockroach.proto.Timestamp timestamp = 9;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->timestamp());
+    }
+
+    // optional .cockroach.proto.Timestamp orig_timestamp = 10;
+    if (has_orig_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->orig_timestamp());
+    }
+
+    // optional .cockroach.proto.Timestamp max_timestamp = 11;
+    if (has_max_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->max_timestamp());
+    }
+
+    // optional .cockroach.proto.NodeList certain_nodes = 12;
+    if (has_certain_nodes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->certain_nodes());
+    }
+
+    // optional int32 sequence = 13;
+    if (has_sequence()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->sequence());
+    }
+
+  }
+  // repeated .cockroach.proto.SequenceRange ignored_seqs = 14;
+  total_size += 1 * this->ignored_seqs_size();
+  for (int i = 0; i < this->ignored_seqs_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->ignored_seqs(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void Transaction::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const Transaction* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const Transaction*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void Transaction::MergeFrom(const Transaction& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  ignored_seqs_.MergeFrom(from.ignored_seqs_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_name()) {
+      set_name(from.name());
+    }
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+    if (from.has_id()) {
+      set_id(from.id());
+    }
+    if (from.has_priority()) {
+      set_priority(from.priority());
+    }
+    if (from.has_isolation()) {
+      set_isolation(from.isolation());
+    }
+    if (from.has_status()) {
+      set_status(from.status());
+    }
+    if (from.has_epoch()) {
+      set_epoch(from.epoch());
+    }
+    if (from.has_last_heartbeat()) {
+      mutable_last_heartbeat()->::cockroach::proto::Timestamp::MergeFrom(from.last_heartbeat());
+    }
+  }
+  if (from._has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_orig_timestamp()) {
+      mutable_orig_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.orig_timestamp());
+    }
+    if (from.has_max_timestamp()) {
+      mutable_max_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.max_timestamp());
+    }
+    if (from.has_certain_nodes()) {
+      mutable_certain_nodes()->::cockroach::proto::NodeList::MergeFrom(from.certain_nodes());
+    }
+    if (from.has_sequence()) {
+      set_sequence(from.sequence());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void Transaction::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void Transaction::CopyFrom(const Transaction& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Transaction::IsInitialized() const {
+
+  return true;
+}
+
+void Transaction::Swap(Transaction* other) {
+  if (other != this) {
+    std::swap(name_, other->name_);
+    std::swap(key_, other->key_);
+    std::swap(id_, other->id_);
+    std::swap(priority_, other->priority_);
+    std::swap(isolation_, other->isolation_);
+    std::swap(status_, other->status_);
+    std::swap(epoch_, other->epoch_);
+    std::swap(last_heartbeat_, other->last_heartbeat_);
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(orig_timestamp_, other->orig_timestamp_);
+    std::swap(max_timestamp_, other->max_timestamp_);
+    std::swap(certain_nodes_, other->certain_nodes_);
+    std::swap(sequence_, other->sequence_);
+    ignored_seqs_.Swap(&other->ignored_seqs_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata Transaction::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = Transaction_descriptor_;
+  metadata.reflection = Transaction_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int Lease::kStartFieldNumber;
+const int Lease::kExpirationFieldNumber;
+const int Lease::kRaftNodeIdFieldNumber;
+#endif  // !_MSC_VER
+
+Lease::Lease()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.Lease)
+}
+
+void Lease::InitAsDefaultInstance() {
+  start_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  expiration_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+Lease::Lease(const Lease& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Lease)
+}
+
+void Lease::SharedCtor() {
+  _cached_size_ = 0;
+  start_ = NULL;
+  expiration_ = NULL;
+  raft_node_id_ = GOOGLE_ULONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+Lease::~Lease() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.Lease)
+  SharedDtor();
+}
+
+void Lease::SharedDtor() {
+  if (this != default_instance_) {
+    delete start_;
+    delete expiration_;
+  }
+}
+
+void Lease::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* Lease::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return Lease_descriptor_;
+}
+
+const Lease& Lease::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+Lease* Lease::default_instance_ = NULL;
+
+Lease* Lease::New() const {
+  return new Lease;
+}
+
+void Lease::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_start()) {
+      if (start_ != NULL) start_->::cockroach::proto::Timestamp::Clear();
+    }
+    if (has_expiration()) {
+      if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+    }
+    raft_node_id_ = GOOGLE_ULONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool Lease::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.Lease)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.Timestamp start = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_start()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_expiration;
         break;
       }
 
-      // optional .cockroach.proto.Timestamp last_heartbeat = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_last_heartbeat:
+      // optional .cockroach.proto.Timestamp expiration = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_expiration:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_last_heartbeat()));
+               input, mutable_expiration()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(74)) goto parse_timestamp;
+        if (input->ExpectTag(24)) goto parse_raft_node_id;
         break;
       }
 
-      // optional .cockroach.proto.Timestamp timestamp = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_timestamp:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_timestamp()));
+      // optional uint64 raft_node_id = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_raft_node_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
+                 input, &raft_node_id_)));
+          set_has_raft_node_id();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(82)) goto parse_orig_timestamp;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.Timestamp orig_timestamp = 10;
-      case 10: {
-        if (tag == 82) {
-         parse_orig_timestamp:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_orig_timestamp()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(90)) goto parse_max_timestamp;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.Lease)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.Lease)
+  return false;
+#undef DO_
+}
+
+void Lease::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.Lease)
+  // optional .cockroach.proto.Timestamp start = 1;
+  if (has_start()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->start(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 2;
+  if (has_expiration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->expiration(), output);
+  }
+
+  // optional uint64 raft_node_id = 3;
+  if (has_raft_node_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt64(3, this->raft_node_id(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.Lease)
+}
+
+::google::protobuf::uint8* Lease::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Lease)
+  // optional .cockroach.proto.Timestamp start = 1;
+  if (has_start()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->start(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 2;
+  if (has_expiration()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->expiration(), target);
+  }
+
+  // optional uint64 raft_node_id = 3;
+  if (has_raft_node_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(3, this->raft_node_id(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Lease)
+  return target;
+}
+
+int Lease::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.Timestamp start = 1;
+    if (has_start()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->start());
+    }
+
+    // optional .cockroach.proto.Timestamp expiration = 2;
+    if (has_expiration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->expiration());
+    }
+
+    // optional uint64 raft_node_id = 3;
+    if (has_raft_node_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt64Size(
+          this->raft_node_id());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void Lease::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const Lease* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const Lease*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void Lease::MergeFrom(const Lease& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_start()) {
+      mutable_start()->::cockroach::proto::Timestamp::MergeFrom(from.start());
+    }
+    if (from.has_expiration()) {
+      mutable_expiration()->::cockroach::proto::Timestamp::MergeFrom(from.expiration());
+    }
+    if (from.has_raft_node_id()) {
+      set_raft_node_id(from.raft_node_id());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void Lease::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void Lease::CopyFrom(const Lease& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Lease::IsInitialized() const {
+
+  return true;
+}
+
+void Lease::Swap(Lease* other) {
+  if (other != this) {
+    std::swap(start_, other->start_);
+    std::swap(expiration_, other->expiration_);
+    std::swap(raft_node_id_, other->raft_node_id_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata Lease::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = Lease_descriptor_;
+  metadata.reflection = Lease_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int MVCCSequencedValue::kSequenceFieldNumber;
+const int MVCCSequencedValue::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+MVCCSequencedValue::MVCCSequencedValue()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.MVCCSequencedValue)
+}
+
+void MVCCSequencedValue::InitAsDefaultInstance() {
+  value_ = const_cast< ::cockroach::proto::MVCCValue*>(&::cockroach::proto::MVCCValue::default_instance());
+}
+
+MVCCSequencedValue::MVCCSequencedValue(const MVCCSequencedValue& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.MVCCSequencedValue)
+}
+
+void MVCCSequencedValue::SharedCtor() {
+  _cached_size_ = 0;
+  sequence_ = 0;
+  value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+MVCCSequencedValue::~MVCCSequencedValue() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.MVCCSequencedValue)
+  SharedDtor();
+}
+
+void MVCCSequencedValue::SharedDtor() {
+  if (this != default_instance_) {
+    delete value_;
+  }
+}
+
+void MVCCSequencedValue::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* MVCCSequencedValue::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return MVCCSequencedValue_descriptor_;
+}
+
+const MVCCSequencedValue& MVCCSequencedValue::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  return *default_instance_;
+}
+
+MVCCSequencedValue* MVCCSequencedValue::default_instance_ = NULL;
+
+MVCCSequencedValue* MVCCSequencedValue::New() const {
+  return new MVCCSequencedValue;
+}
+
+void MVCCSequencedValue::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    sequence_ = 0;
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::proto::MVCCValue::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
 
-      // optional .cockroach.proto.Timestamp max_timestamp = 11;
-      case 11: {
-        if (tag == 90) {
-         parse_max_timestamp:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_max_timestamp()));
+bool MVCCSequencedValue::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.MVCCSequencedValue)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int32 sequence = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &sequence_)));
+          set_has_sequence();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(98)) goto parse_certain_nodes;
+        if (input->ExpectTag(18)) goto parse_value;
         break;
       }
 
-      // optional .cockroach.proto.NodeList certain_nodes = 12;
-      case 12: {
-        if (tag == 98) {
-         parse_certain_nodes:
+      // optional .cockroach.proto.MVCCValue value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_certain_nodes()));
+               input, mutable_value()));
         } else {
           goto handle_unusual;
         }
@@ -4381,277 +5702,74 @@ bool Transaction::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.MVCCSequencedValue)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.MVCCSequencedValue)
   return false;
 #undef DO_
 }
 
-void Transaction::SerializeWithCachedSizes(
+void MVCCSequencedValue::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.Transaction)
-  // optional string name = 1;
-  if (has_name()) {
-    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
-      this->name().data(), this->name().length(),
-      ::google::protobuf::internal::WireFormat::SERIALIZE,
-      "name");
-    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
-      1, this->name(), output);
-  }
-
-  // optional bytes key = 2;
-  if (has_key()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      2, this->key(), output);
-  }
-
-  // optional bytes id = 3;
-  if (has_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
-      3, this->id(), output);
-  }
-
-  // optional int32 priority = 4;
-  if (has_priority()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(4, this->priority(), output);
-  }
-
-  // optional .cockroach.proto.IsolationType isolation = 5;
-  if (has_isolation()) {
-    ::google::protobuf::internal::WireFormatLite::WriteEnum(
-      5, this->isolation(), output);
-  }
-
-  // optional .cockroach.proto.TransactionStatus status = 6;
-  if (has_status()) {
-    ::google::protobuf::internal::WireFormatLite::WriteEnum(
-      6, this->status(), output);
-  }
-
-  // optional int32 epoch = 7;
-  if (has_epoch()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt32(7, this->epoch(), output);
-  }
-
-  // optional .cockroach.proto.Timestamp last_heartbeat = 8;
-  if (has_last_heartbeat()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      8, this->last_heartbeat(), output);
-  }
-
-  // optional .cockroach.proto.Timestamp timestamp = 9;
-  if (has_timestamp()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      9, this->timestamp(), output);
-  }
-
-  // optional .cockroach.proto.Timestamp orig_timestamp = 10;
-  if (has_orig_timestamp()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      10, this->orig_timestamp(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.MVCCSequencedValue)
+  // optional int32 sequence = 1;
+  if (has_sequence()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->sequence(), output);
   }
 
-  // optional .cockroach.proto.Timestamp max_timestamp = 11;
-  if (has_max_timestamp()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      11, this->max_timestamp(), output);
-  }
-
-  // optional .cockroach.proto.NodeList certain_nodes = 12;
-  if (has_certain_nodes()) {
+  // optional .cockroach.proto.MVCCValue value = 2;
+  if (has_value()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      12, this->certain_nodes(), output);
+      2, this->value(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.MVCCSequencedValue)
 }
 
-::google::protobuf::uint8* Transaction::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* MVCCSequencedValue::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Transaction)
-  // optional string name = 1;
-  if (has_name()) {
-    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
-      this->name().data(), this->name().length(),
-      ::google::protobuf::internal::WireFormat::SERIALIZE,
-      "name");
-    target =
-      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
-        1, this->name(), target);
-  }
-
-  // optional bytes key = 2;
-  if (has_key()) {
-    target =
-      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
-        2, this->key(), target);
-  }
-
-  // optional bytes id = 3;
-  if (has_id()) {
-    target =
-      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
-        3, this->id(), target);
-  }
-
-  // optional int32 priority = 4;
-  if (has_priority()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(4, this->priority(), target);
-  }
-
-  // optional .cockroach.proto.IsolationType isolation = 5;
-  if (has_isolation()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
-      5, this->isolation(), target);
-  }
-
-  // optional .cockroach.proto.TransactionStatus status = 6;
-  if (has_status()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
-      6, this->status(), target);
-  }
-
-  // optional int32 epoch = 7;
-  if (has_epoch()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(7, this->epoch(), target);
-  }
-
-  // optional .cockroach.proto.Timestamp last_heartbeat = 8;
-  if (has_last_heartbeat()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        8, this->last_heartbeat(), target);
-  }
-
-  // optional .cockroach.proto.Timestamp timestamp = 9;
-  if (has_timestamp()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        9, this->timestamp(), target);
-  }
-
-  // optional .cockroach.proto.Timestamp orig_timestamp = 10;
-  if (has_orig_timestamp()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        10, this->orig_timestamp(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.MVCCSequencedValue)
+  // optional int32 sequence = 1;
+  if (has_sequence()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->sequence(), target);
   }
 
-  // optional .cockroach.proto.Timestamp max_timestamp = 11;
-  if (has_max_timestamp()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        11, this->max_timestamp(), target);
-  }
-
-  // optional .cockroach.proto.NodeList certain_nodes = 12;
-  if (has_certain_nodes()) {
+  // optional .cockroach.proto.MVCCValue value = 2;
+  if (has_value()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        12, this->certain_nodes(), target);
+        2, this->value(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Transaction)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.MVCCSequencedValue)
   return target;
 }
 
-int Transaction::ByteSize() const {
-  int total_size = 0;
-
-  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional string name = 1;
-    if (has_name()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::StringSize(
-          this->name());
-    }
-
-    // optional bytes key = 2;
-    if (has_key()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::BytesSize(
-          this->key());
-    }
-
-    // optional bytes id = 3;
-    if (has_id()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::BytesSize(
-          this->id());
-    }
-
-    // optional int32 priority = 4;
-    if (has_priority()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->priority());
-    }
-
-    // optional .cockroach.proto.IsolationType isolation = 5;
-    if (has_isolation()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::EnumSize(this->isolation());
-    }
-
-    // optional .cockroach.proto.TransactionStatus status = 6;
-    if (has_status()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::EnumSize(this->status());
-    }
-
-    // optional int32 epoch = 7;
-    if (has_epoch()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int32Size(
-          this->epoch());
-    }
-
-    // optional .cockroach.proto.Timestamp last_heartbeat = 8;
-    if (has_last_heartbeat()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->last_heartbeat());
-    }
-
-  }
-  if (_has_bits_[8 / 32] & (0xffu << (8 % 32))) {
-    // optional .cockroach.proto.Timestamp timestamp = 9;
-    if (has_timestamp()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->timestamp());
-    }
-
-    // optional .cockroach.proto.Timestamp orig_timestamp = 10;
-    if (has_orig_timestamp()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->orig_timestamp());
-    }
+int MVCCSequencedValue::ByteSize() const {
+  int total_size = 0;
 
-    // optional .cockroach.proto.Timestamp max_timestamp = 11;
-    if (has_max_timestamp()) {
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 sequence = 1;
+    if (has_sequence()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->max_timestamp());
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->sequence());
     }
 
-    // optional .cockroach.proto.NodeList certain_nodes = 12;
-    if (has_certain_nodes()) {
+    // optional .cockroach.proto.MVCCValue value = 2;
+    if (has_value()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->certain_nodes());
+          this->value());
     }
 
   }
@@ -4666,10 +5784,10 @@ int Transaction::ByteSize() const {
   return total_size;
 }
 
-void Transaction::MergeFrom(const ::google::protobuf::Message& from) {
+void MVCCSequencedValue::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const Transaction* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const Transaction*>(
+  const MVCCSequencedValue* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const MVCCSequencedValue*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -4678,93 +5796,51 @@ void Transaction::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void Transaction::MergeFrom(const Transaction& from) {
+void MVCCSequencedValue::MergeFrom(const MVCCSequencedValue& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_name()) {
-      set_name(from.name());
-    }
-    if (from.has_key()) {
-      set_key(from.key());
-    }
-    if (from.has_id()) {
-      set_id(from.id());
-    }
-    if (from.has_priority()) {
-      set_priority(from.priority());
-    }
-    if (from.has_isolation()) {
-      set_isolation(from.isolation());
-    }
-    if (from.has_status()) {
-      set_status(from.status());
-    }
-    if (from.has_epoch()) {
-      set_epoch(from.epoch());
-    }
-    if (from.has_last_heartbeat()) {
-      mutable_last_heartbeat()->::cockroach::proto::Timestamp::MergeFrom(from.last_heartbeat());
-    }
-  }
-  if (from._has_bits_[8 / 32] & (0xffu << (8 % 32))) {
-    if (from.has_timestamp()) {
-      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
-    }
-    if (from.has_orig_timestamp()) {
-      mutable_orig_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.orig_timestamp());
-    }
-    if (from.has_max_timestamp()) {
-      mutable_max_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.max_timestamp());
+    if (from.has_sequence()) {
+      set_sequence(from.sequence());
     }
-    if (from.has_certain_nodes()) {
-      mutable_certain_nodes()->::cockroach::proto::NodeList::MergeFrom(from.certain_nodes());
+    if (from.has_value()) {
+      mutable_value()->::cockroach::proto::MVCCValue::MergeFrom(from.value());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void Transaction::CopyFrom(const ::google::protobuf::Message& from) {
+void MVCCSequencedValue::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void Transaction::CopyFrom(const Transaction& from) {
+void MVCCSequencedValue::CopyFrom(const MVCCSequencedValue& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool Transaction::IsInitialized() const {
+bool MVCCSequencedValue::IsInitialized() const {
 
   return true;
 }
 
-void Transaction::Swap(Transaction* other) {
+void MVCCSequencedValue::Swap(MVCCSequencedValue* other) {
   if (other != this) {
-    std::swap(name_, other->name_);
-    std::swap(key_, other->key_);
-    std::swap(id_, other->id_);
-    std::swap(priority_, other->priority_);
-    std::swap(isolation_, other->isolation_);
-    std::swap(status_, other->status_);
-    std::swap(epoch_, other->epoch_);
-    std::swap(last_heartbeat_, other->last_heartbeat_);
-    std::swap(timestamp_, other->timestamp_);
-    std::swap(orig_timestamp_, other->orig_timestamp_);
-    std::swap(max_timestamp_, other->max_timestamp_);
-    std::swap(certain_nodes_, other->certain_nodes_);
+    std::swap(sequence_, other->sequence_);
+    std::swap(value_, other->value_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata Transaction::GetMetadata() const {
+::google::protobuf::Metadata MVCCSequencedValue::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = Transaction_descriptor_;
-  metadata.reflection = Transaction_reflection_;
+  metadata.descriptor = MVCCSequencedValue_descriptor_;
+  metadata.reflection = MVCCSequencedValue_reflection_;
   return metadata;
 }
 
@@ -4772,127 +5848,248 @@ void Transaction::Swap(Transaction* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int Lease::kStartFieldNumber;
-const int Lease::kExpirationFieldNumber;
-const int Lease::kRaftNodeIdFieldNumber;
+const int MVCCMetadata::kTxnFieldNumber;
+const int MVCCMetadata::kTimestampFieldNumber;
+const int MVCCMetadata::kDeletedFieldNumber;
+const int MVCCMetadata::kKeyBytesFieldNumber;
+const int MVCCMetadata::kValBytesFieldNumber;
+const int MVCCMetadata::kValueFieldNumber;
+const int MVCCMetadata::kSequenceFieldNumber;
+const int MVCCMetadata::kIntentHistoryFieldNumber;
+const int MVCCMetadata::kExpirationFieldNumber;
 #endif  // !_MSC_VER
 
-Lease::Lease()
+MVCCMetadata::MVCCMetadata()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.Lease)
+  // @@protoc_insertion_point(constructor:cockroach.proto.MVCCMetadata)
 }
 
-void Lease::InitAsDefaultInstance() {
-  start_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+void MVCCMetadata::InitAsDefaultInstance() {
+  txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
+  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  value_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
   expiration_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
 }
 
-Lease::Lease(const Lease& from)
+MVCCMetadata::MVCCMetadata(const MVCCMetadata& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Lease)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.MVCCMetadata)
 }
 
-void Lease::SharedCtor() {
+void MVCCMetadata::SharedCtor() {
   _cached_size_ = 0;
-  start_ = NULL;
+  txn_ = NULL;
+  timestamp_ = NULL;
+  deleted_ = false;
+  key_bytes_ = GOOGLE_LONGLONG(0);
+  val_bytes_ = GOOGLE_LONGLONG(0);
+  value_ = NULL;
+  sequence_ = 0;
   expiration_ = NULL;
-  raft_node_id_ = GOOGLE_ULONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-Lease::~Lease() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.Lease)
+MVCCMetadata::~MVCCMetadata() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.MVCCMetadata)
   SharedDtor();
 }
 
-void Lease::SharedDtor() {
+void MVCCMetadata::SharedDtor() {
   if (this != default_instance_) {
-    delete start_;
+    delete txn_;
+    delete timestamp_;
+    delete value_;
     delete expiration_;
   }
 }
 
-void Lease::SetCachedSize(int size) const {
+void MVCCMetadata::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* Lease::descriptor() {
+const ::google::protobuf::Descriptor* MVCCMetadata::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return Lease_descriptor_;
+  return MVCCMetadata_descriptor_;
 }
 
-const Lease& Lease::default_instance() {
+const MVCCMetadata& MVCCMetadata::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-Lease* Lease::default_instance_ = NULL;
+MVCCMetadata* MVCCMetadata::default_instance_ = NULL;
 
-Lease* Lease::New() const {
-  return new Lease;
+MVCCMetadata* MVCCMetadata::New() const {
+  return new MVCCMetadata;
 }
 
-void Lease::Clear() {
-  if (_has_bits_[0 / 32] & 7) {
-    if (has_start()) {
-      if (start_ != NULL) start_->::cockroach::proto::Timestamp::Clear();
+void MVCCMetadata::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<MVCCMetadata*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 127) {
+    ZR_(key_bytes_, sequence_);
+    if (has_txn()) {
+      if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
     }
-    if (has_expiration()) {
-      if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
     }
-    raft_node_id_ = GOOGLE_ULONGLONG(0);
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+    }
+  }
+  if (has_expiration()) {
+    if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  intent_history_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool Lease::MergePartialFromCodedStream(
+bool MVCCMetadata::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.Lease)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.MVCCMetadata)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.Timestamp start = 1;
+      // optional .cockroach.proto.Transaction txn = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_start()));
+               input, mutable_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp timestamp = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_deleted;
+        break;
+      }
+
+      // optional bool deleted = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_deleted:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &deleted_)));
+          set_has_deleted();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_key_bytes;
+        break;
+      }
+
+      // optional int64 key_bytes = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_key_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &key_bytes_)));
+          set_has_key_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_val_bytes;
+        break;
+      }
+
+      // optional int64 val_bytes = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_val_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &val_bytes_)));
+          set_has_val_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_value;
+        break;
+      }
+
+      // optional .cockroach.proto.Value value = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(56)) goto parse_sequence;
+        break;
+      }
+
+      // optional int32 sequence = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_sequence:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &sequence_)));
+          set_has_sequence();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_expiration;
+        if (input->ExpectTag(66)) goto parse_intent_history;
         break;
       }
 
-      // optional .cockroach.proto.Timestamp expiration = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_expiration:
+      // repeated .cockroach.proto.MVCCSequencedValue intent_history = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_intent_history:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_expiration()));
+                input, add_intent_history()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(24)) goto parse_raft_node_id;
+        if (input->ExpectTag(66)) goto parse_intent_history;
+        if (input->ExpectTag(74)) goto parse_expiration;
         break;
       }
 
-      // optional uint64 raft_node_id = 3;
-      case 3: {
-        if (tag == 24) {
-         parse_raft_node_id:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::uint64, ::google::protobuf::internal::WireFormatLite::TYPE_UINT64>(
-                 input, &raft_node_id_)));
-          set_has_raft_node_id();
+      // optional .cockroach.proto.Timestamp expiration = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_expiration:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_expiration()));
         } else {
           goto handle_unusual;
         }
@@ -4914,97 +6111,209 @@ bool Lease::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.Lease)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.MVCCMetadata)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.Lease)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.MVCCMetadata)
   return false;
 #undef DO_
 }
 
-void Lease::SerializeWithCachedSizes(
+void MVCCMetadata::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.Lease)
-  // optional .cockroach.proto.Timestamp start = 1;
-  if (has_start()) {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.MVCCMetadata)
+  // optional .cockroach.proto.Transaction txn = 1;
+  if (has_txn()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->start(), output);
+      1, this->txn(), output);
   }
 
-  // optional .cockroach.proto.Timestamp expiration = 2;
-  if (has_expiration()) {
+  // optional .cockroach.proto.Timestamp timestamp = 2;
+  if (has_timestamp()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->expiration(), output);
+      2, this->timestamp(), output);
   }
 
-  // optional uint64 raft_node_id = 3;
-  if (has_raft_node_id()) {
-    ::google::protobuf::internal::WireFormatLite::WriteUInt64(3, this->raft_node_id(), output);
+  // optional bool deleted = 3;
+  if (has_deleted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->deleted(), output);
+  }
+
+  // optional int64 key_bytes = 4;
+  if (has_key_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->key_bytes(), output);
+  }
+
+  // optional int64 val_bytes = 5;
+  if (has_val_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->val_bytes(), output);
+  }
+
+  // optional .cockroach.proto.Value value = 6;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->value(), output);
+  }
+
+  // optional int32 sequence = 7;
+  if (has_sequence()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(7, this->sequence(), output);
+  }
+
+  // repeated .cockroach.proto.MVCCSequencedValue intent_history = 8;
+  for (int i = 0; i < this->intent_history_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, this->intent_history(i), output);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 9;
+  if (has_expiration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->expiration(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.Lease)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.MVCCMetadata)
 }
 
-::google::protobuf::uint8* Lease::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* MVCCMetadata::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Lease)
-  // optional .cockroach.proto.Timestamp start = 1;
-  if (has_start()) {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.MVCCMetadata)
+  // optional .cockroach.proto.Transaction txn = 1;
+  if (has_txn()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        1, this->start(), target);
+        1, this->txn(), target);
   }
 
-  // optional .cockroach.proto.Timestamp expiration = 2;
-  if (has_expiration()) {
+  // optional .cockroach.proto.Timestamp timestamp = 2;
+  if (has_timestamp()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, this->expiration(), target);
+        2, this->timestamp(), target);
   }
 
-  // optional uint64 raft_node_id = 3;
-  if (has_raft_node_id()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteUInt64ToArray(3, this->raft_node_id(), target);
+  // optional bool deleted = 3;
+  if (has_deleted()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->deleted(), target);
+  }
+
+  // optional int64 key_bytes = 4;
+  if (has_key_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->key_bytes(), target);
+  }
+
+  // optional int64 val_bytes = 5;
+  if (has_val_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->val_bytes(), target);
+  }
+
+  // optional .cockroach.proto.Value value = 6;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->value(), target);
+  }
+
+  // optional int32 sequence = 7;
+  if (has_sequence()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(7, this->sequence(), target);
+  }
+
+  // repeated .cockroach.proto.MVCCSequencedValue intent_history = 8;
+  for (int i = 0; i < this->intent_history_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, this->intent_history(i), target);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 9;
+  if (has_expiration()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->expiration(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Lease)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.MVCCMetadata)
   return target;
 }
 
-int Lease::ByteSize() const {
+int MVCCMetadata::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .cockroach.proto.Timestamp start = 1;
-    if (has_start()) {
+    // optional .cockroach.proto.Transaction txn = 1;
+    if (has_txn()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->start());
+          this->txn());
     }
 
-    // optional .cockroach.proto.Timestamp expiration = 2;
-    if (has_expiration()) {
+    // optional .cockroach.proto.Timestamp timestamp = 2;
+    if (has_timestamp()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->expiration());
+          this->timestamp());
     }
 
-    // optional uint64 raft_node_id = 3;
-    if (has_raft_node_id()) {
+    // optional bool deleted = 3;
+    if (has_deleted()) {
+      total_size += 1 + 1;
+    }
+
+    // optional int64 key_bytes = 4;
+    if (has_key_bytes()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::UInt64Size(
-          this->raft_node_id());
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->key_bytes());
+    }
+
+    // optional int64 val_bytes = 5;
+    if (has_val_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->val_bytes());
+    }
+
+    // optional .cockroach.proto.Value value = 6;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
+    }
+
+    // optional int32 sequence = 7;
+    if (has_sequence()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->sequence());
+    }
+
+  }
+  if (_has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    // optional .cockroach.proto.Timestamp expiration = 9;
+    if (has_expiration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->expiration());
     }
 
   }
+  // repeated .cockroach.proto.MVCCSequencedValue intent_history = 8;
+  total_size += 1 * this->intent_history_size();
+  for (int i = 0; i < this->intent_history_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->intent_history(i));
+  }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -5016,10 +6325,10 @@ int Lease::ByteSize() const {
   return total_size;
 }
 
-void Lease::MergeFrom(const ::google::protobuf::Message& from) {
+void MVCCMetadata::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const Lease* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const Lease*>(
+  const MVCCMetadata* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const MVCCMetadata*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5028,55 +6337,79 @@ void Lease::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void Lease::MergeFrom(const Lease& from) {
+void MVCCMetadata::MergeFrom(const MVCCMetadata& from) {
   GOOGLE_CHECK_NE(&from, this);
+  intent_history_.MergeFrom(from.intent_history_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_start()) {
-      mutable_start()->::cockroach::proto::Timestamp::MergeFrom(from.start());
+    if (from.has_txn()) {
+      mutable_txn()->::cockroach::proto::Transaction::MergeFrom(from.txn());
+    }
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_deleted()) {
+      set_deleted(from.deleted());
+    }
+    if (from.has_key_bytes()) {
+      set_key_bytes(from.key_bytes());
+    }
+    if (from.has_val_bytes()) {
+      set_val_bytes(from.val_bytes());
     }
+    if (from.has_value()) {
+      mutable_value()->::cockroach::proto::Value::MergeFrom(from.value());
+    }
+    if (from.has_sequence()) {
+      set_sequence(from.sequence());
+    }
+  }
+  if (from._has_bits_[8 / 32] & (0xffu << (8 % 32))) {
     if (from.has_expiration()) {
       mutable_expiration()->::cockroach::proto::Timestamp::MergeFrom(from.expiration());
     }
-    if (from.has_raft_node_id()) {
-      set_raft_node_id(from.raft_node_id());
-    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void Lease::CopyFrom(const ::google::protobuf::Message& from) {
+void MVCCMetadata::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void Lease::CopyFrom(const Lease& from) {
+void MVCCMetadata::CopyFrom(const MVCCMetadata& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool Lease::IsInitialized() const {
+bool MVCCMetadata::IsInitialized() const {
 
   return true;
 }
 
-void Lease::Swap(Lease* other) {
+void MVCCMetadata::Swap(MVCCMetadata* other) {
   if (other != this) {
-    std::swap(start_, other->start_);
+    std::swap(txn_, other->txn_);
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(deleted_, other->deleted_);
+    std::swap(key_bytes_, other->key_bytes_);
+    std::swap(val_bytes_, other->val_bytes_);
+    std::swap(value_, other->value_);
+    std::swap(sequence_, other->sequence_);
+    intent_history_.Swap(&other->intent_history_);
     std::swap(expiration_, other->expiration_);
-    std::swap(raft_node_id_, other->raft_node_id_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata Lease::GetMetadata() const {
+::google::protobuf::Metadata MVCCMetadata::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = Lease_descriptor_;
-  metadata.reflection = Lease_reflection_;
+  metadata.descriptor = MVCCMetadata_descriptor_;
+  metadata.reflection = MVCCMetadata_reflection_;
   return metadata;
 }
 
@@ -5084,81 +6417,69 @@ void Lease::Swap(Lease* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int MVCCMetadata::kTxnFieldNumber;
-const int MVCCMetadata::kTimestampFieldNumber;
-const int MVCCMetadata::kDeletedFieldNumber;
-const int MVCCMetadata::kKeyBytesFieldNumber;
-const int MVCCMetadata::kValBytesFieldNumber;
-const int MVCCMetadata::kValueFieldNumber;
+const int GCMetadata::kLastScanNanosFieldNumber;
+const int GCMetadata::kOldestIntentNanosFieldNumber;
+const int GCMetadata::kNextExpirationNanosFieldNumber;
 #endif  // !_MSC_VER
 
-MVCCMetadata::MVCCMetadata()
+GCMetadata::GCMetadata()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.MVCCMetadata)
+  // @@protoc_insertion_point(constructor:cockroach.proto.GCMetadata)
 }
-
-void MVCCMetadata::InitAsDefaultInstance() {
-  txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
-  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
-  value_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
+
+void GCMetadata::InitAsDefaultInstance() {
 }
 
-MVCCMetadata::MVCCMetadata(const MVCCMetadata& from)
+GCMetadata::GCMetadata(const GCMetadata& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.MVCCMetadata)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.GCMetadata)
 }
 
-void MVCCMetadata::SharedCtor() {
+void GCMetadata::SharedCtor() {
   _cached_size_ = 0;
-  txn_ = NULL;
-  timestamp_ = NULL;
-  deleted_ = false;
-  key_bytes_ = GOOGLE_LONGLONG(0);
-  val_bytes_ = GOOGLE_LONGLONG(0);
-  value_ = NULL;
+  last_scan_nanos_ = GOOGLE_LONGLONG(0);
+  oldest_intent_nanos_ = GOOGLE_LONGLONG(0);
+  next_expiration_nanos_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-MVCCMetadata::~MVCCMetadata() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.MVCCMetadata)
+GCMetadata::~GCMetadata() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.GCMetadata)
   SharedDtor();
 }
 
-void MVCCMetadata::SharedDtor() {
+void GCMetadata::SharedDtor() {
   if (this != default_instance_) {
-    delete txn_;
-    delete timestamp_;
-    delete value_;
   }
 }
 
-void MVCCMetadata::SetCachedSize(int size) const {
+void GCMetadata::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* MVCCMetadata::descriptor() {
+const ::google::protobuf::Descriptor* GCMetadata::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return MVCCMetadata_descriptor_;
+  return GCMetadata_descriptor_;
 }
 
-const MVCCMetadata& MVCCMetadata::default_instance() {
+const GCMetadata& GCMetadata::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-MVCCMetadata* MVCCMetadata::default_instance_ = NULL;
+GCMetadata* GCMetadata::default_instance_ = NULL;
 
-MVCCMetadata* MVCCMetadata::New() const {
-  return new MVCCMetadata;
+GCMetadata* GCMetadata::New() const {
+  return new GCMetadata;
 }
 
-void MVCCMetadata::Clear() {
+void GCMetadata::Clear() {
 #define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
-  &reinterpret_cast<MVCCMetadata*>(16)->f) - \
+  &reinterpret_cast<GCMetadata*>(16)->f) - \
    reinterpret_cast<char*>(16))
 
 #define ZR_(first, last) do {                              \
@@ -5167,19 +6488,7 @@ void MVCCMetadata::Clear() {
     ::memset(&first, 0, n);                                \
   } while (0)
 
-  if (_has_bits_[0 / 32] & 63) {
-    ZR_(key_bytes_, val_bytes_);
-    if (has_txn()) {
-      if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
-    }
-    if (has_timestamp()) {
-      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-    }
-    deleted_ = false;
-    if (has_value()) {
-      if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-    }
-  }
+  ZR_(last_scan_nanos_, next_expiration_nanos_);
 
 #undef OFFSET_OF_FIELD_
 #undef ZR_
@@ -5188,92 +6497,53 @@ void MVCCMetadata::Clear() {
   mutable_unknown_fields()->Clear();
 }
 
-bool MVCCMetadata::MergePartialFromCodedStream(
+bool GCMetadata::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.MVCCMetadata)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.GCMetadata)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.Transaction txn = 1;
+      // optional int64 last_scan_nanos = 1;
       case 1: {
-        if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(18)) goto parse_timestamp;
-        break;
-      }
-
-      // optional .cockroach.proto.Timestamp timestamp = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_timestamp:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_timestamp()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(24)) goto parse_deleted;
-        break;
-      }
-
-      // optional bool deleted = 3;
-      case 3: {
-        if (tag == 24) {
-         parse_deleted:
+        if (tag == 8) {
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &deleted_)));
-          set_has_deleted();
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &last_scan_nanos_)));
+          set_has_last_scan_nanos();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(32)) goto parse_key_bytes;
+        if (input->ExpectTag(16)) goto parse_oldest_intent_nanos;
         break;
       }
 
-      // optional int64 key_bytes = 4;
-      case 4: {
-        if (tag == 32) {
-         parse_key_bytes:
+      // optional int64 oldest_intent_nanos = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_oldest_intent_nanos:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
                    ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &key_bytes_)));
-          set_has_key_bytes();
+                 input, &oldest_intent_nanos_)));
+          set_has_oldest_intent_nanos();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(40)) goto parse_val_bytes;
+        if (input->ExpectTag(24)) goto parse_next_expiration_nanos;
         break;
       }
 
-      // optional int64 val_bytes = 5;
-      case 5: {
-        if (tag == 40) {
-         parse_val_bytes:
+      // optional int64 next_expiration_nanos = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_next_expiration_nanos:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
                    ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &val_bytes_)));
-          set_has_val_bytes();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(50)) goto parse_value;
-        break;
-      }
-
-      // optional .cockroach.proto.Value value = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_value:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_value()));
+                 input, &next_expiration_nanos_)));
+          set_has_next_expiration_nanos();
         } else {
           goto handle_unusual;
         }
@@ -5295,146 +6565,88 @@ bool MVCCMetadata::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.MVCCMetadata)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.GCMetadata)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.MVCCMetadata)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.GCMetadata)
   return false;
 #undef DO_
 }
 
-void MVCCMetadata::SerializeWithCachedSizes(
+void GCMetadata::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.MVCCMetadata)
-  // optional .cockroach.proto.Transaction txn = 1;
-  if (has_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->txn(), output);
-  }
-
-  // optional .cockroach.proto.Timestamp timestamp = 2;
-  if (has_timestamp()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->timestamp(), output);
-  }
-
-  // optional bool deleted = 3;
-  if (has_deleted()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->deleted(), output);
-  }
-
-  // optional int64 key_bytes = 4;
-  if (has_key_bytes()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->key_bytes(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.GCMetadata)
+  // optional int64 last_scan_nanos = 1;
+  if (has_last_scan_nanos()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->last_scan_nanos(), output);
   }
 
-  // optional int64 val_bytes = 5;
-  if (has_val_bytes()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->val_bytes(), output);
+  // optional int64 oldest_intent_nanos = 2;
+  if (has_oldest_intent_nanos()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->oldest_intent_nanos(), output);
   }
 
-  // optional .cockroach.proto.Value value = 6;
-  if (has_value()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      6, this->value(), output);
+  // optional int64 next_expiration_nanos = 3;
+  if (has_next_expiration_nanos()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->next_expiration_nanos(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.MVCCMetadata)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.GCMetadata)
 }
 
-::google::protobuf::uint8* MVCCMetadata::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* GCMetadata::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.MVCCMetadata)
-  // optional .cockroach.proto.Transaction txn = 1;
-  if (has_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->txn(), target);
-  }
-
-  // optional .cockroach.proto.Timestamp timestamp = 2;
-  if (has_timestamp()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->timestamp(), target);
-  }
-
-  // optional bool deleted = 3;
-  if (has_deleted()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->deleted(), target);
-  }
-
-  // optional int64 key_bytes = 4;
-  if (has_key_bytes()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->key_bytes(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.GCMetadata)
+  // optional int64 last_scan_nanos = 1;
+  if (has_last_scan_nanos()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->last_scan_nanos(), target);
   }
 
-  // optional int64 val_bytes = 5;
-  if (has_val_bytes()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->val_bytes(), target);
+  // optional int64 oldest_intent_nanos = 2;
+  if (has_oldest_intent_nanos()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->oldest_intent_nanos(), target);
   }
 
-  // optional .cockroach.proto.Value value = 6;
-  if (has_value()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        6, this->value(), target);
+  // optional int64 next_expiration_nanos = 3;
+  if (has_next_expiration_nanos()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->next_expiration_nanos(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.MVCCMetadata)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.GCMetadata)
   return target;
 }
 
-int MVCCMetadata::ByteSize() const {
+int GCMetadata::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .cockroach.proto.Transaction txn = 1;
-    if (has_txn()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->txn());
-    }
-
-    // optional .cockroach.proto.Timestamp timestamp = 2;
-    if (has_timestamp()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->timestamp());
-    }
-
-    // optional bool deleted = 3;
-    if (has_deleted()) {
-      total_size += 1 + 1;
-    }
-
-    // optional int64 key_bytes = 4;
-    if (has_key_bytes()) {
+    // optional int64 last_scan_nanos = 1;
+    if (has_last_scan_nanos()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->key_bytes());
+          this->last_scan_nanos());
     }
 
-    // optional int64 val_bytes = 5;
-    if (has_val_bytes()) {
+    // optional int64 oldest_intent_nanos = 2;
+    if (has_oldest_intent_nanos()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->val_bytes());
+          this->oldest_intent_nanos());
     }
 
-    // optional .cockroach.proto.Value value = 6;
-    if (has_value()) {
+    // optional int64 next_expiration_nanos = 3;
+    if (has_next_expiration_nanos()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->value());
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->next_expiration_nanos());
     }
 
   }
@@ -5449,10 +6661,10 @@ int MVCCMetadata::ByteSize() const {
   return total_size;
 }
 
-void MVCCMetadata::MergeFrom(const ::google::protobuf::Message& from) {
+void GCMetadata::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const MVCCMetadata* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const MVCCMetadata*>(
+  const GCMetadata* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const GCMetadata*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5461,67 +6673,55 @@ void MVCCMetadata::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void MVCCMetadata::MergeFrom(const MVCCMetadata& from) {
+void GCMetadata::MergeFrom(const GCMetadata& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_txn()) {
-      mutable_txn()->::cockroach::proto::Transaction::MergeFrom(from.txn());
-    }
-    if (from.has_timestamp()) {
-      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
-    }
-    if (from.has_deleted()) {
-      set_deleted(from.deleted());
-    }
-    if (from.has_key_bytes()) {
-      set_key_bytes(from.key_bytes());
+    if (from.has_last_scan_nanos()) {
+      set_last_scan_nanos(from.last_scan_nanos());
     }
-    if (from.has_val_bytes()) {
-      set_val_bytes(from.val_bytes());
+    if (from.has_oldest_intent_nanos()) {
+      set_oldest_intent_nanos(from.oldest_intent_nanos());
     }
-    if (from.has_value()) {
-      mutable_value()->::cockroach::proto::Value::MergeFrom(from.value());
+    if (from.has_next_expiration_nanos()) {
+      set_next_expiration_nanos(from.next_expiration_nanos());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void MVCCMetadata::CopyFrom(const ::google::protobuf::Message& from) {
+void GCMetadata::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void MVCCMetadata::CopyFrom(const MVCCMetadata& from) {
+void GCMetadata::CopyFrom(const GCMetadata& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool MVCCMetadata::IsInitialized() const {
+bool GCMetadata::IsInitialized() const {
 
   return true;
 }
 
-void MVCCMetadata::Swap(MVCCMetadata* other) {
+void GCMetadata::Swap(GCMetadata* other) {
   if (other != this) {
-    std::swap(txn_, other->txn_);
-    std::swap(timestamp_, other->timestamp_);
-    std::swap(deleted_, other->deleted_);
-    std::swap(key_bytes_, other->key_bytes_);
-    std::swap(val_bytes_, other->val_bytes_);
-    std::swap(value_, other->value_);
+    std::swap(last_scan_nanos_, other->last_scan_nanos_);
+    std::swap(oldest_intent_nanos_, other->oldest_intent_nanos_);
+    std::swap(next_expiration_nanos_, other->next_expiration_nanos_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata MVCCMetadata::GetMetadata() const {
+::google::protobuf::Metadata GCMetadata::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = MVCCMetadata_descriptor_;
-  metadata.reflection = MVCCMetadata_reflection_;
+  metadata.descriptor = GCMetadata_descriptor_;
+  metadata.reflection = GCMetadata_reflection_;
   return metadata;
 }
 
@@ -5529,116 +6729,156 @@ void MVCCMetadata::Swap(MVCCMetadata* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int GCMetadata::kLastScanNanosFieldNumber;
-const int GCMetadata::kOldestIntentNanosFieldNumber;
+const int ProtectedTimestamp::kKeyFieldNumber;
+const int ProtectedTimestamp::kEndKeyFieldNumber;
+const int ProtectedTimestamp::kTimestampFieldNumber;
+const int ProtectedTimestamp::kExpirationFieldNumber;
 #endif  // !_MSC_VER
 
-GCMetadata::GCMetadata()
+ProtectedTimestamp::ProtectedTimestamp()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.GCMetadata)
+  // @@protoc_insertion_point(constructor:cockroach.proto.ProtectedTimestamp)
 }
 
-void GCMetadata::InitAsDefaultInstance() {
+void ProtectedTimestamp::InitAsDefaultInstance() {
+  timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+  expiration_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
 }
 
-GCMetadata::GCMetadata(const GCMetadata& from)
+ProtectedTimestamp::ProtectedTimestamp(const ProtectedTimestamp& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.GCMetadata)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ProtectedTimestamp)
 }
 
-void GCMetadata::SharedCtor() {
+void ProtectedTimestamp::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  last_scan_nanos_ = GOOGLE_LONGLONG(0);
-  oldest_intent_nanos_ = GOOGLE_LONGLONG(0);
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  timestamp_ = NULL;
+  expiration_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-GCMetadata::~GCMetadata() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.GCMetadata)
+ProtectedTimestamp::~ProtectedTimestamp() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ProtectedTimestamp)
   SharedDtor();
 }
 
-void GCMetadata::SharedDtor() {
+void ProtectedTimestamp::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
   if (this != default_instance_) {
+    delete timestamp_;
+    delete expiration_;
   }
 }
 
-void GCMetadata::SetCachedSize(int size) const {
+void ProtectedTimestamp::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* GCMetadata::descriptor() {
+const ::google::protobuf::Descriptor* ProtectedTimestamp::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return GCMetadata_descriptor_;
+  return ProtectedTimestamp_descriptor_;
 }
 
-const GCMetadata& GCMetadata::default_instance() {
+const ProtectedTimestamp& ProtectedTimestamp::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   return *default_instance_;
 }
 
-GCMetadata* GCMetadata::default_instance_ = NULL;
+ProtectedTimestamp* ProtectedTimestamp::default_instance_ = NULL;
 
-GCMetadata* GCMetadata::New() const {
-  return new GCMetadata;
+ProtectedTimestamp* ProtectedTimestamp::New() const {
+  return new ProtectedTimestamp;
 }
 
-void GCMetadata::Clear() {
-#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
-  &reinterpret_cast<GCMetadata*>(16)->f) - \
-   reinterpret_cast<char*>(16))
-
-#define ZR_(first, last) do {                              \
-    size_t f = OFFSET_OF_FIELD_(first);                    \
-    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
-    ::memset(&first, 0, n);                                \
-  } while (0)
-
-  ZR_(last_scan_nanos_, oldest_intent_nanos_);
-
-#undef OFFSET_OF_FIELD_
-#undef ZR_
-
+void ProtectedTimestamp::Clear() {
+  if (_has_bits_[0 / 32] & 15) {
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
+      }
+    }
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    if (has_expiration()) {
+      if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+    }
+  }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool GCMetadata::MergePartialFromCodedStream(
+bool ProtectedTimestamp::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.GCMetadata)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ProtectedTimestamp)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional int64 last_scan_nanos = 1;
+      // optional bytes key = 1;
       case 1: {
-        if (tag == 8) {
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &last_scan_nanos_)));
-          set_has_last_scan_nanos();
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_oldest_intent_nanos;
+        if (input->ExpectTag(18)) goto parse_end_key;
         break;
       }
 
-      // optional int64 oldest_intent_nanos = 2;
+      // optional bytes end_key = 2;
       case 2: {
-        if (tag == 16) {
-         parse_oldest_intent_nanos:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &oldest_intent_nanos_)));
-          set_has_oldest_intent_nanos();
+        if (tag == 18) {
+         parse_end_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_end_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp timestamp = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_expiration;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp expiration = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_expiration:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_expiration()));
         } else {
           goto handle_unusual;
         }
@@ -5660,71 +6900,117 @@ bool GCMetadata::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.proto.GCMetadata)
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ProtectedTimestamp)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.proto.GCMetadata)
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ProtectedTimestamp)
   return false;
 #undef DO_
 }
 
-void GCMetadata::SerializeWithCachedSizes(
+void ProtectedTimestamp::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.proto.GCMetadata)
-  // optional int64 last_scan_nanos = 1;
-  if (has_last_scan_nanos()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->last_scan_nanos(), output);
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ProtectedTimestamp)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
   }
 
-  // optional int64 oldest_intent_nanos = 2;
-  if (has_oldest_intent_nanos()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->oldest_intent_nanos(), output);
+  // optional bytes end_key = 2;
+  if (has_end_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->end_key(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp timestamp = 3;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->timestamp(), output);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 4;
+  if (has_expiration()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->expiration(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.proto.GCMetadata)
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ProtectedTimestamp)
 }
 
-::google::protobuf::uint8* GCMetadata::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ProtectedTimestamp::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.GCMetadata)
-  // optional int64 last_scan_nanos = 1;
-  if (has_last_scan_nanos()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->last_scan_nanos(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ProtectedTimestamp)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
   }
 
-  // optional int64 oldest_intent_nanos = 2;
-  if (has_oldest_intent_nanos()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->oldest_intent_nanos(), target);
+  // optional bytes end_key = 2;
+  if (has_end_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->end_key(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp timestamp = 3;
+  if (has_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->timestamp(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp expiration = 4;
+  if (has_expiration()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->expiration(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.GCMetadata)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ProtectedTimestamp)
   return target;
 }
 
-int GCMetadata::ByteSize() const {
+int ProtectedTimestamp::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional int64 last_scan_nanos = 1;
-    if (has_last_scan_nanos()) {
+    // optional bytes key = 1;
+    if (has_key()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->last_scan_nanos());
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
     }
 
-    // optional int64 oldest_intent_nanos = 2;
-    if (has_oldest_intent_nanos()) {
+    // optional bytes end_key = 2;
+    if (has_end_key()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->oldest_intent_nanos());
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->end_key());
+    }
+
+    // optional .cockroach.proto.Timestamp timestamp = 3;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->timestamp());
+    }
+
+    // optional .cockroach.proto.Timestamp expiration = 4;
+    if (has_expiration()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->expiration());
     }
 
   }
@@ -5739,10 +7025,10 @@ int GCMetadata::ByteSize() const {
   return total_size;
 }
 
-void GCMetadata::MergeFrom(const ::google::protobuf::Message& from) {
+void ProtectedTimestamp::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const GCMetadata* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const GCMetadata*>(
+  const ProtectedTimestamp* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ProtectedTimestamp*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5751,51 +7037,59 @@ void GCMetadata::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void GCMetadata::MergeFrom(const GCMetadata& from) {
+void ProtectedTimestamp::MergeFrom(const ProtectedTimestamp& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_last_scan_nanos()) {
-      set_last_scan_nanos(from.last_scan_nanos());
+    if (from.has_key()) {
+      set_key(from.key());
     }
-    if (from.has_oldest_intent_nanos()) {
-      set_oldest_intent_nanos(from.oldest_intent_nanos());
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
+    }
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_expiration()) {
+      mutable_expiration()->::cockroach::proto::Timestamp::MergeFrom(from.expiration());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void GCMetadata::CopyFrom(const ::google::protobuf::Message& from) {
+void ProtectedTimestamp::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GCMetadata::CopyFrom(const GCMetadata& from) {
+void ProtectedTimestamp::CopyFrom(const ProtectedTimestamp& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GCMetadata::IsInitialized() const {
+bool ProtectedTimestamp::IsInitialized() const {
 
   return true;
 }
 
-void GCMetadata::Swap(GCMetadata* other) {
+void ProtectedTimestamp::Swap(ProtectedTimestamp* other) {
   if (other != this) {
-    std::swap(last_scan_nanos_, other->last_scan_nanos_);
-    std::swap(oldest_intent_nanos_, other->oldest_intent_nanos_);
+    std::swap(key_, other->key_);
+    std::swap(end_key_, other->end_key_);
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(expiration_, other->expiration_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata GCMetadata::GetMetadata() const {
+::google::protobuf::Metadata ProtectedTimestamp::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = GCMetadata_descriptor_;
-  metadata.reflection = GCMetadata_reflection_;
+  metadata.descriptor = ProtectedTimestamp_descriptor_;
+  metadata.reflection = ProtectedTimestamp_reflection_;
   return metadata;
 }
 
@@ -48,10 +48,13 @@ class MergeTrigger;
 class ChangeReplicasTrigger;
 class InternalCommitTrigger;
 class NodeList;
+class SequenceRange;
 class Transaction;
 class Lease;
+class MVCCSequencedValue;
 class MVCCMetadata;
 class GCMetadata;
+class ProtectedTimestamp;
 class TimeSeriesDatapoint;
 class TimeSeriesData;
 class MVCCStats;
@@ -305,6 +308,15 @@ class Value : public ::google::protobuf::Message {
   inline ::std::string* release_tag();
   inline void set_allocated_tag(::std::string* tag);
 
+  // optional .cockroach.proto.Timestamp expiration = 6;
+  inline bool has_expiration() const;
+  inline void clear_expiration();
+  static const int kExpirationFieldNumber = 6;
+  inline const ::cockroach::proto::Timestamp& expiration() const;
+  inline ::cockroach::proto::Timestamp* mutable_expiration();
+  inline ::cockroach::proto::Timestamp* release_expiration();
+  inline void set_allocated_expiration(::cockroach::proto::Timestamp* expiration);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.Value)
  private:
   inline void set_has_bytes();
@@ -317,6 +329,8 @@ class Value : public ::google::protobuf::Message {
   inline void clear_has_timestamp();
   inline void set_has_tag();
   inline void clear_has_tag();
+  inline void set_has_expiration();
+  inline void clear_has_expiration();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -326,6 +340,7 @@ class Value : public ::google::protobuf::Message {
   ::google::protobuf::int64 integer_;
   ::cockroach::proto::Timestamp* timestamp_;
   ::std::string* tag_;
+  ::cockroach::proto::Timestamp* expiration_;
   ::google::protobuf::uint32 checksum_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
@@ -1227,6 +1242,95 @@ class NodeList : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class SequenceRange : public ::google::protobuf::Message {
+ public:
+  SequenceRange();
+  virtual ~SequenceRange();
+
+  SequenceRange(const SequenceRange& from);
+
+  inline SequenceRange& operator=(const SequenceRange& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const SequenceRange& default_instance();
+
+  void Swap(SequenceRange* other);
+
+  // implements Message ----------------------------------------------
+
+  SequenceRange* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const SequenceRange& from);
+  void MergeFrom(const SequenceRange& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int32 start = 1;
+  inline bool has_start() const;
+  inline void clear_start();
+  static const int kStartFieldNumber = 1;
+  inline ::google::protobuf::int32 start() const;
+  inline void set_start(::google::protobuf::int32 value);
+
+  // optional int32 end = 2;
+  inline bool has_end() const;
+  inline void clear_end();
+  static const int kEndFieldNumber = 2;
+  inline ::google::protobuf::int32 end() const;
+  inline void set_end(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.SequenceRange)
+ private:
+  inline void set_has_start();
+  inline void clear_has_start();
+  inline void set_has_end();
+  inline void clear_has_end();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int32 start_;
+  ::google::protobuf::int32 end_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static SequenceRange* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class Transaction : public ::google::protobuf::Message {
  public:
   Transaction();
@@ -1389,6 +1493,25 @@ class Transaction : public ::google::protobuf::Message {
   inline ::cockroach::proto::NodeList* release_certain_nodes();
   inline void set_allocated_certain_nodes(::cockroach::proto::NodeList* certain_nodes);
 
+  // optional int32 sequence = 13;
+  inline bool has_sequence() const;
+  inline void clear_sequence();
+  static const int kSequenceFieldNumber = 13;
+  inline ::google::protobuf::int32 sequence() const;
+  inline void set_sequence(::google::protobuf::int32 value);
+
+  // repeated .cockroach.proto.SequenceRange ignored_seqs = 14;
+  inline int ignored_seqs_size() const;
+  inline void clear_ignored_seqs();
+  static const int kIgnoredSeqsFieldNumber = 14;
+  inline const ::cockroach::proto::SequenceRange& ignored_seqs(int index) const;
+  inline ::cockroach::proto::SequenceRange* mutable_ignored_seqs(int index);
+  inline ::cockroach::proto::SequenceRange* add_ignored_seqs();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange >&
+      ignored_seqs() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange >*
+      mutable_ignored_seqs();
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.Transaction)
  private:
   inline void set_has_name();
@@ -1415,6 +1538,8 @@ class Transaction : public ::google::protobuf::Message {
   inline void clear_has_max_timestamp();
   inline void set_has_certain_nodes();
   inline void clear_has_certain_nodes();
+  inline void set_has_sequence();
+  inline void clear_has_sequence();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1432,6 +1557,8 @@ class Transaction : public ::google::protobuf::Message {
   ::cockroach::proto::Timestamp* orig_timestamp_;
   ::cockroach::proto::Timestamp* max_timestamp_;
   ::cockroach::proto::NodeList* certain_nodes_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange > ignored_seqs_;
+  ::google::protobuf::int32 sequence_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
@@ -1544,6 +1671,97 @@ class Lease : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class MVCCSequencedValue : public ::google::protobuf::Message {
+ public:
+  MVCCSequencedValue();
+  virtual ~MVCCSequencedValue();
+
+  MVCCSequencedValue(const MVCCSequencedValue& from);
+
+  inline MVCCSequencedValue& operator=(const MVCCSequencedValue& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const MVCCSequencedValue& default_instance();
+
+  void Swap(MVCCSequencedValue* other);
+
+  // implements Message ----------------------------------------------
+
+  MVCCSequencedValue* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const MVCCSequencedValue& from);
+  void MergeFrom(const MVCCSequencedValue& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int32 sequence = 1;
+  inline bool has_sequence() const;
+  inline void clear_sequence();
+  static const int kSequenceFieldNumber = 1;
+  inline ::google::protobuf::int32 sequence() const;
+  inline void set_sequence(::google::protobuf::int32 value);
+
+  // optional .cockroach.proto.MVCCValue value = 2;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 2;
+  inline const ::cockroach::proto::MVCCValue& value() const;
+  inline ::cockroach::proto::MVCCValue* mutable_value();
+  inline ::cockroach::proto::MVCCValue* release_value();
+  inline void set_allocated_value(::cockroach::proto::MVCCValue* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.MVCCSequencedValue)
+ private:
+  inline void set_has_sequence();
+  inline void clear_has_sequence();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::MVCCValue* value_;
+  ::google::protobuf::int32 sequence_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static MVCCSequencedValue* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class MVCCMetadata : public ::google::protobuf::Message {
  public:
   MVCCMetadata();
@@ -1645,6 +1863,34 @@ class MVCCMetadata : public ::google::protobuf::Message {
   inline ::cockroach::proto::Value* release_value();
   inline void set_allocated_value(::cockroach::proto::Value* value);
 
+  // optional int32 sequence = 7;
+  inline bool has_sequence() const;
+  inline void clear_sequence();
+  static const int kSequenceFieldNumber = 7;
+  inline ::google::protobuf::int32 sequence() const;
+  inline void set_sequence(::google::protobuf::int32 value);
+
+  // repeated .cockroach.proto.MVCCSequencedValue intent_history = 8;
+  inline int intent_history_size() const;
+  inline void clear_intent_history();
+  static const int kIntentHistoryFieldNumber = 8;
+  inline const ::cockroach::proto::MVCCSequencedValue& intent_history(int index) const;
+  inline ::cockroach::proto::MVCCSequencedValue* mutable_intent_history(int index);
+  inline ::cockroach::proto::MVCCSequencedValue* add_intent_history();
+  inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::MVCCSequencedValue >&
+      intent_history() const;
+  inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::MVCCSequencedValue >*
+      mutable_intent_history();
+
+  // optional .cockroach.proto.Timestamp expiration = 9;
+  inline bool has_expiration() const;
+  inline void clear_expiration();
+  static const int kExpirationFieldNumber = 9;
+  inline const ::cockroach::proto::Timestamp& expiration() const;
+  inline ::cockroach::proto::Timestamp* mutable_expiration();
+  inline ::cockroach::proto::Timestamp* release_expiration();
+  inline void set_allocated_expiration(::cockroach::proto::Timestamp* expiration);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.MVCCMetadata)
  private:
   inline void set_has_txn();
@@ -1659,6 +1905,10 @@ class MVCCMetadata : public ::google::protobuf::Message {
   inline void clear_has_val_bytes();
   inline void set_has_value();
   inline void clear_has_value();
+  inline void set_has_sequence();
+  inline void clear_has_sequence();
+  inline void set_has_expiration();
+  inline void clear_has_expiration();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1668,8 +1918,11 @@ class MVCCMetadata : public ::google::protobuf::Message {
   ::cockroach::proto::Timestamp* timestamp_;
   ::google::protobuf::int64 key_bytes_;
   ::google::protobuf::int64 val_bytes_;
-  ::cockroach::proto::Value* value_;
   bool deleted_;
+  ::google::protobuf::int32 sequence_;
+  ::cockroach::proto::Value* value_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::MVCCSequencedValue > intent_history_;
+  ::cockroach::proto::Timestamp* expiration_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
@@ -1746,12 +1999,21 @@ class GCMetadata : public ::google::protobuf::Message {
   inline ::google::protobuf::int64 oldest_intent_nanos() const;
   inline void set_oldest_intent_nanos(::google::protobuf::int64 value);
 
+  // optional int64 next_expiration_nanos = 3;
+  inline bool has_next_expiration_nanos() const;
+  inline void clear_next_expiration_nanos();
+  static const int kNextExpirationNanosFieldNumber = 3;
+  inline ::google::protobuf::int64 next_expiration_nanos() const;
+  inline void set_next_expiration_nanos(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.GCMetadata)
  private:
   inline void set_has_last_scan_nanos();
   inline void clear_has_last_scan_nanos();
   inline void set_has_oldest_intent_nanos();
   inline void clear_has_oldest_intent_nanos();
+  inline void set_has_next_expiration_nanos();
+  inline void clear_has_next_expiration_nanos();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1759,6 +2021,7 @@ class GCMetadata : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::google::protobuf::int64 last_scan_nanos_;
   ::google::protobuf::int64 oldest_intent_nanos_;
+  ::google::protobuf::int64 next_expiration_nanos_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
@@ -1768,6 +2031,129 @@ class GCMetadata : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class ProtectedTimestamp : public ::google::protobuf::Message {
+ public:
+  ProtectedTimestamp();
+  virtual ~ProtectedTimestamp();
+
+  ProtectedTimestamp(const ProtectedTimestamp& from);
+
+  inline ProtectedTimestamp& operator=(const ProtectedTimestamp& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ProtectedTimestamp& default_instance();
+
+  void Swap(ProtectedTimestamp* other);
+
+  // implements Message ----------------------------------------------
+
+  ProtectedTimestamp* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ProtectedTimestamp& from);
+  void MergeFrom(const ProtectedTimestamp& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // optional bytes end_key = 2;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 2;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
+
+  // optional .cockroach.proto.Timestamp timestamp = 3;
+  inline bool has_timestamp() const;
+  inline void clear_timestamp();
+  static const int kTimestampFieldNumber = 3;
+  inline const ::cockroach::proto::Timestamp& timestamp() const;
+  inline ::cockroach::proto::Timestamp* mutable_timestamp();
+  inline ::cockroach::proto::Timestamp* release_timestamp();
+  inline void set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp);
+
+  // optional .cockroach.proto.Timestamp expiration = 4;
+  inline bool has_expiration() const;
+  inline void clear_expiration();
+  static const int kExpirationFieldNumber = 4;
+  inline const ::cockroach::proto::Timestamp& expiration() const;
+  inline ::cockroach::proto::Timestamp* mutable_expiration();
+  inline ::cockroach::proto::Timestamp* release_expiration();
+  inline void set_allocated_expiration(::cockroach::proto::Timestamp* expiration);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ProtectedTimestamp)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
+  inline void set_has_expiration();
+  inline void clear_has_expiration();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  ::std::string* end_key_;
+  ::cockroach::proto::Timestamp* timestamp_;
+  ::cockroach::proto::Timestamp* expiration_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fdata_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fdata_2eproto();
+
+  void InitAsDefaultInstance();
+  static ProtectedTimestamp* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class TimeSeriesDatapoint : public ::google::protobuf::Message {
  public:
   TimeSeriesDatapoint();
@@ -2456,18 +2842,59 @@ inline void Value::set_allocated_tag(::std::string* tag) {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.tag)
 }
 
-// -------------------------------------------------------------------
-
-// MVCCValue
-
-// optional bool deleted = 1;
-inline bool MVCCValue::has_deleted() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .cockroach.proto.Timestamp expiration = 6;
+inline bool Value::has_expiration() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
 }
-inline void MVCCValue::set_has_deleted() {
-  _has_bits_[0] |= 0x00000001u;
+inline void Value::set_has_expiration() {
+  _has_bits_[0] |= 0x00000020u;
 }
-inline void MVCCValue::clear_has_deleted() {
+inline void Value::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void Value::clear_expiration() {
+  if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+  clear_has_expiration();
+}
+inline const ::cockroach::proto::Timestamp& Value::expiration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Value.expiration)
+  return expiration_ != NULL ? *expiration_ : *default_instance_->expiration_;
+}
+inline ::cockroach::proto::Timestamp* Value::mutable_expiration() {
+  set_has_expiration();
+  if (expiration_ == NULL) expiration_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Value.expiration)
+  return expiration_;
+}
+inline ::cockroach::proto::Timestamp* Value::release_expiration() {
+  clear_has_expiration();
+  ::cockroach::proto::Timestamp* temp = expiration_;
+  expiration_ = NULL;
+  return temp;
+}
+inline void Value::set_allocated_expiration(::cockroach::proto::Timestamp* expiration) {
+  delete expiration_;
+  expiration_ = expiration;
+  if (expiration) {
+    set_has_expiration();
+  } else {
+    clear_has_expiration();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Value.expiration)
+}
+
+// -------------------------------------------------------------------
+
+// MVCCValue
+
+// optional bool deleted = 1;
+inline bool MVCCValue::has_deleted() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void MVCCValue::set_has_deleted() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void MVCCValue::clear_has_deleted() {
   _has_bits_[0] &= ~0x00000001u;
 }
 inline void MVCCValue::clear_deleted() {
@@ -3409,6 +3836,58 @@ NodeList::mutable_nodes() {
 
 // -------------------------------------------------------------------
 
+// SequenceRange
+
+// optional int32 start = 1;
+inline bool SequenceRange::has_start() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void SequenceRange::set_has_start() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void SequenceRange::clear_has_start() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void SequenceRange::clear_start() {
+  start_ = 0;
+  clear_has_start();
+}
+inline ::google::protobuf::int32 SequenceRange::start() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.SequenceRange.start)
+  return start_;
+}
+inline void SequenceRange::set_start(::google::protobuf::int32 value) {
+  set_has_start();
+  start_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.SequenceRange.start)
+}
+
+// optional int32 end = 2;
+inline bool SequenceRange::has_end() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void SequenceRange::set_has_end() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void SequenceRange::clear_has_end() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void SequenceRange::clear_end() {
+  end_ = 0;
+  clear_has_end();
+}
+inline ::google::protobuf::int32 SequenceRange::end() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.SequenceRange.end)
+  return end_;
+}
+inline void SequenceRange::set_end(::google::protobuf::int32 value) {
+  set_has_end();
+  end_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.SequenceRange.end)
+}
+
+// -------------------------------------------------------------------
+
 // Transaction
 
 // optional string name = 1;
@@ -3942,6 +4421,60 @@ inline void Transaction::set_allocated_certain_nodes(::cockroach::proto::NodeLis
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Transaction.certain_nodes)
 }
 
+// optional int32 sequence = 13;
+inline bool Transaction::has_sequence() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void Transaction::set_has_sequence() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void Transaction::clear_has_sequence() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void Transaction::clear_sequence() {
+  sequence_ = 0;
+  clear_has_sequence();
+}
+inline ::google::protobuf::int32 Transaction::sequence() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.sequence)
+  return sequence_;
+}
+inline void Transaction::set_sequence(::google::protobuf::int32 value) {
+  set_has_sequence();
+  sequence_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.Transaction.sequence)
+}
+
+// repeated .cockroach.proto.SequenceRange ignored_seqs = 14;
+inline int Transaction::ignored_seqs_size() const {
+  return ignored_seqs_.size();
+}
+inline void Transaction::clear_ignored_seqs() {
+  ignored_seqs_.Clear();
+}
+inline const ::cockroach::proto::SequenceRange& Transaction::ignored_seqs(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Transaction.ignored_seqs)
+  return ignored_seqs_.Get(index);
+}
+inline ::cockroach::proto::SequenceRange* Transaction::mutable_ignored_seqs(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Transaction.ignored_seqs)
+  return ignored_seqs_.Mutable(index);
+}
+inline ::cockroach::proto::SequenceRange* Transaction::add_ignored_seqs() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.Transaction.ignored_seqs)
+  return ignored_seqs_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange >&
+Transaction::ignored_seqs() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.Transaction.ignored_seqs)
+  return ignored_seqs_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::SequenceRange >*
+Transaction::mutable_ignored_seqs() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.Transaction.ignored_seqs)
+  return &ignored_seqs_;
+}
+
 // -------------------------------------------------------------------
 
 // Lease
@@ -4054,6 +4587,75 @@ inline void Lease::set_raft_node_id(::google::protobuf::uint64 value) {
 
 // -------------------------------------------------------------------
 
+// MVCCSequencedValue
+
+// optional int32 sequence = 1;
+inline bool MVCCSequencedValue::has_sequence() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void MVCCSequencedValue::set_has_sequence() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void MVCCSequencedValue::clear_has_sequence() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void MVCCSequencedValue::clear_sequence() {
+  sequence_ = 0;
+  clear_has_sequence();
+}
+inline ::google::protobuf::int32 MVCCSequencedValue::sequence() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCSequencedValue.sequence)
+  return sequence_;
+}
+inline void MVCCSequencedValue::set_sequence(::google::protobuf::int32 value) {
+  set_has_sequence();
+  sequence_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.MVCCSequencedValue.sequence)
+}
+
+// optional .cockroach.proto.MVCCValue value = 2;
+inline bool MVCCSequencedValue::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void MVCCSequencedValue::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void MVCCSequencedValue::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void MVCCSequencedValue::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::MVCCValue::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::MVCCValue& MVCCSequencedValue::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCSequencedValue.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::MVCCValue* MVCCSequencedValue::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::MVCCValue;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.MVCCSequencedValue.value)
+  return value_;
+}
+inline ::cockroach::proto::MVCCValue* MVCCSequencedValue::release_value() {
+  clear_has_value();
+  ::cockroach::proto::MVCCValue* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void MVCCSequencedValue::set_allocated_value(::cockroach::proto::MVCCValue* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MVCCSequencedValue.value)
+}
+
+// -------------------------------------------------------------------
+
 // MVCCMetadata
 
 // optional .cockroach.proto.Transaction txn = 1;
@@ -4251,6 +4853,101 @@ inline void MVCCMetadata::set_allocated_value(::cockroach::proto::Value* value)
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MVCCMetadata.value)
 }
 
+// optional int32 sequence = 7;
+inline bool MVCCMetadata::has_sequence() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void MVCCMetadata::set_has_sequence() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void MVCCMetadata::clear_has_sequence() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void MVCCMetadata::clear_sequence() {
+  sequence_ = 0;
+  clear_has_sequence();
+}
+inline ::google::protobuf::int32 MVCCMetadata::sequence() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCMetadata.sequence)
+  return sequence_;
+}
+inline void MVCCMetadata::set_sequence(::google::protobuf::int32 value) {
+  set_has_sequence();
+  sequence_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.MVCCMetadata.sequence)
+}
+
+// repeated .cockroach.proto.MVCCSequencedValue intent_history = 8;
+inline int MVCCMetadata::intent_history_size() const {
+  return intent_history_.size();
+}
+inline void MVCCMetadata::clear_intent_history() {
+  intent_history_.Clear();
+}
+inline const ::cockroach::proto::MVCCSequencedValue& MVCCMetadata::intent_history(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCMetadata.intent_history)
+  return intent_history_.Get(index);
+}
+inline ::cockroach::proto::MVCCSequencedValue* MVCCMetadata::mutable_intent_history(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.MVCCMetadata.intent_history)
+  return intent_history_.Mutable(index);
+}
+inline ::cockroach::proto::MVCCSequencedValue* MVCCMetadata::add_intent_history() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.MVCCMetadata.intent_history)
+  return intent_history_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::MVCCSequencedValue >&
+MVCCMetadata::intent_history() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.MVCCMetadata.intent_history)
+  return intent_history_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::MVCCSequencedValue >*
+MVCCMetadata::mutable_intent_history() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.MVCCMetadata.intent_history)
+  return &intent_history_;
+}
+
+// optional .cockroach.proto.Timestamp expiration = 9;
+inline bool MVCCMetadata::has_expiration() const {
+  return (_has_bits_[0] & 0x00000100u) != 0;
+}
+inline void MVCCMetadata::set_has_expiration() {
+  _has_bits_[0] |= 0x00000100u;
+}
+inline void MVCCMetadata::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000100u;
+}
+inline void MVCCMetadata::clear_expiration() {
+  if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+  clear_has_expiration();
+}
+inline const ::cockroach::proto::Timestamp& MVCCMetadata::expiration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.MVCCMetadata.expiration)
+  return expiration_ != NULL ? *expiration_ : *default_instance_->expiration_;
+}
+inline ::cockroach::proto::Timestamp* MVCCMetadata::mutable_expiration() {
+  set_has_expiration();
+  if (expiration_ == NULL) expiration_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.MVCCMetadata.expiration)
+  return expiration_;
+}
+inline ::cockroach::proto::Timestamp* MVCCMetadata::release_expiration() {
+  clear_has_expiration();
+  ::cockroach::proto::Timestamp* temp = expiration_;
+  expiration_ = NULL;
+  return temp;
+}
+inline void MVCCMetadata::set_allocated_expiration(::cockroach::proto::Timestamp* expiration) {
+  delete expiration_;
+  expiration_ = expiration;
+  if (expiration) {
+    set_has_expiration();
+  } else {
+    clear_has_expiration();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.MVCCMetadata.expiration)
+}
+
 // -------------------------------------------------------------------
 
 // GCMetadata
@@ -4303,6 +5000,268 @@ inline void GCMetadata::set_oldest_intent_nanos(::google::protobuf::int64 value)
   // @@protoc_insertion_point(field_set:cockroach.proto.GCMetadata.oldest_intent_nanos)
 }
 
+// optional int64 next_expiration_nanos = 3;
+inline bool GCMetadata::has_next_expiration_nanos() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void GCMetadata::set_has_next_expiration_nanos() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void GCMetadata::clear_has_next_expiration_nanos() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void GCMetadata::clear_next_expiration_nanos() {
+  next_expiration_nanos_ = GOOGLE_LONGLONG(0);
+  clear_has_next_expiration_nanos();
+}
+inline ::google::protobuf::int64 GCMetadata::next_expiration_nanos() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GCMetadata.next_expiration_nanos)
+  return next_expiration_nanos_;
+}
+inline void GCMetadata::set_next_expiration_nanos(::google::protobuf::int64 value) {
+  set_has_next_expiration_nanos();
+  next_expiration_nanos_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GCMetadata.next_expiration_nanos)
+}
+
+// -------------------------------------------------------------------
+
+// ProtectedTimestamp
+
+// optional bytes key = 1;
+inline bool ProtectedTimestamp::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ProtectedTimestamp::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ProtectedTimestamp::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ProtectedTimestamp::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& ProtectedTimestamp::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ProtectedTimestamp.key)
+  return *key_;
+}
+inline void ProtectedTimestamp::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.ProtectedTimestamp.key)
+}
+inline void ProtectedTimestamp::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.ProtectedTimestamp.key)
+}
+inline void ProtectedTimestamp::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.ProtectedTimestamp.key)
+}
+inline ::std::string* ProtectedTimestamp::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ProtectedTimestamp.key)
+  return key_;
+}
+inline ::std::string* ProtectedTimestamp::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void ProtectedTimestamp::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ProtectedTimestamp.key)
+}
+
+// optional bytes end_key = 2;
+inline bool ProtectedTimestamp::has_end_key() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ProtectedTimestamp::set_has_end_key() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ProtectedTimestamp::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ProtectedTimestamp::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
+  }
+  clear_has_end_key();
+}
+inline const ::std::string& ProtectedTimestamp::end_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ProtectedTimestamp.end_key)
+  return *end_key_;
+}
+inline void ProtectedTimestamp::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.ProtectedTimestamp.end_key)
+}
+inline void ProtectedTimestamp::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.ProtectedTimestamp.end_key)
+}
+inline void ProtectedTimestamp::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.ProtectedTimestamp.end_key)
+}
+inline ::std::string* ProtectedTimestamp::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ProtectedTimestamp.end_key)
+  return end_key_;
+}
+inline ::std::string* ProtectedTimestamp::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void ProtectedTimestamp::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ProtectedTimestamp.end_key)
+}
+
+// optional .cockroach.proto.Timestamp timestamp = 3;
+inline bool ProtectedTimestamp::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ProtectedTimestamp::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ProtectedTimestamp::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ProtectedTimestamp::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& ProtectedTimestamp::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ProtectedTimestamp.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ProtectedTimestamp::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ProtectedTimestamp.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ProtectedTimestamp::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void ProtectedTimestamp::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ProtectedTimestamp.timestamp)
+}
+
+// optional .cockroach.proto.Timestamp expiration = 4;
+inline bool ProtectedTimestamp::has_expiration() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void ProtectedTimestamp::set_has_expiration() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void ProtectedTimestamp::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void ProtectedTimestamp::clear_expiration() {
+  if (expiration_ != NULL) expiration_->::cockroach::proto::Timestamp::Clear();
+  clear_has_expiration();
+}
+inline const ::cockroach::proto::Timestamp& ProtectedTimestamp::expiration() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ProtectedTimestamp.expiration)
+  return expiration_ != NULL ? *expiration_ : *default_instance_->expiration_;
+}
+inline ::cockroach::proto::Timestamp* ProtectedTimestamp::mutable_expiration() {
+  set_has_expiration();
+  if (expiration_ == NULL) expiration_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ProtectedTimestamp.expiration)
+  return expiration_;
+}
+inline ::cockroach::proto::Timestamp* ProtectedTimestamp::release_expiration() {
+  clear_has_expiration();
+  ::cockroach::proto::Timestamp* temp = expiration_;
+  expiration_ = NULL;
+  return temp;
+}
+inline void ProtectedTimestamp::set_allocated_expiration(::cockroach::proto::Timestamp* expiration) {
+  delete expiration_;
+  expiration_ = expiration;
+  if (expiration) {
+    set_has_expiration();
+  } else {
+    clear_has_expiration();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ProtectedTimestamp.expiration)
+}
+
 // -------------------------------------------------------------------
 
 // TimeSeriesDatapoint
@@ -57,6 +57,12 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* ConditionFailedError_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ConditionFailedError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* QuotaExceededError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  QuotaExceededError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* StoreBackpressureError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  StoreBackpressureError_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ErrorDetail_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ErrorDetail_reflection_ = NULL;
@@ -73,6 +79,8 @@ struct ErrorDetailOneofInstance {
   const ::cockroach::proto::WriteTooOldError* write_too_old_;
   const ::cockroach::proto::OpRequiresTxnError* op_requires_txn_;
   const ::cockroach::proto::ConditionFailedError* condition_failed_;
+  const ::cockroach::proto::QuotaExceededError* quota_exceeded_;
+  const ::cockroach::proto::StoreBackpressureError* store_backpressure_;
 }* ErrorDetail_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* Error_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -248,7 +256,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(WriteTooOldError));
   OpRequiresTxnError_descriptor_ = file->message_type(10);
-  static const int OpRequiresTxnError_offsets_[1] = {
+  static const int OpRequiresTxnError_offsets_[0] = {
   };
   OpRequiresTxnError_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -276,8 +284,42 @@ void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ConditionFailedError));
-  ErrorDetail_descriptor_ = file->message_type(12);
-  static const int ErrorDetail_offsets_[13] = {
+  QuotaExceededError_descriptor_ = file->message_type(12);
+  static const int QuotaExceededError_offsets_[4] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(QuotaExceededError, prefix_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(QuotaExceededError, quota_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(QuotaExceededError, limit_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(QuotaExceededError, usage_),
+  };
+  QuotaExceededError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      QuotaExceededError_descriptor_,
+      QuotaExceededError::default_instance_,
+      QuotaExceededError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(QuotaExceededError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(QuotaExceededError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(QuotaExceededError));
+  StoreBackpressureError_descriptor_ = file->message_type(13);
+  static const int StoreBackpressureError_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreBackpressureError, store_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreBackpressureError, reason_),
+  };
+  StoreBackpressureError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      StoreBackpressureError_descriptor_,
+      StoreBackpressureError::default_instance_,
+      StoreBackpressureError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreBackpressureError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreBackpressureError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(StoreBackpressureError));
+  ErrorDetail_descriptor_ = file->message_type(14);
+  static const int ErrorDetail_offsets_[15] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, not_leader_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, range_not_found_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, range_key_mismatch_),
@@ -290,6 +332,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, write_too_old_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, op_requires_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, condition_failed_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, quota_exceeded_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ErrorDetail_default_oneof_instance_, store_backpressure_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ErrorDetail, value_),
   };
   ErrorDetail_reflection_ =
@@ -305,7 +349,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ErrorDetail));
-  Error_descriptor_ = file->message_type(13);
+  Error_descriptor_ = file->message_type(15);
   static const int Error_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, message_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, retryable_),
@@ -360,6 +404,10 @@ void protobuf_RegisterTypes(const ::std::string&) {
     OpRequiresTxnError_descriptor_, &OpRequiresTxnError::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ConditionFailedError_descriptor_, &ConditionFailedError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    QuotaExceededError_descriptor_, &QuotaExceededError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    StoreBackpressureError_descriptor_, &StoreBackpressureError::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ErrorDetail_descriptor_, &ErrorDetail::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -393,6 +441,10 @@ void protobuf_ShutdownFile_cockroach_2fproto_2ferrors_2eproto() {
   delete OpRequiresTxnError_reflection_;
   delete ConditionFailedError::default_instance_;
   delete ConditionFailedError_reflection_;
+  delete QuotaExceededError::default_instance_;
+  delete QuotaExceededError_reflection_;
+  delete StoreBackpressureError::default_instance_;
+  delete StoreBackpressureError_reflection_;
   delete ErrorDetail::default_instance_;
   delete ErrorDetail_default_oneof_instance_;
   delete ErrorDetail_reflection_;
@@ -442,35 +494,43 @@ void protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto() {
     "ting_timestamp\030\002 \001(\0132\032.cockroach.proto.T"
     "imestampB\004\310\336\037\000\"\024\n\022OpRequiresTxnError\"D\n\024"
     "ConditionFailedError\022,\n\014actual_value\030\001 \001"
-    "(\0132\026.cockroach.proto.Value\"\314\006\n\013ErrorDeta"
-    "il\0225\n\nnot_leader\030\001 \001(\0132\037.cockroach.proto"
-    ".NotLeaderErrorH\000\022>\n\017range_not_found\030\002 \001"
-    "(\0132#.cockroach.proto.RangeNotFoundErrorH"
-    "\000\022D\n\022range_key_mismatch\030\003 \001(\0132&.cockroac"
-    "h.proto.RangeKeyMismatchErrorH\000\022_\n read_"
-    "within_uncertainty_interval\030\004 \001(\01323.cock"
-    "roach.proto.ReadWithinUncertaintyInterva"
-    "lErrorH\000\022G\n\023transaction_aborted\030\005 \001(\0132(."
-    "cockroach.proto.TransactionAbortedErrorH"
-    "\000\022A\n\020transaction_push\030\006 \001(\0132%.cockroach."
-    "proto.TransactionPushErrorH\000\022C\n\021transact"
-    "ion_retry\030\007 \001(\0132&.cockroach.proto.Transa"
-    "ctionRetryErrorH\000\022E\n\022transaction_status\030"
-    "\010 \001(\0132\'.cockroach.proto.TransactionStatu"
-    "sErrorH\000\0229\n\014write_intent\030\t \001(\0132!.cockroa"
-    "ch.proto.WriteIntentErrorH\000\022:\n\rwrite_too"
-    "_old\030\n \001(\0132!.cockroach.proto.WriteTooOld"
-    "ErrorH\000\022>\n\017op_requires_txn\030\013 \001(\0132#.cockr"
-    "oach.proto.OpRequiresTxnErrorH\000\022A\n\020condi"
-    "tion_failed\030\014 \001(\0132%.cockroach.proto.Cond"
-    "itionFailedErrorH\000:\004\310\240\037\001B\007\n\005value\"\255\001\n\005Er"
-    "ror\022\025\n\007message\030\001 \001(\tB\004\310\336\037\000\022\027\n\tretryable\030"
-    "\002 \001(\010B\004\310\336\037\000\022F\n\023transaction_restart\030\004 \001(\016"
-    "2#.cockroach.proto.TransactionRestartB\004\310"
-    "\336\037\000\022,\n\006detail\030\003 \001(\0132\034.cockroach.proto.Er"
-    "rorDetail*;\n\022TransactionRestart\022\t\n\005ABORT"
-    "\020\000\022\013\n\007BACKOFF\020\001\022\r\n\tIMMEDIATE\020\002B\023Z\005proto\340"
-    "\342\036\001\310\342\036\001\320\342\036\001", 2411);
+    "(\0132\026.cockroach.proto.Value\"p\n\022QuotaExcee"
+    "dedError\022\033\n\006prefix\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\023\n"
+    "\005quota\030\002 \001(\tB\004\310\336\037\000\022\023\n\005limit\030\003 \001(\003B\004\310\336\037\000\022"
+    "\023\n\005usage\030\004 \001(\003B\004\310\336\037\000\"\\\n\026StoreBackpressur"
+    "eError\022,\n\010store_id\030\001 \001(\005B\032\310\336\037\000\342\336\037\007StoreI"
+    "D\332\336\037\007StoreID\022\024\n\006reason\030\002 \001(\tB\004\310\336\037\000\"\322\007\n\013E"
+    "rrorDetail\0225\n\nnot_leader\030\001 \001(\0132\037.cockroa"
+    "ch.proto.NotLeaderErrorH\000\022>\n\017range_not_f"
+    "ound\030\002 \001(\0132#.cockroach.proto.RangeNotFou"
+    "ndErrorH\000\022D\n\022range_key_mismatch\030\003 \001(\0132&."
+    "cockroach.proto.RangeKeyMismatchErrorH\000\022"
+    "_\n read_within_uncertainty_interval\030\004 \001("
+    "\01323.cockroach.proto.ReadWithinUncertaint"
+    "yIntervalErrorH\000\022G\n\023transaction_aborted\030"
+    "\005 \001(\0132(.cockroach.proto.TransactionAbort"
+    "edErrorH\000\022A\n\020transaction_push\030\006 \001(\0132%.co"
+    "ckroach.proto.TransactionPushErrorH\000\022C\n\021"
+    "transaction_retry\030\007 \001(\0132&.cockroach.prot"
+    "o.TransactionRetryErrorH\000\022E\n\022transaction"
+    "_status\030\010 \001(\0132\'.cockroach.proto.Transact"
+    "ionStatusErrorH\000\0229\n\014write_intent\030\t \001(\0132!"
+    ".cockroach.proto.WriteIntentErrorH\000\022:\n\rw"
+    "rite_too_old\030\n \001(\0132!.cockroach.proto.Wri"
+    "teTooOldErrorH\000\022>\n\017op_requires_txn\030\013 \001(\013"
+    "2#.cockroach.proto.OpRequiresTxnErrorH\000\022"
+    "A\n\020condition_failed\030\014 \001(\0132%.cockroach.pr"
+    "oto.ConditionFailedErrorH\000\022=\n\016quota_exce"
+    "eded\030\r \001(\0132#.cockroach.proto.QuotaExceed"
+    "edErrorH\000\022E\n\022store_backpressure\030\016 \001(\0132\'."
+    "cockroach.proto.StoreBackpressureErrorH\000"
+    ":\004\310\240\037\001B\007\n\005value\"\255\001\n\005Error\022\025\n\007message\030\001 \001"
+    "(\tB\004\310\336\037\000\022\027\n\tretryable\030\002 \001(\010B\004\310\336\037\000\022F\n\023tra"
+    "nsaction_restart\030\004 \001(\0162#.cockroach.proto"
+    ".TransactionRestartB\004\310\336\037\000\022,\n\006detail\030\003 \001("
+    "\0132\034.cockroach.proto.ErrorDetail*;\n\022Trans"
+    "actionRestart\022\t\n\005ABORT\020\000\022\013\n\007BACKOFF\020\001\022\r\n"
+    "\tIMMEDIATE\020\002B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 2753);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/errors.proto", &protobuf_RegisterTypes);
   NotLeaderError::default_instance_ = new NotLeaderError();
@@ -485,6 +545,8 @@ void protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto() {
   WriteTooOldError::default_instance_ = new WriteTooOldError();
   OpRequiresTxnError::default_instance_ = new OpRequiresTxnError();
   ConditionFailedError::default_instance_ = new ConditionFailedError();
+  QuotaExceededError::default_instance_ = new QuotaExceededError();
+  StoreBackpressureError::default_instance_ = new StoreBackpressureError();
   ErrorDetail::default_instance_ = new ErrorDetail();
   ErrorDetail_default_oneof_instance_ = new ErrorDetailOneofInstance;
   Error::default_instance_ = new Error();
@@ -500,6 +562,8 @@ void protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto() {
   WriteTooOldError::default_instance_->InitAsDefaultInstance();
   OpRequiresTxnError::default_instance_->InitAsDefaultInstance();
   ConditionFailedError::default_instance_->InitAsDefaultInstance();
+  QuotaExceededError::default_instance_->InitAsDefaultInstance();
+  StoreBackpressureError::default_instance_->InitAsDefaultInstance();
   ErrorDetail::default_instance_->InitAsDefaultInstance();
   Error::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_cockroach_2fproto_2ferrors_2eproto);
@@ -3293,14 +3357,19 @@ bool OpRequiresTxnError::MergePartialFromCodedStream(
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
-  handle_unusual:
-    if (tag == 0 ||
-        ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
-        ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
-      goto success;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
     }
-    DO_(::google::protobuf::internal::WireFormat::SkipField(
-          input, tag, mutable_unknown_fields()));
   }
 success:
   // @@protoc_insertion_point(parse_success:cockroach.proto.OpRequiresTxnError)
@@ -3382,6 +3451,7 @@ bool OpRequiresTxnError::IsInitialized() const {
 
 void OpRequiresTxnError::Swap(OpRequiresTxnError* other) {
   if (other != this) {
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
@@ -3626,277 +3696,956 @@ void ConditionFailedError::Swap(ConditionFailedError* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ErrorDetail::kNotLeaderFieldNumber;
-const int ErrorDetail::kRangeNotFoundFieldNumber;
-const int ErrorDetail::kRangeKeyMismatchFieldNumber;
-const int ErrorDetail::kReadWithinUncertaintyIntervalFieldNumber;
-const int ErrorDetail::kTransactionAbortedFieldNumber;
-const int ErrorDetail::kTransactionPushFieldNumber;
-const int ErrorDetail::kTransactionRetryFieldNumber;
-const int ErrorDetail::kTransactionStatusFieldNumber;
-const int ErrorDetail::kWriteIntentFieldNumber;
-const int ErrorDetail::kWriteTooOldFieldNumber;
-const int ErrorDetail::kOpRequiresTxnFieldNumber;
-const int ErrorDetail::kConditionFailedFieldNumber;
+const int QuotaExceededError::kPrefixFieldNumber;
+const int QuotaExceededError::kQuotaFieldNumber;
+const int QuotaExceededError::kLimitFieldNumber;
+const int QuotaExceededError::kUsageFieldNumber;
 #endif  // !_MSC_VER
 
-ErrorDetail::ErrorDetail()
+QuotaExceededError::QuotaExceededError()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.ErrorDetail)
+  // @@protoc_insertion_point(constructor:cockroach.proto.QuotaExceededError)
 }
 
-void ErrorDetail::InitAsDefaultInstance() {
-  ErrorDetail_default_oneof_instance_->not_leader_ = const_cast< ::cockroach::proto::NotLeaderError*>(&::cockroach::proto::NotLeaderError::default_instance());
-  ErrorDetail_default_oneof_instance_->range_not_found_ = const_cast< ::cockroach::proto::RangeNotFoundError*>(&::cockroach::proto::RangeNotFoundError::default_instance());
-  ErrorDetail_default_oneof_instance_->range_key_mismatch_ = const_cast< ::cockroach::proto::RangeKeyMismatchError*>(&::cockroach::proto::RangeKeyMismatchError::default_instance());
-  ErrorDetail_default_oneof_instance_->read_within_uncertainty_interval_ = const_cast< ::cockroach::proto::ReadWithinUncertaintyIntervalError*>(&::cockroach::proto::ReadWithinUncertaintyIntervalError::default_instance());
-  ErrorDetail_default_oneof_instance_->transaction_aborted_ = const_cast< ::cockroach::proto::TransactionAbortedError*>(&::cockroach::proto::TransactionAbortedError::default_instance());
-  ErrorDetail_default_oneof_instance_->transaction_push_ = const_cast< ::cockroach::proto::TransactionPushError*>(&::cockroach::proto::TransactionPushError::default_instance());
-  ErrorDetail_default_oneof_instance_->transaction_retry_ = const_cast< ::cockroach::proto::TransactionRetryError*>(&::cockroach::proto::TransactionRetryError::default_instance());
-  ErrorDetail_default_oneof_instance_->transaction_status_ = const_cast< ::cockroach::proto::TransactionStatusError*>(&::cockroach::proto::TransactionStatusError::default_instance());
-  ErrorDetail_default_oneof_instance_->write_intent_ = const_cast< ::cockroach::proto::WriteIntentError*>(&::cockroach::proto::WriteIntentError::default_instance());
-  ErrorDetail_default_oneof_instance_->write_too_old_ = const_cast< ::cockroach::proto::WriteTooOldError*>(&::cockroach::proto::WriteTooOldError::default_instance());
-  ErrorDetail_default_oneof_instance_->op_requires_txn_ = const_cast< ::cockroach::proto::OpRequiresTxnError*>(&::cockroach::proto::OpRequiresTxnError::default_instance());
-  ErrorDetail_default_oneof_instance_->condition_failed_ = const_cast< ::cockroach::proto::ConditionFailedError*>(&::cockroach::proto::ConditionFailedError::default_instance());
+void QuotaExceededError::InitAsDefaultInstance() {
 }
 
-ErrorDetail::ErrorDetail(const ErrorDetail& from)
+QuotaExceededError::QuotaExceededError(const QuotaExceededError& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ErrorDetail)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.QuotaExceededError)
 }
 
-void ErrorDetail::SharedCtor() {
+void QuotaExceededError::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
+  prefix_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  quota_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  limit_ = GOOGLE_LONGLONG(0);
+  usage_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
-  clear_has_value();
 }
 
-ErrorDetail::~ErrorDetail() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.ErrorDetail)
+QuotaExceededError::~QuotaExceededError() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.QuotaExceededError)
   SharedDtor();
 }
 
-void ErrorDetail::SharedDtor() {
-  if (has_value()) {
-    clear_value();
+void QuotaExceededError::SharedDtor() {
+  if (prefix_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete prefix_;
+  }
+  if (quota_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete quota_;
   }
   if (this != default_instance_) {
   }
 }
 
-void ErrorDetail::SetCachedSize(int size) const {
+void QuotaExceededError::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ErrorDetail::descriptor() {
+const ::google::protobuf::Descriptor* QuotaExceededError::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ErrorDetail_descriptor_;
+  return QuotaExceededError_descriptor_;
 }
 
-const ErrorDetail& ErrorDetail::default_instance() {
+const QuotaExceededError& QuotaExceededError::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto();
   return *default_instance_;
 }
 
-ErrorDetail* ErrorDetail::default_instance_ = NULL;
+QuotaExceededError* QuotaExceededError::default_instance_ = NULL;
 
-ErrorDetail* ErrorDetail::New() const {
-  return new ErrorDetail;
+QuotaExceededError* QuotaExceededError::New() const {
+  return new QuotaExceededError;
 }
 
-void ErrorDetail::clear_value() {
-  switch(value_case()) {
-    case kNotLeader: {
-      delete value_.not_leader_;
-      break;
-    }
-    case kRangeNotFound: {
-      delete value_.range_not_found_;
-      break;
-    }
-    case kRangeKeyMismatch: {
-      delete value_.range_key_mismatch_;
-      break;
-    }
-    case kReadWithinUncertaintyInterval: {
-      delete value_.read_within_uncertainty_interval_;
-      break;
-    }
-    case kTransactionAborted: {
-      delete value_.transaction_aborted_;
-      break;
-    }
-    case kTransactionPush: {
-      delete value_.transaction_push_;
-      break;
-    }
-    case kTransactionRetry: {
-      delete value_.transaction_retry_;
-      break;
-    }
-    case kTransactionStatus: {
-      delete value_.transaction_status_;
-      break;
-    }
-    case kWriteIntent: {
-      delete value_.write_intent_;
-      break;
-    }
-    case kWriteTooOld: {
-      delete value_.write_too_old_;
-      break;
-    }
-    case kOpRequiresTxn: {
-      delete value_.op_requires_txn_;
-      break;
-    }
-    case kConditionFailed: {
-      delete value_.condition_failed_;
-      break;
+void QuotaExceededError::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<QuotaExceededError*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 15) {
+    ZR_(limit_, usage_);
+    if (has_prefix()) {
+      if (prefix_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        prefix_->clear();
+      }
     }
-    case VALUE_NOT_SET: {
-      break;
+    if (has_quota()) {
+      if (quota_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        quota_->clear();
+      }
     }
   }
-  _oneof_case_[0] = VALUE_NOT_SET;
-}
 
+#undef OFFSET_OF_FIELD_
+#undef ZR_
 
-void ErrorDetail::Clear() {
-  clear_value();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ErrorDetail::MergePartialFromCodedStream(
+bool QuotaExceededError::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.ErrorDetail)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.QuotaExceededError)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.NotLeaderError not_leader = 1;
+      // optional bytes prefix = 1;
       case 1: {
         if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_not_leader()));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_prefix()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_range_not_found;
+        if (input->ExpectTag(18)) goto parse_quota;
         break;
       }
 
-      // optional .cockroach.proto.RangeNotFoundError range_not_found = 2;
+      // optional string quota = 2;
       case 2: {
         if (tag == 18) {
-         parse_range_not_found:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_range_not_found()));
+         parse_quota:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_quota()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->quota().data(), this->quota().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "quota");
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_range_key_mismatch;
+        if (input->ExpectTag(24)) goto parse_limit;
         break;
       }
 
-      // optional .cockroach.proto.RangeKeyMismatchError range_key_mismatch = 3;
+      // optional int64 limit = 3;
       case 3: {
-        if (tag == 26) {
-         parse_range_key_mismatch:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_range_key_mismatch()));
+        if (tag == 24) {
+         parse_limit:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &limit_)));
+          set_has_limit();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(34)) goto parse_read_within_uncertainty_interval;
+        if (input->ExpectTag(32)) goto parse_usage;
         break;
       }
 
-      // optional .cockroach.proto.ReadWithinUncertaintyIntervalError read_within_uncertainty_interval = 4;
+      // optional int64 usage = 4;
       case 4: {
-        if (tag == 34) {
-         parse_read_within_uncertainty_interval:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_read_within_uncertainty_interval()));
+        if (tag == 32) {
+         parse_usage:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &usage_)));
+          set_has_usage();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(42)) goto parse_transaction_aborted;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.TransactionAbortedError transaction_aborted = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_transaction_aborted:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_transaction_aborted()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(50)) goto parse_transaction_push;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.QuotaExceededError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.QuotaExceededError)
+  return false;
+#undef DO_
+}
 
-      // optional .cockroach.proto.TransactionPushError transaction_push = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_transaction_push:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_transaction_push()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(58)) goto parse_transaction_retry;
-        break;
-      }
+void QuotaExceededError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.QuotaExceededError)
+  // optional bytes prefix = 1;
+  if (has_prefix()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->prefix(), output);
+  }
 
-      // optional .cockroach.proto.TransactionRetryError transaction_retry = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_transaction_retry:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_transaction_retry()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(66)) goto parse_transaction_status;
-        break;
-      }
+  // optional string quota = 2;
+  if (has_quota()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->quota().data(), this->quota().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "quota");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->quota(), output);
+  }
 
-      // optional .cockroach.proto.TransactionStatusError transaction_status = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_transaction_status:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_transaction_status()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(74)) goto parse_write_intent;
-        break;
-      }
+  // optional int64 limit = 3;
+  if (has_limit()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->limit(), output);
+  }
 
-      // optional .cockroach.proto.WriteIntentError write_intent = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_write_intent:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_write_intent()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(82)) goto parse_write_too_old;
-        break;
-      }
+  // optional int64 usage = 4;
+  if (has_usage()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->usage(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.QuotaExceededError)
+}
+
+::google::protobuf::uint8* QuotaExceededError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.QuotaExceededError)
+  // optional bytes prefix = 1;
+  if (has_prefix()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->prefix(), target);
+  }
+
+  // optional string quota = 2;
+  if (has_quota()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->quota().data(), this->quota().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "quota");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->quota(), target);
+  }
+
+  // optional int64 limit = 3;
+  if (has_limit()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->limit(), target);
+  }
+
+  // optional int64 usage = 4;
+  if (has_usage()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->usage(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.QuotaExceededError)
+  return target;
+}
+
+int QuotaExceededError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional bytes prefix = 1;
+    if (has_prefix()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->prefix());
+    }
+
+    // optional string quota = 2;
+    if (has_quota()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->quota());
+    }
+
+    // optional int64 limit = 3;
+    if (has_limit()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->limit());
+    }
+
+    // optional int64 usage = 4;
+    if (has_usage()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->usage());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void QuotaExceededError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const QuotaExceededError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const QuotaExceededError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void QuotaExceededError::MergeFrom(const QuotaExceededError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_prefix()) {
+      set_prefix(from.prefix());
+    }
+    if (from.has_quota()) {
+      set_quota(from.quota());
+    }
+    if (from.has_limit()) {
+      set_limit(from.limit());
+    }
+    if (from.has_usage()) {
+      set_usage(from.usage());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void QuotaExceededError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void QuotaExceededError::CopyFrom(const QuotaExceededError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool QuotaExceededError::IsInitialized() const {
+
+  return true;
+}
+
+void QuotaExceededError::Swap(QuotaExceededError* other) {
+  if (other != this) {
+    std::swap(prefix_, other->prefix_);
+    std::swap(quota_, other->quota_);
+    std::swap(limit_, other->limit_);
+    std::swap(usage_, other->usage_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata QuotaExceededError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = QuotaExceededError_descriptor_;
+  metadata.reflection = QuotaExceededError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int StoreBackpressureError::kStoreIdFieldNumber;
+const int StoreBackpressureError::kReasonFieldNumber;
+#endif  // !_MSC_VER
+
+StoreBackpressureError::StoreBackpressureError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.StoreBackpressureError)
+}
+
+void StoreBackpressureError::InitAsDefaultInstance() {
+}
+
+StoreBackpressureError::StoreBackpressureError(const StoreBackpressureError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.StoreBackpressureError)
+}
+
+void StoreBackpressureError::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  store_id_ = 0;
+  reason_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+StoreBackpressureError::~StoreBackpressureError() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.StoreBackpressureError)
+  SharedDtor();
+}
+
+void StoreBackpressureError::SharedDtor() {
+  if (reason_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete reason_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void StoreBackpressureError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* StoreBackpressureError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return StoreBackpressureError_descriptor_;
+}
+
+const StoreBackpressureError& StoreBackpressureError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto();
+  return *default_instance_;
+}
+
+StoreBackpressureError* StoreBackpressureError::default_instance_ = NULL;
+
+StoreBackpressureError* StoreBackpressureError::New() const {
+  return new StoreBackpressureError;
+}
+
+void StoreBackpressureError::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    store_id_ = 0;
+    if (has_reason()) {
+      if (reason_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        reason_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool StoreBackpressureError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.StoreBackpressureError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int32 store_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &store_id_)));
+          set_has_store_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_reason;
+        break;
+      }
+
+      // optional string reason = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_reason:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_reason()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->reason().data(), this->reason().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "reason");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.StoreBackpressureError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.StoreBackpressureError)
+  return false;
+#undef DO_
+}
+
+void StoreBackpressureError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.StoreBackpressureError)
+  // optional int32 store_id = 1;
+  if (has_store_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(1, this->store_id(), output);
+  }
+
+  // optional string reason = 2;
+  if (has_reason()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->reason().data(), this->reason().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "reason");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->reason(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.StoreBackpressureError)
+}
+
+::google::protobuf::uint8* StoreBackpressureError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.StoreBackpressureError)
+  // optional int32 store_id = 1;
+  if (has_store_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(1, this->store_id(), target);
+  }
+
+  // optional string reason = 2;
+  if (has_reason()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->reason().data(), this->reason().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "reason");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->reason(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.StoreBackpressureError)
+  return target;
+}
+
+int StoreBackpressureError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int32 store_id = 1;
+    if (has_store_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->store_id());
+    }
+
+    // optional string reason = 2;
+    if (has_reason()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->reason());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void StoreBackpressureError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const StoreBackpressureError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const StoreBackpressureError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void StoreBackpressureError::MergeFrom(const StoreBackpressureError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_store_id()) {
+      set_store_id(from.store_id());
+    }
+    if (from.has_reason()) {
+      set_reason(from.reason());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void StoreBackpressureError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void StoreBackpressureError::CopyFrom(const StoreBackpressureError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool StoreBackpressureError::IsInitialized() const {
+
+  return true;
+}
+
+void StoreBackpressureError::Swap(StoreBackpressureError* other) {
+  if (other != this) {
+    std::swap(store_id_, other->store_id_);
+    std::swap(reason_, other->reason_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata StoreBackpressureError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = StoreBackpressureError_descriptor_;
+  metadata.reflection = StoreBackpressureError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ErrorDetail::kNotLeaderFieldNumber;
+const int ErrorDetail::kRangeNotFoundFieldNumber;
+const int ErrorDetail::kRangeKeyMismatchFieldNumber;
+const int ErrorDetail::kReadWithinUncertaintyIntervalFieldNumber;
+const int ErrorDetail::kTransactionAbortedFieldNumber;
+const int ErrorDetail::kTransactionPushFieldNumber;
+const int ErrorDetail::kTransactionRetryFieldNumber;
+const int ErrorDetail::kTransactionStatusFieldNumber;
+const int ErrorDetail::kWriteIntentFieldNumber;
+const int ErrorDetail::kWriteTooOldFieldNumber;
+const int ErrorDetail::kOpRequiresTxnFieldNumber;
+const int ErrorDetail::kConditionFailedFieldNumber;
+const int ErrorDetail::kQuotaExceededFieldNumber;
+const int ErrorDetail::kStoreBackpressureFieldNumber;
+#endif  // !_MSC_VER
+
+ErrorDetail::ErrorDetail()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ErrorDetail)
+}
+
+void ErrorDetail::InitAsDefaultInstance() {
+  ErrorDetail_default_oneof_instance_->not_leader_ = const_cast< ::cockroach::proto::NotLeaderError*>(&::cockroach::proto::NotLeaderError::default_instance());
+  ErrorDetail_default_oneof_instance_->range_not_found_ = const_cast< ::cockroach::proto::RangeNotFoundError*>(&::cockroach::proto::RangeNotFoundError::default_instance());
+  ErrorDetail_default_oneof_instance_->range_key_mismatch_ = const_cast< ::cockroach::proto::RangeKeyMismatchError*>(&::cockroach::proto::RangeKeyMismatchError::default_instance());
+  ErrorDetail_default_oneof_instance_->read_within_uncertainty_interval_ = const_cast< ::cockroach::proto::ReadWithinUncertaintyIntervalError*>(&::cockroach::proto::ReadWithinUncertaintyIntervalError::default_instance());
+  ErrorDetail_default_oneof_instance_->transaction_aborted_ = const_cast< ::cockroach::proto::TransactionAbortedError*>(&::cockroach::proto::TransactionAbortedError::default_instance());
+  ErrorDetail_default_oneof_instance_->transaction_push_ = const_cast< ::cockroach::proto::TransactionPushError*>(&::cockroach::proto::TransactionPushError::default_instance());
+  ErrorDetail_default_oneof_instance_->transaction_retry_ = const_cast< ::cockroach::proto::TransactionRetryError*>(&::cockroach::proto::TransactionRetryError::default_instance());
+  ErrorDetail_default_oneof_instance_->transaction_status_ = const_cast< ::cockroach::proto::TransactionStatusError*>(&::cockroach::proto::TransactionStatusError::default_instance());
+  ErrorDetail_default_oneof_instance_->write_intent_ = const_cast< ::cockroach::proto::WriteIntentError*>(&::cockroach::proto::WriteIntentError::default_instance());
+  ErrorDetail_default_oneof_instance_->write_too_old_ = const_cast< ::cockroach::proto::WriteTooOldError*>(&::cockroach::proto::WriteTooOldError::default_instance());
+  ErrorDetail_default_oneof_instance_->op_requires_txn_ = const_cast< ::cockroach::proto::OpRequiresTxnError*>(&::cockroach::proto::OpRequiresTxnError::default_instance());
+  ErrorDetail_default_oneof_instance_->condition_failed_ = const_cast< ::cockroach::proto::ConditionFailedError*>(&::cockroach::proto::ConditionFailedError::default_instance());
+  ErrorDetail_default_oneof_instance_->quota_exceeded_ = const_cast< ::cockroach::proto::QuotaExceededError*>(&::cockroach::proto::QuotaExceededError::default_instance());
+  ErrorDetail_default_oneof_instance_->store_backpressure_ = const_cast< ::cockroach::proto::StoreBackpressureError*>(&::cockroach::proto::StoreBackpressureError::default_instance());
+}
+
+ErrorDetail::ErrorDetail(const ErrorDetail& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ErrorDetail)
+}
+
+void ErrorDetail::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  clear_has_value();
+}
+
+ErrorDetail::~ErrorDetail() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ErrorDetail)
+  SharedDtor();
+}
+
+void ErrorDetail::SharedDtor() {
+  if (has_value()) {
+    clear_value();
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void ErrorDetail::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ErrorDetail::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ErrorDetail_descriptor_;
+}
+
+const ErrorDetail& ErrorDetail::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto();
+  return *default_instance_;
+}
+
+ErrorDetail* ErrorDetail::default_instance_ = NULL;
+
+ErrorDetail* ErrorDetail::New() const {
+  return new ErrorDetail;
+}
+
+void ErrorDetail::clear_value() {
+  switch(value_case()) {
+    case kNotLeader: {
+      delete value_.not_leader_;
+      break;
+    }
+    case kRangeNotFound: {
+      delete value_.range_not_found_;
+      break;
+    }
+    case kRangeKeyMismatch: {
+      delete value_.range_key_mismatch_;
+      break;
+    }
+    case kReadWithinUncertaintyInterval: {
+      delete value_.read_within_uncertainty_interval_;
+      break;
+    }
+    case kTransactionAborted: {
+      delete value_.transaction_aborted_;
+      break;
+    }
+    case kTransactionPush: {
+      delete value_.transaction_push_;
+      break;
+    }
+    case kTransactionRetry: {
+      delete value_.transaction_retry_;
+      break;
+    }
+    case kTransactionStatus: {
+      delete value_.transaction_status_;
+      break;
+    }
+    case kWriteIntent: {
+      delete value_.write_intent_;
+      break;
+    }
+    case kWriteTooOld: {
+      delete value_.write_too_old_;
+      break;
+    }
+    case kOpRequiresTxn: {
+      delete value_.op_requires_txn_;
+      break;
+    }
+    case kConditionFailed: {
+      delete value_.condition_failed_;
+      break;
+    }
+    case kQuotaExceeded: {
+      delete value_.quota_exceeded_;
+      break;
+    }
+    case kStoreBackpressure: {
+      delete value_.store_backpressure_;
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  _oneof_case_[0] = VALUE_NOT_SET;
+}
+
+
+void ErrorDetail::Clear() {
+  clear_value();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ErrorDetail::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ErrorDetail)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.NotLeaderError not_leader = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_not_leader()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_range_not_found;
+        break;
+      }
+
+      // optional .cockroach.proto.RangeNotFoundError range_not_found = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_range_not_found:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_range_not_found()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_range_key_mismatch;
+        break;
+      }
+
+      // optional .cockroach.proto.RangeKeyMismatchError range_key_mismatch = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_range_key_mismatch:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_range_key_mismatch()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_read_within_uncertainty_interval;
+        break;
+      }
+
+      // optional .cockroach.proto.ReadWithinUncertaintyIntervalError read_within_uncertainty_interval = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_read_within_uncertainty_interval:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_read_within_uncertainty_interval()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_transaction_aborted;
+        break;
+      }
+
+      // optional .cockroach.proto.TransactionAbortedError transaction_aborted = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_transaction_aborted:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_transaction_aborted()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_transaction_push;
+        break;
+      }
+
+      // optional .cockroach.proto.TransactionPushError transaction_push = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_transaction_push:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_transaction_push()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_transaction_retry;
+        break;
+      }
+
+      // optional .cockroach.proto.TransactionRetryError transaction_retry = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_transaction_retry:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_transaction_retry()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_transaction_status;
+        break;
+      }
+
+      // optional .cockroach.proto.TransactionStatusError transaction_status = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_transaction_status:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_transaction_status()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_write_intent;
+        break;
+      }
+
+      // optional .cockroach.proto.WriteIntentError write_intent = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_write_intent:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_write_intent()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_write_too_old;
+        break;
+      }
 
       // optional .cockroach.proto.WriteTooOldError write_too_old = 10;
       case 10: {
@@ -3933,6 +4682,32 @@ bool ErrorDetail::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(106)) goto parse_quota_exceeded;
+        break;
+      }
+
+      // optional .cockroach.proto.QuotaExceededError quota_exceeded = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_quota_exceeded:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_quota_exceeded()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_store_backpressure;
+        break;
+      }
+
+      // optional .cockroach.proto.StoreBackpressureError store_backpressure = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_store_backpressure:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_store_backpressure()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -4034,6 +4809,18 @@ void ErrorDetail::SerializeWithCachedSizes(
       12, this->condition_failed(), output);
   }
 
+  // optional .cockroach.proto.QuotaExceededError quota_exceeded = 13;
+  if (has_quota_exceeded()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, this->quota_exceeded(), output);
+  }
+
+  // optional .cockroach.proto.StoreBackpressureError store_backpressure = 14;
+  if (has_store_backpressure()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->store_backpressure(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -4128,6 +4915,20 @@ void ErrorDetail::SerializeWithCachedSizes(
         12, this->condition_failed(), target);
   }
 
+  // optional .cockroach.proto.QuotaExceededError quota_exceeded = 13;
+  if (has_quota_exceeded()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, this->quota_exceeded(), target);
+  }
+
+  // optional .cockroach.proto.StoreBackpressureError store_backpressure = 14;
+  if (has_store_backpressure()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->store_backpressure(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -4224,6 +5025,20 @@ int ErrorDetail::ByteSize() const {
           this->condition_failed());
       break;
     }
+    // optional .cockroach.proto.QuotaExceededError quota_exceeded = 13;
+    case kQuotaExceeded: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->quota_exceeded());
+      break;
+    }
+    // optional .cockroach.proto.StoreBackpressureError store_backpressure = 14;
+    case kStoreBackpressure: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->store_backpressure());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -4302,6 +5117,14 @@ void ErrorDetail::MergeFrom(const ErrorDetail& from) {
       mutable_condition_failed()->::cockroach::proto::ConditionFailedError::MergeFrom(from.condition_failed());
       break;
     }
+    case kQuotaExceeded: {
+      mutable_quota_exceeded()->::cockroach::proto::QuotaExceededError::MergeFrom(from.quota_exceeded());
+      break;
+    }
+    case kStoreBackpressure: {
+      mutable_store_backpressure()->::cockroach::proto::StoreBackpressureError::MergeFrom(from.store_backpressure());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
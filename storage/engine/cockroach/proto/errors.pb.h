@@ -50,6 +50,8 @@ class WriteIntentError;
 class WriteTooOldError;
 class OpRequiresTxnError;
 class ConditionFailedError;
+class QuotaExceededError;
+class StoreBackpressureError;
 class ErrorDetail;
 class Error;
 
@@ -1151,6 +1153,219 @@ class ConditionFailedError : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class QuotaExceededError : public ::google::protobuf::Message {
+ public:
+  QuotaExceededError();
+  virtual ~QuotaExceededError();
+
+  QuotaExceededError(const QuotaExceededError& from);
+
+  inline QuotaExceededError& operator=(const QuotaExceededError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const QuotaExceededError& default_instance();
+
+  void Swap(QuotaExceededError* other);
+
+  // implements Message ----------------------------------------------
+
+  QuotaExceededError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const QuotaExceededError& from);
+  void MergeFrom(const QuotaExceededError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes prefix = 1;
+  inline bool has_prefix() const;
+  inline void clear_prefix();
+  static const int kPrefixFieldNumber = 1;
+  inline const ::std::string& prefix() const;
+  inline void set_prefix(const ::std::string& value);
+  inline void set_prefix(const char* value);
+  inline void set_prefix(const void* value, size_t size);
+  inline ::std::string* mutable_prefix();
+  inline ::std::string* release_prefix();
+  inline void set_allocated_prefix(::std::string* prefix);
+
+  // optional string quota = 2;
+  inline bool has_quota() const;
+  inline void clear_quota();
+  static const int kQuotaFieldNumber = 2;
+  inline const ::std::string& quota() const;
+  inline void set_quota(const ::std::string& value);
+  inline void set_quota(const char* value);
+  inline void set_quota(const char* value, size_t size);
+  inline ::std::string* mutable_quota();
+  inline ::std::string* release_quota();
+  inline void set_allocated_quota(::std::string* quota);
+
+  // optional int64 limit = 3;
+  inline bool has_limit() const;
+  inline void clear_limit();
+  static const int kLimitFieldNumber = 3;
+  inline ::google::protobuf::int64 limit() const;
+  inline void set_limit(::google::protobuf::int64 value);
+
+  // optional int64 usage = 4;
+  inline bool has_usage() const;
+  inline void clear_usage();
+  static const int kUsageFieldNumber = 4;
+  inline ::google::protobuf::int64 usage() const;
+  inline void set_usage(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.QuotaExceededError)
+ private:
+  inline void set_has_prefix();
+  inline void clear_has_prefix();
+  inline void set_has_quota();
+  inline void clear_has_quota();
+  inline void set_has_limit();
+  inline void clear_has_limit();
+  inline void set_has_usage();
+  inline void clear_has_usage();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* prefix_;
+  ::std::string* quota_;
+  ::google::protobuf::int64 limit_;
+  ::google::protobuf::int64 usage_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2ferrors_2eproto();
+
+  void InitAsDefaultInstance();
+  static QuotaExceededError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class StoreBackpressureError : public ::google::protobuf::Message {
+ public:
+  StoreBackpressureError();
+  virtual ~StoreBackpressureError();
+
+  StoreBackpressureError(const StoreBackpressureError& from);
+
+  inline StoreBackpressureError& operator=(const StoreBackpressureError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const StoreBackpressureError& default_instance();
+
+  void Swap(StoreBackpressureError* other);
+
+  // implements Message ----------------------------------------------
+
+  StoreBackpressureError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const StoreBackpressureError& from);
+  void MergeFrom(const StoreBackpressureError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int32 store_id = 1;
+  inline bool has_store_id() const;
+  inline void clear_store_id();
+  static const int kStoreIdFieldNumber = 1;
+  inline ::google::protobuf::int32 store_id() const;
+  inline void set_store_id(::google::protobuf::int32 value);
+
+  // optional string reason = 2;
+  inline bool has_reason() const;
+  inline void clear_reason();
+  static const int kReasonFieldNumber = 2;
+  inline const ::std::string& reason() const;
+  inline void set_reason(const ::std::string& value);
+  inline void set_reason(const char* value);
+  inline void set_reason(const char* value, size_t size);
+  inline ::std::string* mutable_reason();
+  inline ::std::string* release_reason();
+  inline void set_allocated_reason(::std::string* reason);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.StoreBackpressureError)
+ private:
+  inline void set_has_store_id();
+  inline void clear_has_store_id();
+  inline void set_has_reason();
+  inline void clear_has_reason();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* reason_;
+  ::google::protobuf::int32 store_id_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2ferrors_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2ferrors_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2ferrors_2eproto();
+
+  void InitAsDefaultInstance();
+  static StoreBackpressureError* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class ErrorDetail : public ::google::protobuf::Message {
  public:
   ErrorDetail();
@@ -1187,6 +1402,8 @@ class ErrorDetail : public ::google::protobuf::Message {
     kWriteTooOld = 10,
     kOpRequiresTxn = 11,
     kConditionFailed = 12,
+    kQuotaExceeded = 13,
+    kStoreBackpressure = 14,
     VALUE_NOT_SET = 0,
   };
 
@@ -1328,6 +1545,24 @@ class ErrorDetail : public ::google::protobuf::Message {
   inline ::cockroach::proto::ConditionFailedError* release_condition_failed();
   inline void set_allocated_condition_failed(::cockroach::proto::ConditionFailedError* condition_failed);
 
+  // optional .cockroach.proto.QuotaExceededError quota_exceeded = 13;
+  inline bool has_quota_exceeded() const;
+  inline void clear_quota_exceeded();
+  static const int kQuotaExceededFieldNumber = 13;
+  inline const ::cockroach::proto::QuotaExceededError& quota_exceeded() const;
+  inline ::cockroach::proto::QuotaExceededError* mutable_quota_exceeded();
+  inline ::cockroach::proto::QuotaExceededError* release_quota_exceeded();
+  inline void set_allocated_quota_exceeded(::cockroach::proto::QuotaExceededError* quota_exceeded);
+
+  // optional .cockroach.proto.StoreBackpressureError store_backpressure = 14;
+  inline bool has_store_backpressure() const;
+  inline void clear_store_backpressure();
+  static const int kStoreBackpressureFieldNumber = 14;
+  inline const ::cockroach::proto::StoreBackpressureError& store_backpressure() const;
+  inline ::cockroach::proto::StoreBackpressureError* mutable_store_backpressure();
+  inline ::cockroach::proto::StoreBackpressureError* release_store_backpressure();
+  inline void set_allocated_store_backpressure(::cockroach::proto::StoreBackpressureError* store_backpressure);
+
   inline ValueCase value_case() const;
   // @@protoc_insertion_point(class_scope:cockroach.proto.ErrorDetail)
  private:
@@ -1343,6 +1578,8 @@ class ErrorDetail : public ::google::protobuf::Message {
   inline void set_has_write_too_old();
   inline void set_has_op_requires_txn();
   inline void set_has_condition_failed();
+  inline void set_has_quota_exceeded();
+  inline void set_has_store_backpressure();
 
   inline bool has_value();
   void clear_value();
@@ -1365,6 +1602,8 @@ class ErrorDetail : public ::google::protobuf::Message {
     ::cockroach::proto::WriteTooOldError* write_too_old_;
     ::cockroach::proto::OpRequiresTxnError* op_requires_txn_;
     ::cockroach::proto::ConditionFailedError* condition_failed_;
+    ::cockroach::proto::QuotaExceededError* quota_exceeded_;
+    ::cockroach::proto::StoreBackpressureError* store_backpressure_;
   } value_;
   ::google::protobuf::uint32 _oneof_case_[1];
 
@@ -2423,6 +2662,7 @@ inline void WriteTooOldError::set_allocated_existing_timestamp(::cockroach::prot
 
 // OpRequiresTxnError
 
+
 // -------------------------------------------------------------------
 
 // ConditionFailedError
@@ -2470,6 +2710,314 @@ inline void ConditionFailedError::set_allocated_actual_value(::cockroach::proto:
 
 // -------------------------------------------------------------------
 
+// QuotaExceededError
+
+// optional bytes prefix = 1;
+inline bool QuotaExceededError::has_prefix() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void QuotaExceededError::set_has_prefix() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void QuotaExceededError::clear_has_prefix() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void QuotaExceededError::clear_prefix() {
+  if (prefix_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    prefix_->clear();
+  }
+  clear_has_prefix();
+}
+inline const ::std::string& QuotaExceededError::prefix() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.QuotaExceededError.prefix)
+  return *prefix_;
+}
+inline void QuotaExceededError::set_prefix(const ::std::string& value) {
+  set_has_prefix();
+  if (prefix_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    prefix_ = new ::std::string;
+  }
+  prefix_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.QuotaExceededError.prefix)
+}
+inline void QuotaExceededError::set_prefix(const char* value) {
+  set_has_prefix();
+  if (prefix_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    prefix_ = new ::std::string;
+  }
+  prefix_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.QuotaExceededError.prefix)
+}
+inline void QuotaExceededError::set_prefix(const void* value, size_t size) {
+  set_has_prefix();
+  if (prefix_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    prefix_ = new ::std::string;
+  }
+  prefix_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.QuotaExceededError.prefix)
+}
+inline ::std::string* QuotaExceededError::mutable_prefix() {
+  set_has_prefix();
+  if (prefix_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    prefix_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.QuotaExceededError.prefix)
+  return prefix_;
+}
+inline ::std::string* QuotaExceededError::release_prefix() {
+  clear_has_prefix();
+  if (prefix_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = prefix_;
+    prefix_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void QuotaExceededError::set_allocated_prefix(::std::string* prefix) {
+  if (prefix_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete prefix_;
+  }
+  if (prefix) {
+    set_has_prefix();
+    prefix_ = prefix;
+  } else {
+    clear_has_prefix();
+    prefix_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.QuotaExceededError.prefix)
+}
+
+// optional string quota = 2;
+inline bool QuotaExceededError::has_quota() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void QuotaExceededError::set_has_quota() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void QuotaExceededError::clear_has_quota() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void QuotaExceededError::clear_quota() {
+  if (quota_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    quota_->clear();
+  }
+  clear_has_quota();
+}
+inline const ::std::string& QuotaExceededError::quota() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.QuotaExceededError.quota)
+  return *quota_;
+}
+inline void QuotaExceededError::set_quota(const ::std::string& value) {
+  set_has_quota();
+  if (quota_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    quota_ = new ::std::string;
+  }
+  quota_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.QuotaExceededError.quota)
+}
+inline void QuotaExceededError::set_quota(const char* value) {
+  set_has_quota();
+  if (quota_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    quota_ = new ::std::string;
+  }
+  quota_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.QuotaExceededError.quota)
+}
+inline void QuotaExceededError::set_quota(const char* value, size_t size) {
+  set_has_quota();
+  if (quota_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    quota_ = new ::std::string;
+  }
+  quota_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.QuotaExceededError.quota)
+}
+inline ::std::string* QuotaExceededError::mutable_quota() {
+  set_has_quota();
+  if (quota_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    quota_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.QuotaExceededError.quota)
+  return quota_;
+}
+inline ::std::string* QuotaExceededError::release_quota() {
+  clear_has_quota();
+  if (quota_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = quota_;
+    quota_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void QuotaExceededError::set_allocated_quota(::std::string* quota) {
+  if (quota_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete quota_;
+  }
+  if (quota) {
+    set_has_quota();
+    quota_ = quota;
+  } else {
+    clear_has_quota();
+    quota_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.QuotaExceededError.quota)
+}
+
+// optional int64 limit = 3;
+inline bool QuotaExceededError::has_limit() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void QuotaExceededError::set_has_limit() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void QuotaExceededError::clear_has_limit() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void QuotaExceededError::clear_limit() {
+  limit_ = GOOGLE_LONGLONG(0);
+  clear_has_limit();
+}
+inline ::google::protobuf::int64 QuotaExceededError::limit() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.QuotaExceededError.limit)
+  return limit_;
+}
+inline void QuotaExceededError::set_limit(::google::protobuf::int64 value) {
+  set_has_limit();
+  limit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.QuotaExceededError.limit)
+}
+
+// optional int64 usage = 4;
+inline bool QuotaExceededError::has_usage() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void QuotaExceededError::set_has_usage() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void QuotaExceededError::clear_has_usage() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void QuotaExceededError::clear_usage() {
+  usage_ = GOOGLE_LONGLONG(0);
+  clear_has_usage();
+}
+inline ::google::protobuf::int64 QuotaExceededError::usage() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.QuotaExceededError.usage)
+  return usage_;
+}
+inline void QuotaExceededError::set_usage(::google::protobuf::int64 value) {
+  set_has_usage();
+  usage_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.QuotaExceededError.usage)
+}
+
+// -------------------------------------------------------------------
+
+// StoreBackpressureError
+
+// optional int32 store_id = 1;
+inline bool StoreBackpressureError::has_store_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void StoreBackpressureError::set_has_store_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void StoreBackpressureError::clear_has_store_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void StoreBackpressureError::clear_store_id() {
+  store_id_ = 0;
+  clear_has_store_id();
+}
+inline ::google::protobuf::int32 StoreBackpressureError::store_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreBackpressureError.store_id)
+  return store_id_;
+}
+inline void StoreBackpressureError::set_store_id(::google::protobuf::int32 value) {
+  set_has_store_id();
+  store_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreBackpressureError.store_id)
+}
+
+// optional string reason = 2;
+inline bool StoreBackpressureError::has_reason() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void StoreBackpressureError::set_has_reason() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void StoreBackpressureError::clear_has_reason() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void StoreBackpressureError::clear_reason() {
+  if (reason_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    reason_->clear();
+  }
+  clear_has_reason();
+}
+inline const ::std::string& StoreBackpressureError::reason() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.StoreBackpressureError.reason)
+  return *reason_;
+}
+inline void StoreBackpressureError::set_reason(const ::std::string& value) {
+  set_has_reason();
+  if (reason_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    reason_ = new ::std::string;
+  }
+  reason_->assign(value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.StoreBackpressureError.reason)
+}
+inline void StoreBackpressureError::set_reason(const char* value) {
+  set_has_reason();
+  if (reason_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    reason_ = new ::std::string;
+  }
+  reason_->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.StoreBackpressureError.reason)
+}
+inline void StoreBackpressureError::set_reason(const char* value, size_t size) {
+  set_has_reason();
+  if (reason_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    reason_ = new ::std::string;
+  }
+  reason_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.StoreBackpressureError.reason)
+}
+inline ::std::string* StoreBackpressureError::mutable_reason() {
+  set_has_reason();
+  if (reason_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    reason_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.StoreBackpressureError.reason)
+  return reason_;
+}
+inline ::std::string* StoreBackpressureError::release_reason() {
+  clear_has_reason();
+  if (reason_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = reason_;
+    reason_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void StoreBackpressureError::set_allocated_reason(::std::string* reason) {
+  if (reason_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete reason_;
+  }
+  if (reason) {
+    set_has_reason();
+    reason_ = reason;
+  } else {
+    clear_has_reason();
+    reason_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.StoreBackpressureError.reason)
+}
+
+// -------------------------------------------------------------------
+
 // ErrorDetail
 
 // optional .cockroach.proto.NotLeaderError not_leader = 1;
@@ -2988,6 +3536,91 @@ inline void ErrorDetail::set_allocated_condition_failed(::cockroach::proto::Cond
   }
 }
 
+// optional .cockroach.proto.QuotaExceededError quota_exceeded = 13;
+inline bool ErrorDetail::has_quota_exceeded() const {
+  return value_case() == kQuotaExceeded;
+}
+inline void ErrorDetail::set_has_quota_exceeded() {
+  _oneof_case_[0] = kQuotaExceeded;
+}
+inline void ErrorDetail::clear_quota_exceeded() {
+  if (has_quota_exceeded()) {
+    delete value_.quota_exceeded_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::QuotaExceededError& ErrorDetail::quota_exceeded() const {
+  return has_quota_exceeded() ? *value_.quota_exceeded_
+                      : ::cockroach::proto::QuotaExceededError::default_instance();
+}
+inline ::cockroach::proto::QuotaExceededError* ErrorDetail::mutable_quota_exceeded() {
+  if (!has_quota_exceeded()) {
+    clear_value();
+    set_has_quota_exceeded();
+    value_.quota_exceeded_ = new ::cockroach::proto::QuotaExceededError;
+  }
+  return value_.quota_exceeded_;
+}
+inline ::cockroach::proto::QuotaExceededError* ErrorDetail::release_quota_exceeded() {
+  if (has_quota_exceeded()) {
+    clear_has_value();
+    ::cockroach::proto::QuotaExceededError* temp = value_.quota_exceeded_;
+    value_.quota_exceeded_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ErrorDetail::set_allocated_quota_exceeded(::cockroach::proto::QuotaExceededError* quota_exceeded) {
+  clear_value();
+  if (quota_exceeded) {
+    set_has_quota_exceeded();
+    value_.quota_exceeded_ = quota_exceeded;
+  }
+}
+// optional .cockroach.proto.StoreBackpressureError store_backpressure = 14;
+inline bool ErrorDetail::has_store_backpressure() const {
+  return value_case() == kStoreBackpressure;
+}
+inline void ErrorDetail::set_has_store_backpressure() {
+  _oneof_case_[0] = kStoreBackpressure;
+}
+inline void ErrorDetail::clear_store_backpressure() {
+  if (has_store_backpressure()) {
+    delete value_.store_backpressure_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::StoreBackpressureError& ErrorDetail::store_backpressure() const {
+  return has_store_backpressure() ? *value_.store_backpressure_
+                      : ::cockroach::proto::StoreBackpressureError::default_instance();
+}
+inline ::cockroach::proto::StoreBackpressureError* ErrorDetail::mutable_store_backpressure() {
+  if (!has_store_backpressure()) {
+    clear_value();
+    set_has_store_backpressure();
+    value_.store_backpressure_ = new ::cockroach::proto::StoreBackpressureError;
+  }
+  return value_.store_backpressure_;
+}
+inline ::cockroach::proto::StoreBackpressureError* ErrorDetail::release_store_backpressure() {
+  if (has_store_backpressure()) {
+    clear_has_value();
+    ::cockroach::proto::StoreBackpressureError* temp = value_.store_backpressure_;
+    value_.store_backpressure_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ErrorDetail::set_allocated_store_backpressure(::cockroach::proto::StoreBackpressureError* store_backpressure) {
+  clear_value();
+  if (store_backpressure) {
+    set_has_store_backpressure();
+    value_.store_backpressure_ = store_backpressure;
+  }
+}
+
 inline bool ErrorDetail::has_value() {
   return value_case() != VALUE_NOT_SET;
 }
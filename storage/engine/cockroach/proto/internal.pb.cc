@@ -72,6 +72,18 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* InternalLeaderLeaseResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   InternalLeaderLeaseResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalCloseTimestampRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalCloseTimestampRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalCloseTimestampResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalCloseTimestampResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalQueryTxnRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalQueryTxnRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalQueryTxnResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalQueryTxnResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* InternalRequestUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   InternalRequestUnion_reflection_ = NULL;
@@ -127,6 +139,8 @@ struct ReadWriteCmdResponseOneofInstance {
   const ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
   const ::cockroach::proto::InternalGCResponse* internal_gc_;
   const ::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease_;
+  const ::cockroach::proto::InternalCloseTimestampResponse* internal_close_timestamp_;
+  const ::cockroach::proto::IngestResponse* ingest_;
 }* ReadWriteCmdResponse_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* InternalRaftCommandUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -151,6 +165,8 @@ struct InternalRaftCommandUnionOneofInstance {
   const ::cockroach::proto::InternalGCRequest* internal_gc_;
   const ::cockroach::proto::InternalLeaderLeaseRequest* internal_lease_;
   const ::cockroach::proto::InternalBatchRequest* internal_batch_;
+  const ::cockroach::proto::InternalCloseTimestampRequest* internal_close_timestamp_;
+  const ::cockroach::proto::IngestRequest* ingest_;
 }* InternalRaftCommandUnion_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* InternalRaftCommand_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -298,10 +314,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalGCResponse));
   InternalPushTxnRequest_descriptor_ = file->message_type(6);
-  static const int InternalPushTxnRequest_offsets_[3] = {
+  static const int InternalPushTxnRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPushTxnRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPushTxnRequest, pushee_txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPushTxnRequest, abort_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPushTxnRequest, force_),
   };
   InternalPushTxnRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -423,9 +440,10 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalTruncateLogResponse));
   InternalLeaderLeaseRequest_descriptor_ = file->message_type(14);
-  static const int InternalLeaderLeaseRequest_offsets_[2] = {
+  static const int InternalLeaderLeaseRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, lease_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, transfer_),
   };
   InternalLeaderLeaseRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -453,7 +471,71 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalLeaderLeaseResponse));
-  InternalRequestUnion_descriptor_ = file->message_type(16);
+  InternalCloseTimestampRequest_descriptor_ = file->message_type(16);
+  static const int InternalCloseTimestampRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCloseTimestampRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCloseTimestampRequest, closed_timestamp_),
+  };
+  InternalCloseTimestampRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalCloseTimestampRequest_descriptor_,
+      InternalCloseTimestampRequest::default_instance_,
+      InternalCloseTimestampRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCloseTimestampRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCloseTimestampRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalCloseTimestampRequest));
+  InternalCloseTimestampResponse_descriptor_ = file->message_type(17);
+  static const int InternalCloseTimestampResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCloseTimestampResponse, header_),
+  };
+  InternalCloseTimestampResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalCloseTimestampResponse_descriptor_,
+      InternalCloseTimestampResponse::default_instance_,
+      InternalCloseTimestampResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCloseTimestampResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalCloseTimestampResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalCloseTimestampResponse));
+  InternalQueryTxnRequest_descriptor_ = file->message_type(18);
+  static const int InternalQueryTxnRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnRequest, queried_txn_),
+  };
+  InternalQueryTxnRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalQueryTxnRequest_descriptor_,
+      InternalQueryTxnRequest::default_instance_,
+      InternalQueryTxnRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalQueryTxnRequest));
+  InternalQueryTxnResponse_descriptor_ = file->message_type(19);
+  static const int InternalQueryTxnResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, queried_txn_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, waiting_txns_),
+  };
+  InternalQueryTxnResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalQueryTxnResponse_descriptor_,
+      InternalQueryTxnResponse::default_instance_,
+      InternalQueryTxnResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalQueryTxnResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalQueryTxnResponse));
+  InternalRequestUnion_descriptor_ = file->message_type(20);
   static const int InternalRequestUnion_offsets_[12] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, contains_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, get_),
@@ -481,7 +563,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalRequestUnion));
-  InternalResponseUnion_descriptor_ = file->message_type(17);
+  InternalResponseUnion_descriptor_ = file->message_type(21);
   static const int InternalResponseUnion_offsets_[12] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, contains_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, get_),
@@ -509,7 +591,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalResponseUnion));
-  InternalBatchRequest_descriptor_ = file->message_type(18);
+  InternalBatchRequest_descriptor_ = file->message_type(22);
   static const int InternalBatchRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchRequest, requests_),
@@ -525,7 +607,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalBatchRequest));
-  InternalBatchResponse_descriptor_ = file->message_type(19);
+  InternalBatchResponse_descriptor_ = file->message_type(23);
   static const int InternalBatchResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchResponse, responses_),
@@ -541,8 +623,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalBatchResponse));
-  ReadWriteCmdResponse_descriptor_ = file->message_type(20);
-  static const int ReadWriteCmdResponse_offsets_[14] = {
+  ReadWriteCmdResponse_descriptor_ = file->message_type(24);
+  static const int ReadWriteCmdResponse_offsets_[16] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, conditional_put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, increment_),
@@ -556,6 +638,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_truncate_log_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_gc_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_leader_lease_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_close_timestamp_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, ingest_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, value_),
   };
   ReadWriteCmdResponse_reflection_ =
@@ -571,8 +655,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ReadWriteCmdResponse));
-  InternalRaftCommandUnion_descriptor_ = file->message_type(21);
-  static const int InternalRaftCommandUnion_offsets_[20] = {
+  InternalRaftCommandUnion_descriptor_ = file->message_type(25);
+  static const int InternalRaftCommandUnion_offsets_[22] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, contains_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, put_),
@@ -592,6 +676,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_gc_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_lease_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_batch_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_close_timestamp_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, ingest_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, value_),
   };
   InternalRaftCommandUnion_reflection_ =
@@ -607,7 +693,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalRaftCommandUnion));
-  InternalRaftCommand_descriptor_ = file->message_type(22);
+  InternalRaftCommand_descriptor_ = file->message_type(26);
   static const int InternalRaftCommand_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, raft_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, origin_node_id_),
@@ -624,7 +710,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalRaftCommand));
-  RaftMessageRequest_descriptor_ = file->message_type(23);
+  RaftMessageRequest_descriptor_ = file->message_type(27);
   static const int RaftMessageRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftMessageRequest, group_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftMessageRequest, msg_),
@@ -640,7 +726,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RaftMessageRequest));
-  RaftMessageResponse_descriptor_ = file->message_type(24);
+  RaftMessageResponse_descriptor_ = file->message_type(28);
   static const int RaftMessageResponse_offsets_[1] = {
   };
   RaftMessageResponse_reflection_ =
@@ -654,7 +740,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RaftMessageResponse));
-  InternalTimeSeriesData_descriptor_ = file->message_type(25);
+  InternalTimeSeriesData_descriptor_ = file->message_type(29);
   static const int InternalTimeSeriesData_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, start_timestamp_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, sample_duration_nanos_),
@@ -671,7 +757,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalTimeSeriesData));
-  InternalTimeSeriesSample_descriptor_ = file->message_type(26);
+  InternalTimeSeriesSample_descriptor_ = file->message_type(30);
   static const int InternalTimeSeriesSample_offsets_[9] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, offset_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, int_count_),
@@ -694,7 +780,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalTimeSeriesSample));
-  RaftTruncatedState_descriptor_ = file->message_type(27);
+  RaftTruncatedState_descriptor_ = file->message_type(31);
   static const int RaftTruncatedState_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftTruncatedState, index_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftTruncatedState, term_),
@@ -710,7 +796,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RaftTruncatedState));
-  RaftSnapshotData_descriptor_ = file->message_type(28);
+  RaftSnapshotData_descriptor_ = file->message_type(32);
   static const int RaftSnapshotData_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftSnapshotData, kv_),
   };
@@ -788,6 +874,14 @@ void protobuf_RegisterTypes(const ::std::string&) {
     InternalLeaderLeaseRequest_descriptor_, &InternalLeaderLeaseRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     InternalLeaderLeaseResponse_descriptor_, &InternalLeaderLeaseResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalCloseTimestampRequest_descriptor_, &InternalCloseTimestampRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalCloseTimestampResponse_descriptor_, &InternalCloseTimestampResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalQueryTxnRequest_descriptor_, &InternalQueryTxnRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalQueryTxnResponse_descriptor_, &InternalQueryTxnResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     InternalRequestUnion_descriptor_, &InternalRequestUnion::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -855,6 +949,14 @@ void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto() {
   delete InternalLeaderLeaseRequest_reflection_;
   delete InternalLeaderLeaseResponse::default_instance_;
   delete InternalLeaderLeaseResponse_reflection_;
+  delete InternalCloseTimestampRequest::default_instance_;
+  delete InternalCloseTimestampRequest_reflection_;
+  delete InternalCloseTimestampResponse::default_instance_;
+  delete InternalCloseTimestampResponse_reflection_;
+  delete InternalQueryTxnRequest::default_instance_;
+  delete InternalQueryTxnRequest_reflection_;
+  delete InternalQueryTxnResponse::default_instance_;
+  delete InternalQueryTxnResponse_reflection_;
   delete InternalRequestUnion::default_instance_;
   delete InternalRequestUnion_default_oneof_instance_;
   delete InternalRequestUnion_reflection_;
@@ -924,152 +1026,174 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
     "timestamp\030\002 \001(\0132\032.cockroach.proto.Timest"
     "ampB\004\310\336\037\000\"O\n\022InternalGCResponse\0229\n\006heade"
     "r\030\001 \001(\0132\037.cockroach.proto.ResponseHeader"
-    "B\010\310\336\037\000\320\336\037\001\"\237\001\n\026InternalPushTxnRequest\0228\n"
+    "B\010\310\336\037\000\320\336\037\001\"\264\001\n\026InternalPushTxnRequest\0228\n"
     "\006header\030\001 \001(\0132\036.cockroach.proto.RequestH"
     "eaderB\010\310\336\037\000\320\336\037\001\0226\n\npushee_txn\030\002 \001(\0132\034.co"
     "ckroach.proto.TransactionB\004\310\336\037\000\022\023\n\005Abort"
-    "\030\003 \001(\010B\004\310\336\037\000\"\206\001\n\027InternalPushTxnResponse"
-    "\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Respo"
-    "nseHeaderB\010\310\336\037\000\320\336\037\001\0220\n\npushee_txn\030\002 \001(\0132"
-    "\034.cockroach.proto.Transaction\"X\n\034Interna"
-    "lResolveIntentRequest\0228\n\006header\030\001 \001(\0132\036."
-    "cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\""
-    "Z\n\035InternalResolveIntentResponse\0229\n\006head"
-    "er\030\001 \001(\0132\037.cockroach.proto.ResponseHeade"
-    "rB\010\310\336\037\000\320\336\037\001\"}\n\024InternalMergeRequest\0228\n\006h"
-    "eader\030\001 \001(\0132\036.cockroach.proto.RequestHea"
-    "derB\010\310\336\037\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.cockroach"
-    ".proto.ValueB\004\310\336\037\000\"R\n\025InternalMergeRespo"
-    "nse\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Re"
-    "sponseHeaderB\010\310\336\037\000\320\336\037\001\"k\n\032InternalTrunca"
-    "teLogRequest\0228\n\006header\030\001 \001(\0132\036.cockroach"
-    ".proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\023\n\005index\030"
-    "\002 \001(\004B\004\310\336\037\000\"X\n\033InternalTruncateLogRespon"
-    "se\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Res"
-    "ponseHeaderB\010\310\336\037\000\320\336\037\001\"\203\001\n\032InternalLeader"
-    "LeaseRequest\0228\n\006header\030\001 \001(\0132\036.cockroach"
-    ".proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022+\n\005lease\030"
-    "\002 \001(\0132\026.cockroach.proto.LeaseB\004\310\336\037\000\"X\n\033I"
-    "nternalLeaderLeaseResponse\0229\n\006header\030\001 \001"
-    "(\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037"
-    "\000\320\336\037\001\"\246\005\n\024InternalRequestUnion\0224\n\010contai"
-    "ns\030\001 \001(\0132 .cockroach.proto.ContainsReque"
-    "stH\000\022*\n\003get\030\002 \001(\0132\033.cockroach.proto.GetR"
-    "equestH\000\022*\n\003put\030\003 \001(\0132\033.cockroach.proto."
-    "PutRequestH\000\022A\n\017conditional_put\030\004 \001(\0132&."
-    "cockroach.proto.ConditionalPutRequestH\000\022"
-    "6\n\tincrement\030\005 \001(\0132!.cockroach.proto.Inc"
-    "rementRequestH\000\0220\n\006delete\030\006 \001(\0132\036.cockro"
-    "ach.proto.DeleteRequestH\000\022;\n\014delete_rang"
-    "e\030\007 \001(\0132#.cockroach.proto.DeleteRangeReq"
-    "uestH\000\022,\n\004scan\030\010 \001(\0132\034.cockroach.proto.S"
-    "canRequestH\000\022A\n\017end_transaction\030\t \001(\0132&."
-    "cockroach.proto.EndTransactionRequestH\000\022"
-    "D\n\021internal_push_txn\030\036 \001(\0132\'.cockroach.p"
-    "roto.InternalPushTxnRequestH\000\022P\n\027interna"
-    "l_resolve_intent\030\037 \001(\0132-.cockroach.proto"
-    ".InternalResolveIntentRequestH\000:\004\310\240\037\001B\007\n"
-    "\005value\"\262\005\n\025InternalResponseUnion\0225\n\010cont"
-    "ains\030\001 \001(\0132!.cockroach.proto.ContainsRes"
-    "ponseH\000\022+\n\003get\030\002 \001(\0132\034.cockroach.proto.G"
-    "etResponseH\000\022+\n\003put\030\003 \001(\0132\034.cockroach.pr"
-    "oto.PutResponseH\000\022B\n\017conditional_put\030\004 \001"
-    "(\0132\'.cockroach.proto.ConditionalPutRespo"
-    "nseH\000\0227\n\tincrement\030\005 \001(\0132\".cockroach.pro"
-    "to.IncrementResponseH\000\0221\n\006delete\030\006 \001(\0132\037"
-    ".cockroach.proto.DeleteResponseH\000\022<\n\014del"
-    "ete_range\030\007 \001(\0132$.cockroach.proto.Delete"
-    "RangeResponseH\000\022-\n\004scan\030\010 \001(\0132\035.cockroac"
-    "h.proto.ScanResponseH\000\022B\n\017end_transactio"
-    "n\030\t \001(\0132\'.cockroach.proto.EndTransaction"
-    "ResponseH\000\022E\n\021internal_push_txn\030\036 \001(\0132(."
-    "cockroach.proto.InternalPushTxnResponseH"
-    "\000\022Q\n\027internal_resolve_intent\030\037 \001(\0132..coc"
-    "kroach.proto.InternalResolveIntentRespon"
-    "seH\000:\004\310\240\037\001B\007\n\005value\"\217\001\n\024InternalBatchReq"
-    "uest\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.R"
-    "equestHeaderB\010\310\336\037\000\320\336\037\001\022=\n\010requests\030\002 \003(\013"
-    "2%.cockroach.proto.InternalRequestUnionB"
-    "\004\310\336\037\000\"\223\001\n\025InternalBatchResponse\0229\n\006heade"
+    "\030\003 \001(\010B\004\310\336\037\000\022\023\n\005force\030\004 \001(\010B\004\310\336\037\000\"\206\001\n\027In"
+    "ternalPushTxnResponse\0229\n\006header\030\001 \001(\0132\037."
+    "cockroach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001"
+    "\0220\n\npushee_txn\030\002 \001(\0132\034.cockroach.proto.T"
+    "ransaction\"X\n\034InternalResolveIntentReque"
+    "st\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Req"
+    "uestHeaderB\010\310\336\037\000\320\336\037\001\"Z\n\035InternalResolveI"
+    "ntentResponse\0229\n\006header\030\001 \001(\0132\037.cockroac"
+    "h.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"}\n\024Inte"
+    "rnalMergeRequest\0228\n\006header\030\001 \001(\0132\036.cockr"
+    "oach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022+\n\005va"
+    "lue\030\002 \001(\0132\026.cockroach.proto.ValueB\004\310\336\037\000\""
+    "R\n\025InternalMergeResponse\0229\n\006header\030\001 \001(\013"
+    "2\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000\320"
+    "\336\037\001\"k\n\032InternalTruncateLogRequest\0228\n\006hea"
+    "der\030\001 \001(\0132\036.cockroach.proto.RequestHeade"
+    "rB\010\310\336\037\000\320\336\037\001\022\023\n\005index\030\002 \001(\004B\004\310\336\037\000\"X\n\033Inte"
+    "rnalTruncateLogResponse\0229\n\006header\030\001 \001(\0132"
+    "\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000\320\336"
+    "\037\001\"\233\001\n\032InternalLeaderLeaseRequest\0228\n\006hea"
+    "der\030\001 \001(\0132\036.cockroach.proto.RequestHeade"
+    "rB\010\310\336\037\000\320\336\037\001\022+\n\005lease\030\002 \001(\0132\026.cockroach.p"
+    "roto.LeaseB\004\310\336\037\000\022\026\n\010transfer\030\003 \001(\010B\004\310\336\037\000"
+    "\"X\n\033InternalLeaderLeaseResponse\0229\n\006heade"
     "r\030\001 \001(\0132\037.cockroach.proto.ResponseHeader"
-    "B\010\310\336\037\000\320\336\037\001\022\?\n\tresponses\030\002 \003(\0132&.cockroac"
-    "h.proto.InternalResponseUnionB\004\310\336\037\000\"\213\007\n\024"
-    "ReadWriteCmdResponse\022+\n\003put\030\001 \001(\0132\034.cock"
-    "roach.proto.PutResponseH\000\022B\n\017conditional"
-    "_put\030\002 \001(\0132\'.cockroach.proto.Conditional"
-    "PutResponseH\000\0227\n\tincrement\030\003 \001(\0132\".cockr"
-    "oach.proto.IncrementResponseH\000\0221\n\006delete"
-    "\030\004 \001(\0132\037.cockroach.proto.DeleteResponseH"
-    "\000\022<\n\014delete_range\030\005 \001(\0132$.cockroach.prot"
-    "o.DeleteRangeResponseH\000\022B\n\017end_transacti"
-    "on\030\006 \001(\0132\'.cockroach.proto.EndTransactio"
-    "nResponseH\000\022O\n\026internal_heartbeat_txn\030\n "
-    "\001(\0132-.cockroach.proto.InternalHeartbeatT"
-    "xnResponseH\000\022E\n\021internal_push_txn\030\013 \001(\0132"
-    "(.cockroach.proto.InternalPushTxnRespons"
-    "eH\000\022Q\n\027internal_resolve_intent\030\014 \001(\0132..c"
-    "ockroach.proto.InternalResolveIntentResp"
-    "onseH\000\022@\n\016internal_merge\030\r \001(\0132&.cockroa"
-    "ch.proto.InternalMergeResponseH\000\022M\n\025inte"
-    "rnal_truncate_log\030\016 \001(\0132,.cockroach.prot"
-    "o.InternalTruncateLogResponseH\000\022:\n\013inter"
-    "nal_gc\030\017 \001(\0132#.cockroach.proto.InternalG"
-    "CResponseH\000\022M\n\025internal_leader_lease\030\020 \001"
-    "(\0132,.cockroach.proto.InternalLeaderLease"
-    "ResponseH\000:\004\310\240\037\001B\007\n\005value\"\343\t\n\030InternalRa"
-    "ftCommandUnion\0224\n\010contains\030\001 \001(\0132 .cockr"
-    "oach.proto.ContainsRequestH\000\022*\n\003get\030\002 \001("
-    "\0132\033.cockroach.proto.GetRequestH\000\022*\n\003put\030"
-    "\003 \001(\0132\033.cockroach.proto.PutRequestH\000\022A\n\017"
-    "conditional_put\030\004 \001(\0132&.cockroach.proto."
-    "ConditionalPutRequestH\000\0226\n\tincrement\030\005 \001"
-    "(\0132!.cockroach.proto.IncrementRequestH\000\022"
-    "0\n\006delete\030\006 \001(\0132\036.cockroach.proto.Delete"
-    "RequestH\000\022;\n\014delete_range\030\007 \001(\0132#.cockro"
-    "ach.proto.DeleteRangeRequestH\000\022,\n\004scan\030\010"
-    " \001(\0132\034.cockroach.proto.ScanRequestH\000\022A\n\017"
-    "end_transaction\030\t \001(\0132&.cockroach.proto."
-    "EndTransactionRequestH\000\022.\n\005batch\030\036 \001(\0132\035"
-    ".cockroach.proto.BatchRequestH\000\022L\n\025inter"
-    "nal_range_lookup\030\037 \001(\0132+.cockroach.proto"
-    ".InternalRangeLookupRequestH\000\022N\n\026interna"
-    "l_heartbeat_txn\030  \001(\0132,.cockroach.proto."
-    "InternalHeartbeatTxnRequestH\000\022D\n\021interna"
-    "l_push_txn\030! \001(\0132\'.cockroach.proto.Inter"
-    "nalPushTxnRequestH\000\022P\n\027internal_resolve_"
-    "intent\030\" \001(\0132-.cockroach.proto.InternalR"
-    "esolveIntentRequestH\000\022H\n\027internal_merge_"
-    "response\030# \001(\0132%.cockroach.proto.Interna"
-    "lMergeRequestH\000\022L\n\025internal_truncate_log"
-    "\030$ \001(\0132+.cockroach.proto.InternalTruncat"
-    "eLogRequestH\000\022I\n\013internal_gc\030% \001(\0132\".coc"
-    "kroach.proto.InternalGCRequestB\016\342\336\037\nInte"
-    "rnalGCH\000\022E\n\016internal_lease\030& \001(\0132+.cockr"
-    "oach.proto.InternalLeaderLeaseRequestH\000\022"
-    "\?\n\016internal_batch\030\' \001(\0132%.cockroach.prot"
-    "o.InternalBatchRequestH\000:\004\310\240\037\001B\007\n\005value\""
-    "\242\001\n\023InternalRaftCommand\022\037\n\007raft_id\030\001 \001(\003"
-    "B\016\310\336\037\000\342\336\037\006RaftID\022,\n\016origin_node_id\030\002 \001(\004"
-    "B\024\310\336\037\000\342\336\037\014OriginNodeID\022<\n\003cmd\030\003 \001(\0132).co"
-    "ckroach.proto.InternalRaftCommandUnionB\004"
-    "\310\336\037\000\"D\n\022RaftMessageRequest\022!\n\010group_id\030\001"
-    " \001(\004B\017\310\336\037\000\342\336\037\007GroupID\022\013\n\003msg\030\002 \001(\014\"\025\n\023Ra"
-    "ftMessageResponse\"\236\001\n\026InternalTimeSeries"
-    "Data\022#\n\025start_timestamp_nanos\030\001 \001(\003B\004\310\336\037"
-    "\000\022#\n\025sample_duration_nanos\030\002 \001(\003B\004\310\336\037\000\022:"
-    "\n\007samples\030\003 \003(\0132).cockroach.proto.Intern"
-    "alTimeSeriesSample\"\320\001\n\030InternalTimeSerie"
-    "sSample\022\024\n\006offset\030\001 \001(\005B\004\310\336\037\000\022\027\n\tint_cou"
-    "nt\030\002 \001(\rB\004\310\336\037\000\022\017\n\007int_sum\030\003 \001(\003\022\017\n\007int_m"
-    "ax\030\004 \001(\003\022\017\n\007int_min\030\005 \001(\003\022\031\n\013float_count"
-    "\030\006 \001(\rB\004\310\336\037\000\022\021\n\tfloat_sum\030\007 \001(\002\022\021\n\tfloat"
-    "_max\030\010 \001(\002\022\021\n\tfloat_min\030\t \001(\002\"=\n\022RaftTru"
-    "ncatedState\022\023\n\005index\030\001 \001(\004B\004\310\336\037\000\022\022\n\004term"
-    "\030\002 \001(\004B\004\310\336\037\000\"z\n\020RaftSnapshotData\022>\n\002KV\030\001"
-    " \003(\0132*.cockroach.proto.RaftSnapshotData."
-    "KeyValueB\006\342\336\037\002KV\032&\n\010KeyValue\022\013\n\003key\030\001 \001("
-    "\014\022\r\n\005value\030\002 \001(\014*%\n\021InternalValueType\022\n\n"
-    "\006_CR_TS\020\001\032\004\210\243\036\000B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 6796);
+    "B\010\310\336\037\000\320\336\037\001\"\225\001\n\035InternalCloseTimestampReq"
+    "uest\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.R"
+    "equestHeaderB\010\310\336\037\000\320\336\037\001\022:\n\020closed_timesta"
+    "mp\030\002 \001(\0132\032.cockroach.proto.TimestampB\004\310\336"
+    "\037\000\"[\n\036InternalCloseTimestampResponse\0229\n\006"
+    "header\030\001 \001(\0132\037.cockroach.proto.ResponseH"
+    "eaderB\010\310\336\037\000\320\336\037\001\"\214\001\n\027InternalQueryTxnRequ"
+    "est\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Re"
+    "questHeaderB\010\310\336\037\000\320\336\037\001\0227\n\013queried_txn\030\002 \001"
+    "(\0132\034.cockroach.proto.TransactionB\004\310\336\037\000\"\236"
+    "\001\n\030InternalQueryTxnResponse\0229\n\006header\030\001 "
+    "\001(\0132\037.cockroach.proto.ResponseHeaderB\010\310\336"
+    "\037\000\320\336\037\001\0221\n\013queried_txn\030\002 \001(\0132\034.cockroach."
+    "proto.Transaction\022\024\n\014waiting_txns\030\003 \003(\014\""
+    "\246\005\n\024InternalRequestUnion\0224\n\010contains\030\001 \001"
+    "(\0132 .cockroach.proto.ContainsRequestH\000\022*"
+    "\n\003get\030\002 \001(\0132\033.cockroach.proto.GetRequest"
+    "H\000\022*\n\003put\030\003 \001(\0132\033.cockroach.proto.PutReq"
+    "uestH\000\022A\n\017conditional_put\030\004 \001(\0132&.cockro"
+    "ach.proto.ConditionalPutRequestH\000\0226\n\tinc"
+    "rement\030\005 \001(\0132!.cockroach.proto.Increment"
+    "RequestH\000\0220\n\006delete\030\006 \001(\0132\036.cockroach.pr"
+    "oto.DeleteRequestH\000\022;\n\014delete_range\030\007 \001("
+    "\0132#.cockroach.proto.DeleteRangeRequestH\000"
+    "\022,\n\004scan\030\010 \001(\0132\034.cockroach.proto.ScanReq"
+    "uestH\000\022A\n\017end_transaction\030\t \001(\0132&.cockro"
+    "ach.proto.EndTransactionRequestH\000\022D\n\021int"
+    "ernal_push_txn\030\036 \001(\0132\'.cockroach.proto.I"
+    "nternalPushTxnRequestH\000\022P\n\027internal_reso"
+    "lve_intent\030\037 \001(\0132-.cockroach.proto.Inter"
+    "nalResolveIntentRequestH\000:\004\310\240\037\001B\007\n\005value"
+    "\"\262\005\n\025InternalResponseUnion\0225\n\010contains\030\001"
+    " \001(\0132!.cockroach.proto.ContainsResponseH"
+    "\000\022+\n\003get\030\002 \001(\0132\034.cockroach.proto.GetResp"
+    "onseH\000\022+\n\003put\030\003 \001(\0132\034.cockroach.proto.Pu"
+    "tResponseH\000\022B\n\017conditional_put\030\004 \001(\0132\'.c"
+    "ockroach.proto.ConditionalPutResponseH\000\022"
+    "7\n\tincrement\030\005 \001(\0132\".cockroach.proto.Inc"
+    "rementResponseH\000\0221\n\006delete\030\006 \001(\0132\037.cockr"
+    "oach.proto.DeleteResponseH\000\022<\n\014delete_ra"
+    "nge\030\007 \001(\0132$.cockroach.proto.DeleteRangeR"
+    "esponseH\000\022-\n\004scan\030\010 \001(\0132\035.cockroach.prot"
+    "o.ScanResponseH\000\022B\n\017end_transaction\030\t \001("
+    "\0132\'.cockroach.proto.EndTransactionRespon"
+    "seH\000\022E\n\021internal_push_txn\030\036 \001(\0132(.cockro"
+    "ach.proto.InternalPushTxnResponseH\000\022Q\n\027i"
+    "nternal_resolve_intent\030\037 \001(\0132..cockroach"
+    ".proto.InternalResolveIntentResponseH\000:\004"
+    "\310\240\037\001B\007\n\005value\"\217\001\n\024InternalBatchRequest\0228"
+    "\n\006header\030\001 \001(\0132\036.cockroach.proto.Request"
+    "HeaderB\010\310\336\037\000\320\336\037\001\022=\n\010requests\030\002 \003(\0132%.coc"
+    "kroach.proto.InternalRequestUnionB\004\310\336\037\000\""
+    "\223\001\n\025InternalBatchResponse\0229\n\006header\030\001 \001("
+    "\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000"
+    "\320\336\037\001\022?\n\tresponses\030\002 \003(\0132&.cockroach.prot"
+    "o.InternalResponseUnionB\004\310\336\037\000\"\223\010\n\024ReadWr"
+    "iteCmdResponse\022+\n\003put\030\001 \001(\0132\034.cockroach."
+    "proto.PutResponseH\000\022B\n\017conditional_put\030\002"
+    " \001(\0132\'.cockroach.proto.ConditionalPutRes"
+    "ponseH\000\0227\n\tincrement\030\003 \001(\0132\".cockroach.p"
+    "roto.IncrementResponseH\000\0221\n\006delete\030\004 \001(\013"
+    "2\037.cockroach.proto.DeleteResponseH\000\022<\n\014d"
+    "elete_range\030\005 \001(\0132$.cockroach.proto.Dele"
+    "teRangeResponseH\000\022B\n\017end_transaction\030\006 \001"
+    "(\0132\'.cockroach.proto.EndTransactionRespo"
+    "nseH\000\022O\n\026internal_heartbeat_txn\030\n \001(\0132-."
+    "cockroach.proto.InternalHeartbeatTxnResp"
+    "onseH\000\022E\n\021internal_push_txn\030\013 \001(\0132(.cock"
+    "roach.proto.InternalPushTxnResponseH\000\022Q\n"
+    "\027internal_resolve_intent\030\014 \001(\0132..cockroa"
+    "ch.proto.InternalResolveIntentResponseH\000"
+    "\022@\n\016internal_merge\030\r \001(\0132&.cockroach.pro"
+    "to.InternalMergeResponseH\000\022M\n\025internal_t"
+    "runcate_log\030\016 \001(\0132,.cockroach.proto.Inte"
+    "rnalTruncateLogResponseH\000\022:\n\013internal_gc"
+    "\030\017 \001(\0132#.cockroach.proto.InternalGCRespo"
+    "nseH\000\022M\n\025internal_leader_lease\030\020 \001(\0132,.c"
+    "ockroach.proto.InternalLeaderLeaseRespon"
+    "seH\000\022S\n\030internal_close_timestamp\030\021 \001(\0132/"
+    ".cockroach.proto.InternalCloseTimestampR"
+    "esponseH\000\0221\n\006ingest\030\022 \001(\0132\037.cockroach.pr"
+    "oto.IngestResponseH\000:\004\310\240\037\001B\007\n\005value\"\351\n\n\030"
+    "InternalRaftCommandUnion\0224\n\010contains\030\001 \001"
+    "(\0132 .cockroach.proto.ContainsRequestH\000\022*"
+    "\n\003get\030\002 \001(\0132\033.cockroach.proto.GetRequest"
+    "H\000\022*\n\003put\030\003 \001(\0132\033.cockroach.proto.PutReq"
+    "uestH\000\022A\n\017conditional_put\030\004 \001(\0132&.cockro"
+    "ach.proto.ConditionalPutRequestH\000\0226\n\tinc"
+    "rement\030\005 \001(\0132!.cockroach.proto.Increment"
+    "RequestH\000\0220\n\006delete\030\006 \001(\0132\036.cockroach.pr"
+    "oto.DeleteRequestH\000\022;\n\014delete_range\030\007 \001("
+    "\0132#.cockroach.proto.DeleteRangeRequestH\000"
+    "\022,\n\004scan\030\010 \001(\0132\034.cockroach.proto.ScanReq"
+    "uestH\000\022A\n\017end_transaction\030\t \001(\0132&.cockro"
+    "ach.proto.EndTransactionRequestH\000\022.\n\005bat"
+    "ch\030\036 \001(\0132\035.cockroach.proto.BatchRequestH"
+    "\000\022L\n\025internal_range_lookup\030\037 \001(\0132+.cockr"
+    "oach.proto.InternalRangeLookupRequestH\000\022"
+    "N\n\026internal_heartbeat_txn\030  \001(\0132,.cockro"
+    "ach.proto.InternalHeartbeatTxnRequestH\000\022"
+    "D\n\021internal_push_txn\030! \001(\0132\'.cockroach.p"
+    "roto.InternalPushTxnRequestH\000\022P\n\027interna"
+    "l_resolve_intent\030\" \001(\0132-.cockroach.proto"
+    ".InternalResolveIntentRequestH\000\022H\n\027inter"
+    "nal_merge_response\030# \001(\0132%.cockroach.pro"
+    "to.InternalMergeRequestH\000\022L\n\025internal_tr"
+    "uncate_log\030$ \001(\0132+.cockroach.proto.Inter"
+    "nalTruncateLogRequestH\000\022I\n\013internal_gc\030%"
+    " \001(\0132\".cockroach.proto.InternalGCRequest"
+    "B\016\342\336\037\nInternalGCH\000\022E\n\016internal_lease\030& \001"
+    "(\0132+.cockroach.proto.InternalLeaderLease"
+    "RequestH\000\022?\n\016internal_batch\030\' \001(\0132%.cock"
+    "roach.proto.InternalBatchRequestH\000\022R\n\030in"
+    "ternal_close_timestamp\030( \001(\0132..cockroach"
+    ".proto.InternalCloseTimestampRequestH\000\0220"
+    "\n\006ingest\030* \001(\0132\036.cockroach.proto.IngestR"
+    "equestH\000:\004\310\240\037\001B\007\n\005value\"\242\001\n\023InternalRaft"
+    "Command\022\037\n\007raft_id\030\001 \001(\003B\016\310\336\037\000\342\336\037\006RaftID"
+    "\022,\n\016origin_node_id\030\002 \001(\004B\024\310\336\037\000\342\336\037\014Origin"
+    "NodeID\022<\n\003cmd\030\003 \001(\0132).cockroach.proto.In"
+    "ternalRaftCommandUnionB\004\310\336\037\000\"D\n\022RaftMess"
+    "ageRequest\022!\n\010group_id\030\001 \001(\004B\017\310\336\037\000\342\336\037\007Gr"
+    "oupID\022\013\n\003msg\030\002 \001(\014\"\025\n\023RaftMessageRespons"
+    "e\"\236\001\n\026InternalTimeSeriesData\022#\n\025start_ti"
+    "mestamp_nanos\030\001 \001(\003B\004\310\336\037\000\022#\n\025sample_dura"
+    "tion_nanos\030\002 \001(\003B\004\310\336\037\000\022:\n\007samples\030\003 \003(\0132"
+    ").cockroach.proto.InternalTimeSeriesSamp"
+    "le\"\320\001\n\030InternalTimeSeriesSample\022\024\n\006offse"
+    "t\030\001 \001(\005B\004\310\336\037\000\022\027\n\tint_count\030\002 \001(\rB\004\310\336\037\000\022\017"
+    "\n\007int_sum\030\003 \001(\003\022\017\n\007int_max\030\004 \001(\003\022\017\n\007int_"
+    "min\030\005 \001(\003\022\031\n\013float_count\030\006 \001(\rB\004\310\336\037\000\022\021\n\t"
+    "float_sum\030\007 \001(\002\022\021\n\tfloat_max\030\010 \001(\002\022\021\n\tfl"
+    "oat_min\030\t \001(\002\"=\n\022RaftTruncatedState\022\023\n\005i"
+    "ndex\030\001 \001(\004B\004\310\336\037\000\022\022\n\004term\030\002 \001(\004B\004\310\336\037\000\"z\n\020"
+    "RaftSnapshotData\022>\n\002KV\030\001 \003(\0132*.cockroach"
+    ".proto.RaftSnapshotData.KeyValueB\006\342\336\037\002KV"
+    "\032&\n\010KeyValue\022\013\n\003key\030\001 \001(\014\022\r\n\005value\030\002 \001(\014"
+    "*%\n\021InternalValueType\022\n\n\006_CR_TS\020\001\032\004\210\243\036\000B"
+    "\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 7660);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/internal.proto", &protobuf_RegisterTypes);
   InternalRangeLookupRequest::default_instance_ = new InternalRangeLookupRequest();
@@ -1089,6 +1213,10 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
   InternalTruncateLogResponse::default_instance_ = new InternalTruncateLogResponse();
   InternalLeaderLeaseRequest::default_instance_ = new InternalLeaderLeaseRequest();
   InternalLeaderLeaseResponse::default_instance_ = new InternalLeaderLeaseResponse();
+  InternalCloseTimestampRequest::default_instance_ = new InternalCloseTimestampRequest();
+  InternalCloseTimestampResponse::default_instance_ = new InternalCloseTimestampResponse();
+  InternalQueryTxnRequest::default_instance_ = new InternalQueryTxnRequest();
+  InternalQueryTxnResponse::default_instance_ = new InternalQueryTxnResponse();
   InternalRequestUnion::default_instance_ = new InternalRequestUnion();
   InternalRequestUnion_default_oneof_instance_ = new InternalRequestUnionOneofInstance;
   InternalResponseUnion::default_instance_ = new InternalResponseUnion();
@@ -1124,6 +1252,10 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
   InternalTruncateLogResponse::default_instance_->InitAsDefaultInstance();
   InternalLeaderLeaseRequest::default_instance_->InitAsDefaultInstance();
   InternalLeaderLeaseResponse::default_instance_->InitAsDefaultInstance();
+  InternalCloseTimestampRequest::default_instance_->InitAsDefaultInstance();
+  InternalCloseTimestampResponse::default_instance_->InitAsDefaultInstance();
+  InternalQueryTxnRequest::default_instance_->InitAsDefaultInstance();
+  InternalQueryTxnResponse::default_instance_->InitAsDefaultInstance();
   InternalRequestUnion::default_instance_->InitAsDefaultInstance();
   InternalResponseUnion::default_instance_->InitAsDefaultInstance();
   InternalBatchRequest::default_instance_->InitAsDefaultInstance();
@@ -2971,6 +3103,7 @@ void InternalGCResponse::Swap(InternalGCResponse* other) {
 const int InternalPushTxnRequest::kHeaderFieldNumber;
 const int InternalPushTxnRequest::kPusheeTxnFieldNumber;
 const int InternalPushTxnRequest::kAbortFieldNumber;
+const int InternalPushTxnRequest::kForceFieldNumber;
 #endif  // !_MSC_VER
 
 InternalPushTxnRequest::InternalPushTxnRequest()
@@ -2996,6 +3129,7 @@ void InternalPushTxnRequest::SharedCtor() {
   header_ = NULL;
   pushee_txn_ = NULL;
   abort_ = false;
+  force_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -3033,15 +3167,29 @@ InternalPushTxnRequest* InternalPushTxnRequest::New() const {
 }
 
 void InternalPushTxnRequest::Clear() {
-  if (_has_bits_[0 / 32] & 7) {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<InternalPushTxnRequest*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 15) {
+    ZR_(abort_, force_);
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
     if (has_pushee_txn()) {
       if (pushee_txn_ != NULL) pushee_txn_->::cockroach::proto::Transaction::Clear();
     }
-    abort_ = false;
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -3092,6 +3240,21 @@ bool InternalPushTxnRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(32)) goto parse_force;
+        break;
+      }
+
+      // optional bool force = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_force:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &force_)));
+          set_has_force();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3138,6 +3301,11 @@ void InternalPushTxnRequest::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->abort(), output);
   }
 
+  // optional bool force = 4;
+  if (has_force()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->force(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -3167,6 +3335,11 @@ void InternalPushTxnRequest::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->abort(), target);
   }
 
+  // optional bool force = 4;
+  if (has_force()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->force(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -3198,6 +3371,11 @@ int InternalPushTxnRequest::ByteSize() const {
       total_size += 1 + 1;
     }
 
+    // optional bool force = 4;
+    if (has_force()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -3234,6 +3412,9 @@ void InternalPushTxnRequest::MergeFrom(const InternalPushTxnRequest& from) {
     if (from.has_abort()) {
       set_abort(from.abort());
     }
+    if (from.has_force()) {
+      set_force(from.force());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -3260,6 +3441,7 @@ void InternalPushTxnRequest::Swap(InternalPushTxnRequest* other) {
     std::swap(header_, other->header_);
     std::swap(pushee_txn_, other->pushee_txn_);
     std::swap(abort_, other->abort_);
+    std::swap(force_, other->force_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -5002,6 +5184,7 @@ void InternalTruncateLogResponse::Swap(InternalTruncateLogResponse* other) {
 #ifndef _MSC_VER
 const int InternalLeaderLeaseRequest::kHeaderFieldNumber;
 const int InternalLeaderLeaseRequest::kLeaseFieldNumber;
+const int InternalLeaderLeaseRequest::kTransferFieldNumber;
 #endif  // !_MSC_VER
 
 InternalLeaderLeaseRequest::InternalLeaderLeaseRequest()
@@ -5026,6 +5209,7 @@ void InternalLeaderLeaseRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   lease_ = NULL;
+  transfer_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -5063,13 +5247,14 @@ InternalLeaderLeaseRequest* InternalLeaderLeaseRequest::New() const {
 }
 
 void InternalLeaderLeaseRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
+  if (_has_bits_[0 / 32] & 7) {
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
     if (has_lease()) {
       if (lease_ != NULL) lease_->::cockroach::proto::Lease::Clear();
     }
+    transfer_ = false;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -5106,6 +5291,21 @@ bool InternalLeaderLeaseRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(24)) goto parse_transfer;
+        break;
+      }
+
+      // optional bool transfer = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_transfer:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &transfer_)));
+          set_has_transfer();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -5147,6 +5347,11 @@ void InternalLeaderLeaseRequest::SerializeWithCachedSizes(
       2, this->lease(), output);
   }
 
+  // optional bool transfer = 3;
+  if (has_transfer()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->transfer(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -5171,6 +5376,11 @@ void InternalLeaderLeaseRequest::SerializeWithCachedSizes(
         2, this->lease(), target);
   }
 
+  // optional bool transfer = 3;
+  if (has_transfer()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->transfer(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -5197,6 +5407,11 @@ int InternalLeaderLeaseRequest::ByteSize() const {
           this->lease());
     }
 
+    // optional bool transfer = 3;
+    if (has_transfer()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -5230,6 +5445,9 @@ void InternalLeaderLeaseRequest::MergeFrom(const InternalLeaderLeaseRequest& fro
     if (from.has_lease()) {
       mutable_lease()->::cockroach::proto::Lease::MergeFrom(from.lease());
     }
+    if (from.has_transfer()) {
+      set_transfer(from.transfer());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -5255,6 +5473,7 @@ void InternalLeaderLeaseRequest::Swap(InternalLeaderLeaseRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
     std::swap(lease_, other->lease_);
+    std::swap(transfer_, other->transfer_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -5500,219 +5719,1303 @@ void InternalLeaderLeaseResponse::Swap(InternalLeaderLeaseResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int InternalRequestUnion::kContainsFieldNumber;
-const int InternalRequestUnion::kGetFieldNumber;
-const int InternalRequestUnion::kPutFieldNumber;
-const int InternalRequestUnion::kConditionalPutFieldNumber;
-const int InternalRequestUnion::kIncrementFieldNumber;
-const int InternalRequestUnion::kDeleteFieldNumber;
-const int InternalRequestUnion::kDeleteRangeFieldNumber;
-const int InternalRequestUnion::kScanFieldNumber;
-const int InternalRequestUnion::kEndTransactionFieldNumber;
-const int InternalRequestUnion::kInternalPushTxnFieldNumber;
-const int InternalRequestUnion::kInternalResolveIntentFieldNumber;
+const int InternalCloseTimestampRequest::kHeaderFieldNumber;
+const int InternalCloseTimestampRequest::kClosedTimestampFieldNumber;
 #endif  // !_MSC_VER
 
-InternalRequestUnion::InternalRequestUnion()
+InternalCloseTimestampRequest::InternalCloseTimestampRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRequestUnion)
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalCloseTimestampRequest)
 }
 
-void InternalRequestUnion::InitAsDefaultInstance() {
-  InternalRequestUnion_default_oneof_instance_->contains_ = const_cast< ::cockroach::proto::ContainsRequest*>(&::cockroach::proto::ContainsRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetRequest*>(&::cockroach::proto::GetRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutRequest*>(&::cockroach::proto::PutRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutRequest*>(&::cockroach::proto::ConditionalPutRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementRequest*>(&::cockroach::proto::IncrementRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteRequest*>(&::cockroach::proto::DeleteRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnRequest*>(&::cockroach::proto::InternalPushTxnRequest::default_instance());
-  InternalRequestUnion_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentRequest*>(&::cockroach::proto::InternalResolveIntentRequest::default_instance());
+void InternalCloseTimestampRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  closed_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
 }
 
-InternalRequestUnion::InternalRequestUnion(const InternalRequestUnion& from)
+InternalCloseTimestampRequest::InternalCloseTimestampRequest(const InternalCloseTimestampRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRequestUnion)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalCloseTimestampRequest)
 }
 
-void InternalRequestUnion::SharedCtor() {
+void InternalCloseTimestampRequest::SharedCtor() {
   _cached_size_ = 0;
+  header_ = NULL;
+  closed_timestamp_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
-  clear_has_value();
 }
 
-InternalRequestUnion::~InternalRequestUnion() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRequestUnion)
+InternalCloseTimestampRequest::~InternalCloseTimestampRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalCloseTimestampRequest)
   SharedDtor();
 }
 
-void InternalRequestUnion::SharedDtor() {
-  if (has_value()) {
-    clear_value();
-  }
+void InternalCloseTimestampRequest::SharedDtor() {
   if (this != default_instance_) {
+    delete header_;
+    delete closed_timestamp_;
   }
 }
 
-void InternalRequestUnion::SetCachedSize(int size) const {
+void InternalCloseTimestampRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* InternalRequestUnion::descriptor() {
+const ::google::protobuf::Descriptor* InternalCloseTimestampRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return InternalRequestUnion_descriptor_;
+  return InternalCloseTimestampRequest_descriptor_;
 }
 
-const InternalRequestUnion& InternalRequestUnion::default_instance() {
+const InternalCloseTimestampRequest& InternalCloseTimestampRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   return *default_instance_;
 }
 
-InternalRequestUnion* InternalRequestUnion::default_instance_ = NULL;
+InternalCloseTimestampRequest* InternalCloseTimestampRequest::default_instance_ = NULL;
 
-InternalRequestUnion* InternalRequestUnion::New() const {
-  return new InternalRequestUnion;
+InternalCloseTimestampRequest* InternalCloseTimestampRequest::New() const {
+  return new InternalCloseTimestampRequest;
 }
 
-void InternalRequestUnion::clear_value() {
-  switch(value_case()) {
-    case kContains: {
-      delete value_.contains_;
-      break;
-    }
-    case kGet: {
-      delete value_.get_;
-      break;
-    }
-    case kPut: {
-      delete value_.put_;
-      break;
-    }
-    case kConditionalPut: {
-      delete value_.conditional_put_;
-      break;
-    }
-    case kIncrement: {
-      delete value_.increment_;
-      break;
-    }
-    case kDelete: {
-      delete value_.delete__;
-      break;
-    }
-    case kDeleteRange: {
-      delete value_.delete_range_;
-      break;
-    }
-    case kScan: {
-      delete value_.scan_;
-      break;
-    }
-    case kEndTransaction: {
-      delete value_.end_transaction_;
-      break;
-    }
-    case kInternalPushTxn: {
-      delete value_.internal_push_txn_;
-      break;
-    }
-    case kInternalResolveIntent: {
-      delete value_.internal_resolve_intent_;
-      break;
+void InternalCloseTimestampRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
-    case VALUE_NOT_SET: {
-      break;
+    if (has_closed_timestamp()) {
+      if (closed_timestamp_ != NULL) closed_timestamp_->::cockroach::proto::Timestamp::Clear();
     }
   }
-  _oneof_case_[0] = VALUE_NOT_SET;
-}
-
-
-void InternalRequestUnion::Clear() {
-  clear_value();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool InternalRequestUnion::MergePartialFromCodedStream(
+bool InternalCloseTimestampRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRequestUnion)
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalCloseTimestampRequest)
   for (;;) {
-    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .cockroach.proto.ContainsRequest contains = 1;
+      // optional .cockroach.proto.RequestHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_contains()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_get;
+        if (input->ExpectTag(18)) goto parse_closed_timestamp;
         break;
       }
 
-      // optional .cockroach.proto.GetRequest get = 2;
+      // optional .cockroach.proto.Timestamp closed_timestamp = 2;
       case 2: {
         if (tag == 18) {
-         parse_get:
+         parse_closed_timestamp:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_get()));
+               input, mutable_closed_timestamp()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_put;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .cockroach.proto.PutRequest put = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_put()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(34)) goto parse_conditional_put;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalCloseTimestampRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalCloseTimestampRequest)
+  return false;
+#undef DO_
+}
 
-      // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-      case 4: {
-        if (tag == 34) {
-         parse_conditional_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_conditional_put()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(42)) goto parse_increment;
-        break;
-      }
+void InternalCloseTimestampRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalCloseTimestampRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
 
-      // optional .cockroach.proto.IncrementRequest increment = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_increment:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_increment()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(50)) goto parse_delete;
-        break;
-      }
+  // optional .cockroach.proto.Timestamp closed_timestamp = 2;
+  if (has_closed_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->closed_timestamp(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalCloseTimestampRequest)
+}
+
+::google::protobuf::uint8* InternalCloseTimestampRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalCloseTimestampRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .cockroach.proto.Timestamp closed_timestamp = 2;
+  if (has_closed_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->closed_timestamp(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalCloseTimestampRequest)
+  return target;
+}
+
+int InternalCloseTimestampRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .cockroach.proto.Timestamp closed_timestamp = 2;
+    if (has_closed_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->closed_timestamp());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalCloseTimestampRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalCloseTimestampRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalCloseTimestampRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalCloseTimestampRequest::MergeFrom(const InternalCloseTimestampRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_closed_timestamp()) {
+      mutable_closed_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.closed_timestamp());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalCloseTimestampRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalCloseTimestampRequest::CopyFrom(const InternalCloseTimestampRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalCloseTimestampRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalCloseTimestampRequest::Swap(InternalCloseTimestampRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(closed_timestamp_, other->closed_timestamp_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalCloseTimestampRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalCloseTimestampRequest_descriptor_;
+  metadata.reflection = InternalCloseTimestampRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalCloseTimestampResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalCloseTimestampResponse::InternalCloseTimestampResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalCloseTimestampResponse)
+}
+
+void InternalCloseTimestampResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+InternalCloseTimestampResponse::InternalCloseTimestampResponse(const InternalCloseTimestampResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalCloseTimestampResponse)
+}
+
+void InternalCloseTimestampResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalCloseTimestampResponse::~InternalCloseTimestampResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalCloseTimestampResponse)
+  SharedDtor();
+}
+
+void InternalCloseTimestampResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalCloseTimestampResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalCloseTimestampResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalCloseTimestampResponse_descriptor_;
+}
+
+const InternalCloseTimestampResponse& InternalCloseTimestampResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalCloseTimestampResponse* InternalCloseTimestampResponse::default_instance_ = NULL;
+
+InternalCloseTimestampResponse* InternalCloseTimestampResponse::New() const {
+  return new InternalCloseTimestampResponse;
+}
+
+void InternalCloseTimestampResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalCloseTimestampResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalCloseTimestampResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalCloseTimestampResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalCloseTimestampResponse)
+  return false;
+#undef DO_
+}
+
+void InternalCloseTimestampResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalCloseTimestampResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalCloseTimestampResponse)
+}
+
+::google::protobuf::uint8* InternalCloseTimestampResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalCloseTimestampResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalCloseTimestampResponse)
+  return target;
+}
+
+int InternalCloseTimestampResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalCloseTimestampResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalCloseTimestampResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalCloseTimestampResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalCloseTimestampResponse::MergeFrom(const InternalCloseTimestampResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalCloseTimestampResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalCloseTimestampResponse::CopyFrom(const InternalCloseTimestampResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalCloseTimestampResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalCloseTimestampResponse::Swap(InternalCloseTimestampResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalCloseTimestampResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalCloseTimestampResponse_descriptor_;
+  metadata.reflection = InternalCloseTimestampResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalQueryTxnRequest::kHeaderFieldNumber;
+const int InternalQueryTxnRequest::kQueriedTxnFieldNumber;
+#endif  // !_MSC_VER
+
+InternalQueryTxnRequest::InternalQueryTxnRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalQueryTxnRequest)
+}
+
+void InternalQueryTxnRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  queried_txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
+}
+
+InternalQueryTxnRequest::InternalQueryTxnRequest(const InternalQueryTxnRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalQueryTxnRequest)
+}
+
+void InternalQueryTxnRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  queried_txn_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalQueryTxnRequest::~InternalQueryTxnRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalQueryTxnRequest)
+  SharedDtor();
+}
+
+void InternalQueryTxnRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete queried_txn_;
+  }
+}
+
+void InternalQueryTxnRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalQueryTxnRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalQueryTxnRequest_descriptor_;
+}
+
+const InternalQueryTxnRequest& InternalQueryTxnRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalQueryTxnRequest* InternalQueryTxnRequest::default_instance_ = NULL;
+
+InternalQueryTxnRequest* InternalQueryTxnRequest::New() const {
+  return new InternalQueryTxnRequest;
+}
+
+void InternalQueryTxnRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_queried_txn()) {
+      if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalQueryTxnRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalQueryTxnRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_queried_txn;
+        break;
+      }
+
+      // optional .cockroach.proto.Transaction queried_txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_queried_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_queried_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalQueryTxnRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalQueryTxnRequest)
+  return false;
+#undef DO_
+}
+
+void InternalQueryTxnRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalQueryTxnRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  if (has_queried_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->queried_txn(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalQueryTxnRequest)
+}
+
+::google::protobuf::uint8* InternalQueryTxnRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalQueryTxnRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  if (has_queried_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->queried_txn(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalQueryTxnRequest)
+  return target;
+}
+
+int InternalQueryTxnRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .cockroach.proto.Transaction queried_txn = 2;
+    if (has_queried_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->queried_txn());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalQueryTxnRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalQueryTxnRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalQueryTxnRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalQueryTxnRequest::MergeFrom(const InternalQueryTxnRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_queried_txn()) {
+      mutable_queried_txn()->::cockroach::proto::Transaction::MergeFrom(from.queried_txn());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalQueryTxnRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalQueryTxnRequest::CopyFrom(const InternalQueryTxnRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalQueryTxnRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalQueryTxnRequest::Swap(InternalQueryTxnRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(queried_txn_, other->queried_txn_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalQueryTxnRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalQueryTxnRequest_descriptor_;
+  metadata.reflection = InternalQueryTxnRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalQueryTxnResponse::kHeaderFieldNumber;
+const int InternalQueryTxnResponse::kQueriedTxnFieldNumber;
+const int InternalQueryTxnResponse::kWaitingTxnsFieldNumber;
+#endif  // !_MSC_VER
+
+InternalQueryTxnResponse::InternalQueryTxnResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalQueryTxnResponse)
+}
+
+void InternalQueryTxnResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  queried_txn_ = const_cast< ::cockroach::proto::Transaction*>(&::cockroach::proto::Transaction::default_instance());
+}
+
+InternalQueryTxnResponse::InternalQueryTxnResponse(const InternalQueryTxnResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalQueryTxnResponse)
+}
+
+void InternalQueryTxnResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  queried_txn_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalQueryTxnResponse::~InternalQueryTxnResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalQueryTxnResponse)
+  SharedDtor();
+}
+
+void InternalQueryTxnResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete queried_txn_;
+  }
+}
+
+void InternalQueryTxnResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalQueryTxnResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalQueryTxnResponse_descriptor_;
+}
+
+const InternalQueryTxnResponse& InternalQueryTxnResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalQueryTxnResponse* InternalQueryTxnResponse::default_instance_ = NULL;
+
+InternalQueryTxnResponse* InternalQueryTxnResponse::New() const {
+  return new InternalQueryTxnResponse;
+}
+
+void InternalQueryTxnResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_queried_txn()) {
+      if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+    }
+  }
+  waiting_txns_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalQueryTxnResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalQueryTxnResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_queried_txn;
+        break;
+      }
+
+      // optional .cockroach.proto.Transaction queried_txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_queried_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_queried_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_waiting_txns;
+        break;
+      }
+
+      // repeated bytes waiting_txns = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_waiting_txns:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_waiting_txns()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_waiting_txns;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.InternalQueryTxnResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.InternalQueryTxnResponse)
+  return false;
+#undef DO_
+}
+
+void InternalQueryTxnResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.InternalQueryTxnResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  if (has_queried_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->queried_txn(), output);
+  }
+
+  // repeated bytes waiting_txns = 3;
+  for (int i = 0; i < this->waiting_txns_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      3, this->waiting_txns(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.InternalQueryTxnResponse)
+}
+
+::google::protobuf::uint8* InternalQueryTxnResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.InternalQueryTxnResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  if (has_queried_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->queried_txn(), target);
+  }
+
+  // repeated bytes waiting_txns = 3;
+  for (int i = 0; i < this->waiting_txns_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(3, this->waiting_txns(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.InternalQueryTxnResponse)
+  return target;
+}
+
+int InternalQueryTxnResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .cockroach.proto.Transaction queried_txn = 2;
+    if (has_queried_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->queried_txn());
+    }
+
+  }
+  // repeated bytes waiting_txns = 3;
+  total_size += 1 * this->waiting_txns_size();
+  for (int i = 0; i < this->waiting_txns_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->waiting_txns(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalQueryTxnResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalQueryTxnResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalQueryTxnResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalQueryTxnResponse::MergeFrom(const InternalQueryTxnResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  waiting_txns_.MergeFrom(from.waiting_txns_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_queried_txn()) {
+      mutable_queried_txn()->::cockroach::proto::Transaction::MergeFrom(from.queried_txn());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalQueryTxnResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalQueryTxnResponse::CopyFrom(const InternalQueryTxnResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalQueryTxnResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalQueryTxnResponse::Swap(InternalQueryTxnResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(queried_txn_, other->queried_txn_);
+    waiting_txns_.Swap(&other->waiting_txns_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalQueryTxnResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalQueryTxnResponse_descriptor_;
+  metadata.reflection = InternalQueryTxnResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalRequestUnion::kContainsFieldNumber;
+const int InternalRequestUnion::kGetFieldNumber;
+const int InternalRequestUnion::kPutFieldNumber;
+const int InternalRequestUnion::kConditionalPutFieldNumber;
+const int InternalRequestUnion::kIncrementFieldNumber;
+const int InternalRequestUnion::kDeleteFieldNumber;
+const int InternalRequestUnion::kDeleteRangeFieldNumber;
+const int InternalRequestUnion::kScanFieldNumber;
+const int InternalRequestUnion::kEndTransactionFieldNumber;
+const int InternalRequestUnion::kInternalPushTxnFieldNumber;
+const int InternalRequestUnion::kInternalResolveIntentFieldNumber;
+#endif  // !_MSC_VER
+
+InternalRequestUnion::InternalRequestUnion()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.InternalRequestUnion)
+}
+
+void InternalRequestUnion::InitAsDefaultInstance() {
+  InternalRequestUnion_default_oneof_instance_->contains_ = const_cast< ::cockroach::proto::ContainsRequest*>(&::cockroach::proto::ContainsRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetRequest*>(&::cockroach::proto::GetRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutRequest*>(&::cockroach::proto::PutRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutRequest*>(&::cockroach::proto::ConditionalPutRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementRequest*>(&::cockroach::proto::IncrementRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteRequest*>(&::cockroach::proto::DeleteRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnRequest*>(&::cockroach::proto::InternalPushTxnRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentRequest*>(&::cockroach::proto::InternalResolveIntentRequest::default_instance());
+}
+
+InternalRequestUnion::InternalRequestUnion(const InternalRequestUnion& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.InternalRequestUnion)
+}
+
+void InternalRequestUnion::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  clear_has_value();
+}
+
+InternalRequestUnion::~InternalRequestUnion() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.InternalRequestUnion)
+  SharedDtor();
+}
+
+void InternalRequestUnion::SharedDtor() {
+  if (has_value()) {
+    clear_value();
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void InternalRequestUnion::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalRequestUnion::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalRequestUnion_descriptor_;
+}
+
+const InternalRequestUnion& InternalRequestUnion::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  return *default_instance_;
+}
+
+InternalRequestUnion* InternalRequestUnion::default_instance_ = NULL;
+
+InternalRequestUnion* InternalRequestUnion::New() const {
+  return new InternalRequestUnion;
+}
+
+void InternalRequestUnion::clear_value() {
+  switch(value_case()) {
+    case kContains: {
+      delete value_.contains_;
+      break;
+    }
+    case kGet: {
+      delete value_.get_;
+      break;
+    }
+    case kPut: {
+      delete value_.put_;
+      break;
+    }
+    case kConditionalPut: {
+      delete value_.conditional_put_;
+      break;
+    }
+    case kIncrement: {
+      delete value_.increment_;
+      break;
+    }
+    case kDelete: {
+      delete value_.delete__;
+      break;
+    }
+    case kDeleteRange: {
+      delete value_.delete_range_;
+      break;
+    }
+    case kScan: {
+      delete value_.scan_;
+      break;
+    }
+    case kEndTransaction: {
+      delete value_.end_transaction_;
+      break;
+    }
+    case kInternalPushTxn: {
+      delete value_.internal_push_txn_;
+      break;
+    }
+    case kInternalResolveIntent: {
+      delete value_.internal_resolve_intent_;
+      break;
+    }
+    case VALUE_NOT_SET: {
+      break;
+    }
+  }
+  _oneof_case_[0] = VALUE_NOT_SET;
+}
+
+
+void InternalRequestUnion::Clear() {
+  clear_value();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalRequestUnion::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.InternalRequestUnion)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ContainsRequest contains = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_contains()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_get;
+        break;
+      }
+
+      // optional .cockroach.proto.GetRequest get = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_get:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_put;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRequest put = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_conditional_put;
+        break;
+      }
+
+      // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_conditional_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_increment;
+        break;
+      }
+
+      // optional .cockroach.proto.IncrementRequest increment = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_increment:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_increment()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_delete;
+        break;
+      }
 
       // optional .cockroach.proto.DeleteRequest delete = 6;
       case 6: {
@@ -7403,6 +8706,8 @@ const int ReadWriteCmdResponse::kInternalMergeFieldNumber;
 const int ReadWriteCmdResponse::kInternalTruncateLogFieldNumber;
 const int ReadWriteCmdResponse::kInternalGcFieldNumber;
 const int ReadWriteCmdResponse::kInternalLeaderLeaseFieldNumber;
+const int ReadWriteCmdResponse::kInternalCloseTimestampFieldNumber;
+const int ReadWriteCmdResponse::kIngestFieldNumber;
 #endif  // !_MSC_VER
 
 ReadWriteCmdResponse::ReadWriteCmdResponse()
@@ -7425,6 +8730,8 @@ void ReadWriteCmdResponse::InitAsDefaultInstance() {
   ReadWriteCmdResponse_default_oneof_instance_->internal_truncate_log_ = const_cast< ::cockroach::proto::InternalTruncateLogResponse*>(&::cockroach::proto::InternalTruncateLogResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_gc_ = const_cast< ::cockroach::proto::InternalGCResponse*>(&::cockroach::proto::InternalGCResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_leader_lease_ = const_cast< ::cockroach::proto::InternalLeaderLeaseResponse*>(&::cockroach::proto::InternalLeaderLeaseResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->internal_close_timestamp_ = const_cast< ::cockroach::proto::InternalCloseTimestampResponse*>(&::cockroach::proto::InternalCloseTimestampResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->ingest_ = const_cast< ::cockroach::proto::IngestResponse*>(&::cockroach::proto::IngestResponse::default_instance());
 }
 
 ReadWriteCmdResponse::ReadWriteCmdResponse(const ReadWriteCmdResponse& from)
@@ -7528,6 +8835,14 @@ void ReadWriteCmdResponse::clear_value() {
       delete value_.internal_leader_lease_;
       break;
     }
+    case kInternalCloseTimestamp: {
+      delete value_.internal_close_timestamp_;
+      break;
+    }
+    case kIngest: {
+      delete value_.ingest_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -7716,6 +9031,32 @@ bool ReadWriteCmdResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(138)) goto parse_internal_close_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalCloseTimestampResponse internal_close_timestamp = 17;
+      case 17: {
+        if (tag == 138) {
+         parse_internal_close_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_close_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(146)) goto parse_ingest;
+        break;
+      }
+
+      // optional .cockroach.proto.IngestResponse ingest = 18;
+      case 18: {
+        if (tag == 146) {
+         parse_ingest:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_ingest()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -7823,6 +9164,18 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
       16, this->internal_leader_lease(), output);
   }
 
+  // optional .cockroach.proto.InternalCloseTimestampResponse internal_close_timestamp = 17;
+  if (has_internal_close_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      17, this->internal_close_timestamp(), output);
+  }
+
+  // optional .cockroach.proto.IngestResponse ingest = 18;
+  if (has_ingest()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      18, this->ingest(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -7924,6 +9277,20 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
         16, this->internal_leader_lease(), target);
   }
 
+  // optional .cockroach.proto.InternalCloseTimestampResponse internal_close_timestamp = 17;
+  if (has_internal_close_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        17, this->internal_close_timestamp(), target);
+  }
+
+  // optional .cockroach.proto.IngestResponse ingest = 18;
+  if (has_ingest()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        18, this->ingest(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -8027,6 +9394,20 @@ int ReadWriteCmdResponse::ByteSize() const {
           this->internal_leader_lease());
       break;
     }
+    // optional .cockroach.proto.InternalCloseTimestampResponse internal_close_timestamp = 17;
+    case kInternalCloseTimestamp: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_close_timestamp());
+      break;
+    }
+    // optional .cockroach.proto.IngestResponse ingest = 18;
+    case kIngest: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->ingest());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -8109,6 +9490,14 @@ void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
       mutable_internal_leader_lease()->::cockroach::proto::InternalLeaderLeaseResponse::MergeFrom(from.internal_leader_lease());
       break;
     }
+    case kInternalCloseTimestamp: {
+      mutable_internal_close_timestamp()->::cockroach::proto::InternalCloseTimestampResponse::MergeFrom(from.internal_close_timestamp());
+      break;
+    }
+    case kIngest: {
+      mutable_ingest()->::cockroach::proto::IngestResponse::MergeFrom(from.ingest());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -8174,6 +9563,8 @@ const int InternalRaftCommandUnion::kInternalTruncateLogFieldNumber;
 const int InternalRaftCommandUnion::kInternalGcFieldNumber;
 const int InternalRaftCommandUnion::kInternalLeaseFieldNumber;
 const int InternalRaftCommandUnion::kInternalBatchFieldNumber;
+const int InternalRaftCommandUnion::kInternalCloseTimestampFieldNumber;
+const int InternalRaftCommandUnion::kIngestFieldNumber;
 #endif  // !_MSC_VER
 
 InternalRaftCommandUnion::InternalRaftCommandUnion()
@@ -8202,6 +9593,8 @@ void InternalRaftCommandUnion::InitAsDefaultInstance() {
   InternalRaftCommandUnion_default_oneof_instance_->internal_gc_ = const_cast< ::cockroach::proto::InternalGCRequest*>(&::cockroach::proto::InternalGCRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->internal_lease_ = const_cast< ::cockroach::proto::InternalLeaderLeaseRequest*>(&::cockroach::proto::InternalLeaderLeaseRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->internal_batch_ = const_cast< ::cockroach::proto::InternalBatchRequest*>(&::cockroach::proto::InternalBatchRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->internal_close_timestamp_ = const_cast< ::cockroach::proto::InternalCloseTimestampRequest*>(&::cockroach::proto::InternalCloseTimestampRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->ingest_ = const_cast< ::cockroach::proto::IngestRequest*>(&::cockroach::proto::IngestRequest::default_instance());
 }
 
 InternalRaftCommandUnion::InternalRaftCommandUnion(const InternalRaftCommandUnion& from)
@@ -8329,6 +9722,14 @@ void InternalRaftCommandUnion::clear_value() {
       delete value_.internal_batch_;
       break;
     }
+    case kInternalCloseTimestamp: {
+      delete value_.internal_close_timestamp_;
+      break;
+    }
+    case kIngest: {
+      delete value_.ingest_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -8595,6 +9996,32 @@ bool InternalRaftCommandUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(322)) goto parse_internal_close_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.InternalCloseTimestampRequest internal_close_timestamp = 40;
+      case 40: {
+        if (tag == 322) {
+         parse_internal_close_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_close_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(338)) goto parse_ingest;
+        break;
+      }
+
+      // optional .cockroach.proto.IngestRequest ingest = 42;
+      case 42: {
+        if (tag == 338) {
+         parse_ingest:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_ingest()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -8738,6 +10165,18 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
       39, this->internal_batch(), output);
   }
 
+  // optional .cockroach.proto.InternalCloseTimestampRequest internal_close_timestamp = 40;
+  if (has_internal_close_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      40, this->internal_close_timestamp(), output);
+  }
+
+  // optional .cockroach.proto.IngestRequest ingest = 42;
+  if (has_ingest()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      42, this->ingest(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -8881,6 +10320,20 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
         39, this->internal_batch(), target);
   }
 
+  // optional .cockroach.proto.InternalCloseTimestampRequest internal_close_timestamp = 40;
+  if (has_internal_close_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        40, this->internal_close_timestamp(), target);
+  }
+
+  // optional .cockroach.proto.IngestRequest ingest = 42;
+  if (has_ingest()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        42, this->ingest(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -9026,6 +10479,20 @@ int InternalRaftCommandUnion::ByteSize() const {
           this->internal_batch());
       break;
     }
+    // optional .cockroach.proto.InternalCloseTimestampRequest internal_close_timestamp = 40;
+    case kInternalCloseTimestamp: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_close_timestamp());
+      break;
+    }
+    // optional .cockroach.proto.IngestRequest ingest = 42;
+    case kIngest: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->ingest());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -9132,6 +10599,14 @@ void InternalRaftCommandUnion::MergeFrom(const InternalRaftCommandUnion& from) {
       mutable_internal_batch()->::cockroach::proto::InternalBatchRequest::MergeFrom(from.internal_batch());
       break;
     }
+    case kInternalCloseTimestamp: {
+      mutable_internal_close_timestamp()->::cockroach::proto::InternalCloseTimestampRequest::MergeFrom(from.internal_close_timestamp());
+      break;
+    }
+    case kIngest: {
+      mutable_ingest()->::cockroach::proto::IngestRequest::MergeFrom(from.ingest());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -56,6 +56,10 @@ class InternalTruncateLogRequest;
 class InternalTruncateLogResponse;
 class InternalLeaderLeaseRequest;
 class InternalLeaderLeaseResponse;
+class InternalCloseTimestampRequest;
+class InternalCloseTimestampResponse;
+class InternalQueryTxnRequest;
+class InternalQueryTxnResponse;
 class InternalRequestUnion;
 class InternalResponseUnion;
 class InternalBatchRequest;
@@ -801,6 +805,13 @@ class InternalPushTxnRequest : public ::google::protobuf::Message {
   inline bool abort() const;
   inline void set_abort(bool value);
 
+  // optional bool force = 4;
+  inline bool has_force() const;
+  inline void clear_force();
+  static const int kForceFieldNumber = 4;
+  inline bool force() const;
+  inline void set_force(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.InternalPushTxnRequest)
  private:
   inline void set_has_header();
@@ -809,6 +820,8 @@ class InternalPushTxnRequest : public ::google::protobuf::Message {
   inline void clear_has_pushee_txn();
   inline void set_has_abort();
   inline void clear_has_abort();
+  inline void set_has_force();
+  inline void clear_has_force();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -817,6 +830,7 @@ class InternalPushTxnRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* header_;
   ::cockroach::proto::Transaction* pushee_txn_;
   bool abort_;
+  bool force_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
@@ -1498,12 +1512,21 @@ class InternalLeaderLeaseRequest : public ::google::protobuf::Message {
   inline ::cockroach::proto::Lease* release_lease();
   inline void set_allocated_lease(::cockroach::proto::Lease* lease);
 
+  // optional bool transfer = 3;
+  inline bool has_transfer() const;
+  inline void clear_transfer();
+  static const int kTransferFieldNumber = 3;
+  inline bool transfer() const;
+  inline void set_transfer(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.InternalLeaderLeaseRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_lease();
   inline void clear_has_lease();
+  inline void set_has_transfer();
+  inline void clear_has_transfer();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1511,6 +1534,7 @@ class InternalLeaderLeaseRequest : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
   ::cockroach::proto::Lease* lease_;
+  bool transfer_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
@@ -1601,14 +1625,14 @@ class InternalLeaderLeaseResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class InternalRequestUnion : public ::google::protobuf::Message {
+class InternalCloseTimestampRequest : public ::google::protobuf::Message {
  public:
-  InternalRequestUnion();
-  virtual ~InternalRequestUnion();
+  InternalCloseTimestampRequest();
+  virtual ~InternalCloseTimestampRequest();
 
-  InternalRequestUnion(const InternalRequestUnion& from);
+  InternalCloseTimestampRequest(const InternalCloseTimestampRequest& from);
 
-  inline InternalRequestUnion& operator=(const InternalRequestUnion& from) {
+  inline InternalCloseTimestampRequest& operator=(const InternalCloseTimestampRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1622,32 +1646,17 @@ class InternalRequestUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalRequestUnion& default_instance();
-
-  enum ValueCase {
-    kContains = 1,
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    kInternalPushTxn = 30,
-    kInternalResolveIntent = 31,
-    VALUE_NOT_SET = 0,
-  };
+  static const InternalCloseTimestampRequest& default_instance();
 
-  void Swap(InternalRequestUnion* other);
+  void Swap(InternalCloseTimestampRequest* other);
 
   // implements Message ----------------------------------------------
 
-  InternalRequestUnion* New() const;
+  InternalCloseTimestampRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalRequestUnion& from);
-  void MergeFrom(const InternalRequestUnion& from);
+  void CopyFrom(const InternalCloseTimestampRequest& from);
+  void MergeFrom(const InternalCloseTimestampRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1669,160 +1678,135 @@ class InternalRequestUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ContainsRequest contains = 1;
-  inline bool has_contains() const;
-  inline void clear_contains();
-  static const int kContainsFieldNumber = 1;
-  inline const ::cockroach::proto::ContainsRequest& contains() const;
-  inline ::cockroach::proto::ContainsRequest* mutable_contains();
-  inline ::cockroach::proto::ContainsRequest* release_contains();
-  inline void set_allocated_contains(::cockroach::proto::ContainsRequest* contains);
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // optional .cockroach.proto.GetRequest get = 2;
-  inline bool has_get() const;
-  inline void clear_get();
-  static const int kGetFieldNumber = 2;
-  inline const ::cockroach::proto::GetRequest& get() const;
-  inline ::cockroach::proto::GetRequest* mutable_get();
-  inline ::cockroach::proto::GetRequest* release_get();
-  inline void set_allocated_get(::cockroach::proto::GetRequest* get);
+  // optional .cockroach.proto.Timestamp closed_timestamp = 2;
+  inline bool has_closed_timestamp() const;
+  inline void clear_closed_timestamp();
+  static const int kClosedTimestampFieldNumber = 2;
+  inline const ::cockroach::proto::Timestamp& closed_timestamp() const;
+  inline ::cockroach::proto::Timestamp* mutable_closed_timestamp();
+  inline ::cockroach::proto::Timestamp* release_closed_timestamp();
+  inline void set_allocated_closed_timestamp(::cockroach::proto::Timestamp* closed_timestamp);
 
-  // optional .cockroach.proto.PutRequest put = 3;
-  inline bool has_put() const;
-  inline void clear_put();
-  static const int kPutFieldNumber = 3;
-  inline const ::cockroach::proto::PutRequest& put() const;
-  inline ::cockroach::proto::PutRequest* mutable_put();
-  inline ::cockroach::proto::PutRequest* release_put();
-  inline void set_allocated_put(::cockroach::proto::PutRequest* put);
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalCloseTimestampRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_closed_timestamp();
+  inline void clear_has_closed_timestamp();
 
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  inline bool has_conditional_put() const;
-  inline void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  inline const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
-  inline ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
-  inline ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
-  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  inline bool has_increment() const;
-  inline void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  inline const ::cockroach::proto::IncrementRequest& increment() const;
-  inline ::cockroach::proto::IncrementRequest* mutable_increment();
-  inline ::cockroach::proto::IncrementRequest* release_increment();
-  inline void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Timestamp* closed_timestamp_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  inline bool has_delete_() const;
-  inline void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  inline const ::cockroach::proto::DeleteRequest& delete_() const;
-  inline ::cockroach::proto::DeleteRequest* mutable_delete_();
-  inline ::cockroach::proto::DeleteRequest* release_delete_();
-  inline void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
+  void InitAsDefaultInstance();
+  static InternalCloseTimestampRequest* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  inline bool has_delete_range() const;
-  inline void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  inline const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
-  inline ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
-  inline ::cockroach::proto::DeleteRangeRequest* release_delete_range();
-  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
+class InternalCloseTimestampResponse : public ::google::protobuf::Message {
+ public:
+  InternalCloseTimestampResponse();
+  virtual ~InternalCloseTimestampResponse();
 
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  inline bool has_scan() const;
-  inline void clear_scan();
-  static const int kScanFieldNumber = 8;
-  inline const ::cockroach::proto::ScanRequest& scan() const;
-  inline ::cockroach::proto::ScanRequest* mutable_scan();
-  inline ::cockroach::proto::ScanRequest* release_scan();
-  inline void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
+  InternalCloseTimestampResponse(const InternalCloseTimestampResponse& from);
 
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  inline bool has_end_transaction() const;
-  inline void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  inline const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
-  inline ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
-  inline ::cockroach::proto::EndTransactionRequest* release_end_transaction();
-  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
+  inline InternalCloseTimestampResponse& operator=(const InternalCloseTimestampResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-  // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
-  inline bool has_internal_push_txn() const;
-  inline void clear_internal_push_txn();
-  static const int kInternalPushTxnFieldNumber = 30;
-  inline const ::cockroach::proto::InternalPushTxnRequest& internal_push_txn() const;
-  inline ::cockroach::proto::InternalPushTxnRequest* mutable_internal_push_txn();
-  inline ::cockroach::proto::InternalPushTxnRequest* release_internal_push_txn();
-  inline void set_allocated_internal_push_txn(::cockroach::proto::InternalPushTxnRequest* internal_push_txn);
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
 
-  // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 31;
-  inline bool has_internal_resolve_intent() const;
-  inline void clear_internal_resolve_intent();
-  static const int kInternalResolveIntentFieldNumber = 31;
-  inline const ::cockroach::proto::InternalResolveIntentRequest& internal_resolve_intent() const;
-  inline ::cockroach::proto::InternalResolveIntentRequest* mutable_internal_resolve_intent();
-  inline ::cockroach::proto::InternalResolveIntentRequest* release_internal_resolve_intent();
-  inline void set_allocated_internal_resolve_intent(::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent);
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
 
-  inline ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRequestUnion)
- private:
-  inline void set_has_contains();
-  inline void set_has_get();
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_scan();
-  inline void set_has_end_transaction();
-  inline void set_has_internal_push_txn();
-  inline void set_has_internal_resolve_intent();
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalCloseTimestampResponse& default_instance();
 
-  inline bool has_value();
-  void clear_value();
-  inline void clear_has_value();
+  void Swap(InternalCloseTimestampResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalCloseTimestampResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalCloseTimestampResponse& from);
+  void MergeFrom(const InternalCloseTimestampResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalCloseTimestampResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  union ValueUnion {
-    ::cockroach::proto::ContainsRequest* contains_;
-    ::cockroach::proto::GetRequest* get_;
-    ::cockroach::proto::PutRequest* put_;
-    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
-    ::cockroach::proto::IncrementRequest* increment_;
-    ::cockroach::proto::DeleteRequest* delete__;
-    ::cockroach::proto::DeleteRangeRequest* delete_range_;
-    ::cockroach::proto::ScanRequest* scan_;
-    ::cockroach::proto::EndTransactionRequest* end_transaction_;
-    ::cockroach::proto::InternalPushTxnRequest* internal_push_txn_;
-    ::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
+  ::cockroach::proto::ResponseHeader* header_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalRequestUnion* default_instance_;
+  static InternalCloseTimestampResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalResponseUnion : public ::google::protobuf::Message {
+class InternalQueryTxnRequest : public ::google::protobuf::Message {
  public:
-  InternalResponseUnion();
-  virtual ~InternalResponseUnion();
+  InternalQueryTxnRequest();
+  virtual ~InternalQueryTxnRequest();
 
-  InternalResponseUnion(const InternalResponseUnion& from);
+  InternalQueryTxnRequest(const InternalQueryTxnRequest& from);
 
-  inline InternalResponseUnion& operator=(const InternalResponseUnion& from) {
+  inline InternalQueryTxnRequest& operator=(const InternalQueryTxnRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1836,32 +1820,17 @@ class InternalResponseUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalResponseUnion& default_instance();
-
-  enum ValueCase {
-    kContains = 1,
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    kInternalPushTxn = 30,
-    kInternalResolveIntent = 31,
-    VALUE_NOT_SET = 0,
-  };
+  static const InternalQueryTxnRequest& default_instance();
 
-  void Swap(InternalResponseUnion* other);
+  void Swap(InternalQueryTxnRequest* other);
 
   // implements Message ----------------------------------------------
 
-  InternalResponseUnion* New() const;
+  InternalQueryTxnRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalResponseUnion& from);
-  void MergeFrom(const InternalResponseUnion& from);
+  void CopyFrom(const InternalQueryTxnRequest& from);
+  void MergeFrom(const InternalQueryTxnRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1883,18 +1852,450 @@ class InternalResponseUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.ContainsResponse contains = 1;
-  inline bool has_contains() const;
-  inline void clear_contains();
-  static const int kContainsFieldNumber = 1;
-  inline const ::cockroach::proto::ContainsResponse& contains() const;
-  inline ::cockroach::proto::ContainsResponse* mutable_contains();
-  inline ::cockroach::proto::ContainsResponse* release_contains();
-  inline void set_allocated_contains(::cockroach::proto::ContainsResponse* contains);
-
-  // optional .cockroach.proto.GetResponse get = 2;
-  inline bool has_get() const;
-  inline void clear_get();
+  // optional .cockroach.proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::RequestHeader& header() const;
+  inline ::cockroach::proto::RequestHeader* mutable_header();
+  inline ::cockroach::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  inline bool has_queried_txn() const;
+  inline void clear_queried_txn();
+  static const int kQueriedTxnFieldNumber = 2;
+  inline const ::cockroach::proto::Transaction& queried_txn() const;
+  inline ::cockroach::proto::Transaction* mutable_queried_txn();
+  inline ::cockroach::proto::Transaction* release_queried_txn();
+  inline void set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalQueryTxnRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_queried_txn();
+  inline void clear_has_queried_txn();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Transaction* queried_txn_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalQueryTxnRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalQueryTxnResponse : public ::google::protobuf::Message {
+ public:
+  InternalQueryTxnResponse();
+  virtual ~InternalQueryTxnResponse();
+
+  InternalQueryTxnResponse(const InternalQueryTxnResponse& from);
+
+  inline InternalQueryTxnResponse& operator=(const InternalQueryTxnResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalQueryTxnResponse& default_instance();
+
+  void Swap(InternalQueryTxnResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalQueryTxnResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalQueryTxnResponse& from);
+  void MergeFrom(const InternalQueryTxnResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::cockroach::proto::ResponseHeader& header() const;
+  inline ::cockroach::proto::ResponseHeader* mutable_header();
+  inline ::cockroach::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // optional .cockroach.proto.Transaction queried_txn = 2;
+  inline bool has_queried_txn() const;
+  inline void clear_queried_txn();
+  static const int kQueriedTxnFieldNumber = 2;
+  inline const ::cockroach::proto::Transaction& queried_txn() const;
+  inline ::cockroach::proto::Transaction* mutable_queried_txn();
+  inline ::cockroach::proto::Transaction* release_queried_txn();
+  inline void set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn);
+
+  // repeated bytes waiting_txns = 3;
+  inline int waiting_txns_size() const;
+  inline void clear_waiting_txns();
+  static const int kWaitingTxnsFieldNumber = 3;
+  inline const ::std::string& waiting_txns(int index) const;
+  inline ::std::string* mutable_waiting_txns(int index);
+  inline void set_waiting_txns(int index, const ::std::string& value);
+  inline void set_waiting_txns(int index, const char* value);
+  inline void set_waiting_txns(int index, const void* value, size_t size);
+  inline ::std::string* add_waiting_txns();
+  inline void add_waiting_txns(const ::std::string& value);
+  inline void add_waiting_txns(const char* value);
+  inline void add_waiting_txns(const void* value, size_t size);
+  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& waiting_txns() const;
+  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_waiting_txns();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalQueryTxnResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_queried_txn();
+  inline void clear_has_queried_txn();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::cockroach::proto::Transaction* queried_txn_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> waiting_txns_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalQueryTxnResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalRequestUnion : public ::google::protobuf::Message {
+ public:
+  InternalRequestUnion();
+  virtual ~InternalRequestUnion();
+
+  InternalRequestUnion(const InternalRequestUnion& from);
+
+  inline InternalRequestUnion& operator=(const InternalRequestUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalRequestUnion& default_instance();
+
+  enum ValueCase {
+    kContains = 1,
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kInternalPushTxn = 30,
+    kInternalResolveIntent = 31,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(InternalRequestUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalRequestUnion* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalRequestUnion& from);
+  void MergeFrom(const InternalRequestUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ContainsRequest contains = 1;
+  inline bool has_contains() const;
+  inline void clear_contains();
+  static const int kContainsFieldNumber = 1;
+  inline const ::cockroach::proto::ContainsRequest& contains() const;
+  inline ::cockroach::proto::ContainsRequest* mutable_contains();
+  inline ::cockroach::proto::ContainsRequest* release_contains();
+  inline void set_allocated_contains(::cockroach::proto::ContainsRequest* contains);
+
+  // optional .cockroach.proto.GetRequest get = 2;
+  inline bool has_get() const;
+  inline void clear_get();
+  static const int kGetFieldNumber = 2;
+  inline const ::cockroach::proto::GetRequest& get() const;
+  inline ::cockroach::proto::GetRequest* mutable_get();
+  inline ::cockroach::proto::GetRequest* release_get();
+  inline void set_allocated_get(::cockroach::proto::GetRequest* get);
+
+  // optional .cockroach.proto.PutRequest put = 3;
+  inline bool has_put() const;
+  inline void clear_put();
+  static const int kPutFieldNumber = 3;
+  inline const ::cockroach::proto::PutRequest& put() const;
+  inline ::cockroach::proto::PutRequest* mutable_put();
+  inline ::cockroach::proto::PutRequest* release_put();
+  inline void set_allocated_put(::cockroach::proto::PutRequest* put);
+
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  inline bool has_conditional_put() const;
+  inline void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  inline const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
+  inline ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
+  inline ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
+  inline void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
+
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  inline bool has_increment() const;
+  inline void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  inline const ::cockroach::proto::IncrementRequest& increment() const;
+  inline ::cockroach::proto::IncrementRequest* mutable_increment();
+  inline ::cockroach::proto::IncrementRequest* release_increment();
+  inline void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
+
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  inline bool has_delete_() const;
+  inline void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  inline const ::cockroach::proto::DeleteRequest& delete_() const;
+  inline ::cockroach::proto::DeleteRequest* mutable_delete_();
+  inline ::cockroach::proto::DeleteRequest* release_delete_();
+  inline void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
+
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  inline bool has_delete_range() const;
+  inline void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  inline const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
+  inline ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
+  inline ::cockroach::proto::DeleteRangeRequest* release_delete_range();
+  inline void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
+
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  inline bool has_scan() const;
+  inline void clear_scan();
+  static const int kScanFieldNumber = 8;
+  inline const ::cockroach::proto::ScanRequest& scan() const;
+  inline ::cockroach::proto::ScanRequest* mutable_scan();
+  inline ::cockroach::proto::ScanRequest* release_scan();
+  inline void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
+
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  inline bool has_end_transaction() const;
+  inline void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  inline const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
+  inline ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
+  inline ::cockroach::proto::EndTransactionRequest* release_end_transaction();
+  inline void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
+
+  // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
+  inline bool has_internal_push_txn() const;
+  inline void clear_internal_push_txn();
+  static const int kInternalPushTxnFieldNumber = 30;
+  inline const ::cockroach::proto::InternalPushTxnRequest& internal_push_txn() const;
+  inline ::cockroach::proto::InternalPushTxnRequest* mutable_internal_push_txn();
+  inline ::cockroach::proto::InternalPushTxnRequest* release_internal_push_txn();
+  inline void set_allocated_internal_push_txn(::cockroach::proto::InternalPushTxnRequest* internal_push_txn);
+
+  // optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 31;
+  inline bool has_internal_resolve_intent() const;
+  inline void clear_internal_resolve_intent();
+  static const int kInternalResolveIntentFieldNumber = 31;
+  inline const ::cockroach::proto::InternalResolveIntentRequest& internal_resolve_intent() const;
+  inline ::cockroach::proto::InternalResolveIntentRequest* mutable_internal_resolve_intent();
+  inline ::cockroach::proto::InternalResolveIntentRequest* release_internal_resolve_intent();
+  inline void set_allocated_internal_resolve_intent(::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent);
+
+  inline ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRequestUnion)
+ private:
+  inline void set_has_contains();
+  inline void set_has_get();
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_scan();
+  inline void set_has_end_transaction();
+  inline void set_has_internal_push_txn();
+  inline void set_has_internal_resolve_intent();
+
+  inline bool has_value();
+  void clear_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  union ValueUnion {
+    ::cockroach::proto::ContainsRequest* contains_;
+    ::cockroach::proto::GetRequest* get_;
+    ::cockroach::proto::PutRequest* put_;
+    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
+    ::cockroach::proto::IncrementRequest* increment_;
+    ::cockroach::proto::DeleteRequest* delete__;
+    ::cockroach::proto::DeleteRangeRequest* delete_range_;
+    ::cockroach::proto::ScanRequest* scan_;
+    ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::InternalPushTxnRequest* internal_push_txn_;
+    ::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
+  friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalRequestUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalResponseUnion : public ::google::protobuf::Message {
+ public:
+  InternalResponseUnion();
+  virtual ~InternalResponseUnion();
+
+  InternalResponseUnion(const InternalResponseUnion& from);
+
+  inline InternalResponseUnion& operator=(const InternalResponseUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalResponseUnion& default_instance();
+
+  enum ValueCase {
+    kContains = 1,
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kInternalPushTxn = 30,
+    kInternalResolveIntent = 31,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(InternalResponseUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalResponseUnion* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalResponseUnion& from);
+  void MergeFrom(const InternalResponseUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ContainsResponse contains = 1;
+  inline bool has_contains() const;
+  inline void clear_contains();
+  static const int kContainsFieldNumber = 1;
+  inline const ::cockroach::proto::ContainsResponse& contains() const;
+  inline ::cockroach::proto::ContainsResponse* mutable_contains();
+  inline ::cockroach::proto::ContainsResponse* release_contains();
+  inline void set_allocated_contains(::cockroach::proto::ContainsResponse* contains);
+
+  // optional .cockroach.proto.GetResponse get = 2;
+  inline bool has_get() const;
+  inline void clear_get();
   static const int kGetFieldNumber = 2;
   inline const ::cockroach::proto::GetResponse& get() const;
   inline ::cockroach::proto::GetResponse* mutable_get();
@@ -2254,6 +2655,8 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     kInternalTruncateLog = 14,
     kInternalGc = 15,
     kInternalLeaderLease = 16,
+    kInternalCloseTimestamp = 17,
+    kIngest = 18,
     VALUE_NOT_SET = 0,
   };
 
@@ -2404,6 +2807,24 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   inline ::cockroach::proto::InternalLeaderLeaseResponse* release_internal_leader_lease();
   inline void set_allocated_internal_leader_lease(::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease);
 
+  // optional .cockroach.proto.InternalCloseTimestampResponse internal_close_timestamp = 17;
+  inline bool has_internal_close_timestamp() const;
+  inline void clear_internal_close_timestamp();
+  static const int kInternalCloseTimestampFieldNumber = 17;
+  inline const ::cockroach::proto::InternalCloseTimestampResponse& internal_close_timestamp() const;
+  inline ::cockroach::proto::InternalCloseTimestampResponse* mutable_internal_close_timestamp();
+  inline ::cockroach::proto::InternalCloseTimestampResponse* release_internal_close_timestamp();
+  inline void set_allocated_internal_close_timestamp(::cockroach::proto::InternalCloseTimestampResponse* internal_close_timestamp);
+
+  // optional .cockroach.proto.IngestResponse ingest = 18;
+  inline bool has_ingest() const;
+  inline void clear_ingest();
+  static const int kIngestFieldNumber = 18;
+  inline const ::cockroach::proto::IngestResponse& ingest() const;
+  inline ::cockroach::proto::IngestResponse* mutable_ingest();
+  inline ::cockroach::proto::IngestResponse* release_ingest();
+  inline void set_allocated_ingest(::cockroach::proto::IngestResponse* ingest);
+
   inline ValueCase value_case() const;
   // @@protoc_insertion_point(class_scope:cockroach.proto.ReadWriteCmdResponse)
  private:
@@ -2420,6 +2841,8 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   inline void set_has_internal_truncate_log();
   inline void set_has_internal_gc();
   inline void set_has_internal_leader_lease();
+  inline void set_has_internal_close_timestamp();
+  inline void set_has_ingest();
 
   inline bool has_value();
   void clear_value();
@@ -2443,6 +2866,8 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
     ::cockroach::proto::InternalGCResponse* internal_gc_;
     ::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease_;
+    ::cockroach::proto::InternalCloseTimestampResponse* internal_close_timestamp_;
+    ::cockroach::proto::IngestResponse* ingest_;
   } value_;
   ::google::protobuf::uint32 _oneof_case_[1];
 
@@ -2498,6 +2923,8 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
     kInternalGc = 37,
     kInternalLease = 38,
     kInternalBatch = 39,
+    kInternalCloseTimestamp = 40,
+    kIngest = 42,
     VALUE_NOT_SET = 0,
   };
 
@@ -2702,6 +3129,24 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
   inline ::cockroach::proto::InternalBatchRequest* release_internal_batch();
   inline void set_allocated_internal_batch(::cockroach::proto::InternalBatchRequest* internal_batch);
 
+  // optional .cockroach.proto.InternalCloseTimestampRequest internal_close_timestamp = 40;
+  inline bool has_internal_close_timestamp() const;
+  inline void clear_internal_close_timestamp();
+  static const int kInternalCloseTimestampFieldNumber = 40;
+  inline const ::cockroach::proto::InternalCloseTimestampRequest& internal_close_timestamp() const;
+  inline ::cockroach::proto::InternalCloseTimestampRequest* mutable_internal_close_timestamp();
+  inline ::cockroach::proto::InternalCloseTimestampRequest* release_internal_close_timestamp();
+  inline void set_allocated_internal_close_timestamp(::cockroach::proto::InternalCloseTimestampRequest* internal_close_timestamp);
+
+  // optional .cockroach.proto.IngestRequest ingest = 42;
+  inline bool has_ingest() const;
+  inline void clear_ingest();
+  static const int kIngestFieldNumber = 42;
+  inline const ::cockroach::proto::IngestRequest& ingest() const;
+  inline ::cockroach::proto::IngestRequest* mutable_ingest();
+  inline ::cockroach::proto::IngestRequest* release_ingest();
+  inline void set_allocated_ingest(::cockroach::proto::IngestRequest* ingest);
+
   inline ValueCase value_case() const;
   // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRaftCommandUnion)
  private:
@@ -2724,6 +3169,8 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
   inline void set_has_internal_gc();
   inline void set_has_internal_lease();
   inline void set_has_internal_batch();
+  inline void set_has_internal_close_timestamp();
+  inline void set_has_ingest();
 
   inline bool has_value();
   void clear_value();
@@ -2753,6 +3200,8 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
     ::cockroach::proto::InternalGCRequest* internal_gc_;
     ::cockroach::proto::InternalLeaderLeaseRequest* internal_lease_;
     ::cockroach::proto::InternalBatchRequest* internal_batch_;
+    ::cockroach::proto::InternalCloseTimestampRequest* internal_close_timestamp_;
+    ::cockroach::proto::IngestRequest* ingest_;
   } value_;
   ::google::protobuf::uint32 _oneof_case_[1];
 
@@ -4160,70 +4609,356 @@ inline void InternalPushTxnRequest::set_allocated_pushee_txn(::cockroach::proto:
   if (pushee_txn) {
     set_has_pushee_txn();
   } else {
-    clear_has_pushee_txn();
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnRequest.pushee_txn)
+}
+
+// optional bool Abort = 3;
+inline bool InternalPushTxnRequest::has_abort() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalPushTxnRequest::set_has_abort() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalPushTxnRequest::clear_has_abort() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalPushTxnRequest::clear_abort() {
+  abort_ = false;
+  clear_has_abort();
+}
+inline bool InternalPushTxnRequest::abort() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.Abort)
+  return abort_;
+}
+inline void InternalPushTxnRequest::set_abort(bool value) {
+  set_has_abort();
+  abort_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalPushTxnRequest.Abort)
+}
+
+// optional bool force = 4;
+inline bool InternalPushTxnRequest::has_force() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalPushTxnRequest::set_has_force() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalPushTxnRequest::clear_has_force() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalPushTxnRequest::clear_force() {
+  force_ = false;
+  clear_has_force();
+}
+inline bool InternalPushTxnRequest::force() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.force)
+  return force_;
+}
+inline void InternalPushTxnRequest::set_force(bool value) {
+  set_has_force();
+  force_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalPushTxnRequest.force)
+}
+
+// -------------------------------------------------------------------
+
+// InternalPushTxnResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool InternalPushTxnResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalPushTxnResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalPushTxnResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalPushTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& InternalPushTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalPushTxnResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalPushTxnResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalPushTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnResponse.header)
+}
+
+// optional .cockroach.proto.Transaction pushee_txn = 2;
+inline bool InternalPushTxnResponse::has_pushee_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalPushTxnResponse::set_has_pushee_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalPushTxnResponse::clear_has_pushee_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalPushTxnResponse::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_pushee_txn();
+}
+inline const ::cockroach::proto::Transaction& InternalPushTxnResponse::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnResponse.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalPushTxnResponse::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) pushee_txn_ = new ::cockroach::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnResponse.pushee_txn)
+  return pushee_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalPushTxnResponse::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::cockroach::proto::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+inline void InternalPushTxnResponse::set_allocated_pushee_txn(::cockroach::proto::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnResponse.pushee_txn)
+}
+
+// -------------------------------------------------------------------
+
+// InternalResolveIntentRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool InternalResolveIntentRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalResolveIntentRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalResolveIntentRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalResolveIntentRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& InternalResolveIntentRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalResolveIntentRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalResolveIntentResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool InternalResolveIntentResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalResolveIntentResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalResolveIntentResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalResolveIntentResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& InternalResolveIntentResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalResolveIntentResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* InternalResolveIntentResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalResolveIntentResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalMergeRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool InternalMergeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalMergeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalMergeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalMergeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& InternalMergeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalMergeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* InternalMergeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalMergeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnRequest.pushee_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeRequest.header)
 }
 
-// optional bool Abort = 3;
-inline bool InternalPushTxnRequest::has_abort() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// optional .cockroach.proto.Value value = 2;
+inline bool InternalMergeRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalPushTxnRequest::set_has_abort() {
-  _has_bits_[0] |= 0x00000004u;
+inline void InternalMergeRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalPushTxnRequest::clear_has_abort() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void InternalMergeRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalPushTxnRequest::clear_abort() {
-  abort_ = false;
-  clear_has_abort();
+inline void InternalMergeRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
 }
-inline bool InternalPushTxnRequest::abort() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnRequest.Abort)
-  return abort_;
+inline const ::cockroach::proto::Value& InternalMergeRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline void InternalPushTxnRequest::set_abort(bool value) {
-  set_has_abort();
-  abort_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.InternalPushTxnRequest.Abort)
+inline ::cockroach::proto::Value* InternalMergeRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeRequest.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* InternalMergeRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void InternalMergeRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeRequest.value)
 }
 
 // -------------------------------------------------------------------
 
-// InternalPushTxnResponse
+// InternalMergeResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalPushTxnResponse::has_header() const {
+inline bool InternalMergeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalPushTxnResponse::set_has_header() {
+inline void InternalMergeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalPushTxnResponse::clear_has_header() {
+inline void InternalMergeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalPushTxnResponse::clear_header() {
+inline void InternalMergeResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalPushTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalMergeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalPushTxnResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalMergeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalPushTxnResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalMergeResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalPushTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalMergeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4231,85 +4966,44 @@ inline void InternalPushTxnResponse::set_allocated_header(::cockroach::proto::Re
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnResponse.header)
-}
-
-// optional .cockroach.proto.Transaction pushee_txn = 2;
-inline bool InternalPushTxnResponse::has_pushee_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void InternalPushTxnResponse::set_has_pushee_txn() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void InternalPushTxnResponse::clear_has_pushee_txn() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void InternalPushTxnResponse::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_pushee_txn();
-}
-inline const ::cockroach::proto::Transaction& InternalPushTxnResponse::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalPushTxnResponse.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
-}
-inline ::cockroach::proto::Transaction* InternalPushTxnResponse::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) pushee_txn_ = new ::cockroach::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalPushTxnResponse.pushee_txn)
-  return pushee_txn_;
-}
-inline ::cockroach::proto::Transaction* InternalPushTxnResponse::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::cockroach::proto::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
-  return temp;
-}
-inline void InternalPushTxnResponse::set_allocated_pushee_txn(::cockroach::proto::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
-  } else {
-    clear_has_pushee_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalPushTxnResponse.pushee_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalResolveIntentRequest
+// InternalTruncateLogRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalResolveIntentRequest::has_header() const {
+inline bool InternalTruncateLogRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalResolveIntentRequest::set_has_header() {
+inline void InternalTruncateLogRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalResolveIntentRequest::clear_has_header() {
+inline void InternalTruncateLogRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalResolveIntentRequest::clear_header() {
+inline void InternalTruncateLogRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalResolveIntentRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalTruncateLogRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalTruncateLogRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalTruncateLogRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalResolveIntentRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalTruncateLogRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalResolveIntentRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalTruncateLogRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4317,44 +5011,68 @@ inline void InternalResolveIntentRequest::set_allocated_header(::cockroach::prot
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalTruncateLogRequest.header)
+}
+
+// optional uint64 index = 2;
+inline bool InternalTruncateLogRequest::has_index() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalTruncateLogRequest::set_has_index() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalTruncateLogRequest::clear_has_index() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalTruncateLogRequest::clear_index() {
+  index_ = GOOGLE_ULONGLONG(0);
+  clear_has_index();
+}
+inline ::google::protobuf::uint64 InternalTruncateLogRequest::index() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogRequest.index)
+  return index_;
+}
+inline void InternalTruncateLogRequest::set_index(::google::protobuf::uint64 value) {
+  set_has_index();
+  index_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalTruncateLogRequest.index)
 }
 
 // -------------------------------------------------------------------
 
-// InternalResolveIntentResponse
+// InternalTruncateLogResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalResolveIntentResponse::has_header() const {
+inline bool InternalTruncateLogResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalResolveIntentResponse::set_has_header() {
+inline void InternalTruncateLogResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalResolveIntentResponse::clear_has_header() {
+inline void InternalTruncateLogResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalResolveIntentResponse::clear_header() {
+inline void InternalTruncateLogResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalResolveIntentResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResolveIntentResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalTruncateLogResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalResolveIntentResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalTruncateLogResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalTruncateLogResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalResolveIntentResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalTruncateLogResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalResolveIntentResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalTruncateLogResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4362,44 +5080,44 @@ inline void InternalResolveIntentResponse::set_allocated_header(::cockroach::pro
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalTruncateLogResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalMergeRequest
+// InternalLeaderLeaseRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalMergeRequest::has_header() const {
+inline bool InternalLeaderLeaseRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalMergeRequest::set_has_header() {
+inline void InternalLeaderLeaseRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalMergeRequest::clear_has_header() {
+inline void InternalLeaderLeaseRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalMergeRequest::clear_header() {
+inline void InternalLeaderLeaseRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalMergeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalLeaderLeaseRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalMergeRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalLeaderLeaseRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalMergeRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalLeaderLeaseRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalMergeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalLeaderLeaseRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4407,85 +5125,109 @@ inline void InternalMergeRequest::set_allocated_header(::cockroach::proto::Reque
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseRequest.header)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool InternalMergeRequest::has_value() const {
+// optional .cockroach.proto.Lease lease = 2;
+inline bool InternalLeaderLeaseRequest::has_lease() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalMergeRequest::set_has_value() {
+inline void InternalLeaderLeaseRequest::set_has_lease() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalMergeRequest::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void InternalLeaderLeaseRequest::clear_has_lease() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalLeaderLeaseRequest::clear_lease() {
+  if (lease_ != NULL) lease_->::cockroach::proto::Lease::Clear();
+  clear_has_lease();
+}
+inline const ::cockroach::proto::Lease& InternalLeaderLeaseRequest::lease() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.lease)
+  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
+}
+inline ::cockroach::proto::Lease* InternalLeaderLeaseRequest::mutable_lease() {
+  set_has_lease();
+  if (lease_ == NULL) lease_ = new ::cockroach::proto::Lease;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseRequest.lease)
+  return lease_;
+}
+inline ::cockroach::proto::Lease* InternalLeaderLeaseRequest::release_lease() {
+  clear_has_lease();
+  ::cockroach::proto::Lease* temp = lease_;
+  lease_ = NULL;
+  return temp;
+}
+inline void InternalLeaderLeaseRequest::set_allocated_lease(::cockroach::proto::Lease* lease) {
+  delete lease_;
+  lease_ = lease;
+  if (lease) {
+    set_has_lease();
+  } else {
+    clear_has_lease();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseRequest.lease)
+}
+
+// optional bool transfer = 3;
+inline bool InternalLeaderLeaseRequest::has_transfer() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void InternalMergeRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline void InternalLeaderLeaseRequest::set_has_transfer() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline const ::cockroach::proto::Value& InternalMergeRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline void InternalLeaderLeaseRequest::clear_has_transfer() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline ::cockroach::proto::Value* InternalMergeRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) value_ = new ::cockroach::proto::Value;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeRequest.value)
-  return value_;
+inline void InternalLeaderLeaseRequest::clear_transfer() {
+  transfer_ = false;
+  clear_has_transfer();
 }
-inline ::cockroach::proto::Value* InternalMergeRequest::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+inline bool InternalLeaderLeaseRequest::transfer() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.transfer)
+  return transfer_;
 }
-inline void InternalMergeRequest::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
-  } else {
-    clear_has_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeRequest.value)
+inline void InternalLeaderLeaseRequest::set_transfer(bool value) {
+  set_has_transfer();
+  transfer_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalLeaderLeaseRequest.transfer)
 }
 
 // -------------------------------------------------------------------
 
-// InternalMergeResponse
+// InternalLeaderLeaseResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalMergeResponse::has_header() const {
+inline bool InternalLeaderLeaseResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalMergeResponse::set_has_header() {
+inline void InternalLeaderLeaseResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalMergeResponse::clear_has_header() {
+inline void InternalLeaderLeaseResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalMergeResponse::clear_header() {
+inline void InternalLeaderLeaseResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalMergeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalMergeResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalLeaderLeaseResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalMergeResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalLeaderLeaseResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalMergeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalMergeResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalLeaderLeaseResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalMergeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalLeaderLeaseResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4493,44 +5235,44 @@ inline void InternalMergeResponse::set_allocated_header(::cockroach::proto::Resp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalMergeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalTruncateLogRequest
+// InternalCloseTimestampRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalTruncateLogRequest::has_header() const {
+inline bool InternalCloseTimestampRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalTruncateLogRequest::set_has_header() {
+inline void InternalCloseTimestampRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalTruncateLogRequest::clear_has_header() {
+inline void InternalCloseTimestampRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalTruncateLogRequest::clear_header() {
+inline void InternalCloseTimestampRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalTruncateLogRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalCloseTimestampRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCloseTimestampRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalTruncateLogRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalCloseTimestampRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalTruncateLogRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCloseTimestampRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalTruncateLogRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalCloseTimestampRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalTruncateLogRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalCloseTimestampRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4538,68 +5280,85 @@ inline void InternalTruncateLogRequest::set_allocated_header(::cockroach::proto:
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalTruncateLogRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCloseTimestampRequest.header)
 }
 
-// optional uint64 index = 2;
-inline bool InternalTruncateLogRequest::has_index() const {
+// optional .cockroach.proto.Timestamp closed_timestamp = 2;
+inline bool InternalCloseTimestampRequest::has_closed_timestamp() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalTruncateLogRequest::set_has_index() {
+inline void InternalCloseTimestampRequest::set_has_closed_timestamp() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalTruncateLogRequest::clear_has_index() {
+inline void InternalCloseTimestampRequest::clear_has_closed_timestamp() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalTruncateLogRequest::clear_index() {
-  index_ = GOOGLE_ULONGLONG(0);
-  clear_has_index();
+inline void InternalCloseTimestampRequest::clear_closed_timestamp() {
+  if (closed_timestamp_ != NULL) closed_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_closed_timestamp();
 }
-inline ::google::protobuf::uint64 InternalTruncateLogRequest::index() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogRequest.index)
-  return index_;
+inline const ::cockroach::proto::Timestamp& InternalCloseTimestampRequest::closed_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCloseTimestampRequest.closed_timestamp)
+  return closed_timestamp_ != NULL ? *closed_timestamp_ : *default_instance_->closed_timestamp_;
 }
-inline void InternalTruncateLogRequest::set_index(::google::protobuf::uint64 value) {
-  set_has_index();
-  index_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.InternalTruncateLogRequest.index)
+inline ::cockroach::proto::Timestamp* InternalCloseTimestampRequest::mutable_closed_timestamp() {
+  set_has_closed_timestamp();
+  if (closed_timestamp_ == NULL) closed_timestamp_ = new ::cockroach::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCloseTimestampRequest.closed_timestamp)
+  return closed_timestamp_;
+}
+inline ::cockroach::proto::Timestamp* InternalCloseTimestampRequest::release_closed_timestamp() {
+  clear_has_closed_timestamp();
+  ::cockroach::proto::Timestamp* temp = closed_timestamp_;
+  closed_timestamp_ = NULL;
+  return temp;
+}
+inline void InternalCloseTimestampRequest::set_allocated_closed_timestamp(::cockroach::proto::Timestamp* closed_timestamp) {
+  delete closed_timestamp_;
+  closed_timestamp_ = closed_timestamp;
+  if (closed_timestamp) {
+    set_has_closed_timestamp();
+  } else {
+    clear_has_closed_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCloseTimestampRequest.closed_timestamp)
 }
 
 // -------------------------------------------------------------------
 
-// InternalTruncateLogResponse
+// InternalCloseTimestampResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalTruncateLogResponse::has_header() const {
+inline bool InternalCloseTimestampResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalTruncateLogResponse::set_has_header() {
+inline void InternalCloseTimestampResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalTruncateLogResponse::clear_has_header() {
+inline void InternalCloseTimestampResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalTruncateLogResponse::clear_header() {
+inline void InternalCloseTimestampResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalTruncateLogResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalTruncateLogResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalCloseTimestampResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalCloseTimestampResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalTruncateLogResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalCloseTimestampResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalTruncateLogResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalCloseTimestampResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalTruncateLogResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalCloseTimestampResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalTruncateLogResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalCloseTimestampResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4607,44 +5366,44 @@ inline void InternalTruncateLogResponse::set_allocated_header(::cockroach::proto
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalTruncateLogResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalCloseTimestampResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalLeaderLeaseRequest
+// InternalQueryTxnRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool InternalLeaderLeaseRequest::has_header() const {
+inline bool InternalQueryTxnRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalLeaderLeaseRequest::set_has_header() {
+inline void InternalQueryTxnRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalLeaderLeaseRequest::clear_has_header() {
+inline void InternalQueryTxnRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalLeaderLeaseRequest::clear_header() {
+inline void InternalQueryTxnRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& InternalLeaderLeaseRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.header)
+inline const ::cockroach::proto::RequestHeader& InternalQueryTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalLeaderLeaseRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* InternalQueryTxnRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* InternalLeaderLeaseRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* InternalQueryTxnRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalLeaderLeaseRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void InternalQueryTxnRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4652,85 +5411,85 @@ inline void InternalLeaderLeaseRequest::set_allocated_header(::cockroach::proto:
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnRequest.header)
 }
 
-// optional .cockroach.proto.Lease lease = 2;
-inline bool InternalLeaderLeaseRequest::has_lease() const {
+// optional .cockroach.proto.Transaction queried_txn = 2;
+inline bool InternalQueryTxnRequest::has_queried_txn() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalLeaderLeaseRequest::set_has_lease() {
+inline void InternalQueryTxnRequest::set_has_queried_txn() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalLeaderLeaseRequest::clear_has_lease() {
+inline void InternalQueryTxnRequest::clear_has_queried_txn() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalLeaderLeaseRequest::clear_lease() {
-  if (lease_ != NULL) lease_->::cockroach::proto::Lease::Clear();
-  clear_has_lease();
+inline void InternalQueryTxnRequest::clear_queried_txn() {
+  if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_queried_txn();
 }
-inline const ::cockroach::proto::Lease& InternalLeaderLeaseRequest::lease() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseRequest.lease)
-  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
+inline const ::cockroach::proto::Transaction& InternalQueryTxnRequest::queried_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnRequest.queried_txn)
+  return queried_txn_ != NULL ? *queried_txn_ : *default_instance_->queried_txn_;
 }
-inline ::cockroach::proto::Lease* InternalLeaderLeaseRequest::mutable_lease() {
-  set_has_lease();
-  if (lease_ == NULL) lease_ = new ::cockroach::proto::Lease;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseRequest.lease)
-  return lease_;
+inline ::cockroach::proto::Transaction* InternalQueryTxnRequest::mutable_queried_txn() {
+  set_has_queried_txn();
+  if (queried_txn_ == NULL) queried_txn_ = new ::cockroach::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnRequest.queried_txn)
+  return queried_txn_;
 }
-inline ::cockroach::proto::Lease* InternalLeaderLeaseRequest::release_lease() {
-  clear_has_lease();
-  ::cockroach::proto::Lease* temp = lease_;
-  lease_ = NULL;
+inline ::cockroach::proto::Transaction* InternalQueryTxnRequest::release_queried_txn() {
+  clear_has_queried_txn();
+  ::cockroach::proto::Transaction* temp = queried_txn_;
+  queried_txn_ = NULL;
   return temp;
 }
-inline void InternalLeaderLeaseRequest::set_allocated_lease(::cockroach::proto::Lease* lease) {
-  delete lease_;
-  lease_ = lease;
-  if (lease) {
-    set_has_lease();
+inline void InternalQueryTxnRequest::set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn) {
+  delete queried_txn_;
+  queried_txn_ = queried_txn;
+  if (queried_txn) {
+    set_has_queried_txn();
   } else {
-    clear_has_lease();
+    clear_has_queried_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseRequest.lease)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnRequest.queried_txn)
 }
 
 // -------------------------------------------------------------------
 
-// InternalLeaderLeaseResponse
+// InternalQueryTxnResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool InternalLeaderLeaseResponse::has_header() const {
+inline bool InternalQueryTxnResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalLeaderLeaseResponse::set_has_header() {
+inline void InternalQueryTxnResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalLeaderLeaseResponse::clear_has_header() {
+inline void InternalQueryTxnResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalLeaderLeaseResponse::clear_header() {
+inline void InternalQueryTxnResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& InternalLeaderLeaseResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalLeaderLeaseResponse.header)
+inline const ::cockroach::proto::ResponseHeader& InternalQueryTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalLeaderLeaseResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* InternalQueryTxnResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::cockroach::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalLeaderLeaseResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* InternalLeaderLeaseResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* InternalQueryTxnResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalLeaderLeaseResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void InternalQueryTxnResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4738,7 +5497,102 @@ inline void InternalLeaderLeaseResponse::set_allocated_header(::cockroach::proto
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalLeaderLeaseResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnResponse.header)
+}
+
+// optional .cockroach.proto.Transaction queried_txn = 2;
+inline bool InternalQueryTxnResponse::has_queried_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalQueryTxnResponse::set_has_queried_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalQueryTxnResponse::clear_has_queried_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalQueryTxnResponse::clear_queried_txn() {
+  if (queried_txn_ != NULL) queried_txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_queried_txn();
+}
+inline const ::cockroach::proto::Transaction& InternalQueryTxnResponse::queried_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+  return queried_txn_ != NULL ? *queried_txn_ : *default_instance_->queried_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalQueryTxnResponse::mutable_queried_txn() {
+  set_has_queried_txn();
+  if (queried_txn_ == NULL) queried_txn_ = new ::cockroach::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+  return queried_txn_;
+}
+inline ::cockroach::proto::Transaction* InternalQueryTxnResponse::release_queried_txn() {
+  clear_has_queried_txn();
+  ::cockroach::proto::Transaction* temp = queried_txn_;
+  queried_txn_ = NULL;
+  return temp;
+}
+inline void InternalQueryTxnResponse::set_allocated_queried_txn(::cockroach::proto::Transaction* queried_txn) {
+  delete queried_txn_;
+  queried_txn_ = queried_txn;
+  if (queried_txn) {
+    set_has_queried_txn();
+  } else {
+    clear_has_queried_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalQueryTxnResponse.queried_txn)
+}
+
+// repeated bytes waiting_txns = 3;
+inline int InternalQueryTxnResponse::waiting_txns_size() const {
+  return waiting_txns_.size();
+}
+inline void InternalQueryTxnResponse::clear_waiting_txns() {
+  waiting_txns_.Clear();
+}
+inline const ::std::string& InternalQueryTxnResponse::waiting_txns(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+  return waiting_txns_.Get(index);
+}
+inline ::std::string* InternalQueryTxnResponse::mutable_waiting_txns(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+  return waiting_txns_.Mutable(index);
+}
+inline void InternalQueryTxnResponse::set_waiting_txns(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+  waiting_txns_.Mutable(index)->assign(value);
+}
+inline void InternalQueryTxnResponse::set_waiting_txns(int index, const char* value) {
+  waiting_txns_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+}
+inline void InternalQueryTxnResponse::set_waiting_txns(int index, const void* value, size_t size) {
+  waiting_txns_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+}
+inline ::std::string* InternalQueryTxnResponse::add_waiting_txns() {
+  return waiting_txns_.Add();
+}
+inline void InternalQueryTxnResponse::add_waiting_txns(const ::std::string& value) {
+  waiting_txns_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+}
+inline void InternalQueryTxnResponse::add_waiting_txns(const char* value) {
+  waiting_txns_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+}
+inline void InternalQueryTxnResponse::add_waiting_txns(const void* value, size_t size) {
+  waiting_txns_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+InternalQueryTxnResponse::waiting_txns() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+  return waiting_txns_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+InternalQueryTxnResponse::mutable_waiting_txns() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.InternalQueryTxnResponse.waiting_txns)
+  return &waiting_txns_;
 }
 
 // -------------------------------------------------------------------
@@ -6426,6 +7280,92 @@ inline void ReadWriteCmdResponse::set_allocated_internal_leader_lease(::cockroac
   }
 }
 
+// optional .cockroach.proto.InternalCloseTimestampResponse internal_close_timestamp = 17;
+inline bool ReadWriteCmdResponse::has_internal_close_timestamp() const {
+  return value_case() == kInternalCloseTimestamp;
+}
+inline void ReadWriteCmdResponse::set_has_internal_close_timestamp() {
+  _oneof_case_[0] = kInternalCloseTimestamp;
+}
+inline void ReadWriteCmdResponse::clear_internal_close_timestamp() {
+  if (has_internal_close_timestamp()) {
+    delete value_.internal_close_timestamp_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InternalCloseTimestampResponse& ReadWriteCmdResponse::internal_close_timestamp() const {
+  return has_internal_close_timestamp() ? *value_.internal_close_timestamp_
+                      : ::cockroach::proto::InternalCloseTimestampResponse::default_instance();
+}
+inline ::cockroach::proto::InternalCloseTimestampResponse* ReadWriteCmdResponse::mutable_internal_close_timestamp() {
+  if (!has_internal_close_timestamp()) {
+    clear_value();
+    set_has_internal_close_timestamp();
+    value_.internal_close_timestamp_ = new ::cockroach::proto::InternalCloseTimestampResponse;
+  }
+  return value_.internal_close_timestamp_;
+}
+inline ::cockroach::proto::InternalCloseTimestampResponse* ReadWriteCmdResponse::release_internal_close_timestamp() {
+  if (has_internal_close_timestamp()) {
+    clear_has_value();
+    ::cockroach::proto::InternalCloseTimestampResponse* temp = value_.internal_close_timestamp_;
+    value_.internal_close_timestamp_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_internal_close_timestamp(::cockroach::proto::InternalCloseTimestampResponse* internal_close_timestamp) {
+  clear_value();
+  if (internal_close_timestamp) {
+    set_has_internal_close_timestamp();
+    value_.internal_close_timestamp_ = internal_close_timestamp;
+  }
+}
+
+// optional .cockroach.proto.IngestResponse ingest = 18;
+inline bool ReadWriteCmdResponse::has_ingest() const {
+  return value_case() == kIngest;
+}
+inline void ReadWriteCmdResponse::set_has_ingest() {
+  _oneof_case_[0] = kIngest;
+}
+inline void ReadWriteCmdResponse::clear_ingest() {
+  if (has_ingest()) {
+    delete value_.ingest_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::IngestResponse& ReadWriteCmdResponse::ingest() const {
+  return has_ingest() ? *value_.ingest_
+                      : ::cockroach::proto::IngestResponse::default_instance();
+}
+inline ::cockroach::proto::IngestResponse* ReadWriteCmdResponse::mutable_ingest() {
+  if (!has_ingest()) {
+    clear_value();
+    set_has_ingest();
+    value_.ingest_ = new ::cockroach::proto::IngestResponse;
+  }
+  return value_.ingest_;
+}
+inline ::cockroach::proto::IngestResponse* ReadWriteCmdResponse::release_ingest() {
+  if (has_ingest()) {
+    clear_has_value();
+    ::cockroach::proto::IngestResponse* temp = value_.ingest_;
+    value_.ingest_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_ingest(::cockroach::proto::IngestResponse* ingest) {
+  clear_value();
+  if (ingest) {
+    set_has_ingest();
+    value_.ingest_ = ingest;
+  }
+}
+
 inline bool ReadWriteCmdResponse::has_value() {
   return value_case() != VALUE_NOT_SET;
 }
@@ -7256,6 +8196,92 @@ inline void InternalRaftCommandUnion::set_allocated_internal_batch(::cockroach::
   }
 }
 
+// optional .cockroach.proto.InternalCloseTimestampRequest internal_close_timestamp = 40;
+inline bool InternalRaftCommandUnion::has_internal_close_timestamp() const {
+  return value_case() == kInternalCloseTimestamp;
+}
+inline void InternalRaftCommandUnion::set_has_internal_close_timestamp() {
+  _oneof_case_[0] = kInternalCloseTimestamp;
+}
+inline void InternalRaftCommandUnion::clear_internal_close_timestamp() {
+  if (has_internal_close_timestamp()) {
+    delete value_.internal_close_timestamp_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::InternalCloseTimestampRequest& InternalRaftCommandUnion::internal_close_timestamp() const {
+  return has_internal_close_timestamp() ? *value_.internal_close_timestamp_
+                      : ::cockroach::proto::InternalCloseTimestampRequest::default_instance();
+}
+inline ::cockroach::proto::InternalCloseTimestampRequest* InternalRaftCommandUnion::mutable_internal_close_timestamp() {
+  if (!has_internal_close_timestamp()) {
+    clear_value();
+    set_has_internal_close_timestamp();
+    value_.internal_close_timestamp_ = new ::cockroach::proto::InternalCloseTimestampRequest;
+  }
+  return value_.internal_close_timestamp_;
+}
+inline ::cockroach::proto::InternalCloseTimestampRequest* InternalRaftCommandUnion::release_internal_close_timestamp() {
+  if (has_internal_close_timestamp()) {
+    clear_has_value();
+    ::cockroach::proto::InternalCloseTimestampRequest* temp = value_.internal_close_timestamp_;
+    value_.internal_close_timestamp_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_close_timestamp(::cockroach::proto::InternalCloseTimestampRequest* internal_close_timestamp) {
+  clear_value();
+  if (internal_close_timestamp) {
+    set_has_internal_close_timestamp();
+    value_.internal_close_timestamp_ = internal_close_timestamp;
+  }
+}
+
+// optional .cockroach.proto.IngestRequest ingest = 42;
+inline bool InternalRaftCommandUnion::has_ingest() const {
+  return value_case() == kIngest;
+}
+inline void InternalRaftCommandUnion::set_has_ingest() {
+  _oneof_case_[0] = kIngest;
+}
+inline void InternalRaftCommandUnion::clear_ingest() {
+  if (has_ingest()) {
+    delete value_.ingest_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::IngestRequest& InternalRaftCommandUnion::ingest() const {
+  return has_ingest() ? *value_.ingest_
+                      : ::cockroach::proto::IngestRequest::default_instance();
+}
+inline ::cockroach::proto::IngestRequest* InternalRaftCommandUnion::mutable_ingest() {
+  if (!has_ingest()) {
+    clear_value();
+    set_has_ingest();
+    value_.ingest_ = new ::cockroach::proto::IngestRequest;
+  }
+  return value_.ingest_;
+}
+inline ::cockroach::proto::IngestRequest* InternalRaftCommandUnion::release_ingest() {
+  if (has_ingest()) {
+    clear_has_value();
+    ::cockroach::proto::IngestRequest* temp = value_.ingest_;
+    value_.ingest_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_ingest(::cockroach::proto::IngestRequest* ingest) {
+  clear_value();
+  if (ingest) {
+    set_has_ingest();
+    value_.ingest_ = ingest;
+  }
+}
+
 inline bool InternalRaftCommandUnion::has_value() {
   return value_case() != VALUE_NOT_SET;
 }
@@ -0,0 +1,202 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// An MVCCCommittedValue is a value committed through an MVCCCommitLog.
+type MVCCCommittedValue struct {
+	Key       proto.Key
+	Timestamp proto.Timestamp
+	// Value is nil if the key was deleted.
+	Value *proto.Value
+}
+
+// An MVCCIntent describes the intent left on a key by a write through
+// an MVCCCommitLog.
+type MVCCIntent struct {
+	Key       proto.Key
+	Timestamp proto.Timestamp
+	// Resolved is true if the key no longer has an intent.
+	Resolved bool
+}
+
+// An MVCCCommitLog wraps an engine, typically a batch, and records the
+// MVCC values committed by the writes made through it: non-transactional
+// writes, and transactional writes whose intents are resolved as
+// committed. Inline values are not recorded. It also records the
+// intents written, pushed and resolved. The log only describes writes
+// to the wrapped engine; if it is a batch, the log must be discarded
+// unless the batch is committed.
+type MVCCCommitLog struct {
+	Engine
+	// Values holds the committed values in the order of their writes.
+	Values []MVCCCommittedValue
+	// Intents holds the intent changes in the order of their writes.
+	Intents []MVCCIntent
+}
+
+// NewMVCCCommitLog returns an MVCCCommitLog wrapping the engine.
+func NewMVCCCommitLog(engine Engine) *MVCCCommitLog {
+	return &MVCCCommitLog{Engine: engine}
+}
+
+// mvccLogCommit records the value committed to key at timestamp if
+// engine is an MVCCCommitLog.
+func mvccLogCommit(engine Engine, key proto.Key, timestamp proto.Timestamp, value *proto.MVCCValue) {
+	log, ok := engine.(*MVCCCommitLog)
+	if !ok {
+		return
+	}
+	cv := MVCCCommittedValue{
+		Key:       append(proto.Key(nil), key...),
+		Timestamp: timestamp,
+	}
+	if !value.Deleted && value.Value != nil {
+		v := *value.Value
+		v.Timestamp = &cv.Timestamp
+		cv.Value = &v
+	}
+	log.Values = append(log.Values, cv)
+}
+
+// mvccLogCommittedVersion reads the version of key at timestamp and
+// records it as committed if engine is an MVCCCommitLog.
+func mvccLogCommittedVersion(engine Engine, key proto.Key, timestamp proto.Timestamp) error {
+	if _, ok := engine.(*MVCCCommitLog); !ok {
+		return nil
+	}
+	value := &proto.MVCCValue{}
+	ok, _, _, err := engine.GetProto(MVCCEncodeVersionKey(key, timestamp), value)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return util.Errorf("key %q has no committed version at %s", key, timestamp)
+	}
+	mvccLogCommit(engine, key, timestamp, value)
+	return nil
+}
+
+// mvccLogIntent records the intent written to key at timestamp if
+// engine is an MVCCCommitLog.
+func mvccLogIntent(engine Engine, key proto.Key, timestamp proto.Timestamp) {
+	if log, ok := engine.(*MVCCCommitLog); ok {
+		log.Intents = append(log.Intents, MVCCIntent{Key: append(proto.Key(nil), key...), Timestamp: timestamp})
+	}
+}
+
+// mvccLogIntentState reads the metadata of key and records its intent,
+// or that it has none, if engine is an MVCCCommitLog.
+func mvccLogIntentState(engine Engine, key proto.Key) error {
+	log, ok := engine.(*MVCCCommitLog)
+	if !ok {
+		return nil
+	}
+	meta := &proto.MVCCMetadata{}
+	ok, _, _, err := engine.GetProto(MVCCEncodeKey(key), meta)
+	if err != nil {
+		return err
+	}
+	intent := MVCCIntent{Key: append(proto.Key(nil), key...)}
+	if ok && meta.Txn != nil {
+		intent.Timestamp = meta.Timestamp
+	} else {
+		intent.Resolved = true
+	}
+	log.Intents = append(log.Intents, intent)
+	return nil
+}
+
+// MVCCIterateCommitted invokes f with the values committed to the keys
+// in [key, endKey) after startTime, in key order and, for each key, in
+// commit order. Intents and inline values are skipped. Iteration stops
+// at the first error returned by f.
+func MVCCIterateCommitted(engine Engine, key, endKey proto.Key, startTime proto.Timestamp,
+	f func(MVCCCommittedValue) error) error {
+	if len(endKey) == 0 {
+		return emptyKeyError()
+	}
+	// The committed versions of the current key after startTime, most
+	// recent first.
+	var versions []MVCCCommittedValue
+	meta := &proto.MVCCMetadata{}
+	flush := func() error {
+		for i := len(versions) - 1; i >= 0; i-- {
+			if err := f(versions[i]); err != nil {
+				return err
+			}
+		}
+		versions = versions[:0]
+		return nil
+	}
+	if err := engine.Iterate(MVCCEncodeKey(key), MVCCEncodeKey(endKey), func(kv proto.RawKeyValue) (bool, error) {
+		key, ts, isValue := MVCCDecodeKey(kv.Key)
+		if !isValue {
+			if err := flush(); err != nil {
+				return true, err
+			}
+			if err := gogoproto.Unmarshal(kv.Value, meta); err != nil {
+				return true, util.Errorf("unable to unmarshal MVCC metadata %q: %s", kv.Key, err)
+			}
+			return false, nil
+		}
+		if !startTime.Less(ts) || (meta.Txn != nil && ts.Equal(meta.Timestamp)) {
+			return false, nil
+		}
+		value := &proto.MVCCValue{}
+		if err := gogoproto.Unmarshal(kv.Value, value); err != nil {
+			return true, util.Errorf("unable to unmarshal MVCC value %q: %s", kv.Key, err)
+		}
+		cv := MVCCCommittedValue{Key: key, Timestamp: ts}
+		if !value.Deleted && value.Value != nil {
+			cv.Value = value.Value
+			cv.Value.Timestamp = &cv.Timestamp
+		}
+		versions = append(versions, cv)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// MVCCIterateIntents invokes f with the intents on keys in [key,
+// endKey), in key order. Iteration stops at the first error returned
+// by f.
+func MVCCIterateIntents(engine Engine, key, endKey proto.Key, f func(MVCCIntent) error) error {
+	if len(endKey) == 0 {
+		return emptyKeyError()
+	}
+	meta := &proto.MVCCMetadata{}
+	return engine.Iterate(MVCCEncodeKey(key), MVCCEncodeKey(endKey), func(kv proto.RawKeyValue) (bool, error) {
+		key, _, isValue := MVCCDecodeKey(kv.Key)
+		if isValue {
+			return false, nil
+		}
+		if err := gogoproto.Unmarshal(kv.Value, meta); err != nil {
+			return true, util.Errorf("unable to unmarshal MVCC metadata %q: %s", kv.Key, err)
+		}
+		if meta.Txn == nil {
+			return false, nil
+		}
+		return false, f(MVCCIntent{Key: key, Timestamp: meta.Timestamp})
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// verifyCommittedValues checks the committed values against the
+// expected keys, timestamps and values, nil for deletions.
+func verifyCommittedValues(t *testing.T, values []MVCCCommittedValue, keys []proto.Key,
+	timestamps []proto.Timestamp, expValues []*proto.Value) {
+	if len(values) != len(keys) {
+		t.Fatalf("expected %d committed values; got %+v", len(keys), values)
+	}
+	for i, cv := range values {
+		if !cv.Key.Equal(keys[i]) || !cv.Timestamp.Equal(timestamps[i]) {
+			t.Errorf("%d: expected %q at %s; got %q at %s", i, keys[i], timestamps[i], cv.Key, cv.Timestamp)
+		}
+		if expValues[i] == nil {
+			if cv.Value != nil {
+				t.Errorf("%d: expected deletion; got %+v", i, cv.Value)
+			}
+			continue
+		}
+		if cv.Value == nil || !bytes.Equal(cv.Value.Bytes, expValues[i].Bytes) {
+			t.Errorf("%d: expected value %q; got %+v", i, expValues[i].Bytes, cv.Value)
+		} else if cv.Value.Timestamp == nil || !cv.Value.Timestamp.Equal(timestamps[i]) {
+			t.Errorf("%d: expected value timestamp %s; got %+v", i, timestamps[i], cv.Value.Timestamp)
+		}
+	}
+}
+
+// TestMVCCCommitLog verifies that an MVCCCommitLog records
+// non-transactional writes and committed intents as committed values,
+// but not intents which are written, pushed or aborted, and that it
+// records every intent change.
+func TestMVCCCommitLog(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+	log := NewMVCCCommitLog(engine)
+
+	if err := MVCCPut(log, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(log, nil, testKey2, makeTS(2, 0), value2, makeTxn(txn1, makeTS(2, 0))); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(log, nil, testKey3, makeTS(2, 0), value3, makeTxn(txn1, makeTS(2, 0))); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(log, nil, testKey1, makeTS(3, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	// Push the first intent, then commit it at a later timestamp.
+	if err := MVCCResolveWriteIntent(log, nil, testKey2, makeTS(3, 0), makeTxn(txn1, makeTS(3, 0))); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCResolveWriteIntent(log, nil, testKey2, makeTS(4, 0), makeTxn(txn1Commit, makeTS(4, 0))); err != nil {
+		t.Fatal(err)
+	}
+	// Abort the second intent.
+	if err := MVCCResolveWriteIntent(log, nil, testKey3, makeTS(4, 0), makeTxn(txn1Abort, makeTS(4, 0))); err != nil {
+		t.Fatal(err)
+	}
+
+	verifyCommittedValues(t, log.Values,
+		[]proto.Key{testKey1, testKey1, testKey2},
+		[]proto.Timestamp{makeTS(1, 0), makeTS(3, 0), makeTS(4, 0)},
+		[]*proto.Value{&value1, nil, &value2})
+
+	expIntents := []MVCCIntent{
+		{Key: testKey2, Timestamp: makeTS(2, 0)},
+		{Key: testKey3, Timestamp: makeTS(2, 0)},
+		{Key: testKey2, Timestamp: makeTS(3, 0)},
+		{Key: testKey2, Resolved: true},
+		{Key: testKey3, Resolved: true},
+	}
+	if !reflect.DeepEqual(log.Intents, expIntents) {
+		t.Errorf("expected intents %+v; got %+v", expIntents, log.Intents)
+	}
+}
+
+// TestMVCCIterateCommitted verifies that the committed values after a
+// timestamp are iterated in key and commit order, skipping intents, and
+// that the intents are iterated.
+func TestMVCCIterateCommitted(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(2, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(3, 0), value3, makeTxn(txn1, makeTS(3, 0))); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey3, makeTS(4, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		startTime  proto.Timestamp
+		keys       []proto.Key
+		timestamps []proto.Timestamp
+		values     []*proto.Value
+	}{
+		{
+			startTime:  proto.ZeroTimestamp,
+			keys:       []proto.Key{testKey1, testKey1, testKey2, testKey3, testKey3},
+			timestamps: []proto.Timestamp{makeTS(1, 0), makeTS(2, 0), makeTS(1, 0), makeTS(1, 0), makeTS(4, 0)},
+			values:     []*proto.Value{&value1, &value2, &value1, &value1, nil},
+		},
+		{
+			startTime:  makeTS(1, 0),
+			keys:       []proto.Key{testKey1, testKey3},
+			timestamps: []proto.Timestamp{makeTS(2, 0), makeTS(4, 0)},
+			values:     []*proto.Value{&value2, nil},
+		},
+		{
+			startTime: makeTS(4, 0),
+		},
+	}
+	for i, test := range testCases {
+		var values []MVCCCommittedValue
+		if err := MVCCIterateCommitted(engine, testKey1, testKey4, test.startTime, func(cv MVCCCommittedValue) error {
+			values = append(values, cv)
+			return nil
+		}); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		verifyCommittedValues(t, values, test.keys, test.timestamps, test.values)
+	}
+
+	var intents []MVCCIntent
+	if err := MVCCIterateIntents(engine, testKey1, testKey4, func(intent MVCCIntent) error {
+		intents = append(intents, intent)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if expIntents := []MVCCIntent{{Key: testKey2, Timestamp: makeTS(3, 0)}}; !reflect.DeepEqual(intents, expIntents) {
+		t.Errorf("expected intents %+v; got %+v", expIntents, intents)
+	}
+}
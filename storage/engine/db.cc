@@ -25,6 +25,7 @@
 #include "rocksdb/env.h"
 #include "rocksdb/merge_operator.h"
 #include "rocksdb/options.h"
+#include "rocksdb/sst_file_writer.h"
 #include "rocksdb/table.h"
 #include "rocksdb/utilities/write_batch_with_index.h"
 #include "cockroach/proto/api.pb.h"
@@ -62,6 +63,13 @@ struct DBSnapshot {
   const rocksdb::Snapshot* rep;
 };
 
+struct DBSstFileWriter {
+  // The writer builds its SSTable in an in-memory env, which must
+  // outlive the writer.
+  std::unique_ptr<rocksdb::Env> memenv;
+  std::unique_ptr<rocksdb::SstFileWriter> rep;
+};
+
 }  // extern "C"
 
 namespace {
@@ -77,6 +85,9 @@ const rocksdb::Slice kKeyLocalTransactionSuffix("\x00\x01txn-", 6);
 
 const DBStatus kSuccess = { NULL, 0 };
 
+// The path of the SSTable built by a DBSstFileWriter in its env.
+const char kSstFilePath[] = "/sst";
+
 std::string ToString(DBSlice s) {
   return std::string(s.data, s.len);
 }
@@ -153,6 +164,10 @@ const cockroach::proto::ResponseHeader* GetResponseHeader(const cockroach::proto
     return &rwResp.internal_merge().header();
   } else if (rwResp.has_internal_truncate_log()) {
     return &rwResp.internal_truncate_log().header();
+  } else if (rwResp.has_internal_close_timestamp()) {
+    return &rwResp.internal_close_timestamp().header();
+  } else if (rwResp.has_ingest()) {
+    return &rwResp.ingest().header();
   }
   return NULL;
 }
@@ -1103,6 +1118,16 @@ class BaseDeltaIterator : public rocksdb::Iterator {
   const rocksdb::Comparator* comparator_;  // not owned
 };
 
+// getIntProperty returns the integer value of the named property, or
+// zero if the property is unknown or not an integer.
+int64_t getIntProperty(rocksdb::DB* rep, const std::string& name) {
+  std::string value;
+  if (!rep->GetProperty(name, &value)) {
+    return 0;
+  }
+  return strtoll(value.c_str(), NULL, 10);
+}
+
 }  // namespace
 
 DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions db_opts) {
@@ -1185,6 +1210,34 @@ uint64_t DBApproximateSize(DBEngine* db, DBSlice start, DBSlice end) {
   return result;
 }
 
+DBStatus DBGetHealth(DBEngine* db, DBHealth* health) {
+  health->pending_compaction_bytes =
+      getIntProperty(db->rep, "rocksdb.estimate-pending-compaction-bytes");
+  health->l0_file_count =
+      getIntProperty(db->rep, "rocksdb.num-files-at-level0");
+  return kSuccess;
+}
+
+DBStatus DBIngestExternalFile(DBEngine* db, DBSlice contents) {
+  // RocksDB ingests files, so write the contents to a uniquely named
+  // file in the database's env first.
+  rocksdb::Env* env = db->rep->GetEnv();
+  const std::string path =
+      db->rep->GetName() + "/ingest-" + env->GenerateUniqueId() + ".sst";
+  rocksdb::Status status = rocksdb::WriteStringToFile(
+      env, ToSlice(contents), path, true /* should_sync */);
+  if (!status.ok()) {
+    return ToDBStatus(status);
+  }
+  rocksdb::IngestExternalFileOptions options;
+  options.move_files = true;
+  status = db->rep->IngestExternalFile({path}, options);
+  // On success the file has been moved or linked into the database;
+  // either way the original is no longer needed.
+  env->DeleteFile(path);
+  return ToDBStatus(status);
+}
+
 DBStatus DBPut(DBEngine* db, DBSlice key, DBSlice value) {
   rocksdb::WriteOptions options;
   return ToDBStatus(db->rep->Put(options, ToSlice(key), ToSlice(value)));
@@ -1329,6 +1382,43 @@ DBIterator* DBBatchNewIter(DBEngine* db, DBBatch* batch) {
   return iter;
 }
 
+DBStatus DBSstFileWriterNew(DBSstFileWriter** writer) {
+  std::unique_ptr<DBSstFileWriter> w(new DBSstFileWriter);
+  w->memenv.reset(rocksdb::NewMemEnv(rocksdb::Env::Default()));
+  rocksdb::Options options;
+  options.env = w->memenv.get();
+  options.compression = rocksdb::kSnappyCompression;
+  w->rep.reset(new rocksdb::SstFileWriter(rocksdb::EnvOptions(), options));
+  rocksdb::Status status = w->rep->Open(kSstFilePath);
+  if (!status.ok()) {
+    return ToDBStatus(status);
+  }
+  *writer = w.release();
+  return kSuccess;
+}
+
+DBStatus DBSstFileWriterAdd(DBSstFileWriter* writer, DBSlice key, DBSlice value) {
+  return ToDBStatus(writer->rep->Add(ToSlice(key), ToSlice(value)));
+}
+
+DBStatus DBSstFileWriterFinish(DBSstFileWriter* writer, DBString* contents) {
+  rocksdb::Status status = writer->rep->Finish();
+  if (!status.ok()) {
+    return ToDBStatus(status);
+  }
+  std::string data;
+  status = rocksdb::ReadFileToString(writer->memenv.get(), kSstFilePath, &data);
+  if (!status.ok()) {
+    return ToDBStatus(status);
+  }
+  *contents = ToDBString(data);
+  return kSuccess;
+}
+
+void DBSstFileWriterDestroy(DBSstFileWriter* writer) {
+  delete writer;
+}
+
 DBStatus DBMergeOne(DBSlice existing, DBSlice update, DBString* new_value) {
   new_value->len = 0;
 
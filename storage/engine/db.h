@@ -47,6 +47,7 @@ typedef struct DBBatch DBBatch;
 typedef struct DBEngine DBEngine;
 typedef struct DBIterator DBIterator;
 typedef struct DBSnapshot DBSnapshot;
+typedef struct DBSstFileWriter DBSstFileWriter;
 
 // DBOptions contains local database options.
 typedef struct {
@@ -55,6 +56,13 @@ typedef struct {
   bool logging_enabled;
 } DBOptions;
 
+// DBHealth contains engine statistics used to detect when compactions
+// are falling behind the write load.
+typedef struct {
+  int64_t pending_compaction_bytes;
+  int64_t l0_file_count;
+} DBHealth;
+
 // Opens the database located in "dir", creating it if it doesn't
 // exist.
 DBStatus DBOpen(DBEngine **db, DBSlice dir, DBOptions options);
@@ -84,6 +92,17 @@ DBStatus DBCompactRange(DBEngine* db, DBSlice* start, DBSlice* end);
 // range [start,end].
 uint64_t DBApproximateSize(DBEngine* db, DBSlice start, DBSlice end);
 
+// Retrieves the estimated number of bytes awaiting compaction and the
+// number of files at level 0. Statistics which are not supported by
+// the underlying storage are reported as zero.
+DBStatus DBGetHealth(DBEngine* db, DBHealth* health);
+
+// Ingests the contents of an SSTable built by a DBSstFileWriter into
+// the database. The SSTable's keys are added atomically, bypassing the
+// memtable and the write-ahead log, and overwrite any existing values
+// for the same keys.
+DBStatus DBIngestExternalFile(DBEngine* db, DBSlice contents);
+
 // Sets the database entry for "key" to "value".
 DBStatus DBPut(DBEngine* db, DBSlice key, DBSlice value);
 
@@ -174,6 +193,22 @@ void DBBatchDelete(DBBatch* batch, DBSlice key);
 // is the callers responsibility to call DBIterDestroy().
 DBIterator* DBBatchNewIter(DBEngine* db, DBBatch* batch);
 
+// Creates a new writer which builds an SSTable in memory for use in
+// DBIngestExternalFile(). It is the callers responsibility to call
+// DBSstFileWriterDestroy().
+DBStatus DBSstFileWriterNew(DBSstFileWriter** writer);
+
+// Adds the key/value pair to the SSTable. Keys must be added in
+// strictly increasing order.
+DBStatus DBSstFileWriterAdd(DBSstFileWriter* writer, DBSlice key, DBSlice value);
+
+// Finishes the SSTable and retrieves its contents. No further keys may
+// be added to the writer.
+DBStatus DBSstFileWriterFinish(DBSstFileWriter* writer, DBString* contents);
+
+// Destroys a writer, freeing any associated memory.
+void DBSstFileWriterDestroy(DBSstFileWriter* writer);
+
 // Implements the merge operator on a single pair of values. update is
 // merged with existing. This method is provided for invocation from
 // Go code.
@@ -110,6 +110,14 @@ type Engine interface {
 	// ApproximateSize returns the approximate number of bytes the engine is
 	// using to store data for the given range of keys.
 	ApproximateSize(start, end proto.EncodedKey) (uint64, error)
+	// Health returns statistics on the engine's backlog of background
+	// work, used to detect when writes are outpacing compactions.
+	Health() (Health, error)
+	// IngestExternalFile atomically adds the key/value pairs of an
+	// SSTable built by an SSTWriter to the engine, overwriting any
+	// existing values for the same keys. Batches and snapshots don't
+	// support ingestion.
+	IngestExternalFile(data []byte) error
 	// Flush causes the engine to write all in-memory data to disk
 	// immediately.
 	Flush() error
@@ -132,6 +140,17 @@ type Engine interface {
 	Commit() error
 }
 
+// Health contains statistics on an engine's backlog of compactions.
+// Statistics which the engine does not support are zero.
+type Health struct {
+	// PendingCompactionBytes is the estimated number of bytes which
+	// compactions must rewrite to bring the engine back into shape.
+	PendingCompactionBytes int64
+	// L0FileCount is the number of files at level 0. Every read must
+	// consult every level 0 file, so a growing count slows all reads.
+	L0FileCount int64
+}
+
 var bufferPool = sync.Pool{
 	New: func() interface{} {
 		return gogoproto.NewBuffer(nil)
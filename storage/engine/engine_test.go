@@ -779,6 +779,30 @@ func TestApproximateSize(t *testing.T) {
 	}, t)
 }
 
+// TestEngineHealth verifies that flushed data is reported as a level
+// 0 file, for the engine as well as its snapshots and batches.
+func TestEngineHealth(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	runWithAllEngines(func(engine Engine, t *testing.T) {
+		if h, err := engine.Health(); err != nil || h.L0FileCount != 0 {
+			t.Fatalf("expected no level 0 files in empty engine; got %+v, %v", h, err)
+		}
+		insertKeys([]proto.EncodedKey{proto.EncodedKey("a"), proto.EncodedKey("b")}, engine, t)
+		if err := engine.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		snap := engine.NewSnapshot()
+		defer snap.Close()
+		batch := engine.NewBatch()
+		defer batch.Close()
+		for _, e := range []Engine{engine, snap, batch} {
+			if h, err := e.Health(); err != nil || h.L0FileCount != 1 {
+				t.Errorf("expected one level 0 file; got %+v, %v", h, err)
+			}
+		}
+	}, t)
+}
+
 func insertKeys(keys []proto.EncodedKey, engine Engine, t *testing.T) {
 	insertKeysAndValues(keys, nil, engine, t)
 }
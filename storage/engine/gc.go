@@ -29,6 +29,7 @@ import (
 type GarbageCollector struct {
 	expiration proto.Timestamp
 	policy     proto.GCPolicy
+	protected  *proto.Timestamp // Lowest protected timestamp, if any
 }
 
 // NewGarbageCollector allocates and returns a new GC, with expiration
@@ -41,11 +42,24 @@ func NewGarbageCollector(now proto.Timestamp, policy proto.GCPolicy) *GarbageCol
 	}
 }
 
+// Protect keeps the versions of each key read at or after the
+// timestamp from being garbage collected, regardless of the policy.
+// Only the lowest protected timestamp needs to be supplied.
+func (gc *GarbageCollector) Protect(timestamp proto.Timestamp) {
+	if gc.protected == nil || timestamp.Less(*gc.protected) {
+		gc.protected = &timestamp
+	}
+	if timestamp.Less(gc.expiration) {
+		gc.expiration = timestamp
+	}
+}
+
 // Filter makes decisions about garbage collection based on the
 // garbage collection policy for batches of values for the same key.
 // Returns the timestamp including, and after which, all values should
 // be garbage collected. If no values should be GC'd, returns
-// proto.ZeroTimestamp.
+// proto.ZeroTimestamp. A most recent value which has expired by the
+// GC timestamp is treated as a deletion tombstone.
 func (gc *GarbageCollector) Filter(keys []proto.EncodedKey, values [][]byte) proto.Timestamp {
 	if gc.policy.TTLSeconds <= 0 {
 		return proto.ZeroTimestamp
@@ -57,6 +71,7 @@ func (gc *GarbageCollector) Filter(keys []proto.EncodedKey, values [][]byte) pro
 	// Loop over values. All should be MVCC versions.
 	delTS := proto.ZeroTimestamp
 	survivors := false
+	protectedRead := false
 	for i, key := range keys {
 		_, ts, isValue := MVCCDecodeKey(key)
 		if !isValue {
@@ -68,10 +83,20 @@ func (gc *GarbageCollector) Filter(keys []proto.EncodedKey, values [][]byte) pro
 			log.Errorf("unable to unmarshal MVCC value %q: %v", key, err)
 			return proto.ZeroTimestamp
 		}
+		// The most recent version at or below the protected timestamp is
+		// the one read at it.
+		if gc.protected != nil && !protectedRead && !gc.protected.Less(ts) {
+			protectedRead = true
+			if !mvccVal.Deleted && !mvccVal.Value.IsExpired(*gc.protected) {
+				survivors = true
+			}
+			continue
+		}
 		if i == 0 {
 			// If the first value isn't a deletion tombstone, don't consider
-			// it for GC. It should always survive if non-deleted.
-			if !mvccVal.Deleted {
+			// it for GC. It should always survive if non-deleted, unless it
+			// has expired by the GC timestamp.
+			if !mvccVal.Deleted && !mvccVal.Value.IsExpired(gc.expiration) {
 				survivors = true
 				continue
 			}
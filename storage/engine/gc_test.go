@@ -47,6 +47,14 @@ func serializedMVCCValue(deleted bool, t *testing.T) []byte {
 	return data
 }
 
+func serializedExpiringMVCCValue(expiration proto.Timestamp, t *testing.T) []byte {
+	data, err := gogoproto.Marshal(&proto.MVCCValue{Value: &proto.Value{Expiration: &expiration}})
+	if err != nil {
+		t.Fatalf("unexpected marshal error: %v", err)
+	}
+	return data
+}
+
 // TestGarbageCollectorFilter verifies the filter policies for
 // different sorts of MVCC keys.
 func TestGarbageCollectorFilter(t *testing.T) {
@@ -55,6 +63,7 @@ func TestGarbageCollectorFilter(t *testing.T) {
 	gcB := NewGarbageCollector(makeTS(0, 0), proto.GCPolicy{TTLSeconds: 2})
 	n := serializedMVCCValue(false, t)
 	d := serializedMVCCValue(true, t)
+	e := serializedExpiringMVCCValue(makeTS(3E9, 0), t)
 	testData := []struct {
 		gc       *GarbageCollector
 		time     proto.Timestamp
@@ -79,6 +88,12 @@ func TestGarbageCollectorFilter(t *testing.T) {
 		{gcA, makeTS(5E9, 0), aKeys, [][]byte{n, n, n}, makeTS(1E9, 1)},
 		{gcB, makeTS(5E9, 0), bKeys, [][]byte{n, n}, makeTS(1E9, 0)},
 		{gcB, makeTS(5E9, 0), bKeys, [][]byte{d, n}, makeTS(2E9, 0)},
+		// A most recent value which has expired by the GC timestamp is
+		// collected like a deletion tombstone.
+		{gcA, makeTS(3E9, 0), aKeys, [][]byte{e, n, n}, makeTS(1E9, 1)},
+		{gcA, makeTS(4E9, 0), aKeys, [][]byte{e, n, n}, makeTS(2E9, 0)},
+		{gcB, makeTS(4E9, 0), bKeys, [][]byte{e, n}, makeTS(1E9, 0)},
+		{gcB, makeTS(5E9, 0), bKeys, [][]byte{e, n}, makeTS(2E9, 0)},
 	}
 	for i, test := range testData {
 		test.gc.expiration = test.time
@@ -89,3 +104,33 @@ func TestGarbageCollectorFilter(t *testing.T) {
 		}
 	}
 }
+
+// TestGarbageCollectorProtect verifies that the versions read at or
+// after a protected timestamp survive garbage collection.
+func TestGarbageCollectorProtect(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	n := serializedMVCCValue(false, t)
+	d := serializedMVCCValue(true, t)
+	testData := []struct {
+		protected *proto.Timestamp
+		keys      []proto.EncodedKey
+		values    [][]byte
+		expDelTS  proto.Timestamp
+	}{
+		{nil, aKeys, [][]byte{n, n, n}, makeTS(1E9, 1)},
+		{&proto.Timestamp{WallTime: 1E9, Logical: 5}, aKeys, [][]byte{n, n, n}, makeTS(1E9, 0)},
+		{&proto.Timestamp{WallTime: 2E9}, aKeys, [][]byte{n, n, n}, makeTS(1E9, 1)},
+		{&proto.Timestamp{WallTime: 1}, aKeys, [][]byte{n, n, n}, proto.ZeroTimestamp},
+		{nil, bKeys, [][]byte{d, n}, makeTS(2E9, 0)},
+		{&proto.Timestamp{WallTime: 1E9}, bKeys, [][]byte{d, n}, proto.ZeroTimestamp},
+	}
+	for i, test := range testData {
+		gc := NewGarbageCollector(makeTS(5E9, 0), proto.GCPolicy{TTLSeconds: 1})
+		if test.protected != nil {
+			gc.Protect(*test.protected)
+		}
+		if delTS := gc.Filter(test.keys, test.values); !delTS.Equal(test.expDelTS) {
+			t.Errorf("%d: expected deletion timestamp %s; got %s", i, test.expDelTS, delTS)
+		}
+	}
+}
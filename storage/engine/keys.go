@@ -61,6 +61,12 @@ func NodeStatusKey(nodeID int32) proto.Key {
 	return MakeKey(KeyStatusNodePrefix, encoding.EncodeUvarint(nil, uint64(nodeID)))
 }
 
+// ProtectedTimestampKey returns the key of the protected timestamp
+// record with the specified ID.
+func ProtectedTimestampKey(id string) proto.Key {
+	return MakeKey(KeyProtectedTimestampPrefix, proto.Key(id))
+}
+
 // MakeRangeIDKey creates a range-local key based on the range's
 // Raft ID, metadata key suffix, and optional detail (e.g. the
 // encoded command ID for a response cache entry, etc.).
@@ -175,6 +181,12 @@ func RangeLastVerificationTimestampKey(raftID int64) proto.Key {
 	return MakeRangeIDKey(raftID, KeyLocalRangeLastVerificationTimestampSuffix, proto.Key{})
 }
 
+// RangeIngestMarkerKey returns a range-local key marking an SSTable
+// ingestion which may not have completed.
+func RangeIngestMarkerKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRangeIngestMarkerSuffix, proto.Key{})
+}
+
 // RangeTreeNodeKey returns a range-local key for the the range's
 // node in the range tree.
 func RangeTreeNodeKey(key proto.Key) proto.Key {
@@ -402,6 +414,9 @@ var (
 	KeyLocalRangeLastVerificationTimestampSuffix = proto.Key("rlvt")
 	// KeyLocalRangeStatSuffix is the suffix for range statistics.
 	KeyLocalRangeStatSuffix = proto.Key("rst-")
+	// KeyLocalRangeIngestMarkerSuffix is the suffix for a range's
+	// marker of an SSTable ingestion in progress.
+	KeyLocalRangeIngestMarkerSuffix = proto.Key("rsst")
 
 	// KeyLocalRangeKeyPrefix is the prefix identifying per-range data
 	// indexed by range key (either start key, or some key in the
@@ -457,6 +472,9 @@ var (
 	// KeyConfigZonePrefix specifies the key prefix for zone
 	// configurations. The suffix is the affected key prefix.
 	KeyConfigZonePrefix = MakeKey(KeySystemPrefix, proto.Key("zone"))
+	// KeyProtectedTimestampPrefix specifies the key prefix for protected
+	// timestamp records, keyed by record ID.
+	KeyProtectedTimestampPrefix = MakeKey(KeySystemPrefix, proto.Key("pts-"))
 	// KeyNodeIDGenerator is the global node ID generator sequence.
 	KeyNodeIDGenerator = MakeKey(KeySystemPrefix, proto.Key("node-idgen"))
 	// KeyRaftIDGenerator is the global Raft consensus group ID generator sequence.
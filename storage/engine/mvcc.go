@@ -263,6 +263,23 @@ func updateStatsOnGC(ms *proto.MVCCStats, key proto.Key, keySize, valSize int64,
 	ms.GCBytesAge -= MVCCComputeGCBytesAge(keySize+valSize, ageSeconds)
 }
 
+// updateStatsOnExpire updates stat counters after garbage collection
+// of the metadata of a key whose most recent value had expired. The
+// expired value was still counted as live, so its key and value bytes
+// are subtracted along with the metadata's, but not from the GC'able
+// bytes age stat.
+func updateStatsOnExpire(ms *proto.MVCCStats, key proto.Key, metaKeySize, metaValSize int64, meta *proto.MVCCMetadata) {
+	if !updateStatsForKey(ms, key) {
+		return
+	}
+	ms.LiveBytes -= meta.KeyBytes + meta.ValBytes + metaKeySize + metaValSize
+	ms.LiveCount--
+	ms.KeyBytes -= meta.KeyBytes + metaKeySize
+	ms.ValBytes -= meta.ValBytes + metaValSize
+	ms.KeyCount--
+	ms.ValCount--
+}
+
 // MVCCComputeGCBytesAge comptues the value to assign to the specified
 // number of bytes, at the given age (in seconds).
 func MVCCComputeGCBytesAge(bytes, ageSeconds int64) int64 {
@@ -418,7 +435,19 @@ var getBufferPool = sync.Pool{
 // The consistent parameter indicates that intents should cause
 // WriteIntentErrors. If set to false, intents are ignored; keys with
 // an intent but no earlier committed versions, will be skipped.
+//
+// A value which has expired by timestamp reads as though it had been
+// deleted.
 func MVCCGet(engine Engine, key proto.Key, timestamp proto.Timestamp, consistent bool, txn *proto.Transaction) (*proto.Value, error) {
+	return mvccGet(engine, key, timestamp, timestamp, consistent, txn)
+}
+
+// mvccGet reads the value of key as of timestamp, treating a value
+// which has expired by expiredAt as deleted. Writers read at
+// proto.MaxTimestamp to detect newer intents, but must expire values
+// as of their own timestamp.
+func mvccGet(engine Engine, key proto.Key, timestamp, expiredAt proto.Timestamp, consistent bool,
+	txn *proto.Transaction) (*proto.Value, error) {
 	if len(key) == 0 {
 		return nil, emptyKeyError()
 	}
@@ -448,7 +477,7 @@ func MVCCGet(engine Engine, key proto.Key, timestamp proto.Timestamp, consistent
 		return nil, err
 	}
 
-	return mvccGetInternal(engine, key, metaKey, timestamp, consistent, txn, getValue, buf)
+	return mvccGetInternal(engine, key, metaKey, timestamp, expiredAt, consistent, txn, getValue, buf)
 }
 
 // getEarlierFunc fetches an earlier version of a key starting at
@@ -460,9 +489,10 @@ type getValueFunc func(engine Engine, start, end proto.EncodedKey,
 
 // mvccGetInternal parses the MVCCMetadata from the specified raw key
 // value, and reads the versioned value indicated by timestamp, taking
-// the transaction txn into account. getValue is a helper function to
-// get an earlier version of the value when doing historical reads.
-func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey, timestamp proto.Timestamp,
+// the transaction txn into account. A value which has expired by
+// expiredAt is returned as nil. getValue is a helper function to get
+// an earlier version of the value when doing historical reads.
+func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey, timestamp, expiredAt proto.Timestamp,
 	consistent bool, txn *proto.Transaction, getValue getValueFunc, buf *getBuffer) (*proto.Value, error) {
 	if !consistent && txn != nil {
 		return nil, util.Errorf("cannot allow inconsistent reads within a transaction")
@@ -507,6 +537,16 @@ func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey, tim
 		// we're now reading. In this case, we skip the intent.
 		if meta.Txn != nil && txn.Epoch != meta.Txn.Epoch {
 			valueKey, err = getValue(engine, latestKey.Next(), MVCCEncodeKey(key.Next()), value)
+		} else if meta.Txn != nil && txn.IsSeqIgnored(meta.Sequence) {
+			// The intent was written after a savepoint the txn has since
+			// rolled back to. Read the most recent value it wrote before
+			// the savepoint or, lacking one, skip the intent entirely.
+			if i := intentHistoryIndex(meta, txn); i >= 0 {
+				*value = meta.IntentHistory[i].Value
+				valueKey = latestKey
+			} else {
+				valueKey, err = getValue(engine, latestKey.Next(), MVCCEncodeKey(key.Next()), value)
+			}
 		} else {
 			var ok bool
 			ok, _, _, err = engine.GetProto(latestKey, value)
@@ -570,6 +610,9 @@ func mvccGetInternal(engine Engine, key proto.Key, metaKey proto.EncodedKey, tim
 
 	if value.Deleted {
 		value.Value = nil
+	} else if value.Value.IsExpired(expiredAt) {
+		// An expired value reads as though it had been deleted.
+		return nil, nil
 	}
 
 	// Set the timestamp if the value is not nil (i.e. not a deletion tombstone).
@@ -616,6 +659,11 @@ var putBufferPool = sync.Pool{
 // single row and never accumulate more than a single value. Successive
 // zero timestamp writes to a key replace the value and deletes clear
 // the value. In addition, zero timestamp values may be merged.
+//
+// If the value has an expiration, which must follow the timestamp, the
+// value is invisible to reads at or after the expiration and is garbage
+// collected like a deletion tombstone written at that time. Inlined
+// values can't expire.
 func MVCCPut(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp,
 	value proto.Value, txn *proto.Transaction) error {
 	if value.Timestamp != nil && !value.Timestamp.Equal(timestamp) {
@@ -679,6 +727,9 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 			metaKey, putIsInline, meta.IsInline())
 	}
 	if putIsInline {
+		if value.Value != nil && value.Value.Expiration != nil {
+			return util.Errorf("%q: inline values cannot expire", metaKey)
+		}
 		var metaKeySize, metaValSize int64
 		if value.Deleted {
 			metaKeySize, metaValSize, err = 0, 0, engine.Clear(metaKey)
@@ -689,6 +740,10 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 		updateStatsForInline(ms, key, origMetaKeySize, origMetaValSize, metaKeySize, metaValSize)
 		return err
 	}
+	if value.Value.IsExpired(timestamp) {
+		return util.Errorf("%q: expiration %s must follow the write timestamp %s",
+			key, value.Value.Expiration, timestamp)
+	}
 
 	var newMeta *proto.MVCCMetadata
 	// In case the key metadata exists.
@@ -701,6 +756,16 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 			return &proto.WriteIntentError{Key: key, Txn: *meta.Txn}
 		}
 
+		// A snapshot isolation txn reads as of its original timestamp, so
+		// it must not overwrite a version committed since; it would lose
+		// the committed update. Serializable txns don't need this check:
+		// writing at a timestamp past the original one forces a retry on
+		// commit.
+		if meta.Txn == nil && txn != nil && txn.Isolation == proto.SNAPSHOT &&
+			txn.OrigTimestamp.Less(meta.Timestamp) {
+			return &proto.WriteTooOldError{Timestamp: timestamp, ExistingTimestamp: meta.Timestamp}
+		}
+
 		// We can update the current metadata only if both the timestamp
 		// and epoch of the new intent are greater than or equal to
 		// existing. If either of these conditions doesn't hold, it's
@@ -710,14 +775,25 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 		// returned above.
 		if !timestamp.Less(meta.Timestamp) &&
 			(meta.Txn == nil || txn.Epoch >= meta.Txn.Epoch) {
+			newMeta = &buf.newMeta
+			*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp}
+			if txn != nil {
+				newMeta.Sequence = txn.Sequence
+			}
+			// If we're overwriting our own intent from the same epoch,
+			// remember the value it held so that a rollback to a
+			// savepoint can restore it.
+			if meta.Txn != nil && txn.Epoch == meta.Txn.Epoch {
+				if err := mvccPushIntentHistory(engine, metaKey, meta, newMeta, txn); err != nil {
+					return err
+				}
+			}
 			// If this is an intent and timestamps have changed,
 			// need to remove old version.
 			if meta.Txn != nil && !timestamp.Equal(meta.Timestamp) {
 				versionKey := mvccEncodeTimestamp(metaKey, meta.Timestamp)
 				engine.Clear(versionKey)
 			}
-			newMeta = &buf.newMeta
-			*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp}
 		} else if timestamp.Less(meta.Timestamp) && meta.Txn == nil {
 			// If we receive a Put request to write before an already-
 			// committed version, send write tool old error.
@@ -735,6 +811,9 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 		meta = nil
 		newMeta = &buf.newMeta
 		*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp}
+		if txn != nil {
+			newMeta.Sequence = txn.Sequence
+		}
 	}
 
 	// Make sure to zero the redundant timestamp (timestamp is encoded
@@ -754,6 +833,7 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 	newMeta.KeyBytes = mvccVersionTimestampSize
 	newMeta.ValBytes = valueSize
 	newMeta.Deleted = value.Deleted
+	newMeta.Expiration = value.Value.GetExpiration()
 	metaKeySize, metaValSize, err := PutProto(engine, metaKey, newMeta)
 	if err != nil {
 		return err
@@ -762,18 +842,65 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 	// Update MVCC stats.
 	updateStatsOnPut(ms, key, origMetaKeySize, origMetaValSize, metaKeySize, metaValSize, meta, newMeta, origAgeSeconds)
 
+	// Non-transactional writes are committed immediately.
+	if txn == nil {
+		mvccLogCommit(engine, key, timestamp, &buf.value)
+	} else {
+		mvccLogIntent(engine, key, timestamp)
+	}
+	return nil
+}
+
+// mvccPushIntentHistory carries the intent history of meta over to
+// newMeta and appends the value of the intent being overwritten.
+// Entries written at sequence numbers txn has rolled back are
+// dropped, as they can never be restored.
+func mvccPushIntentHistory(engine Engine, metaKey proto.EncodedKey, meta, newMeta *proto.MVCCMetadata,
+	txn *proto.Transaction) error {
+	for _, h := range meta.IntentHistory {
+		if !txn.IsSeqIgnored(h.Sequence) {
+			newMeta.IntentHistory = append(newMeta.IntentHistory, h)
+		}
+	}
+	if txn.IsSeqIgnored(meta.Sequence) {
+		return nil
+	}
+	h := proto.MVCCSequencedValue{Sequence: meta.Sequence}
+	versionKey := mvccEncodeTimestamp(metaKey, meta.Timestamp)
+	ok, _, _, err := engine.GetProto(versionKey, &h.Value)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return util.Errorf("unable to find intent value for key %q", versionKey)
+	}
+	newMeta.IntentHistory = append(newMeta.IntentHistory, h)
 	return nil
 }
 
+// intentHistoryIndex returns the index of the most recent entry in
+// the intent history of meta which txn hasn't rolled back, or -1 if
+// there is none.
+func intentHistoryIndex(meta *proto.MVCCMetadata, txn *proto.Transaction) int {
+	for i := len(meta.IntentHistory) - 1; i >= 0; i-- {
+		if !txn.IsSeqIgnored(meta.IntentHistory[i].Sequence) {
+			return i
+		}
+	}
+	return -1
+}
+
 // MVCCIncrement fetches the value for key, and assuming the value is
 // an "integer" type, increments it by inc and stores the new
-// value. The newly incremented value is returned.
+// value. The newly incremented value is returned. The new value keeps
+// the expiration of the existing one; an expired value is treated as
+// zero.
 func MVCCIncrement(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction, inc int64) (int64, error) {
 	// Handle check for non-existence of key. In order to detect
 	// the potential write intent by another concurrent transaction
 	// with a newer timestamp, we need to use the max timestamp
 	// while reading.
-	value, err := MVCCGet(engine, key, proto.MaxTimestamp, true, txn)
+	value, err := mvccGet(engine, key, proto.MaxTimestamp, timestamp, true, txn)
 	if err != nil {
 		return 0, err
 	}
@@ -798,7 +925,7 @@ func MVCCIncrement(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp
 	}
 
 	r := int64Val + inc
-	newValue := proto.Value{Integer: gogoproto.Int64(r)}
+	newValue := proto.Value{Integer: gogoproto.Int64(r), Expiration: value.GetExpiration()}
 	newValue.InitChecksum(key)
 	return r, MVCCPut(engine, ms, key, timestamp, newValue, txn)
 }
@@ -812,7 +939,7 @@ func MVCCConditionalPut(engine Engine, ms *proto.MVCCStats, key proto.Key, times
 	// the potential write intent by another concurrent transaction
 	// with a newer timestamp, we need to use the max timestamp
 	// while reading.
-	existVal, err := MVCCGet(engine, key, proto.MaxTimestamp, true, txn)
+	existVal, err := mvccGet(engine, key, proto.MaxTimestamp, timestamp, true, txn)
 	if err != nil {
 		return err
 	}
@@ -955,7 +1082,7 @@ func MVCCIterate(engine Engine, key, endKey proto.Key, timestamp proto.Timestamp
 		if err := iter.ValueProto(&buf.meta); err != nil {
 			return err
 		}
-		value, err := mvccGetInternal(engine, key, metaKey, timestamp, consistent, txn, getValue, buf)
+		value, err := mvccGetInternal(engine, key, metaKey, timestamp, timestamp, consistent, txn, getValue, buf)
 		if err != nil {
 			return err
 		}
@@ -990,6 +1117,15 @@ func MVCCIterate(engine Engine, key, endKey proto.Key, timestamp proto.Timestamp
 // epoch matching the commit epoch), and which intents get aborted,
 // even if the transaction succeeds.
 func MVCCResolveWriteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction) error {
+	if err := mvccResolveWriteIntent(engine, ms, key, timestamp, txn); err != nil {
+		return err
+	}
+	return mvccLogIntentState(engine, key)
+}
+
+// mvccResolveWriteIntent resolves the intent on key, as described for
+// MVCCResolveWriteIntent.
+func mvccResolveWriteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction) error {
 	if len(key) == 0 {
 		return emptyKeyError()
 	}
@@ -1018,14 +1154,33 @@ func MVCCResolveWriteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, t
 	// timestamp-encoded key) if timestamp changed.
 	commit := txn.Status == proto.COMMITTED
 	pushed := txn.Status == proto.PENDING && meta.Txn.Timestamp.Less(txn.Timestamp)
-	if (commit || pushed) && meta.Txn.Epoch == txn.Epoch {
+
+	// If committing an intent which was written after a savepoint the
+	// txn rolled back to, first rewind it to the latest value written
+	// before the savepoint. If there is no such value, the intent is
+	// removed as though the txn had aborted.
+	rolledBack := false
+	if commit && meta.Txn.Epoch == txn.Epoch && txn.IsSeqIgnored(meta.Sequence) {
+		var restored bool
+		restored, origMetaKeySize, origMetaValSize, err = mvccRewindIntent(engine, ms, key, metaKey, meta,
+			origMetaKeySize, origMetaValSize, txn)
+		if err != nil {
+			return err
+		}
+		rolledBack = !restored
+	}
+
+	if (commit || pushed) && meta.Txn.Epoch == txn.Epoch && !rolledBack {
 		origTimestamp := meta.Timestamp
 		newMeta := *meta
 		newMeta.Timestamp = txn.Timestamp
 		if pushed { // keep intent if we're pushing timestamp
 			newMeta.Txn = txn
 		} else {
+			// Committed values have no use for the intent history.
 			newMeta.Txn = nil
+			newMeta.Sequence = 0
+			newMeta.IntentHistory = nil
 		}
 		metaKeySize, metaValSize, err := PutProto(engine, metaKey, &newMeta)
 		if err != nil {
@@ -1049,6 +1204,9 @@ func MVCCResolveWriteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, t
 			engine.Clear(origKey)
 			engine.Put(newKey, valBytes)
 		}
+		if commit {
+			return mvccLogCommittedVersion(engine, key, txn.Timestamp)
+		}
 		return nil
 	}
 
@@ -1093,10 +1251,11 @@ func MVCCResolveWriteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, t
 		}
 		// Update the keyMetadata with the next version.
 		newMeta := &proto.MVCCMetadata{
-			Timestamp: ts,
-			Deleted:   value.Deleted,
-			KeyBytes:  mvccVersionTimestampSize,
-			ValBytes:  valueSize,
+			Timestamp:  ts,
+			Deleted:    value.Deleted,
+			KeyBytes:   mvccVersionTimestampSize,
+			ValBytes:   valueSize,
+			Expiration: value.Value.GetExpiration(),
 		}
 		metaKeySize, metaValSize, err := PutProto(engine, metaKey, newMeta)
 		if err != nil {
@@ -1111,6 +1270,39 @@ func MVCCResolveWriteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, t
 	return nil
 }
 
+// mvccRewindIntent replaces the value of the intent described by meta
+// with the most recent value from its history which txn hasn't rolled
+// back. On success, meta is updated in place and the new metadata
+// key and value sizes are returned. Returns false if every value in
+// the history was rolled back, leaving the intent untouched.
+func mvccRewindIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, metaKey proto.EncodedKey,
+	meta *proto.MVCCMetadata, origMetaKeySize, origMetaValSize int64, txn *proto.Transaction) (bool, int64, int64, error) {
+	i := intentHistoryIndex(meta, txn)
+	if i < 0 {
+		return false, origMetaKeySize, origMetaValSize, nil
+	}
+	h := meta.IntentHistory[i]
+	versionKey := mvccEncodeTimestamp(metaKey, meta.Timestamp)
+	_, valueSize, err := PutProto(engine, versionKey, &h.Value)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	newMeta := *meta
+	newMeta.Sequence = h.Sequence
+	newMeta.IntentHistory = meta.IntentHistory[:i]
+	newMeta.Deleted = h.Value.Deleted
+	newMeta.ValBytes = valueSize
+	newMeta.Expiration = h.Value.Value.GetExpiration()
+	metaKeySize, metaValSize, err := PutProto(engine, metaKey, &newMeta)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	// Rewinding an intent amounts to overwriting it in place.
+	updateStatsOnPut(ms, key, origMetaKeySize, origMetaValSize, metaKeySize, metaValSize, meta, &newMeta, 0)
+	*meta = newMeta
+	return true, metaKeySize, metaValSize, nil
+}
+
 // MVCCResolveWriteIntentRange commits or aborts (rolls back) the
 // range of write intents specified by start and end keys for a given
 // txn. ResolveWriteIntentRange will skip write intents of other
@@ -1160,7 +1352,9 @@ func MVCCResolveWriteIntentRange(engine Engine, ms *proto.MVCCStats, key, endKey
 // MVCCGarbageCollect creates an iterator on the engine. In parallel
 // it iterates through the keys listed for garbage collection by the
 // keys slice. The engine iterator is seeked in turn to each listed
-// key, clearing all values with timestamps <= to expiration.
+// key, clearing all values with timestamps <= to expiration. The
+// latest value of a key may only be GC'd if it's a deletion tombstone
+// or has expired by timestamp.
 func MVCCGarbageCollect(engine Engine, ms *proto.MVCCStats, keys []proto.InternalGCRequest_GCKey, timestamp proto.Timestamp) error {
 	iter := engine.NewIterator()
 
@@ -1176,15 +1370,21 @@ func MVCCGarbageCollect(engine Engine, ms *proto.MVCCStats, keys []proto.Interna
 		if err := gogoproto.Unmarshal(iter.Value(), meta); err != nil {
 			return util.Errorf("unable to marshal mvcc meta: %s", err)
 		}
+		expired := false
 		if !gcKey.Timestamp.Less(meta.Timestamp) {
-			if !meta.Deleted {
+			expired = meta.IsExpired(timestamp)
+			if !meta.Deleted && !expired {
 				return util.Errorf("request to GC non-deleted, latest value of %q", gcKey.Key)
 			}
 			if meta.Txn != nil {
 				return util.Errorf("request to GC intent at %q", gcKey.Key)
 			}
-			ageSeconds := timestamp.WallTime/1E9 - meta.Timestamp.WallTime/1E9
-			updateStatsOnGC(ms, gcKey.Key, int64(len(iter.Key())), int64(len(iter.Value())), meta, ageSeconds)
+			if expired {
+				updateStatsOnExpire(ms, gcKey.Key, int64(len(iter.Key())), int64(len(iter.Value())), meta)
+			} else {
+				ageSeconds := timestamp.WallTime/1E9 - meta.Timestamp.WallTime/1E9
+				updateStatsOnGC(ms, gcKey.Key, int64(len(iter.Key())), int64(len(iter.Value())), meta, ageSeconds)
+			}
 			engine.Clear(iter.Key())
 		}
 
@@ -1197,8 +1397,12 @@ func MVCCGarbageCollect(engine Engine, ms *proto.MVCCStats, keys []proto.Interna
 				break
 			}
 			if !gcKey.Timestamp.Less(ts) {
-				ageSeconds := timestamp.WallTime/1E9 - ts.WallTime/1E9
-				updateStatsOnGC(ms, gcKey.Key, mvccVersionTimestampSize, int64(len(iter.Value())), nil, ageSeconds)
+				// The expired latest value's bytes were accounted for
+				// along with its metadata.
+				if !expired || !ts.Equal(meta.Timestamp) {
+					ageSeconds := timestamp.WallTime/1E9 - ts.WallTime/1E9
+					updateStatsOnGC(ms, gcKey.Key, mvccVersionTimestampSize, int64(len(iter.Value())), nil, ageSeconds)
+				}
 				engine.Clear(iter.Key())
 			}
 		}
@@ -332,6 +332,61 @@ func TestMVCCUpdateExistingKeyDiffTxn(t *testing.T) {
 	}
 }
 
+// TestMVCCUpdateExistingKeySnapshotTxn verifies that a snapshot
+// isolation txn can't overwrite a value committed after its original
+// timestamp, even when writing at a later timestamp, while a
+// serializable txn can.
+func TestMVCCUpdateExistingKeySnapshotTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		isolation proto.IsolationType
+		expErr    bool
+	}{
+		{proto.SERIALIZABLE, false},
+		{proto.SNAPSHOT, true},
+	}
+	for i, test := range testCases {
+		txn := makeTxn(txn1, makeTS(2, 0))
+		txn.ID = []byte(fmt.Sprintf("Txn%d", i))
+		txn.Isolation = test.isolation
+		txn.OrigTimestamp = makeTS(0, 1)
+		err := MVCCPut(engine, nil, testKey1, txn.Timestamp, value2, txn)
+		if test.expErr {
+			if wtoErr, ok := err.(*proto.WriteTooOldError); !ok {
+				t.Errorf("%d: expected write too old error; got %v", i, err)
+			} else if !wtoErr.ExistingTimestamp.Equal(makeTS(1, 0)) {
+				t.Errorf("%d: expected existing timestamp %s; got %s", i, makeTS(1, 0), wtoErr.ExistingTimestamp)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+		// Abort the intent so the next test case sees the committed value.
+		abortTxn := gogoproto.Clone(txn).(*proto.Transaction)
+		abortTxn.Status = proto.ABORTED
+		if err := MVCCResolveWriteIntent(engine, nil, testKey1, txn.Timestamp, abortTxn); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+	}
+
+	// A snapshot txn which started after the committed value may
+	// overwrite it.
+	txn := makeTxn(txn1, makeTS(2, 0))
+	txn.Isolation = proto.SNAPSHOT
+	txn.OrigTimestamp = makeTS(2, 0)
+	if err := MVCCPut(engine, nil, testKey1, txn.Timestamp, value2, txn); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestMVCCGetNoMoreOldVersion(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	// Need to handle the case here where the scan takes us to the
@@ -469,6 +524,91 @@ func TestMVCCGetAndDelete(t *testing.T) {
 	}
 }
 
+// TestMVCCExpiration verifies that an expired value is invisible to
+// reads at or after its expiration, and to conditional puts and
+// increments writing after it.
+func TestMVCCExpiration(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	exp := makeTS(3, 0)
+	expValue := proto.Value{Bytes: value1.Bytes, Expiration: &exp}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), expValue, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(1, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	cntVal := proto.Value{Integer: gogoproto.Int64(1), Expiration: &exp}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(1, 0), cntVal, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Before the expiration, the value is visible.
+	value, err := MVCCGet(engine, testKey1, makeTS(2, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value.Bytes, value1.Bytes) {
+		t.Fatalf("expected value %q before expiration; got %+v", value1.Bytes, value)
+	}
+	kvs, err := MVCCScan(engine, testKey1, testKey4, 0, makeTS(2, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 3 {
+		t.Fatalf("expected 3 values before expiration; got %d", len(kvs))
+	}
+
+	// At and after the expiration, it isn't.
+	for _, ts := range []proto.Timestamp{exp, makeTS(4, 0)} {
+		value, err = MVCCGet(engine, testKey1, ts, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != nil {
+			t.Fatalf("expected no value at %s; got %+v", ts, value)
+		}
+		kvs, err = MVCCScan(engine, testKey1, testKey4, 0, ts, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != 1 || !kvs[0].Key.Equal(testKey2) {
+			t.Fatalf("expected only %q at %s; got %+v", testKey2, ts, kvs)
+		}
+	}
+
+	// A historical read still sees the value.
+	value, err = MVCCGet(engine, testKey1, makeTS(2, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil {
+		t.Fatal("expected value before expiration")
+	}
+
+	// A conditional put expecting no value succeeds after expiration.
+	if err := MVCCConditionalPut(engine, nil, testKey1, makeTS(4, 0), value3, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	// An increment after expiration starts over from zero.
+	if val, err := MVCCIncrement(engine, nil, testKey3, makeTS(4, 0), nil, 2); err != nil || val != 2 {
+		t.Fatalf("expected increment to 2; got %d, %v", val, err)
+	}
+	if value, err = MVCCGet(engine, testKey3, makeTS(5, 0), true, nil); err != nil || value == nil {
+		t.Fatalf("expected incremented value to be visible; got %+v, %v", value, err)
+	}
+
+	// Expirations must follow the write timestamp and can't be inlined.
+	if err := MVCCPut(engine, nil, testKey4, makeTS(3, 0), expValue, nil); err == nil {
+		t.Error("expected error putting value which expires at its timestamp")
+	}
+	if err := MVCCPut(engine, nil, testKey4, proto.ZeroTimestamp, expValue, nil); err == nil {
+		t.Error("expected error putting inline value which expires")
+	}
+}
+
 func TestMVCCDeleteMissingKey(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	engine := NewInMem(proto.Attributes{}, 1<<20)
@@ -1275,6 +1415,93 @@ func TestMVCCResolveWithPushedTimestamp(t *testing.T) {
 	}
 }
 
+// TestMVCCIgnoredSeqs verifies that intents written at sequence
+// numbers which were rolled back to a savepoint are invisible to
+// reads within the txn and that commit restores the latest value
+// written prior to the savepoint.
+func TestMVCCIgnoredSeqs(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	txn := *txn1
+	for i, v := range []proto.Value{value1, value2, value3} {
+		txn.Sequence = int32(i + 1)
+		if err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), v, &txn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Roll back the writes of value2 and value3.
+	txn.IgnoredSeqs = []proto.SequenceRange{{Start: 2, End: 3}}
+	value, err := MVCCGet(engine, testKey1, makeTS(0, 1), true, &txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value1.Bytes, value.Bytes) {
+		t.Fatalf("expected value %q; got %+v", value1.Bytes, value)
+	}
+
+	// Commit and verify the rolled back values were discarded.
+	txn.Status = proto.COMMITTED
+	if err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), &txn); err != nil {
+		t.Fatal(err)
+	}
+	value, err = MVCCGet(engine, testKey1, makeTS(0, 1), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value1.Bytes, value.Bytes) {
+		t.Fatalf("expected value %q; got %+v", value1.Bytes, value)
+	}
+	meta := &proto.MVCCMetadata{}
+	if _, _, _, err := engine.GetProto(MVCCEncodeKey(testKey1), meta); err != nil {
+		t.Fatal(err)
+	}
+	if meta.Txn != nil || len(meta.IntentHistory) != 0 {
+		t.Errorf("expected committed metadata without intent history; got %+v", meta)
+	}
+}
+
+// TestMVCCIgnoredSeqsAllRolledBack verifies that committing a txn
+// which rolled back every write to a key removes the intent,
+// leaving the prior committed version in place.
+func TestMVCCIgnoredSeqsAllRolledBack(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	txn := *txn1
+	txn.Sequence = 1
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value2, &txn); err != nil {
+		t.Fatal(err)
+	}
+
+	txn.IgnoredSeqs = []proto.SequenceRange{{Start: 1, End: 1}}
+	value, err := MVCCGet(engine, testKey1, makeTS(1, 0), true, &txn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value1.Bytes, value.Bytes) {
+		t.Fatalf("expected value %q; got %+v", value1.Bytes, value)
+	}
+
+	txn.Status = proto.COMMITTED
+	if err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), &txn); err != nil {
+		t.Fatal(err)
+	}
+	value, err = MVCCGet(engine, testKey1, makeTS(2, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value == nil || !bytes.Equal(value1.Bytes, value.Bytes) {
+		t.Fatalf("expected value %q; got %+v", value1.Bytes, value)
+	}
+}
+
 func TestMVCCResolveTxnNoOps(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	engine := createTestEngine()
@@ -2027,3 +2254,59 @@ func TestMVCCGarbageCollectIntent(t *testing.T) {
 		t.Fatal("expected error garbage collecting an intent")
 	}
 }
+
+// TestMVCCGarbageCollectExpired verifies that a latest value can be
+// GC'd once it has expired, along with the key's metadata, and that
+// stats match those computed after GC.
+func TestMVCCGarbageCollectExpired(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	ms := &proto.MVCCStats{}
+
+	bytes := []byte("value")
+	ts1 := makeTS(1E9, 0)
+	ts2 := makeTS(2E9, 0)
+	ts3 := makeTS(3E9, 0)
+	val1 := proto.Value{Bytes: bytes, Timestamp: &ts1}
+	val2 := proto.Value{Bytes: bytes, Timestamp: &ts2, Expiration: &ts3}
+	key := proto.Key("a")
+	for _, val := range []proto.Value{val1, val2} {
+		if err := MVCCPut(engine, ms, key, *val.Timestamp, val, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Manually advance aggregate gc'able bytes age to ts3.
+	ms.GCBytesAge += ms.KeyBytes + ms.ValBytes - ms.LiveBytes
+
+	// The expired value is still live until it's GC'd.
+	expMS, err := MVCCComputeStats(engine, KeyMin, KeyMax, ts3.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyStats("before GC", ms, &expMS, t)
+
+	keys := []proto.InternalGCRequest_GCKey{
+		{Key: key, Timestamp: ts2},
+	}
+	if err := MVCCGarbageCollect(engine, ms, keys, ts2); err == nil {
+		t.Fatal("expected error garbage collecting an unexpired value")
+	}
+	if err := MVCCGarbageCollect(engine, ms, keys, ts3); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := Scan(engine, MVCCEncodeKey(KeyMin), MVCCEncodeKey(KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 0 {
+		t.Fatalf("expected all versions to be GC'd; got %d", len(kvs))
+	}
+
+	expMS, err = MVCCComputeStats(engine, KeyMin, KeyMax, ts3.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyStats("after GC", ms, &expMS, t)
+}
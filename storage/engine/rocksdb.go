@@ -305,6 +305,27 @@ func (r *RocksDB) ApproximateSize(start, end proto.EncodedKey) (uint64, error) {
 	return uint64(C.DBApproximateSize(r.rdb, goToCSlice(start), goToCSlice(end))), nil
 }
 
+// Health returns RocksDB's compaction backlog.
+func (r *RocksDB) Health() (Health, error) {
+	var h C.DBHealth
+	if err := statusToError(C.DBGetHealth(r.rdb, &h)); err != nil {
+		return Health{}, err
+	}
+	return Health{
+		PendingCompactionBytes: int64(h.pending_compaction_bytes),
+		L0FileCount:            int64(h.l0_file_count),
+	}, nil
+}
+
+// IngestExternalFile atomically adds the key/value pairs of the
+// SSTable to RocksDB.
+func (r *RocksDB) IngestExternalFile(data []byte) error {
+	if len(data) == 0 {
+		return util.Errorf("cannot ingest an empty SSTable")
+	}
+	return statusToError(C.DBIngestExternalFile(r.rdb, goToCSlice(data)))
+}
+
 // Flush causes RocksDB to write all in-memory data to disk immediately.
 func (r *RocksDB) Flush() error {
 	return statusToError(C.DBFlush(r.rdb))
@@ -480,6 +501,16 @@ func (r *rocksDBSnapshot) ApproximateSize(start, end proto.EncodedKey) (uint64,
 	return r.parent.ApproximateSize(start, end)
 }
 
+// Health returns the health of the parent engine.
+func (r *rocksDBSnapshot) Health() (Health, error) {
+	return r.parent.Health()
+}
+
+// IngestExternalFile is illegal for snapshot and returns an error.
+func (r *rocksDBSnapshot) IngestExternalFile(data []byte) error {
+	return util.Errorf("cannot ingest into a snapshot")
+}
+
 // Flush is a no-op for snapshots.
 func (r *rocksDBSnapshot) Flush() error {
 	return nil
@@ -631,6 +662,14 @@ func (r *rocksDBBatch) ApproximateSize(start, end proto.EncodedKey) (uint64, err
 	return r.parent.ApproximateSize(start, end)
 }
 
+func (r *rocksDBBatch) Health() (Health, error) {
+	return r.parent.Health()
+}
+
+func (r *rocksDBBatch) IngestExternalFile(data []byte) error {
+	return util.Errorf("cannot ingest into a batch")
+}
+
 func (r *rocksDBBatch) Flush() error {
 	return util.Errorf("cannot flush a batch")
 }
@@ -731,6 +770,47 @@ func (r *rocksDBIterator) Error() error {
 	return statusToError(C.DBIterError(r.iter))
 }
 
+// An SSTWriter builds an SSTable in memory for ingestion into an
+// engine via Engine.IngestExternalFile. Keys must be added in strictly
+// increasing order. The caller must invoke SSTWriter.Close() when
+// finished with the writer to free resources.
+type SSTWriter struct {
+	writer *C.DBSstFileWriter
+}
+
+// NewSSTWriter returns a new SSTWriter.
+func NewSSTWriter() (*SSTWriter, error) {
+	w := &SSTWriter{}
+	if err := statusToError(C.DBSstFileWriterNew(&w.writer)); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Add adds the key/value pair to the SSTable. The key must be greater
+// than all keys added previously.
+func (w *SSTWriter) Add(key proto.EncodedKey, value []byte) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	return statusToError(C.DBSstFileWriterAdd(w.writer, goToCSlice(key), goToCSlice(value)))
+}
+
+// Finish finishes the SSTable and returns its contents. No further
+// keys may be added.
+func (w *SSTWriter) Finish() ([]byte, error) {
+	var contents C.DBString
+	if err := statusToError(C.DBSstFileWriterFinish(w.writer, &contents)); err != nil {
+		return nil, err
+	}
+	return cStringToGoBytes(contents), nil
+}
+
+// Close frees the resources held by the writer.
+func (w *SSTWriter) Close() {
+	C.DBSstFileWriterDestroy(w.writer)
+}
+
 //export rocksDBLog
 func rocksDBLog(s *C.char, n C.int) {
 	// Note that rocksdb logging is only enabled if log.V(1) is true
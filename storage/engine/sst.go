@@ -0,0 +1,261 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// MVCCSSTPut adds value to the SSTable as the only version of key at
+// the specified timestamp, writing the key's MVCC metadata followed by
+// the versioned value, exactly as MVCCPut would on an empty engine.
+// Keys must be added in increasing order.
+func MVCCSSTPut(w *SSTWriter, key proto.Key, timestamp proto.Timestamp, value proto.Value) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	if timestamp.Equal(proto.ZeroTimestamp) {
+		return util.Errorf("cannot write inline value for key %q to SSTable", key)
+	}
+	if value.IsExpired(timestamp) {
+		return util.Errorf("%q: expiration %s must follow the write timestamp %s", key, value.Expiration, timestamp)
+	}
+	value.Timestamp = nil
+	valBytes, err := gogoproto.Marshal(&proto.MVCCValue{Value: &value})
+	if err != nil {
+		return err
+	}
+	meta := &proto.MVCCMetadata{
+		Timestamp:  timestamp,
+		KeyBytes:   mvccVersionTimestampSize,
+		ValBytes:   int64(len(valBytes)),
+		Expiration: value.Expiration,
+	}
+	metaBytes, err := gogoproto.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	metaKey := MVCCEncodeKey(key)
+	if err := w.Add(metaKey, metaBytes); err != nil {
+		return err
+	}
+	return w.Add(mvccEncodeTimestamp(metaKey, timestamp), valBytes)
+}
+
+// loadSST loads the SSTable into a temporary in-memory engine, which
+// the caller must close, verifying that every key lies within [key,
+// endKey) and that no version follows maxTimestamp.
+func loadSST(data []byte, key, endKey proto.Key, maxTimestamp proto.Timestamp) (Engine, error) {
+	tmp := NewInMem(proto.Attributes{}, int64(len(data)))
+	if err := tmp.IngestExternalFile(data); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+
+	encStartKey, encEndKey := MVCCEncodeKey(key), MVCCEncodeKey(endKey)
+	iter := tmp.NewIterator()
+	defer iter.Close()
+	for iter.Seek(nil); iter.Valid(); iter.Next() {
+		k := iter.Key()
+		if k.Less(encStartKey) || !k.Less(encEndKey) {
+			tmp.Close()
+			return nil, util.Errorf("SSTable key %q outside of bounds [%q, %q)", k, key, endKey)
+		}
+		if _, ts, isValue := MVCCDecodeKey(k); isValue && maxTimestamp.Less(ts) {
+			tmp.Close()
+			return nil, util.Errorf("SSTable key %q has a version at %s, after %s", k, ts, maxTimestamp)
+		}
+	}
+	if err := iter.Error(); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	return tmp, nil
+}
+
+// MVCCComputeSSTStats verifies that every key in the SSTable lies
+// within [key, endKey) and returns the MVCC stats of its contents,
+// computed as by MVCCComputeStats. The SSTable is loaded into a
+// temporary in-memory engine to do so.
+func MVCCComputeSSTStats(data []byte, key, endKey proto.Key, nowNanos int64) (proto.MVCCStats, error) {
+	if key.Less(KeyLocalMax) {
+		key = KeyLocalMax
+	}
+	tmp, err := loadSST(data, key, endKey, proto.MaxTimestamp)
+	if err != nil {
+		return proto.MVCCStats{}, err
+	}
+	defer tmp.Close()
+	return MVCCComputeStats(tmp, key, endKey, nowNanos)
+}
+
+// MVCCPrepareIngestSST verifies that every key in the SSTable lies
+// within [key, endKey) and that no version follows the specified
+// timestamp, and returns the MVCC stats of its contents, computed as
+// by MVCCComputeStats. The span must hold no data, unless it holds
+// exactly the SSTable's contents, in which case ingested is true; the
+// caller determines whether its own, interrupted ingestion left them.
+func MVCCPrepareIngestSST(engine Engine, data []byte, key, endKey proto.Key, timestamp proto.Timestamp) (
+	ms proto.MVCCStats, ingested bool, err error) {
+	if key.Less(KeyLocalMax) {
+		key = KeyLocalMax
+	}
+	tmp, err := loadSST(data, key, endKey, timestamp)
+	if err != nil {
+		return ms, false, err
+	}
+	defer tmp.Close()
+	if ms, err = MVCCComputeStats(tmp, key, endKey, timestamp.WallTime); err != nil {
+		return ms, false, err
+	}
+
+	encStartKey, encEndKey := MVCCEncodeKey(key), MVCCEncodeKey(endKey)
+	if existing, err := Scan(engine, encStartKey, encEndKey, 1); err != nil || len(existing) == 0 {
+		return ms, false, err
+	}
+	contents, err := Scan(tmp, encStartKey, encEndKey, 0)
+	if err != nil {
+		return ms, false, err
+	}
+	existing, err := Scan(engine, encStartKey, encEndKey, int64(len(contents))+1)
+	if err != nil {
+		return ms, false, err
+	}
+	if !rawKVsEqual(existing, contents) {
+		return ms, false, util.Errorf("cannot ingest into non-empty span %q-%q", key, endKey)
+	}
+	return ms, true, nil
+}
+
+// rawKVsEqual returns whether the two slices hold the same keys and
+// values.
+func rawKVsEqual(a, b []proto.RawKeyValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i].Key, b[i].Key) || !bytes.Equal(a[i].Value, b[i].Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// MVCCExport returns an SSTable holding the versions of the keys in
+// [key, endKey) written in the time interval (startTime, endTime],
+// with MVCC metadata rewritten to describe the most recent version
+// exported for each key, or nil if there are no such versions. Unless
+// allVersions is true, only the most recent version of each key is
+// exported and, if startTime is zero, keys whose most recent version
+// is a deletion tombstone are omitted. Inline values are always
+// exported. A WriteIntentError is returned for any intent at or below
+// endTime.
+func MVCCExport(engine Engine, key, endKey proto.Key, startTime, endTime proto.Timestamp, allVersions bool) ([]byte, error) {
+	if len(endKey) == 0 {
+		return nil, emptyKeyError()
+	}
+	if key.Less(KeyLocalMax) {
+		key = KeyLocalMax
+	}
+	w, err := NewSSTWriter()
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	// The versions of the current key within the interval, most
+	// recent first.
+	var metaKey proto.EncodedKey
+	var versions []proto.RawKeyValue
+	meta := &proto.MVCCMetadata{}
+	count := 0
+	flush := func() error {
+		if len(versions) == 0 {
+			return nil
+		}
+		defer func() { versions = versions[:0] }()
+		latest := &proto.MVCCValue{}
+		if err := gogoproto.Unmarshal(versions[0].Value, latest); err != nil {
+			return util.Errorf("unable to unmarshal MVCC value %q: %s", versions[0].Key, err)
+		}
+		if latest.Deleted && !allVersions && startTime.Equal(proto.ZeroTimestamp) {
+			return nil
+		}
+		_, ts, _ := MVCCDecodeKey(versions[0].Key)
+		metaBytes, err := gogoproto.Marshal(&proto.MVCCMetadata{
+			Timestamp:  ts,
+			Deleted:    latest.Deleted,
+			KeyBytes:   mvccVersionTimestampSize,
+			ValBytes:   int64(len(versions[0].Value)),
+			Expiration: latest.Value.GetExpiration(),
+		})
+		if err != nil {
+			return err
+		}
+		if err := w.Add(metaKey, metaBytes); err != nil {
+			return err
+		}
+		for _, kv := range versions {
+			if err := w.Add(kv.Key, kv.Value); err != nil {
+				return err
+			}
+		}
+		count++
+		return nil
+	}
+
+	if err := engine.Iterate(MVCCEncodeKey(key), MVCCEncodeKey(endKey), func(kv proto.RawKeyValue) (bool, error) {
+		key, ts, isValue := MVCCDecodeKey(kv.Key)
+		if !isValue {
+			if err := flush(); err != nil {
+				return true, err
+			}
+			if err := gogoproto.Unmarshal(kv.Value, meta); err != nil {
+				return true, util.Errorf("unable to unmarshal MVCC metadata %q: %s", kv.Key, err)
+			}
+			if meta.IsInline() {
+				count++
+				return false, w.Add(kv.Key, kv.Value)
+			}
+			if meta.Txn != nil && !endTime.Less(meta.Timestamp) {
+				return true, &proto.WriteIntentError{Key: key, Txn: *meta.Txn}
+			}
+			metaKey = append(metaKey[:0], kv.Key...)
+			return false, nil
+		}
+		if endTime.Less(ts) || !startTime.Less(ts) || (!allVersions && len(versions) > 0) {
+			return false, nil
+		}
+		versions = append(versions, proto.RawKeyValue{
+			Key:   append(proto.EncodedKey(nil), kv.Key...),
+			Value: append([]byte(nil), kv.Value...),
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	return w.Finish()
+}
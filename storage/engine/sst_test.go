@@ -0,0 +1,314 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// buildTestSST returns an SSTable holding a version of each key at
+// the timestamp, with the key as its value.
+func buildTestSST(t *testing.T, keys []proto.Key, ts proto.Timestamp) []byte {
+	w, err := NewSSTWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	for _, key := range keys {
+		value := proto.Value{Bytes: key}
+		value.InitChecksum(key)
+		if err := MVCCSSTPut(w, key, ts, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := w.Finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// TestMVCCSSTPut verifies that an ingested SSTable built with
+// MVCCSSTPut holds exactly the data and stats MVCCPut would write.
+func TestMVCCSSTPut(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	keys := []proto.Key{proto.Key("a"), proto.Key("b"), proto.Key("c")}
+	ts := makeTS(1E9, 1)
+	data := buildTestSST(t, keys, ts)
+
+	ingested := NewInMem(proto.Attributes{}, 1<<20)
+	defer ingested.Close()
+	if err := ingested.IngestExternalFile(data); err != nil {
+		t.Fatal(err)
+	}
+	put := NewInMem(proto.Attributes{}, 1<<20)
+	defer put.Close()
+	for _, key := range keys {
+		value := proto.Value{Bytes: key}
+		value.InitChecksum(key)
+		if err := MVCCPut(put, nil, key, ts, value, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, key := range keys {
+		value, err := MVCCGet(ingested, key, ts, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value == nil || !bytes.Equal(value.Bytes, key) {
+			t.Errorf("expected value %q for key %q; got %+v", key, key, value)
+		}
+	}
+	expMS, err := MVCCComputeStats(put, proto.KeyMin, proto.KeyMax, 2E9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms, err := MVCCComputeStats(ingested, proto.KeyMin, proto.KeyMax, 2E9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ms, expMS) {
+		t.Errorf("expected stats %+v; got %+v", expMS, ms)
+	}
+	sstMS, err := MVCCComputeSSTStats(data, proto.Key("a"), proto.Key("d"), 2E9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sstMS, expMS) {
+		t.Errorf("expected SSTable stats %+v; got %+v", expMS, sstMS)
+	}
+}
+
+// TestMVCCComputeSSTStatsBounds verifies that SSTables holding keys
+// outside of the specified bounds are rejected.
+func TestMVCCComputeSSTStatsBounds(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	data := buildTestSST(t, []proto.Key{proto.Key("b"), proto.Key("c")}, makeTS(1E9, 0))
+
+	testCases := []struct {
+		key, endKey proto.Key
+		expOK       bool
+	}{
+		{proto.Key("a"), proto.Key("d"), true},
+		{proto.Key("b"), proto.Key("c").Next(), true},
+		{proto.Key("b"), proto.Key("c"), false},
+		{proto.Key("b\x00"), proto.Key("d"), false},
+	}
+	for i, test := range testCases {
+		_, err := MVCCComputeSSTStats(data, test.key, test.endKey, 2E9)
+		if test.expOK != (err == nil) {
+			t.Errorf("%d: expected success %t; got %v", i, test.expOK, err)
+		}
+	}
+}
+
+// TestMVCCPrepareIngestSST verifies that preparing an ingestion
+// computes the stats of every version in the SSTable, rejects
+// versions following the timestamp and non-empty spans, and reports
+// spans already holding exactly the SSTable's contents as ingested.
+func TestMVCCPrepareIngestSST(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	src := NewInMem(proto.Attributes{}, 1<<20)
+	defer src.Close()
+	for _, kv := range []struct {
+		key, value string
+		ts         proto.Timestamp
+	}{
+		{"a", "a1", makeTS(1E9, 0)},
+		{"a", "a2", makeTS(2E9, 0)},
+		{"b", "b1", makeTS(1E9, 0)},
+	} {
+		if err := MVCCPut(src, nil, proto.Key(kv.key), kv.ts, proto.Value{Bytes: []byte(kv.value)}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w, err := NewSSTWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if err := src.Iterate(MVCCEncodeKey(proto.KeyMin), MVCCEncodeKey(proto.KeyMax), func(raw proto.RawKeyValue) (bool, error) {
+		return false, w.Add(raw.Key, raw.Value)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := w.Finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	engine := createTestEngine()
+	defer engine.Close()
+	if _, _, err := MVCCPrepareIngestSST(engine, data, proto.Key("a"), proto.Key("c"), makeTS(1E9, 5)); err == nil {
+		t.Error("expected ingestion of versions following the timestamp to fail")
+	}
+	ts := makeTS(3E9, 0)
+	ms, ingested, err := MVCCPrepareIngestSST(engine, data, proto.Key("a"), proto.Key("c"), ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ingested {
+		t.Error("expected empty span not to be reported as ingested")
+	}
+	expMS, err := MVCCComputeStats(src, proto.Key("a"), proto.Key("c"), ts.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ms, expMS) {
+		t.Errorf("expected stats %+v; got %+v", expMS, ms)
+	}
+
+	// Once ingested, every version is readable at its own timestamp.
+	if err := engine.IngestExternalFile(data); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		ts       proto.Timestamp
+		expValue string
+	}{
+		{makeTS(1E9, 0), "a1"},
+		{makeTS(2E9, 0), "a2"},
+	} {
+		value, err := MVCCGet(engine, proto.Key("a"), test.ts, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value == nil || !bytes.Equal(value.Bytes, []byte(test.expValue)) {
+			t.Errorf("expected %q at %s; got %+v", test.expValue, test.ts, value)
+		}
+	}
+	if ms, ingested, err = MVCCPrepareIngestSST(engine, data, proto.Key("a"), proto.Key("c"), ts); err != nil {
+		t.Fatal(err)
+	}
+	if !ingested {
+		t.Error("expected span holding the SSTable's contents to be reported as ingested")
+	}
+	if !reflect.DeepEqual(ms, expMS) {
+		t.Errorf("expected stats %+v after ingestion; got %+v", expMS, ms)
+	}
+
+	// Any other data in the span is rejected.
+	if err := MVCCPut(engine, nil, proto.Key("b"), makeTS(2E9, 0), proto.Value{Bytes: []byte("b2")}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := MVCCPrepareIngestSST(engine, data, proto.Key("a"), proto.Key("c"), ts); err == nil {
+		t.Error("expected ingestion into non-empty span to fail")
+	}
+}
+
+// TestMVCCExport verifies that exports contain the versions within
+// their time interval, omit deleted keys from full exports of the
+// latest versions and fail on intents.
+func TestMVCCExport(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(2, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(3, 0), value3, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey2, makeTS(4, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(6, 0), value4, makeTxn(txn1, makeTS(6, 0))); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		startTime, endTime proto.Timestamp
+		allVersions        bool
+		expKeys            int64
+		expValues          int64
+	}{
+		// Only a's latest version; b is deleted.
+		{proto.ZeroTimestamp, makeTS(5, 0), false, 1, 1},
+		// b's value is live as of its first version.
+		{proto.ZeroTimestamp, makeTS(2, 0), false, 2, 2},
+		{proto.ZeroTimestamp, makeTS(5, 0), true, 2, 4},
+		// An incremental export includes b's tombstone.
+		{makeTS(2, 0), makeTS(5, 0), false, 2, 2},
+		{makeTS(4, 0), makeTS(5, 0), true, 0, 0},
+	}
+	for i, test := range testCases {
+		data, err := MVCCExport(engine, proto.KeyMin, proto.KeyMax, test.startTime, test.endTime, test.allVersions)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if test.expKeys == 0 {
+			if data != nil {
+				t.Errorf("%d: expected empty export", i)
+			}
+			continue
+		}
+		ms, err := MVCCComputeSSTStats(data, proto.KeyMin, proto.KeyMax, 0)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if ms.KeyCount != test.expKeys || ms.ValCount != test.expValues {
+			t.Errorf("%d: expected %d keys and %d values; got %d and %d",
+				i, test.expKeys, test.expValues, ms.KeyCount, ms.ValCount)
+		}
+	}
+
+	// The exported versions read back as they were written.
+	data, err := MVCCExport(engine, proto.KeyMin, proto.KeyMax, proto.ZeroTimestamp, makeTS(5, 0), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := createTestEngine()
+	defer restored.Close()
+	if err := restored.IngestExternalFile(data); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		key      proto.Key
+		ts       proto.Timestamp
+		expValue *proto.Value
+	}{
+		{testKey1, makeTS(2, 0), &value1},
+		{testKey1, makeTS(5, 0), &value3},
+		{testKey2, makeTS(3, 0), &value2},
+		{testKey2, makeTS(5, 0), nil},
+	} {
+		value, err := MVCCGet(restored, test.key, test.ts, true, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (value == nil) != (test.expValue == nil) ||
+			(value != nil && !bytes.Equal(value.Bytes, test.expValue.Bytes)) {
+			t.Errorf("expected %q at %s to be %+v; got %+v", test.key, test.ts, test.expValue, value)
+		}
+	}
+
+	// Exports at or above the intent's timestamp fail.
+	if _, err := MVCCExport(engine, proto.KeyMin, proto.KeyMax, proto.ZeroTimestamp, makeTS(6, 0), false); err == nil {
+		t.Error("expected export to fail on intent")
+	} else if _, ok := err.(*proto.WriteIntentError); !ok {
+		t.Errorf("expected write intent error; got %s", err)
+	}
+}
@@ -76,7 +76,9 @@ func (gcq *gcQueue) needsLeaderLease() bool {
 // shouldQueue determines whether a range should be queued for garbage
 // collection, and if so, at what priority. Returns true for shouldQ
 // in the event that the cumulative ages of GC'able bytes or extant
-// intents exceed thresholds.
+// intents exceed thresholds, or the earliest expiration of a value,
+// found by the last scan or recorded when the value was written, has
+// aged past the TTL.
 func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool, priority float64) {
 	// Lookup GC policy for this range.
 	policy, err := gcq.lookupGCPolicy(rng)
@@ -92,6 +94,15 @@ func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool,
 	// and normalizes.
 	intentScore := rng.stats.GetAvgIntentAge(now.WallTime) / float64(intentAgeNormalization.Nanoseconds()/1E9)
 
+	// Expiration score. This computes the age of the earliest expiration
+	// found by the last scan or written since, normalized by the TTL.
+	var expScore float64
+	if gcMeta, err := rng.GetGCMetadata(); err != nil {
+		log.Errorf("GC metadata: %s", err)
+	} else if gcMeta.NextExpirationNanos != nil {
+		expScore = float64((now.WallTime-*gcMeta.NextExpirationNanos)/1E9) / float64(policy.TTLSeconds)
+	}
+
 	// Compute priority.
 	if gcScore > 1 {
 		priority += gcScore
@@ -99,6 +110,9 @@ func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool,
 	if intentScore > 1 {
 		priority += intentScore
 	}
+	if expScore > 1 {
+		priority += expScore
+	}
 	shouldQ = priority > 0
 	return
 }
@@ -122,6 +136,17 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	gcMeta := proto.NewGCMetadata(now.WallTime)
 	gc := engine.NewGarbageCollector(now, policy)
 
+	// Keep the versions read at timestamps protected by long-running
+	// operations such as backups.
+	desc := rng.Desc()
+	protected, ok, err := lookupProtectedTimestamp(rng.rm.Gossip(), desc.StartKey, desc.EndKey, now)
+	if err != nil {
+		return err
+	}
+	if ok {
+		gc.Protect(protected)
+	}
+
 	// Compute intent expiration (intent age at which we attempt to resolve).
 	intentExp := now
 	intentExp.WallTime -= intentAgeThreshold.Nanoseconds()
@@ -135,6 +160,7 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	}
 	var mu sync.Mutex
 	var oldestIntentNanos int64 = math.MaxInt64
+	var nextExpirationNanos int64 = math.MaxInt64
 	var wg sync.WaitGroup
 	var expBaseKey proto.Key
 	var keys []proto.EncodedKey
@@ -176,12 +202,19 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 					startIdx = 2
 				}
 				// See if any values may be GC'd.
-				if gcTS := gc.Filter(keys[startIdx:], vals[startIdx:]); !gcTS.Equal(proto.ZeroTimestamp) {
+				gcTS := gc.Filter(keys[startIdx:], vals[startIdx:])
+				if !gcTS.Equal(proto.ZeroTimestamp) {
 					// TODO(spencer): need to split the requests up into
 					// multiple requests in the event that more than X keys
 					// are added to the request.
 					gcArgs.Keys = append(gcArgs.Keys, proto.InternalGCRequest_GCKey{Key: expBaseKey, Timestamp: gcTS})
 				}
+				// Track the earliest expiration of the most recent values
+				// which survive so the range is queued again once it's GC'able.
+				if !meta.Deleted && meta.Expiration != nil && gcTS.Less(meta.Timestamp) &&
+					meta.Expiration.WallTime < nextExpirationNanos {
+					nextExpirationNanos = meta.Expiration.WallTime
+				}
 			}
 		}
 	}
@@ -213,6 +246,9 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	// Wait for any outstanding intent resolves and set oldest extant intent.
 	wg.Wait()
 	gcMeta.OldestIntentNanos = gogoproto.Int64(oldestIntentNanos)
+	if nextExpirationNanos != math.MaxInt64 {
+		gcMeta.NextExpirationNanos = gogoproto.Int64(nextExpirationNanos)
+	}
 
 	// Send GC request through range.
 	gcArgs.GCMeta = *gcMeta
@@ -323,3 +359,64 @@ func (gcq *gcQueue) lookupGCPolicy(rng *Range) (proto.GCPolicy, error) {
 	}
 	return *gc, nil
 }
+
+// lookupGCPolicyForKey returns the GC policy of the most specific zone
+// containing the key which has one.
+func lookupGCPolicyForKey(g *gossip.Gossip, key proto.Key) (proto.GCPolicy, error) {
+	info, err := g.GetInfo(gossip.KeyConfigZone)
+	if err != nil {
+		return proto.GCPolicy{}, util.Errorf("unable to fetch zone config from gossip: %s", err)
+	}
+	configMap, ok := info.(PrefixConfigMap)
+	if !ok {
+		return proto.GCPolicy{}, util.Errorf("gossiped info is not a prefix configuration map: %+v", info)
+	}
+	var gc *proto.GCPolicy
+	if err := configMap.VisitPrefixesHierarchically(key, func(start, end proto.Key, config interface{}) (bool, error) {
+		gc = config.(*proto.ZoneConfig).GC
+		return gc != nil, nil
+	}); err != nil {
+		return proto.GCPolicy{}, err
+	}
+	if gc == nil {
+		return proto.GCPolicy{}, util.Errorf("no zone with a GC policy for key %q", key)
+	}
+	return *gc, nil
+}
+
+// lookupGCThreshold returns the timestamp beneath which, as of now,
+// versions of keys in [key, endKey) may have been garbage collected:
+// that of the zone with the shortest GC TTL among the zones of the
+// span. It returns false if none of the zones garbage collects.
+func lookupGCThreshold(g *gossip.Gossip, key, endKey proto.Key, now proto.Timestamp) (proto.Timestamp, bool, error) {
+	info, err := g.GetInfo(gossip.KeyConfigZone)
+	if err != nil {
+		return proto.ZeroTimestamp, false, util.Errorf("unable to fetch zone config from gossip: %s", err)
+	}
+	configMap, ok := info.(PrefixConfigMap)
+	if !ok {
+		return proto.ZeroTimestamp, false, util.Errorf("gossiped info is not a prefix configuration map: %+v", info)
+	}
+	// The zones of the span are those of its start key and of each
+	// prefix boundary within it.
+	keys := []proto.Key{key}
+	for _, pc := range configMap {
+		if key.Less(pc.Prefix) && pc.Prefix.Less(endKey) {
+			keys = append(keys, pc.Prefix)
+		}
+	}
+	var ttl int32
+	for _, k := range keys {
+		policy, err := lookupGCPolicyForKey(g, k)
+		if err != nil {
+			return proto.ZeroTimestamp, false, err
+		}
+		if policy.TTLSeconds > 0 && (ttl == 0 || policy.TTLSeconds < ttl) {
+			ttl = policy.TTLSeconds
+		}
+	}
+	if ttl == 0 {
+		return proto.ZeroTimestamp, false, nil
+	}
+	return proto.Timestamp{WallTime: now.WallTime - int64(ttl)*1E9}, true, nil
+}
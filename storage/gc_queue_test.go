@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -47,7 +48,8 @@ func TestGCQueueShouldQueue(t *testing.T) {
 	tc.Start(t)
 	defer tc.Stop()
 
-	// Put an empty GC metadata; all that's read from it is last scan nanos.
+	// Put an empty GC metadata; without a next expiration, it doesn't
+	// contribute to priority.
 	key := engine.RangeGCMetadataKey(tc.rng.Desc().RaftID)
 	if err := engine.MVCCPutProto(tc.rng.rm.Engine(), nil, key, proto.ZeroTimestamp, nil, &proto.GCMetadata{}); err != nil {
 		t.Fatal(err)
@@ -279,6 +281,241 @@ func TestGCQueueProcess(t *testing.T) {
 	}
 }
 
+// TestGCQueueProtectedTimestamp verifies that the GC queue keeps the
+// versions read at the protected timestamps of unexpired records.
+func TestGCQueueProtectedTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+
+	ts1 := makeTS(now-2*24*60*60*1E9+1, 0) // 2d old
+	ts2 := makeTS(now-25*60*60*1E9, 0)     // 25h old
+	ts3 := makeTS(now-1E9, 0)              // 1s old
+	key1 := proto.Key("a")
+	key2 := proto.Key("b")
+	for _, key := range []proto.Key{key1, key2} {
+		for _, ts := range []proto.Timestamp{ts1, ts2, ts3} {
+			pArgs, pReply := putArgs(key, []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+			pArgs.Timestamp = ts
+			if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// Protect key1 as of just after ts2, and key2 with an expired record.
+	for id, pts := range map[string]*proto.ProtectedTimestamp{
+		"key1": {Key: key1, EndKey: key1.Next(), Timestamp: makeTS(ts2.WallTime+1, 0)},
+		"key2": {Key: key2, EndKey: key2.Next(), Timestamp: ts1, Expiration: makeTS(now-1, 0)},
+	} {
+		putProtectedTimestamp(t, &tc, id, pts)
+	}
+
+	if err := newGCQueue().process(tc.clock.Now(), tc.rng); err != nil {
+		t.Fatal(err)
+	}
+
+	expKVs := []struct {
+		key proto.Key
+		ts  proto.Timestamp
+	}{
+		{key1, proto.ZeroTimestamp},
+		{key1, ts3},
+		{key1, ts2},
+		{key2, proto.ZeroTimestamp},
+		{key2, ts3},
+	}
+	kvs, err := engine.Scan(tc.store.Engine(), engine.MVCCEncodeKey(key1), engine.MVCCEncodeKey(engine.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != len(expKVs) {
+		t.Fatalf("expected length %d; got %d", len(expKVs), len(kvs))
+	}
+	for i, kv := range kvs {
+		key, ts, _ := engine.MVCCDecodeKey(kv.Key)
+		if !key.Equal(expKVs[i].key) || !ts.Equal(expKVs[i].ts) {
+			t.Errorf("%d: expected %q at %s; got %q at %s", i, expKVs[i].key, expKVs[i].ts, key, ts)
+		}
+	}
+}
+
+// TestGCQueueExpiration verifies that the GC queue collects values
+// which expired longer ago than the TTL, records the earliest
+// expiration of those which survive, and queues the range once that
+// expiration has aged past the TTL.
+func TestGCQueueExpiration(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+
+	ts1 := makeTS(now-2*24*60*60*1E9+1, 0) // 2d old
+	ts2 := makeTS(now-25*60*60*1E9, 0)     // 25h old
+	ts3 := makeTS(now-1E9, 0)              // 1s old
+	ts4 := makeTS(now+60*60*1E9, 0)        // 1h from now
+	key1 := proto.Key("a")
+	key2 := proto.Key("b")
+	key3 := proto.Key("c")
+	data := []struct {
+		key        proto.Key
+		ts         proto.Timestamp
+		expiration proto.Timestamp
+	}{
+		// For key1, expect the value to GC as it expired more than the TTL ago.
+		{key1, ts1, ts2},
+		// For key2, expect the value to survive as it expired within the TTL.
+		{key2, ts1, ts3},
+		// For key3, expect the value to survive as it hasn't expired.
+		{key3, ts2, ts4},
+	}
+	for i, datum := range data {
+		pArgs, pReply := putArgs(datum.key, []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		pArgs.Timestamp = datum.ts
+		pArgs.Value.Expiration = &data[i].expiration
+		if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+			t.Fatalf("%d: could not put data: %s", i, err)
+		}
+	}
+
+	gcQ := newGCQueue()
+	if err := gcQ.process(tc.clock.Now(), tc.rng); err != nil {
+		t.Fatal(err)
+	}
+
+	expKVs := []struct {
+		key proto.Key
+		ts  proto.Timestamp
+	}{
+		{key2, proto.ZeroTimestamp},
+		{key2, ts1},
+		{key3, proto.ZeroTimestamp},
+		{key3, ts2},
+	}
+	kvs, err := engine.Scan(tc.store.Engine(), engine.MVCCEncodeKey(key1), engine.MVCCEncodeKey(engine.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != len(expKVs) {
+		t.Fatalf("expected length %d; got %d", len(expKVs), len(kvs))
+	}
+	for i, kv := range kvs {
+		key, ts, _ := engine.MVCCDecodeKey(kv.Key)
+		if !key.Equal(expKVs[i].key) || !ts.Equal(expKVs[i].ts) {
+			t.Errorf("%d: expected %q at %s; got %q at %s", i, expKVs[i].key, expKVs[i].ts, key, ts)
+		}
+	}
+
+	gcMeta, err := tc.rng.GetGCMetadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gcMeta.GetNextExpirationNanos() != ts3.WallTime {
+		t.Errorf("expected next expiration nanos=%d; got %d", ts3.WallTime, gcMeta.GetNextExpirationNanos())
+	}
+
+	// The range is queued once key2's expiration is older than the TTL.
+	if shouldQ, _ := gcQ.shouldQueue(tc.clock.Now(), tc.rng); shouldQ {
+		t.Error("expected range not to be queued before the expiration ages past the TTL")
+	}
+	if shouldQ, priority := gcQ.shouldQueue(makeTS(ts3.WallTime+2*24*60*60*1E9, 0), tc.rng); !shouldQ || priority < 2 {
+		t.Errorf("expected range to be queued with priority >= 2; got %t, %f", shouldQ, priority)
+	}
+}
+
+// TestGCQueueProcessNextExpiration verifies that a scan records the
+// expiration of a live value in the range's GC metadata, replacing an
+// expiration recorded when a since-overwritten value was written.
+func TestGCQueueProcessNextExpiration(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+
+	key := proto.Key("a")
+	ts1 := makeTS(now-2*60*60*1E9, 0)  // 2h old
+	ts2 := makeTS(now-60*60*1E9, 0)    // 1h old
+	exp1 := makeTS(now+60*60*1E9, 0)   // 1h from now
+	exp2 := makeTS(now+2*60*60*1E9, 0) // 2h from now
+	for i, v := range []struct{ ts, exp proto.Timestamp }{{ts1, exp1}, {ts2, exp2}} {
+		pArgs, pReply := putArgs(key, []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		pArgs.Timestamp = v.ts
+		pArgs.Value.Expiration = &v.exp
+		if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+			t.Fatalf("%d: could not put data: %s", i, err)
+		}
+	}
+	gcMeta, err := tc.rng.GetGCMetadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gcMeta.GetNextExpirationNanos() != exp1.WallTime {
+		t.Fatalf("expected next expiration nanos=%d; got %d", exp1.WallTime, gcMeta.GetNextExpirationNanos())
+	}
+
+	if err := newGCQueue().process(tc.clock.Now(), tc.rng); err != nil {
+		t.Fatal(err)
+	}
+	if gcMeta, err = tc.rng.GetGCMetadata(); err != nil {
+		t.Fatal(err)
+	}
+	if gcMeta.GetNextExpirationNanos() != exp2.WallTime {
+		t.Errorf("expected next expiration nanos=%d; got %d", exp2.WallTime, gcMeta.GetNextExpirationNanos())
+	}
+}
+
+// TestGCQueueShouldQueueExpiration verifies that ranges holding
+// expired values are queued for GC without a prior scan, using the
+// expirations recorded when the values were written.
+func TestGCQueueShouldQueueExpiration(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+
+	expirations := []proto.Timestamp{
+		makeTS(now+2*60*60*1E9, 0), // 2h from now
+		makeTS(now+60*60*1E9, 0),   // 1h from now
+		makeTS(now+3*60*60*1E9, 0), // 3h from now
+	}
+	for i := range expirations {
+		pArgs, pReply := putArgs(proto.Key(fmt.Sprintf("key%d", i)), []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		pArgs.Value.Expiration = &expirations[i]
+		if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+			t.Fatalf("%d: could not put data: %s", i, err)
+		}
+	}
+	gcMeta, err := tc.rng.GetGCMetadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gcMeta.GetNextExpirationNanos() != expirations[1].WallTime {
+		t.Errorf("expected next expiration nanos=%d; got %d", expirations[1].WallTime, gcMeta.GetNextExpirationNanos())
+	}
+
+	gcQ := newGCQueue()
+	if shouldQ, _ := gcQ.shouldQueue(tc.clock.Now(), tc.rng); shouldQ {
+		t.Error("expected range not to be queued before the expiration ages past the TTL")
+	}
+	if shouldQ, _ := gcQ.shouldQueue(makeTS(expirations[1].WallTime+2*24*60*60*1E9, 0), tc.rng); !shouldQ {
+		t.Error("expected range to be queued once the expiration ages past the TTL")
+	}
+}
+
 // TestGCQueueLookupGCPolicy verifies the hierarchical lookup of GC
 // policy in the event that the longest matching key prefix does not
 // have a zone configured.
@@ -0,0 +1,171 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// leaseRebalanceQueueMaxSize is the max size of the lease rebalance queue.
+	leaseRebalanceQueueMaxSize = 100
+
+	// leaseRebalanceQueueTimerDuration is the duration between lease
+	// transfers. Lease counts of other stores are learned through
+	// gossip, so transfers are spaced out to avoid piling leases onto
+	// a single store before it has gossiped its updated count.
+	leaseRebalanceQueueTimerDuration = 1 * time.Second
+
+	// leaseCountRefreshInterval is how often the local lease count is
+	// recounted. Counting visits every range of the store, so it isn't
+	// done for each range the scanner offers the queue.
+	leaseCountRefreshInterval = 1 * time.Second
+)
+
+// leaseRebalanceQueue transfers leader leases away from stores which
+// hold more than their share of leases, as determined by the lease
+// counts gossiped with each store's descriptor.
+type leaseRebalanceQueue struct {
+	*baseQueue
+	storeFinder FindStoreFunc
+	leaseCount  func() int32
+
+	mu        sync.Mutex // Protects count and countTime
+	count     int32      // Cached local lease count
+	countTime time.Time  // When count was last recounted
+}
+
+// newLeaseRebalanceQueue returns a new instance of leaseRebalanceQueue.
+// leaseCount returns the current number of leader leases held by the
+// local store.
+func newLeaseRebalanceQueue(storeFinder FindStoreFunc, leaseCount func() int32) *leaseRebalanceQueue {
+	lq := &leaseRebalanceQueue{
+		storeFinder: storeFinder,
+		leaseCount:  leaseCount,
+	}
+	lq.baseQueue = newBaseQueue("leaseRebalance", lq, leaseRebalanceQueueMaxSize)
+	return lq
+}
+
+func (lq *leaseRebalanceQueue) needsLeaderLease() bool {
+	return true
+}
+
+func (lq *leaseRebalanceQueue) shouldQueue(now proto.Timestamp, rng *Range) (
+	shouldQ bool, priority float64) {
+	target, delta := lq.selectTarget(rng)
+	if target == nil {
+		return
+	}
+	return true, float64(delta)
+}
+
+func (lq *leaseRebalanceQueue) process(now proto.Timestamp, rng *Range) error {
+	target, _ := lq.selectTarget(rng)
+	if target == nil {
+		// Something changed between shouldQueue and process.
+		return nil
+	}
+	log.V(1).Infof("%s: transferring leader lease to store %d", rng, target.StoreID)
+	if err := rng.TransferLeaderLease(target.StoreID); err != nil {
+		return err
+	}
+	lq.mu.Lock()
+	lq.count--
+	lq.mu.Unlock()
+	return nil
+}
+
+func (lq *leaseRebalanceQueue) timer() time.Duration {
+	return leaseRebalanceQueueTimerDuration
+}
+
+// selectTarget returns the replica of the range to which the leader
+// lease should be transferred, or nil if the lease should stay with
+// the local store.
+func (lq *leaseRebalanceQueue) selectTarget(rng *Range) (*proto.Replica, int32) {
+	stores, err := lq.storeFinder(proto.Attributes{})
+	if err != nil {
+		log.Error(err)
+		return nil, 0
+	}
+	return selectLeaseTarget(stores, rng.rm.StoreID(), lq.cachedLeaseCount(), rng.Desc().Replicas)
+}
+
+// cachedLeaseCount returns the number of leader leases held by the
+// local store, recounting them at most once per
+// leaseCountRefreshInterval. Leases transferred away in the meantime
+// are subtracted by process.
+func (lq *leaseRebalanceQueue) cachedLeaseCount() int32 {
+	lq.mu.Lock()
+	defer lq.mu.Unlock()
+	if now := time.Now(); now.Sub(lq.countTime) >= leaseCountRefreshInterval {
+		lq.count, lq.countTime = lq.leaseCount(), now
+	}
+	return lq.count
+}
+
+// selectLeaseTarget picks the replica to which a leader lease held by
+// the store with the given ID and lease count should be transferred,
+// based on the lease counts of the supplied store descriptors. The
+// difference between the lease counts of the two stores is returned
+// along with the replica. The lease is only moved if the local store
+// holds more than the mean number of leases, the target fewer, and
+// the two differ by more than one lease. This keeps leases from
+// ping-ponging between stores with similar counts. Returns nil if
+// the lease should not be moved.
+func selectLeaseTarget(stores []*proto.StoreDescriptor, storeID proto.StoreID, leaseCount int32,
+	replicas []proto.Replica) (*proto.Replica, int32) {
+	counts := map[proto.StoreID]int32{}
+	for _, s := range stores {
+		counts[s.StoreID] = s.LeaseCount
+	}
+	// The local count is more up to date than the gossiped one.
+	counts[storeID] = leaseCount
+
+	var total int32
+	for _, count := range counts {
+		total += count
+	}
+	mean := float64(total) / float64(len(counts))
+	if float64(leaseCount) <= mean {
+		return nil, 0
+	}
+
+	var target *proto.Replica
+	var targetCount int32
+	for i := range replicas {
+		if replicas[i].StoreID == storeID {
+			continue
+		}
+		// Skip stores we know nothing about.
+		count, ok := counts[replicas[i].StoreID]
+		if !ok {
+			continue
+		}
+		if target == nil || count < targetCount {
+			target, targetCount = &replicas[i], count
+		}
+	}
+	if target == nil || float64(targetCount) >= mean || leaseCount-targetCount <= 1 {
+		return nil, 0
+	}
+	return target, leaseCount - targetCount
+}
@@ -0,0 +1,105 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestSelectLeaseTarget verifies that leases are only moved from
+// stores holding more than their share of leases to replicas on stores
+// holding fewer.
+func TestSelectLeaseTarget(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	replicas := []proto.Replica{
+		{NodeID: 1, StoreID: 1},
+		{NodeID: 2, StoreID: 2},
+		{NodeID: 3, StoreID: 3},
+	}
+	testCases := []struct {
+		counts      []int32 // gossiped lease counts of stores 1 through n
+		local       int32   // lease count of store 1
+		expStoreID  proto.StoreID
+		expPriority int32
+	}{
+		// No other stores known.
+		{[]int32{10}, 10, 0, 0},
+		// Balanced.
+		{[]int32{3, 3, 3}, 3, 0, 0},
+		// Off by one; moving would just reverse the imbalance.
+		{[]int32{4, 3, 3}, 4, 0, 0},
+		// Local store holds all leases; pick the emptiest store.
+		{[]int32{10, 1, 0}, 10, 3, 10},
+		// The local count takes precedence over the gossiped one.
+		{[]int32{10, 1, 0}, 1, 0, 0},
+		// Local store is below the mean.
+		{[]int32{1, 10, 10}, 1, 0, 0},
+		// Stores which aren't replicas of the range are not considered,
+		// but they count towards the mean.
+		{[]int32{6, 5, 5, 0}, 6, 0, 0},
+		// Only known stores are considered as targets.
+		{[]int32{10, 0}, 10, 2, 10},
+	}
+	for i, test := range testCases {
+		var stores []*proto.StoreDescriptor
+		for j, count := range test.counts {
+			stores = append(stores, &proto.StoreDescriptor{
+				StoreID:    proto.StoreID(j + 1),
+				LeaseCount: count,
+			})
+		}
+		target, priority := selectLeaseTarget(stores, 1, test.local, replicas)
+		if test.expStoreID == 0 {
+			if target != nil {
+				t.Errorf("%d: expected no target; got %+v", i, target)
+			}
+			continue
+		}
+		if target == nil || target.StoreID != test.expStoreID {
+			t.Errorf("%d: expected target store %d; got %+v", i, test.expStoreID, target)
+		} else if priority != test.expPriority {
+			t.Errorf("%d: expected priority %d; got %d", i, test.expPriority, priority)
+		}
+	}
+}
+
+// TestLeaseRebalanceQueueCachedLeaseCount verifies that the local
+// lease count isn't recounted for every range offered to the queue.
+func TestLeaseRebalanceQueueCachedLeaseCount(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var calls int
+	lq := newLeaseRebalanceQueue(nil, func() int32 {
+		calls++
+		return int32(calls)
+	})
+	for i := 0; i < 10; i++ {
+		if count := lq.cachedLeaseCount(); count != 1 {
+			t.Errorf("%d: expected cached lease count 1; got %d", i, count)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected leases to be counted once; got %d", calls)
+	}
+
+	// Once the refresh interval has passed, the leases are recounted.
+	lq.countTime = lq.countTime.Add(-leaseCountRefreshInterval)
+	if count := lq.cachedLeaseCount(); count != 2 {
+		t.Errorf("expected recounted lease count 2; got %d", count)
+	}
+}
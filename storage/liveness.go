@@ -0,0 +1,172 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// LivenessHeartbeatInterval is the interval at which each node
+	// gossips a liveness heartbeat.
+	LivenessHeartbeatInterval = 10 * time.Second
+
+	// livenessSuspectHeartbeats is the number of consecutive heartbeats
+	// a node may miss before it's suspected of being dead.
+	livenessSuspectHeartbeats = 3
+
+	// defaultTimeUntilStoreDead is the default duration after which the
+	// stores of a node which has stopped heartbeating are considered
+	// dead and their replicas are replaced.
+	defaultTimeUntilStoreDead = 5 * time.Minute
+)
+
+// NodeLivenessStatus describes the liveness of a node as determined
+// from its gossiped heartbeats.
+type NodeLivenessStatus int
+
+const (
+	// NodeLive nodes have heartbeated recently.
+	NodeLive NodeLivenessStatus = iota
+	// NodeSuspect nodes have missed several heartbeats. No new replicas
+	// or leader leases are placed on their stores, but their existing
+	// replicas are kept.
+	NodeSuspect
+	// NodeDead nodes haven't heartbeated for longer than the time until
+	// store dead. Their replicas are replaced on live stores.
+	NodeDead
+)
+
+var nodeLivenessNames = [...]string{"live", "suspect", "dead"}
+
+// String implements the fmt.Stringer interface.
+func (s NodeLivenessStatus) String() string {
+	return nodeLivenessNames[s]
+}
+
+// GossipLiveness gossips a liveness heartbeat for the node at the given
+// wall time (Unix nanos). Heartbeats don't expire, which keeps nodes
+// that stop heartbeating known until they're declared dead.
+func GossipLiveness(g *gossip.Gossip, nodeID proto.NodeID, now int64) error {
+	return g.AddInfo(gossip.MakeNodeLivenessKey(nodeID), now, 0*time.Second)
+}
+
+// nodeLiveness returns the liveness of the node as of the given wall
+// time (Unix nanos). Nodes which have never gossiped a heartbeat are
+// considered live.
+func nodeLiveness(g *gossip.Gossip, nodeID proto.NodeID, now int64, timeUntilDead time.Duration) NodeLivenessStatus {
+	val, err := g.GetInfo(gossip.MakeNodeLivenessKey(nodeID))
+	if err != nil {
+		return NodeLive
+	}
+	heartbeat, ok := val.(int64)
+	if !ok {
+		log.Errorf("gossiped liveness of node %d is not a heartbeat: %+v", nodeID, val)
+		return NodeLive
+	}
+	since := time.Duration(now - heartbeat)
+	if since > timeUntilDead {
+		return NodeDead
+	}
+	if since > livenessSuspectHeartbeats*LivenessHeartbeatInterval {
+		return NodeSuspect
+	}
+	return NodeLive
+}
+
+// splitReplicas partitions replicas into those to keep and those which
+// must be replaced: replicas on nodes being decommissioned or dead
+// for longer than timeUntilDead and replicas on stores being removed.
+// Replicas on suspect nodes are kept.
+func splitReplicas(g *gossip.Gossip, replicas []proto.Replica, now int64,
+	timeUntilDead time.Duration) (keep, replace []proto.Replica) {
+	for _, replica := range replicas {
+		if isReplicaDecommissioning(g, replica) ||
+			nodeLiveness(g, replica.NodeID, now, timeUntilDead) == NodeDead {
+			replace = append(replace, replica)
+		} else {
+			keep = append(keep, replica)
+		}
+	}
+	return
+}
+
+// A LivenessTracker tracks the liveness of all nodes which have
+// gossiped heartbeats.
+type LivenessTracker struct {
+	mu            sync.Mutex
+	gossip        *gossip.Gossip
+	timeUntilDead time.Duration
+	nodeIDs       map[proto.NodeID]struct{} // Nodes which have heartbeated
+}
+
+// NewLivenessTracker returns a LivenessTracker which considers nodes
+// dead once they haven't heartbeated for timeUntilDead.
+func NewLivenessTracker(g *gossip.Gossip, timeUntilDead time.Duration) *LivenessTracker {
+	lt := &LivenessTracker{
+		gossip:        g,
+		timeUntilDead: timeUntilDead,
+		nodeIDs:       map[proto.NodeID]struct{}{},
+	}
+	if g != nil {
+		g.RegisterCallback(gossip.MakePrefixPattern(gossip.KeyNodeLivenessPrefix), lt.livenessGossipUpdate)
+	}
+	return lt
+}
+
+// livenessGossipUpdate is a gossip callback triggered whenever a
+// liveness heartbeat is gossiped. It just tracks the heartbeating
+// node's ID.
+func (lt *LivenessTracker) livenessGossipUpdate(key string, contentsChanged bool) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(key, gossip.MakeKey(gossip.KeyNodeLivenessPrefix, "")), 10, 32)
+	if err != nil {
+		log.Errorf("unable to parse node ID from liveness key %q: %s", key, err)
+		return
+	}
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	lt.nodeIDs[proto.NodeID(id)] = struct{}{}
+}
+
+// NodeIDs returns the sorted IDs of the nodes which have heartbeated.
+func (lt *LivenessTracker) NodeIDs() []proto.NodeID {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	var ints []int
+	for nodeID := range lt.nodeIDs {
+		ints = append(ints, int(nodeID))
+	}
+	sort.Ints(ints)
+	nodeIDs := make([]proto.NodeID, len(ints))
+	for i, id := range ints {
+		nodeIDs[i] = proto.NodeID(id)
+	}
+	return nodeIDs
+}
+
+// Liveness returns the liveness of the node as of the given wall time
+// (Unix nanos).
+func (lt *LivenessTracker) Liveness(nodeID proto.NodeID, now int64) NodeLivenessStatus {
+	return nodeLiveness(lt.gossip, nodeID, now, lt.timeUntilDead)
+}
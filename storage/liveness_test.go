@@ -0,0 +1,129 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestNodeLiveness verifies that nodes become suspect after missing
+// several heartbeats and dead after the time until store dead.
+func TestNodeLiveness(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+	g := s.ctx.Gossip
+
+	if err := GossipLiveness(g, 2, 0); err != nil {
+		t.Fatal(err)
+	}
+	suspectAfter := livenessSuspectHeartbeats * LivenessHeartbeatInterval
+	testCases := []struct {
+		nodeID   proto.NodeID
+		now      time.Duration
+		expected NodeLivenessStatus
+	}{
+		{2, 0, NodeLive},
+		{2, suspectAfter, NodeLive},
+		{2, suspectAfter + 1, NodeSuspect},
+		{2, 5 * time.Minute, NodeSuspect},
+		{2, 5*time.Minute + 1, NodeDead},
+		// Nodes which have never heartbeated are live.
+		{3, time.Hour, NodeLive},
+	}
+	for i, test := range testCases {
+		if status := nodeLiveness(g, test.nodeID, int64(test.now), 5*time.Minute); status != test.expected {
+			t.Errorf("%d: expected node %d to be %s; got %s", i, test.nodeID, test.expected, status)
+		}
+	}
+
+	// A new heartbeat revives the node.
+	if err := GossipLiveness(g, 2, int64(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if status := nodeLiveness(g, 2, int64(time.Hour), 5*time.Minute); status != NodeLive {
+		t.Errorf("expected node 2 to be live; got %s", status)
+	}
+}
+
+// TestSplitReplicas verifies that replicas on decommissioning and dead
+// nodes are replaced while those on live and suspect nodes are kept.
+func TestSplitReplicas(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+	g := s.ctx.Gossip
+
+	now := int64(time.Hour)
+	if err := GossipLiveness(g, 1, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := GossipLiveness(g, 2, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.AddInfo(gossip.MakeDecommissionKey(3), true, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := GossipLiveness(g, 4, now-int64(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	replicas := []proto.Replica{
+		{NodeID: 1, StoreID: 1},
+		{NodeID: 2, StoreID: 2},
+		{NodeID: 3, StoreID: 3},
+		{NodeID: 4, StoreID: 4},
+	}
+	keep, replace := splitReplicas(g, replicas, now, 5*time.Minute)
+	if expKeep := []proto.Replica{replicas[0], replicas[3]}; !reflect.DeepEqual(keep, expKeep) {
+		t.Errorf("expected to keep %+v; got %+v", expKeep, keep)
+	}
+	if expReplace := []proto.Replica{replicas[1], replicas[2]}; !reflect.DeepEqual(replace, expReplace) {
+		t.Errorf("expected to replace %+v; got %+v", expReplace, replace)
+	}
+}
+
+// TestLivenessTracker verifies that the tracker learns of heartbeating
+// nodes through gossip.
+func TestLivenessTracker(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+	g := s.ctx.Gossip
+
+	lt := NewLivenessTracker(g, 5*time.Minute)
+	if err := GossipLiveness(g, 3, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := GossipLiveness(g, 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	util.SucceedsWithin(t, time.Second, func() error {
+		if nodeIDs := lt.NodeIDs(); !reflect.DeepEqual(nodeIDs, []proto.NodeID{1, 3}) {
+			return util.Errorf("expected nodes 1 and 3; got %v", nodeIDs)
+		}
+		return nil
+	})
+	if status := lt.Liveness(3, int64(time.Hour)); status != NodeDead {
+		t.Errorf("expected node 3 to be dead; got %s", status)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sort"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// ValidateProtectedTimestamp returns an error if the protected
+// timestamp record is invalid. If g is not nil, it also returns an
+// error if, as of now, versions beneath the protected timestamp may
+// already have been garbage collected from the zones of the span, as
+// they can no longer be protected.
+func ValidateProtectedTimestamp(g *gossip.Gossip, pts *proto.ProtectedTimestamp, now proto.Timestamp) error {
+	if !pts.Key.Less(pts.EndKey) {
+		return util.Errorf("invalid protected span %q-%q", pts.Key, pts.EndKey)
+	}
+	if pts.Timestamp.Equal(proto.ZeroTimestamp) {
+		return util.Errorf("no timestamp specified to protect")
+	}
+	if !pts.Expiration.Equal(proto.ZeroTimestamp) && !pts.Timestamp.Less(pts.Expiration) {
+		return util.Errorf("expiration %s must follow the protected timestamp %s", pts.Expiration, pts.Timestamp)
+	}
+	if g == nil {
+		return nil
+	}
+	threshold, ok, err := lookupGCThreshold(g, pts.Key, pts.EndKey, now)
+	if err != nil {
+		return err
+	}
+	if ok && pts.Timestamp.Less(threshold) {
+		return util.Errorf("protected timestamp %s is older than the GC threshold %s of %q-%q",
+			pts.Timestamp, threshold, pts.Key, pts.EndKey)
+	}
+	return nil
+}
+
+// protectedTimestamps is the slice of protected timestamp records
+// gossiped by the range which holds them.
+type protectedTimestamps []*proto.ProtectedTimestamp
+
+// loadProtectedTimestamps scans the protected timestamp records from
+// the engine, including those which have expired.
+func loadProtectedTimestamps(e engine.Engine) (protectedTimestamps, error) {
+	kvs, err := engine.MVCCScan(e, engine.KeyProtectedTimestampPrefix,
+		engine.KeyProtectedTimestampPrefix.PrefixEnd(), 0, proto.MaxTimestamp, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	records := protectedTimestamps{}
+	for _, kv := range kvs {
+		pts := &proto.ProtectedTimestamp{}
+		if err := gogoproto.Unmarshal(kv.Value.Bytes, pts); err != nil {
+			return nil, util.Errorf("unable to unmarshal protected timestamp %q: %s", kv.Key, err)
+		}
+		records = append(records, pts)
+	}
+	return records, nil
+}
+
+// gossipedProtectedTimestamps returns the gossiped protected timestamp
+// records which haven't expired by now.
+func gossipedProtectedTimestamps(g *gossip.Gossip, now proto.Timestamp) ([]*proto.ProtectedTimestamp, error) {
+	info, err := g.GetInfo(gossip.KeyProtectedTimestamps)
+	if err != nil {
+		return nil, err
+	}
+	gossiped, ok := info.(protectedTimestamps)
+	if !ok {
+		return nil, util.Errorf("gossiped info is not protected timestamps: %+v", info)
+	}
+	var records []*proto.ProtectedTimestamp
+	for _, pts := range gossiped {
+		if !pts.Expiration.Equal(proto.ZeroTimestamp) && !now.Less(pts.Expiration) {
+			continue
+		}
+		records = append(records, pts)
+	}
+	return records, nil
+}
+
+// lookupProtectedTimestamp returns the lowest timestamp protected by the
+// records overlapping [key, endKey) which haven't expired by now, and
+// whether there is any.
+func lookupProtectedTimestamp(g *gossip.Gossip, key, endKey proto.Key, now proto.Timestamp) (proto.Timestamp, bool, error) {
+	records, err := gossipedProtectedTimestamps(g, now)
+	if err != nil {
+		return proto.ZeroTimestamp, false, err
+	}
+	var protected proto.Timestamp
+	var found bool
+	for _, pts := range records {
+		if !pts.Key.Less(endKey) || !key.Less(pts.EndKey) {
+			continue
+		}
+		if !found || pts.Timestamp.Less(protected) {
+			protected, found = pts.Timestamp, true
+		}
+	}
+	return protected, found, nil
+}
+
+// isTimestampProtected returns whether the records which haven't
+// expired by now and protect versions read at or before the timestamp
+// together cover all of [key, endKey).
+func isTimestampProtected(g *gossip.Gossip, key, endKey proto.Key, timestamp, now proto.Timestamp) (bool, error) {
+	records, err := gossipedProtectedTimestamps(g, now)
+	if err != nil {
+		return false, err
+	}
+	var spans []*proto.ProtectedTimestamp
+	for _, pts := range records {
+		if !timestamp.Less(pts.Timestamp) && pts.Key.Less(endKey) && key.Less(pts.EndKey) {
+			spans = append(spans, pts)
+		}
+	}
+	sort.Sort(protectedTimestampsByKey(spans))
+	covered := key
+	for _, pts := range spans {
+		if covered.Less(pts.Key) {
+			break
+		}
+		if covered.Less(pts.EndKey) {
+			covered = pts.EndKey
+		}
+	}
+	return !covered.Less(endKey), nil
+}
+
+// protectedTimestampsByKey sorts protected timestamp records by the
+// start keys of their spans.
+type protectedTimestampsByKey []*proto.ProtectedTimestamp
+
+func (p protectedTimestampsByKey) Len() int           { return len(p) }
+func (p protectedTimestampsByKey) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p protectedTimestampsByKey) Less(i, j int) bool { return p[i].Key.Less(p[j].Key) }
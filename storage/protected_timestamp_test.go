@@ -0,0 +1,134 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// TestValidateProtectedTimestamp verifies that records protecting
+// versions beneath the GC threshold of any zone of their span are
+// rejected.
+func TestValidateProtectedTimestamp(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	dayZone, hourZone := testDefaultZoneConfig, testDefaultZoneConfig
+	dayZone.GC = &proto.GCPolicy{TTLSeconds: 24 * 60 * 60}
+	hourZone.GC = &proto.GCPolicy{TTLSeconds: 60 * 60}
+	pcc, err := NewPrefixConfigMap([]*PrefixConfig{
+		{engine.KeyMin, nil, &dayZone},
+		{proto.Key("m"), nil, &hourZone},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ctx.Gossip.AddInfo(gossip.KeyConfigZone, pcc, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	const now int64 = 48 * 60 * 60 * 1e9 // 2d past the epoch
+	testCases := []struct {
+		key, endKey proto.Key
+		age         int64 // in seconds
+		expOK       bool
+	}{
+		{proto.Key("a"), proto.Key("c"), 2 * 60 * 60, true},
+		{proto.Key("a"), proto.Key("c"), 25 * 60 * 60, false},
+		{proto.Key("a"), proto.Key("n"), 2 * 60 * 60, false},
+		{proto.Key("a"), proto.Key("n"), 30 * 60, true},
+		{proto.Key("m"), proto.Key("m").PrefixEnd(), 2 * 60 * 60, false},
+		{proto.Key("n"), proto.Key("z"), 2 * 60 * 60, true},
+	}
+	for i, test := range testCases {
+		pts := &proto.ProtectedTimestamp{
+			Key:       test.key,
+			EndKey:    test.endKey,
+			Timestamp: proto.Timestamp{WallTime: now - test.age*1e9},
+		}
+		err := ValidateProtectedTimestamp(s.ctx.Gossip, pts, proto.Timestamp{WallTime: now})
+		if test.expOK != (err == nil) {
+			t.Errorf("%d: expected success %t; got %v", i, test.expOK, err)
+		}
+	}
+	// Without gossip, only the record itself is validated.
+	pts := &proto.ProtectedTimestamp{Key: proto.Key("a"), EndKey: proto.Key("c"), Timestamp: proto.Timestamp{WallTime: 1}}
+	if err := ValidateProtectedTimestamp(nil, pts, proto.Timestamp{WallTime: now}); err != nil {
+		t.Error(err)
+	}
+}
+
+// putProtectedTimestamp writes the protected timestamp record through
+// the test range, which gossips the records.
+func putProtectedTimestamp(t *testing.T, tc *testContext, id string, pts *proto.ProtectedTimestamp) {
+	data, err := gogoproto.Marshal(pts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pArgs, pReply := putArgs(engine.ProtectedTimestampKey(id), data, tc.rng.Desc().RaftID, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRangeGossipProtectedTimestamps verifies that the range holding
+// the protected timestamp records gossips them as they're written and
+// deleted.
+func TestRangeGossipProtectedTimestamps(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	now := tc.clock.Now()
+	records, err := gossipedProtectedTimestamps(tc.gossip, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Errorf("expected no protected timestamps; got %+v", records)
+	}
+
+	pts := &proto.ProtectedTimestamp{Key: proto.Key("a"), EndKey: proto.Key("c"), Timestamp: now}
+	putProtectedTimestamp(t, &tc, "backup", pts)
+	if records, err = gossipedProtectedTimestamps(tc.gossip, now); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || !reflect.DeepEqual(records[0], pts) {
+		t.Errorf("expected gossiped protected timestamp %+v; got %+v", pts, records)
+	}
+
+	dArgs, dReply := deleteArgs(engine.ProtectedTimestampKey("backup"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	dArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(dArgs, dReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if records, err = gossipedProtectedTimestamps(tc.gossip, now); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Errorf("expected no protected timestamps after release; got %+v", records)
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// pushTxnQueryInterval is the interval at which a waiting pusher
+// queries its own transaction record to learn which transactions are
+// waiting on it, in order to detect deadlocks.
+var pushTxnQueryInterval = 100 * time.Millisecond
+
+// A waitingPush is a pusher blocked in the push txn queue.
+type waitingPush struct {
+	args *proto.InternalPushTxnRequest
+	// updated receives the pushee's transaction record each time it's
+	// written, or nil if the queue was cleared.
+	updated chan *proto.Transaction
+	// superseded is closed if the same pusher enqueued another push of
+	// the same pushee, which happens when the client gives up waiting
+	// for the reply and resends the request.
+	superseded chan struct{}
+
+	mu         sync.Mutex
+	dependents map[string]struct{} // IDs of txns waiting on the pusher
+}
+
+// pusherID returns the pusher's transaction ID or nil if the pusher is
+// non-transactional.
+func (w *waitingPush) pusherID() []byte {
+	if w.args.Txn == nil {
+		return nil
+	}
+	return w.args.Txn.ID
+}
+
+// A pushTxnQueue holds pushers which failed to push a transaction
+// whose record belongs to the range. Instead of returning the push
+// failure to the pusher, which would retry with backoff, pushers wait
+// until the pushee commits, aborts or expires before retrying the
+// push.
+//
+// Transactional pushers are themselves pushed by others, so waiting
+// cascades through the resulting wait-for graph. Each waiting pusher
+// periodically queries its own transaction record via
+// InternalQueryTxn, which returns the transactions waiting on the
+// pusher at the range holding the pusher's record, along with the
+// transactions waiting on those in turn. A pusher which finds its
+// pushee among the transactions waiting on it has detected a deadlock.
+// The deadlock is broken by the pusher with the higher priority, which
+// pushes regardless of priorities.
+//
+// The queue is only populated at the range's leader; it's cleared when
+// the lease changes hands or the range is split or merged, in which
+// case waiting pushers retry immediately.
+type pushTxnQueue struct {
+	rng  *Range
+	mu   sync.Mutex
+	txns map[string][]*waitingPush // Waiting pushers keyed by pushee txn ID
+}
+
+// newPushTxnQueue returns a new, empty push txn queue for the range.
+func newPushTxnQueue(rng *Range) *pushTxnQueue {
+	return &pushTxnQueue{
+		rng:  rng,
+		txns: map[string][]*waitingPush{},
+	}
+}
+
+// Clear releases all waiting pushers so that they retry their pushes.
+func (q *pushTxnQueue) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, waiters := range q.txns {
+		for _, w := range waiters {
+			w.notify(nil)
+		}
+	}
+	q.txns = map[string][]*waitingPush{}
+}
+
+// UpdateTxn is invoked after the record of txn was written to the
+// range. Pushers waiting on txn are informed of the update, which
+// releases them if txn was committed or aborted.
+func (q *pushTxnQueue) UpdateTxn(txn *proto.Transaction) {
+	if txn == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	waiters, ok := q.txns[string(txn.ID)]
+	if !ok {
+		return
+	}
+	if txn.Status != proto.PENDING {
+		delete(q.txns, string(txn.ID))
+	}
+	for _, w := range waiters {
+		w.notify(txn)
+	}
+}
+
+// notify sends txn to the waiter, replacing any update it hasn't yet
+// received. Must be called with the queue lock held.
+func (w *waitingPush) notify(txn *proto.Transaction) {
+	select {
+	case <-w.updated:
+	default:
+	}
+	w.updated <- txn
+}
+
+// waitingTxns returns the IDs of all transactions waiting to push the
+// transaction with the given ID, including those which are waiting on
+// the waiters, as far as they're known.
+func (q *pushTxnQueue) waitingTxns(txnID []byte) [][]byte {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	set := map[string]struct{}{}
+	for _, w := range q.txns[string(txnID)] {
+		if id := w.pusherID(); id != nil {
+			set[string(id)] = struct{}{}
+		}
+		w.mu.Lock()
+		for id := range w.dependents {
+			set[id] = struct{}{}
+		}
+		w.mu.Unlock()
+	}
+	var ids [][]byte
+	for id := range set {
+		ids = append(ids, []byte(id))
+	}
+	return ids
+}
+
+// enqueue adds the waiter to the pushers of the pushee, superseding any
+// earlier push of the pushee by the same pusher.
+func (q *pushTxnQueue) enqueue(pusheeID []byte, w *waitingPush) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	waiters := q.txns[string(pusheeID)]
+	if id := w.pusherID(); id != nil {
+		for i := 0; i < len(waiters); i++ {
+			if bytes.Equal(waiters[i].pusherID(), id) {
+				close(waiters[i].superseded)
+				waiters = append(waiters[:i], waiters[i+1:]...)
+				i--
+			}
+		}
+	}
+	q.txns[string(pusheeID)] = append(waiters, w)
+}
+
+// dequeue removes the waiter from the pushers of the pushee, if still
+// present.
+func (q *pushTxnQueue) dequeue(pusheeID []byte, w *waitingPush) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	waiters := q.txns[string(pusheeID)]
+	for i := range waiters {
+		if waiters[i] == w {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(q.txns, string(pusheeID))
+	} else {
+		q.txns[string(pusheeID)] = waiters
+	}
+}
+
+// waitForPush blocks the pusher after the push described by args failed
+// to push the pushee. It returns nil when the push should be retried:
+// once the pushee is committed, aborted or has expired, or when the
+// pusher is allowed to break a deadlock, in which case args.Force is
+// set. An error is returned if the pusher should give up instead, for
+// example because the pusher's own transaction was aborted.
+func (q *pushTxnQueue) waitForPush(args *proto.InternalPushTxnRequest, pushee *proto.Transaction) error {
+	w := &waitingPush{
+		args:       args,
+		updated:    make(chan *proto.Transaction, 1),
+		superseded: make(chan struct{}),
+	}
+	q.enqueue(pushee.ID, w)
+	defer q.dequeue(pushee.ID, w)
+
+	// If the lease was lost after the push failed, the queue may
+	// already have been cleared; retry immediately to be redirected.
+	if held, expired := q.rng.HasLeaderLease(args.Timestamp); !held || expired {
+		return nil
+	}
+
+	expiration := time.NewTimer(q.untilExpiration(pushee))
+	defer expiration.Stop()
+	var query <-chan time.Time
+	if args.Txn != nil {
+		ticker := time.NewTicker(pushTxnQueryInterval)
+		defer ticker.Stop()
+		query = ticker.C
+	}
+
+	for {
+		select {
+		case txn := <-w.updated:
+			if txn == nil || txn.Status != proto.PENDING {
+				return nil
+			}
+			// The pushee sent a heartbeat or was pushed; check on it
+			// again once its latest heartbeat expires.
+			pushee = txn
+			expiration.Reset(q.untilExpiration(pushee))
+		case <-expiration.C:
+			// Pushes evaluate the pushee's heartbeat against the request
+			// timestamp, which has to be moved up to the present for the
+			// pushee to appear expired.
+			log.V(1).Infof("retrying push of possibly expired txn %s", pushee)
+			args.Timestamp.Forward(q.rng.rm.Clock().Now())
+			return nil
+		case <-query:
+			pusher, dependents, err := q.queryTxn(args.Txn)
+			if err != nil {
+				log.V(1).Infof("failed to query pusher txn %s: %s", args.Txn, err)
+				continue
+			}
+			if pusher != nil {
+				switch pusher.Status {
+				case proto.ABORTED:
+					return proto.NewTransactionAbortedError(pusher)
+				case proto.COMMITTED:
+					return proto.NewTransactionStatusError(pusher, "already committed")
+				}
+			}
+			w.mu.Lock()
+			w.dependents = dependents
+			w.mu.Unlock()
+			if _, ok := dependents[string(pushee.ID)]; ok && pusherWinsDeadlock(args.Txn, pusher, pushee) {
+				log.Infof("breaking deadlock between pusher %s and pushee %s", args.Txn, pushee)
+				args.Force = true
+				return nil
+			}
+		case <-w.superseded:
+			return proto.NewTransactionPushError(args.Txn, pushee)
+		case <-q.rng.rm.Stopper().ShouldStop():
+			return proto.NewTransactionPushError(args.Txn, pushee)
+		}
+	}
+}
+
+// untilExpiration returns the duration until the pushee's last
+// heartbeat expires, but no less than pushTxnQueryInterval to avoid
+// spinning on a pushee which the push doesn't consider expired yet.
+func (q *pushTxnQueue) untilExpiration(pushee *proto.Transaction) time.Duration {
+	lastActive := pushee.Timestamp
+	if pushee.LastHeartbeat != nil {
+		lastActive = *pushee.LastHeartbeat
+	}
+	expiration := lastActive.WallTime + 2*DefaultHeartbeatInterval.Nanoseconds() + 1
+	if d := time.Duration(expiration - q.rng.rm.Clock().PhysicalNow()); d > pushTxnQueryInterval {
+		return d
+	}
+	return pushTxnQueryInterval
+}
+
+// queryTxn fetches the persisted record of txn, if any, along with the
+// IDs of the transactions waiting on it.
+func (q *pushTxnQueue) queryTxn(txn *proto.Transaction) (*proto.Transaction, map[string]struct{}, error) {
+	args := &proto.InternalQueryTxnRequest{
+		RequestHeader: proto.RequestHeader{
+			Timestamp: q.rng.rm.Clock().Now(),
+			Key:       txn.Key,
+			User:      UserRoot,
+		},
+		QueriedTxn: *txn,
+	}
+	reply := &proto.InternalQueryTxnResponse{}
+	if err := q.rng.rm.DB().Run(client.Call{Args: args, Reply: reply}); err != nil {
+		return nil, nil, err
+	}
+	dependents := map[string]struct{}{}
+	for _, id := range reply.WaitingTxns {
+		dependents[string(id)] = struct{}{}
+	}
+	return reply.QueriedTxn, dependents, nil
+}
+
+// pusherWinsDeadlock returns whether the pusher, rather than the
+// pushee, should break a deadlock between them. The transaction with
+// the higher priority wins, with ties broken by transaction ID. The
+// pusher's persisted record, if known, reflects priority upgrades
+// since the push was sent.
+func pusherWinsDeadlock(pusher, pusherRecord, pushee *proto.Transaction) bool {
+	priority := pusher.Priority
+	if pusherRecord != nil && pusherRecord.Priority > priority {
+		priority = pusherRecord.Priority
+	}
+	if priority != pushee.Priority {
+		return priority > pushee.Priority
+	}
+	return bytes.Compare(pusher.ID, pushee.ID) > 0
+}
@@ -0,0 +1,167 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// pushAsync pushes the pushee using the pusher's txn through the
+// store, returning a channel which receives the push's error.
+func pushAsync(store *Store, pusher, pushee *proto.Transaction) <-chan error {
+	args, reply := pushTxnArgs(pusher, pushee, true, 1, store.StoreID())
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- store.ExecuteCmd(args, reply)
+	}()
+	return errChan
+}
+
+// TestPushTxnQueueWaitsForPushee verifies that a push which fails
+// waits for the pushee to finish and then succeeds.
+func TestPushTxnQueueWaitsForPushee(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	pusher := newTransaction("pusher", proto.Key("a"), 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pushee := newTransaction("pushee", proto.Key("b"), 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pusher.Priority = 1
+	pushee.Priority = 2 // Pusher will lose.
+
+	errChan := pushAsync(store, pusher, pushee)
+	select {
+	case err := <-errChan:
+		t.Fatalf("expected push to wait on pushee; got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	etArgs, etReply := endTxnArgs(pushee, true, 1, store.StoreID())
+	etArgs.Timestamp = pushee.Timestamp
+	if err := store.ExecuteCmd(etArgs, etReply); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("expected push to succeed once pushee committed; got %s", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("push still waiting after pushee committed")
+	}
+}
+
+// TestPushTxnQueueExpiredPushee verifies that a waiting pusher aborts
+// the pushee once its heartbeat has expired.
+func TestPushTxnQueueExpiredPushee(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, manual, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	pusher := newTransaction("pusher", proto.Key("a"), 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pushee := newTransaction("pushee", proto.Key("b"), 1, proto.SERIALIZABLE, store.ctx.Clock)
+	pusher.Priority = 1
+	pushee.Priority = 2 // Pusher will lose.
+
+	// The push is sent at the pusher's timestamp, at which the pushee
+	// hasn't expired. The pusher has to wait in the queue to find out
+	// that it has expired since.
+	manual.Set(2*DefaultHeartbeatInterval.Nanoseconds() + 1)
+	if err := <-pushAsync(store, pusher, pushee); err != nil {
+		t.Fatalf("expected push of expired pushee to succeed; got %s", err)
+	}
+
+	txnKey := engine.TransactionKey(pushee.Key, pushee.ID)
+	var txn proto.Transaction
+	if ok, err := engine.MVCCGetProto(store.Engine(), txnKey, proto.ZeroTimestamp, true, nil, &txn); !ok || err != nil {
+		t.Fatalf("not found or err: %s", err)
+	}
+	if txn.Status != proto.ABORTED {
+		t.Errorf("expected pushee to be aborted; got %s", txn.Status)
+	}
+}
+
+// TestPushTxnQueueDeadlock verifies that transactions which wait on
+// each other, directly or through other transactions, detect the
+// deadlock and abort one of the transactions involved.
+func TestPushTxnQueueDeadlock(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	for _, count := range []int{2, 3} {
+		// With equal priorities and timestamps, none of the txns can
+		// push the next in the cycle.
+		ts := store.ctx.Clock.Now()
+		var txns []*proto.Transaction
+		for i := 0; i < count; i++ {
+			key := proto.Key(fmt.Sprintf("%d-%d", count, i))
+			txn := newTransaction("test", key, 1, proto.SERIALIZABLE, store.ctx.Clock)
+			txn.Priority = 1
+			txn.Timestamp = ts
+			txns = append(txns, txn)
+		}
+		type result struct {
+			i   int
+			err error
+		}
+		results := make(chan result, count)
+		for i, txn := range txns {
+			go func(i int, errChan <-chan error) {
+				results <- result{i, <-errChan}
+			}(i, pushAsync(store, txn, txns[(i+1)%count]))
+		}
+
+		// Wait for the deadlock to be broken by aborting a txn.
+		var done, aborted int
+		for aborted == 0 {
+			select {
+			case r := <-results:
+				done++
+				if r.err == nil {
+					continue
+				}
+				if _, ok := r.err.(*proto.TransactionAbortedError); !ok {
+					t.Fatalf("%d: expected push by txn %d to succeed or its pusher to be aborted; got %s", count, r.i, r.err)
+				}
+				aborted++
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%d: deadlock not broken", count)
+			}
+		}
+
+		// Pushes of the txns which weren't aborted wait for them to
+		// finish, so end them.
+		for _, txn := range txns {
+			etArgs, etReply := endTxnArgs(txn, false, 1, store.StoreID())
+			etArgs.Timestamp = txn.Timestamp
+			_ = store.ExecuteCmd(etArgs, etReply)
+		}
+		for ; done < count; done++ {
+			select {
+			case <-results:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%d: push still waiting after all txns finished", count)
+			}
+		}
+	}
+}
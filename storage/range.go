@@ -46,12 +46,14 @@ import (
 // init pre-registers RangeDescriptor, PrefixConfigMap types and Transaction.
 func init() {
 	gob.Register(proto.StoreDescriptor{})
+	gob.Register(AcctStats{})
 	gob.Register(PrefixConfigMap{})
 	gob.Register(&proto.AcctConfig{})
 	gob.Register(&proto.PermConfig{})
 	gob.Register(&proto.ZoneConfig{})
 	gob.Register(proto.RangeDescriptor{})
 	gob.Register(proto.Transaction{})
+	gob.Register(protectedTimestamps{})
 }
 
 var (
@@ -119,6 +121,8 @@ var tsCacheMethods = [...]bool{
 	proto.Delete:                true,
 	proto.DeleteRange:           true,
 	proto.InternalResolveIntent: true,
+	proto.Ingest:                true,
+	proto.Export:                true,
 }
 
 // usesTimestampCache returns true if the request affects or is
@@ -150,10 +154,14 @@ type RangeManager interface {
 	Engine() engine.Engine
 	DB() *client.KV
 	Allocator() *allocator
+	AcctLimiter() *acctLimiter
 	Gossip() *gossip.Gossip
 	SplitQueue() *splitQueue
 	Stopper() *util.Stopper
 	EventFeed() StoreEventFeed
+	ChangeFeeds() *changeFeedRegistry
+	ClockOffsetErr() error
+	Draining() bool
 
 	// Range manipulation methods.
 	AddRange(rng *Range) error
@@ -199,12 +207,14 @@ type Range struct {
 	appliedIndex uint64
 	lease        unsafe.Pointer // Information for leader lease, updated atomically
 	llMu         sync.Mutex     // Synchronizes readers' requests for leader lease
-
-	sync.RWMutex                 // Protects the following fields:
-	cmdQ         *CommandQueue   // Enforce at most one command is running per key(s)
-	tsCache      *TimestampCache // Most recent timestamps for keys / key ranges
-	respCache    *ResponseCache  // Provides idempotence for retries
-	pendingCmds  map[cmdIDKey]*pendingCmd
+	pushTxnQ     *pushTxnQueue  // Pushers waiting on txns with records in the range
+
+	sync.RWMutex                    // Protects the following fields:
+	cmdQ            *CommandQueue   // Enforce at most one command is running per key(s)
+	tsCache         *TimestampCache // Most recent timestamps for keys / key ranges
+	respCache       *ResponseCache  // Provides idempotence for retries
+	pendingCmds     map[cmdIDKey]*pendingCmd
+	closedTimestamp proto.Timestamp // FOLLOWER reads at or below are served locally
 }
 
 // NewRange initializes the range using the given metadata.
@@ -216,6 +226,7 @@ func NewRange(desc *proto.RangeDescriptor, rm RangeManager) (*Range, error) {
 		respCache:   NewResponseCache(desc.RaftID, rm.Engine()),
 		pendingCmds: map[cmdIDKey]*pendingCmd{},
 	}
+	r.pushTxnQ = newPushTxnQueue(r)
 	r.SetDesc(desc)
 
 	lastIndex, err := r.loadLastIndex()
@@ -280,6 +291,7 @@ func (r *Range) start() {
 	r.maybeGossipConfigs(func(configPrefix proto.Key) bool {
 		return r.ContainsKey(configPrefix)
 	})
+	r.maybeGossipProtectedTimestamps()
 }
 
 // Destroy cleans up all data associated with this range.
@@ -335,14 +347,39 @@ func (r *Range) newNotLeaderError() error {
 	return err
 }
 
+// checkClockOffset returns a NotLeaderError naming no leader if this
+// node's clock offset from the cluster is unhealthy, so that clients
+// try the other replicas instead.
+func (r *Range) checkClockOffset() error {
+	if err := r.rm.ClockOffsetErr(); err != nil {
+		log.V(1).Infof("range %d: refusing request: %s", r.Desc().RaftID, err)
+		_, replica := r.Desc().FindReplica(r.rm.StoreID())
+		return &proto.NotLeaderError{Replica: replica}
+	}
+	return nil
+}
+
 // requestLeaderLease sends a request to obtain or extend a leader lease for
 // this replica. Unless an error is returned, the obtained lease will be valid
 // for a time interval containing the requested timestamp.
 func (r *Range) requestLeaderLease(timestamp proto.Timestamp) error {
+	// A replica whose clock can't be trusted must not promise to serve
+	// reads for the lease's duration.
+	if err := r.rm.ClockOffsetErr(); err != nil {
+		return util.Errorf("cannot request leader lease: %s", err)
+	}
+	return r.proposeLeaderLease(timestamp, r.rm.RaftNodeID(), false)
+}
+
+// proposeLeaderLease proposes a leader lease starting at timestamp for
+// the replica with the given raft node ID and waits for the command to
+// be applied. If transfer is true, the lease is handed over from this
+// replica, which must be the current lease holder.
+func (r *Range) proposeLeaderLease(timestamp proto.Timestamp, raftNodeID multiraft.NodeID, transfer bool) error {
 	// TODO(Tobias): get duration from configuration, either as a config flag
 	// or, later, dynamically adjusted.
 	duration := int64(defaultLeaderLeaseDuration)
-	// Prepare a Raft command to get a leader lease for this replica.
+	// Prepare a Raft command to get a leader lease for the replica.
 	expiration := timestamp.Add(duration, 0)
 	args := &proto.InternalLeaderLeaseRequest{
 		RequestHeader: proto.RequestHeader{
@@ -356,8 +393,9 @@ func (r *Range) requestLeaderLease(timestamp proto.Timestamp) error {
 		Lease: proto.Lease{
 			Start:      timestamp,
 			Expiration: expiration,
-			RaftNodeID: uint64(r.rm.RaftNodeID()),
+			RaftNodeID: uint64(raftNodeID),
 		},
+		Transfer: transfer,
 	}
 	// Send lease request directly to raft in order to skip unnecessary
 	// checks from normal request machinery, (e.g. the command queue).
@@ -390,9 +428,22 @@ func (r *Range) redirectOnOrAcquireLeaderLease(timestamp proto.Timestamp) error
 	r.llMu.Lock()
 	defer r.llMu.Unlock()
 	// If lease is currently held by another, redirect to holder.
-	if held, expired := r.HasLeaderLease(timestamp); !held && !expired {
+	held, expired := r.HasLeaderLease(timestamp)
+	if !held && !expired {
 		return r.newNotLeaderError()
-	} else if !held || expired {
+	}
+	// While this node's clock offset is unhealthy, neither use nor
+	// acquire the lease, letting it lapse so another replica can take
+	// over.
+	if err := r.checkClockOffset(); err != nil {
+		return err
+	}
+	if !held || expired {
+		// A draining store is handing off its leases; don't reacquire.
+		if r.rm.Draining() {
+			_, replica := r.Desc().FindReplica(r.rm.StoreID())
+			return &proto.NotLeaderError{Replica: replica}
+		}
 		// Otherwise, if not held by this replica or expired, request renewal.
 		if err := r.requestLeaderLease(timestamp); err != nil {
 			return err
@@ -401,6 +452,110 @@ func (r *Range) redirectOnOrAcquireLeaderLease(timestamp proto.Timestamp) error
 	return nil
 }
 
+// TransferLeaderLease hands the leader lease held by this replica to
+// the replica of this range located on the specified store. Returns
+// NotLeaderError if this replica does not currently hold the lease.
+// Transferring the lease to the current holder is a noop.
+func (r *Range) TransferLeaderLease(storeID proto.StoreID) error {
+	r.llMu.Lock()
+	defer r.llMu.Unlock()
+	_, target := r.Desc().FindReplica(storeID)
+	if target == nil {
+		return util.Errorf("range %d has no replica on store %d", r.Desc().RaftID, storeID)
+	}
+	timestamp := r.rm.Clock().Now()
+	if held, expired := r.HasLeaderLease(timestamp); !held || expired {
+		return r.newNotLeaderError()
+	}
+	if storeID == r.rm.StoreID() {
+		return nil
+	}
+	return r.proposeLeaderLease(timestamp, MakeRaftNodeID(target.NodeID, target.StoreID), true)
+}
+
+// closeTimestamp promises that the leader will not accept any further
+// writes at or below the specified timestamp and informs all replicas
+// of the range through raft, allowing them to serve FOLLOWER reads at
+// those timestamps. This replica must hold the leader lease.
+func (r *Range) closeTimestamp(closed proto.Timestamp) error {
+	timestamp := r.rm.Clock().Now()
+	if held, expired := r.HasLeaderLease(timestamp); !held || expired {
+		return r.newNotLeaderError()
+	}
+	desc := r.Desc()
+
+	// Record the closed timestamp as a read of the entire range. This
+	// forces all subsequent writes to higher timestamps. Then wait for
+	// the commands already in flight, which may be writing at lower
+	// timestamps, to be applied so that they precede the closed
+	// timestamp in the raft log.
+	var wg sync.WaitGroup
+	r.Lock()
+	r.tsCache.Add(desc.StartKey, desc.EndKey, closed, proto.NoTxnMD5, true /* readOnly */)
+	r.cmdQ.GetWait(desc.StartKey, desc.EndKey, false /* !readOnly */, &wg)
+	r.Unlock()
+	wg.Wait()
+
+	args := &proto.InternalCloseTimestampRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       desc.StartKey,
+			EndKey:    desc.EndKey,
+			Timestamp: timestamp,
+			RaftID:    desc.RaftID,
+		},
+		ClosedTimestamp: closed,
+	}
+	errChan, pendingCmd := r.proposeRaftCommand(args, &proto.InternalCloseTimestampResponse{})
+	var err error
+	if err = <-errChan; err == nil {
+		// Next if the command was committed, wait for the range to apply it.
+		err = <-pendingCmd.done
+	}
+	return err
+}
+
+// getClosedTimestamp returns the timestamp at or below which this
+// replica may serve FOLLOWER reads.
+func (r *Range) getClosedTimestamp() proto.Timestamp {
+	r.RLock()
+	defer r.RUnlock()
+	return r.closedTimestamp
+}
+
+// checkGCThreshold returns an error if a read of [key, endKey), or of
+// the key alone if endKey is empty, at the timestamp may miss versions
+// which the GC policies of the span's zones allow to be garbage
+// collected, unless protected timestamps keep them across the whole
+// span. GC TTLs are whole seconds, so the policies aren't looked up for
+// reads less than a second in the past.
+func (r *Range) checkGCThreshold(key, endKey proto.Key, timestamp proto.Timestamp) error {
+	now := r.rm.Clock().Now()
+	if timestamp.Equal(proto.ZeroTimestamp) || now.WallTime-timestamp.WallTime < 1E9 || r.rm.Gossip() == nil {
+		return nil
+	}
+	start := engine.KeyAddress(key)
+	end := start.Next()
+	if len(endKey) != 0 {
+		end = engine.KeyAddress(endKey)
+	}
+	threshold, ok, err := lookupGCThreshold(r.rm.Gossip(), start, end, now)
+	if err != nil {
+		// Zone configs may not have been gossiped yet.
+		log.V(1).Infof("unable to verify read timestamp %s of %q-%q: %s", timestamp, start, end, err)
+		return nil
+	}
+	if !ok || !timestamp.Less(threshold) {
+		return nil
+	}
+	// Versions read at or after a protected timestamp are kept.
+	protected, err := isTimestampProtected(r.rm.Gossip(), start, end, timestamp, now)
+	if err == nil && protected {
+		return nil
+	}
+	return util.Errorf("read timestamp %s of %q-%q is older than the GC TTL of its zones; "+
+		"versions older than %s may have been garbage collected", timestamp, start, end, threshold)
+}
+
 // verifyLeaderLease checks whether the requesting replica (by raft
 // node ID) holds the leader lease covering the specified timestamp.
 func (r *Range) verifyLeaderLease(originRaftNodeID multiraft.NodeID, timestamp proto.Timestamp) bool {
@@ -574,18 +729,67 @@ func (r *Range) addAdminCmd(args proto.Request, reply proto.Response) error {
 		r.AdminSplit(args.(*proto.AdminSplitRequest), reply.(*proto.AdminSplitResponse))
 	case *proto.AdminMergeRequest:
 		r.AdminMerge(args.(*proto.AdminMergeRequest), reply.(*proto.AdminMergeResponse))
+	case *proto.AdminTransferLeaseRequest:
+		r.AdminTransferLease(args.(*proto.AdminTransferLeaseRequest), reply.(*proto.AdminTransferLeaseResponse))
 	default:
 		return util.Errorf("unrecognized admin command type: %s", args.Method())
 	}
 	return reply.Header().GoError()
 }
 
+// checkAcctLimits verifies that the request is within the request-rate
+// limit and quotas of the accounting config of the range. Ranges are
+// split along accounting prefixes, so the config is looked up by the
+// range's start key.
+func (r *Range) checkAcctLimits(args proto.Request) error {
+	g := r.rm.Gossip()
+	if g == nil {
+		return nil
+	}
+	info, err := g.GetInfo(gossip.KeyConfigAccounting)
+	if err != nil {
+		// Accounting configs haven't been gossiped yet.
+		return nil
+	}
+	configMap, ok := info.(PrefixConfigMap)
+	if !ok {
+		return util.Errorf("gossiped info is not a prefix configuration map: %+v", info)
+	}
+	pc := configMap.MatchByPrefix(r.Desc().StartKey)
+	config, ok := pc.Config.(*proto.AcctConfig)
+	if !ok {
+		return util.Errorf("accounting config for prefix %q is not an AcctConfig: %+v", pc.Prefix, pc.Config)
+	}
+	return r.rm.AcctLimiter().check(pc.Prefix, config, args, r.rm.Clock().PhysicalNow())
+}
+
 // addReadOnlyCmd updates the read timestamp cache and waits for any
 // overlapping writes currently processing through Raft ahead of us to
 // clear via the read queue.
 func (r *Range) addReadOnlyCmd(args proto.Request, reply proto.Response) error {
 	header := args.Header()
 
+	// Refuse historical reads whose versions may have been garbage
+	// collected rather than return incomplete results.
+	if err := r.checkGCThreshold(header.Key, header.EndKey, header.Timestamp); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
+	// Exports also read the versions written since their start time,
+	// so the start time mustn't precede the GC threshold either.
+	if eArgs, ok := args.(*proto.ExportRequest); ok {
+		if err := r.checkGCThreshold(header.Key, header.EndKey, eArgs.StartTime); err != nil {
+			reply.Header().SetGoError(err)
+			return err
+		}
+	}
+
+	// Refuse all reads while this node's clock can't be trusted.
+	if err := r.checkClockOffset(); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
+
 	// If read-consistency is set to INCONSISTENT, run directly.
 	if header.ReadConsistency == proto.INCONSISTENT {
 		// But disallow any inconsistent reads within txns.
@@ -600,6 +804,24 @@ func (r *Range) addReadOnlyCmd(args proto.Request, reply proto.Response) error {
 	} else if header.ReadConsistency == proto.CONSENSUS {
 		reply.Header().SetGoError(util.Errorf("consensus reads not implemented"))
 		return reply.Header().GoError()
+	} else if header.ReadConsistency == proto.FOLLOWER {
+		// Transactions must read from the leader to update the timestamp
+		// cache on behalf of their later writes.
+		if header.Txn != nil {
+			reply.Header().SetGoError(util.Errorf("cannot allow follower reads within a transaction"))
+			return reply.Header().GoError()
+		}
+		// If the timestamp is closed, no writes can appear below it and
+		// the read can be served from this replica directly.
+		if !header.Timestamp.Equal(proto.ZeroTimestamp) && !r.getClosedTimestamp().Less(header.Timestamp) {
+			err := r.executeCmd(r.rm.Engine(), nil, args, reply)
+			if _, ok := err.(*proto.WriteIntentError); !ok {
+				return err
+			}
+			// Intents can only be resolved through the leader. Fall back
+			// to a consistent read, which redirects there if necessary.
+			reply.Reset()
+		}
 	}
 
 	// Add the read to the command queue to gate subsequent
@@ -612,6 +834,11 @@ func (r *Range) addReadOnlyCmd(args proto.Request, reply proto.Response) error {
 		reply.Header().SetGoError(err)
 		return err
 	}
+	if err := r.checkAcctLimits(args); err != nil {
+		r.endCmd(cmdKey, args, err, true /* readOnly */)
+		reply.Header().SetGoError(err)
+		return err
+	}
 
 	// Execute read-only command.
 	err := r.executeCmd(r.rm.Engine(), nil, args, reply)
@@ -650,6 +877,11 @@ func (r *Range) addReadWriteCmd(args proto.Request, reply proto.Response, wait b
 		reply.Header().SetGoError(err)
 		return err
 	}
+	if err := r.checkAcctLimits(args); err != nil {
+		r.endCmd(cmdKey, args, err, false /* !readOnly */)
+		reply.Header().SetGoError(err)
+		return err
+	}
 
 	// Two important invariants of Cockroach: 1) encountering a more
 	// recently written value means transaction restart. 2) values must
@@ -818,8 +1050,9 @@ func (r *Range) applyRaftCommand(index uint64, originNodeID multiraft.NodeID, ar
 	}
 
 	// Verify the leader lease is held; Note that we don't require the
-	// leader lease when trying to grant the leader lease!
-	if _, ok := args.(*proto.InternalLeaderLeaseRequest); !ok {
+	// leader lease when trying to grant the leader lease, unless the
+	// lease is being transferred away by its current holder!
+	if lArgs, ok := args.(*proto.InternalLeaderLeaseRequest); !ok || lArgs.Transfer {
 		if !r.verifyLeaderLease(originNodeID, header.Timestamp) {
 			err := r.newNotLeaderError()
 			reply.Header().SetGoError(err)
@@ -830,12 +1063,14 @@ func (r *Range) applyRaftCommand(index uint64, originNodeID multiraft.NodeID, ar
 	// Create a new batch for the command to ensure all or nothing semantics.
 	batch := r.rm.Engine().NewBatch()
 	defer batch.Close()
+	// Record the values committed by the command for change feeds.
+	commits := engine.NewMVCCCommitLog(batch)
 
 	// Create an proto.MVCCStats instance.
 	ms := proto.MVCCStats{}
 
 	// Execute the command; the error will also be set in the reply header.
-	err := r.executeCmd(batch, &ms, args, reply)
+	err := r.executeCmd(commits, &ms, args, reply)
 
 	if oldIndex := atomic.LoadUint64(&r.appliedIndex); oldIndex >= index {
 		log.Fatalf("applied index moved backwards: %d >= %d", oldIndex, index)
@@ -852,7 +1087,7 @@ func (r *Range) applyRaftCommand(index uint64, originNodeID multiraft.NodeID, ar
 	if err == nil && proto.IsWrite(args) {
 		// On success, flush the MVCC stats to the batch and commit.
 		r.stats.MergeMVCCStats(batch, &ms, header.Timestamp.WallTime)
-		if err := batch.Commit(); err != nil {
+		if err := r.rm.ChangeFeeds().commit(batch, commits); err != nil {
 			log.Fatalf("failed to commit batch from Raft command execution: %s", err)
 		}
 		committed = true
@@ -863,6 +1098,15 @@ func (r *Range) applyRaftCommand(index uint64, originNodeID multiraft.NodeID, ar
 		r.stats.Update(ms)
 		// If the commit succeeded, potentially add range to split queue.
 		r.maybeAddToSplitQueue()
+		// Inform pushers waiting on a transaction whose record was updated.
+		switch t := reply.(type) {
+		case *proto.EndTransactionResponse:
+			r.pushTxnQ.UpdateTxn(t.Txn)
+		case *proto.InternalHeartbeatTxnResponse:
+			r.pushTxnQ.UpdateTxn(t.Txn)
+		case *proto.InternalPushTxnResponse:
+			r.pushTxnQ.UpdateTxn(t.PusheeTxn)
+		}
 		// Maybe update gossip configs on a put.
 		switch args.(type) {
 		case *proto.PutRequest, *proto.DeleteRequest, *proto.DeleteRangeRequest:
@@ -870,6 +1114,9 @@ func (r *Range) applyRaftCommand(index uint64, originNodeID multiraft.NodeID, ar
 				r.maybeGossipConfigs(func(configPrefix proto.Key) bool {
 					return bytes.HasPrefix(header.Key, configPrefix)
 				})
+				if bytes.HasPrefix(header.Key, engine.KeyProtectedTimestampPrefix) {
+					r.maybeGossipProtectedTimestamps()
+				}
 			}
 		}
 	}
@@ -959,6 +1206,27 @@ func (r *Range) maybeGossipConfigs(match func(configPrefix proto.Key) bool) {
 	}
 }
 
+// maybeGossipProtectedTimestamps gossips the protected timestamp
+// records if they fall within the range and it holds the leader lease,
+// sparing commands and the GC queue from scanning them.
+func (r *Range) maybeGossipProtectedTimestamps() {
+	if r.rm.Gossip() == nil || !r.ContainsKey(engine.KeyProtectedTimestampPrefix) {
+		return
+	}
+	held, expired := r.HasLeaderLease(r.rm.Clock().Now())
+	if r.getLease().RaftNodeID != 0 && (!held || expired) {
+		return
+	}
+	records, err := loadProtectedTimestamps(r.rm.Engine())
+	if err != nil {
+		log.Errorf("failed loading protected timestamps: %s", err)
+		return
+	}
+	if err := r.rm.Gossip().AddInfo(gossip.KeyProtectedTimestamps, records, 0*time.Second); err != nil {
+		log.Errorf("failed to gossip protected timestamps: %s", err)
+	}
+}
+
 // loadConfigMap scans the config entries under keyPrefix and
 // instantiates/returns a config map. Prefix configuration maps
 // include accounting, permissions, and zones.
@@ -1052,5 +1320,9 @@ func (r *Range) splitTrigger(batch engine.Engine, split *proto.SplitTrigger) err
 	r.tsCache.MergeInto(newRng.tsCache, true /* clear */)
 	r.Unlock()
 
+	// Pushers waiting on txns whose records moved to the new range
+	// retry, which redirects them.
+	r.pushTxnQ.Clear()
+
 	return r.rm.SplitRange(r, newRng)
 }
@@ -80,6 +80,8 @@ func (r *Range) executeCmd(batch engine.Engine, ms *proto.MVCCStats, args proto.
 		r.InternalGC(batch, ms, args.(*proto.InternalGCRequest), reply.(*proto.InternalGCResponse))
 	case *proto.InternalPushTxnRequest:
 		r.InternalPushTxn(batch, args.(*proto.InternalPushTxnRequest), reply.(*proto.InternalPushTxnResponse))
+	case *proto.InternalQueryTxnRequest:
+		r.InternalQueryTxn(batch, args.(*proto.InternalQueryTxnRequest), reply.(*proto.InternalQueryTxnResponse))
 	case *proto.InternalResolveIntentRequest:
 		r.InternalResolveIntent(batch, ms, args.(*proto.InternalResolveIntentRequest), reply.(*proto.InternalResolveIntentResponse))
 	case *proto.InternalMergeRequest:
@@ -88,6 +90,12 @@ func (r *Range) executeCmd(batch engine.Engine, ms *proto.MVCCStats, args proto.
 		r.InternalTruncateLog(batch, ms, args.(*proto.InternalTruncateLogRequest), reply.(*proto.InternalTruncateLogResponse))
 	case *proto.InternalLeaderLeaseRequest:
 		r.InternalLeaderLease(batch, ms, args.(*proto.InternalLeaderLeaseRequest), reply.(*proto.InternalLeaderLeaseResponse))
+	case *proto.InternalCloseTimestampRequest:
+		r.InternalCloseTimestamp(batch, ms, args.(*proto.InternalCloseTimestampRequest), reply.(*proto.InternalCloseTimestampResponse))
+	case *proto.IngestRequest:
+		r.Ingest(batch, ms, args.(*proto.IngestRequest), reply.(*proto.IngestResponse))
+	case *proto.ExportRequest:
+		r.Export(batch, args.(*proto.ExportRequest), reply.(*proto.ExportResponse))
 	default:
 		return util.Errorf("unrecognized command %s", args.Method())
 	}
@@ -128,7 +136,7 @@ func (r *Range) executeCmd(batch engine.Engine, ms *proto.MVCCStats, args proto.
 
 // Contains verifies the existence of a key in the key value store.
 func (r *Range) Contains(batch engine.Engine, args *proto.ContainsRequest, reply *proto.ContainsResponse) {
-	val, err := engine.MVCCGet(batch, args.Key, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn)
+	val, err := engine.MVCCGet(batch, args.Key, args.Timestamp, args.ReadConsistency != proto.INCONSISTENT, args.Txn)
 	if err != nil {
 		reply.SetGoError(err)
 		return
@@ -140,7 +148,7 @@ func (r *Range) Contains(batch engine.Engine, args *proto.ContainsRequest, reply
 
 // Get returns the value for a specified key.
 func (r *Range) Get(batch engine.Engine, args *proto.GetRequest, reply *proto.GetResponse) {
-	val, err := engine.MVCCGet(batch, args.Key, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn)
+	val, err := engine.MVCCGet(batch, args.Key, args.Timestamp, args.ReadConsistency != proto.INCONSISTENT, args.Txn)
 	reply.Value = val
 	reply.SetGoError(err)
 }
@@ -148,6 +156,9 @@ func (r *Range) Get(batch engine.Engine, args *proto.GetRequest, reply *proto.Ge
 // Put sets the value for a specified key.
 func (r *Range) Put(batch engine.Engine, ms *proto.MVCCStats, args *proto.PutRequest, reply *proto.PutResponse) {
 	err := engine.MVCCPut(batch, ms, args.Key, args.Timestamp, args.Value, args.Txn)
+	if err == nil {
+		err = r.noteExpiration(batch, ms, args.Value)
+	}
 	reply.SetGoError(err)
 }
 
@@ -156,9 +167,32 @@ func (r *Range) Put(batch engine.Engine, ms *proto.MVCCStats, args *proto.PutReq
 // the actual value.
 func (r *Range) ConditionalPut(batch engine.Engine, ms *proto.MVCCStats, args *proto.ConditionalPutRequest, reply *proto.ConditionalPutResponse) {
 	err := engine.MVCCConditionalPut(batch, ms, args.Key, args.Timestamp, args.Value, args.ExpValue, args.Txn)
+	if err == nil {
+		err = r.noteExpiration(batch, ms, args.Value)
+	}
 	reply.SetGoError(err)
 }
 
+// noteExpiration records the expiration of a value written to the
+// range in its GC metadata if it precedes the earliest expiration
+// already recorded, so that the GC queue collects the value once it
+// has expired without waiting for a scan to find it.
+func (r *Range) noteExpiration(batch engine.Engine, ms *proto.MVCCStats, value proto.Value) error {
+	if value.Expiration == nil {
+		return nil
+	}
+	key := engine.RangeGCMetadataKey(r.Desc().RaftID)
+	gcMeta := &proto.GCMetadata{}
+	if _, err := engine.MVCCGetProto(batch, key, proto.ZeroTimestamp, true, nil, gcMeta); err != nil {
+		return err
+	}
+	if gcMeta.NextExpirationNanos != nil && *gcMeta.NextExpirationNanos <= value.Expiration.WallTime {
+		return nil
+	}
+	gcMeta.NextExpirationNanos = gogoproto.Int64(value.Expiration.WallTime)
+	return engine.MVCCPutProto(batch, ms, key, proto.ZeroTimestamp, nil, gcMeta)
+}
+
 // Increment increments the value (interpreted as varint64 encoded) and
 // returns the newly incremented value (encoded as varint64). If no value
 // exists for the key, zero is incremented.
@@ -181,11 +215,93 @@ func (r *Range) DeleteRange(batch engine.Engine, ms *proto.MVCCStats, args *prot
 	reply.SetGoError(err)
 }
 
+// Ingest adds the contents of an externally built SSTable to the
+// range, retaining every version and its timestamp. The span [Key,
+// EndKey) has already been verified to lie within the range; here
+// every key in the SSTable is verified to lie within the span, which
+// must hold no existing data, and the MVCC stats of the SSTable's
+// contents are computed and added to the range's.
+//
+// The SSTable is ingested into the engine directly, as writing it
+// key by key through the batch is what ingestion avoids, so it can't
+// commit atomically with the batch. Instead, a marker naming the
+// command is written before ingesting and cleared by the batch. If
+// the batch doesn't commit, as on a crash, the command is applied
+// again and, finding the marker and the span holding exactly the
+// SSTable's contents, only commits the stats.
+func (r *Range) Ingest(batch engine.Engine, ms *proto.MVCCStats, args *proto.IngestRequest, reply *proto.IngestResponse) {
+	if args.Txn != nil {
+		reply.SetGoError(util.Errorf("cannot ingest within a transaction"))
+		return
+	}
+	if len(args.EndKey) == 0 {
+		reply.SetGoError(util.Errorf("no end key specified for ingestion of %q", args.Key))
+		return
+	}
+	stats, ingested, err := engine.MVCCPrepareIngestSST(batch, args.Data, args.Key, args.EndKey, args.Timestamp)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	markerKey := engine.RangeIngestMarkerKey(r.Desc().RaftID)
+	if ingested {
+		marker := &proto.ClientCmdID{}
+		ok, err := engine.MVCCGetProto(r.rm.Engine(), markerKey, proto.ZeroTimestamp, true, nil, marker)
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		if !ok || *marker != args.CmdID {
+			reply.SetGoError(util.Errorf("cannot ingest into non-empty span %q-%q", args.Key, args.EndKey))
+			return
+		}
+	} else {
+		// The marker must be durable before the SSTable is.
+		if err := engine.MVCCPutProto(r.rm.Engine(), nil, markerKey, proto.ZeroTimestamp, nil, &args.CmdID); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		if err := r.rm.Engine().Flush(); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		if err := r.rm.Engine().IngestExternalFile(args.Data); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+	}
+	if err := engine.MVCCDelete(batch, nil, markerKey, proto.ZeroTimestamp, nil); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	ms.Accumulate(&stats)
+}
+
+// Export returns the versions of the keys in the span written in the
+// interval (StartTime, Timestamp] as an SSTable. As a consistent read
+// at Timestamp, it fails on any intent at or below that timestamp and
+// updates the timestamp cache so that no writes can later appear
+// beneath it. Like the timestamp, the start time has been checked
+// against the GC threshold of the span.
+func (r *Range) Export(batch engine.Engine, args *proto.ExportRequest, reply *proto.ExportResponse) {
+	if args.Txn != nil {
+		reply.SetGoError(util.Errorf("cannot export within a transaction"))
+		return
+	}
+	if !args.StartTime.Less(args.Timestamp) {
+		reply.SetGoError(util.Errorf("export start time %s must precede end time %s", args.StartTime, args.Timestamp))
+		return
+	}
+	data, err := engine.MVCCExport(batch, args.Key, args.EndKey, args.StartTime, args.Timestamp, args.AllVersions)
+	reply.Data = data
+	reply.SetGoError(err)
+}
+
 // Scan scans the key range specified by start key through end key up
 // to some maximum number of results. The last key of the iteration is
 // returned with the reply.
 func (r *Range) Scan(batch engine.Engine, args *proto.ScanRequest, reply *proto.ScanResponse) {
-	kvs, err := engine.MVCCScan(batch, args.Key, args.EndKey, args.MaxResults, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn)
+	kvs, err := engine.MVCCScan(batch, args.Key, args.EndKey, args.MaxResults, args.Timestamp, args.ReadConsistency != proto.INCONSISTENT, args.Txn)
 	reply.Rows = kvs
 	reply.SetGoError(err)
 }
@@ -239,6 +355,10 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *p
 		if reply.Txn.Priority < args.Txn.Priority {
 			reply.Txn.Priority = args.Txn.Priority
 		}
+		// The requester alone knows which of its writes were rolled
+		// back to a savepoint; intents are resolved accordingly.
+		reply.Txn.Sequence = args.Txn.Sequence
+		reply.Txn.IgnoredSeqs = args.Txn.IgnoredSeqs
 	} else {
 		// The transaction doesn't exist yet on disk; use the supplied version.
 		reply.Txn = gogoproto.Clone(args.Txn).(*proto.Transaction)
@@ -254,8 +374,9 @@ func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *p
 	// args.Commit parameter.
 	if args.Commit {
 		// If the isolation level is SERIALIZABLE, return a transaction
-		// retry error if the commit timestamp isn't equal to the txn
-		// timestamp.
+		// retry error if the commit timestamp isn't equal to the txn's
+		// original timestamp, at which its reads were performed. SNAPSHOT
+		// txns commit at the pushed timestamp.
 		if args.Txn.Isolation == proto.SERIALIZABLE && !reply.Txn.Timestamp.Equal(args.Txn.OrigTimestamp) {
 			reply.SetGoError(proto.NewTransactionRetryError(reply.Txn))
 			return
@@ -547,6 +668,9 @@ func (r *Range) InternalPushTxn(batch engine.Engine, args *proto.InternalPushTxn
 		// Check for an intent from a prior epoch.
 		log.V(1).Infof("pushing intent from previous epoch for txn %s", reply.PusheeTxn)
 		pusherWins = true
+	} else if args.Force {
+		log.V(1).Infof("forcing push of txn %s to break deadlock", reply.PusheeTxn)
+		pusherWins = true
 	} else if reply.PusheeTxn.Priority < priority ||
 		(reply.PusheeTxn.Priority == priority && args.Txn.Timestamp.Less(reply.PusheeTxn.Timestamp)) {
 		// Finally, choose based on priority; if priorities are equal, order by lower txn timestamp.
@@ -582,6 +706,28 @@ func (r *Range) InternalPushTxn(batch engine.Engine, args *proto.InternalPushTxn
 	}
 }
 
+// InternalQueryTxn fetches the current record of the queried
+// transaction, if one has been persisted, along with the transactions
+// which are waiting to push it.
+func (r *Range) InternalQueryTxn(batch engine.Engine, args *proto.InternalQueryTxnRequest, reply *proto.InternalQueryTxnResponse) {
+	if !bytes.Equal(args.Key, args.QueriedTxn.Key) {
+		reply.SetGoError(util.Errorf("request key %s should match queried txn key %s", args.Key, args.QueriedTxn.Key))
+		return
+	}
+	key := engine.TransactionKey(args.QueriedTxn.Key, args.QueriedTxn.ID)
+
+	txn := &proto.Transaction{}
+	ok, err := engine.MVCCGetProto(batch, key, proto.ZeroTimestamp, true, nil, txn)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if ok {
+		reply.QueriedTxn = txn
+	}
+	reply.WaitingTxns = r.pushTxnQ.waitingTxns(args.QueriedTxn.ID)
+}
+
 // InternalResolveIntent updates the transaction status and heartbeat
 // timestamp after receiving transaction heartbeat messages from
 // coordinator. The range will return the current status for this
@@ -680,14 +826,19 @@ func (r *Range) InternalLeaderLease(batch engine.Engine, ms *proto.MVCCStats, ar
 	// If no old lease exists or this is our lease, we don't need to add an
 	// extra tick. This allows multiple requests from the same replica to
 	// merge without ticking away from the minimal common start timestamp.
+	//
+	// A transfer, on the other hand, cuts the previous lease short and
+	// keeps the requested start. The previous holder proposed the
+	// transfer, so its lease was verified to still be valid when the
+	// command was applied.
 	if prevLease.RaftNodeID == 0 || isExtension {
 		effectiveStart.Backward(prevLease.Expiration)
-	} else {
+	} else if !args.Transfer {
 		effectiveStart.Backward(prevLease.Expiration.Next())
 	}
 	rErr.EffectiveStart = effectiveStart
 
-	if isExtension {
+	if isExtension || args.Transfer {
 		if effectiveStart.Less(prevLease.Start) {
 			reply.SetGoError(rErr)
 			return
@@ -723,7 +874,29 @@ func (r *Range) InternalLeaderLease(batch engine.Engine, ms *proto.MVCCStats, ar
 		nodeID, storeID := DecodeRaftNodeID(multiraft.NodeID(args.Lease.RaftNodeID))
 		log.Infof("range %d: new leader lease for store %d on node %d: %s - %s",
 			r.Desc().RaftID, storeID, nodeID, args.Lease.Start, args.Lease.Expiration)
+	} else if r.getLease().RaftNodeID != uint64(r.rm.RaftNodeID()) {
+		// Pushers waiting at this replica retry, to be redirected to the
+		// new holder of the lease.
+		r.pushTxnQ.Clear()
+	}
+}
+
+// InternalCloseTimestamp records the closed timestamp proposed by the
+// leader, below which this replica may serve FOLLOWER reads. Only the
+// holder of the leader lease may close timestamps, which is verified
+// before the command is applied. The closed timestamp is ignored if
+// the range was split or merged after the command was proposed, as
+// the leader may not have closed out writes on the current key span.
+func (r *Range) InternalCloseTimestamp(batch engine.Engine, ms *proto.MVCCStats, args *proto.InternalCloseTimestampRequest, reply *proto.InternalCloseTimestampResponse) {
+	desc := r.Desc()
+	if !bytes.Equal(args.Key, desc.StartKey) || !bytes.Equal(args.EndKey, desc.EndKey) {
+		log.V(1).Infof("range %d: ignoring closed timestamp %s proposed for %s-%s",
+			desc.RaftID, args.ClosedTimestamp, args.Key, args.EndKey)
+		return
 	}
+	r.Lock()
+	r.closedTimestamp.Forward(args.ClosedTimestamp)
+	r.Unlock()
 }
 
 // AdminSplit divides the range into into two ranges, using either
@@ -945,6 +1118,16 @@ func (r *Range) AdminMerge(args *proto.AdminMergeRequest, reply *proto.AdminMerg
 	}
 }
 
+// AdminTransferLease moves the leader lease of this range to the
+// replica located on the requested store. The lease is proposed by
+// this replica, which must be the current lease holder, and takes
+// effect once the command has been applied.
+func (r *Range) AdminTransferLease(args *proto.AdminTransferLeaseRequest, reply *proto.AdminTransferLeaseResponse) {
+	if err := r.TransferLeaderLease(proto.StoreID(args.StoreID)); err != nil {
+		reply.SetGoError(err)
+	}
+}
+
 // updateRangeDescriptorCall returns a client.Call to execute a ConditionalPut
 // on the range descriptor.
 // The conditional put verifies that changes to the range descriptor are made
@@ -1019,6 +1202,17 @@ func (r *Range) mergeTrigger(batch engine.Engine, merge *proto.MergeTrigger) err
 	// and not worth the extra logic and potential for error.
 	r.tsCache.Clear(r.rm.Clock())
 
+	// Forget the closed timestamp, which doesn't cover writes to the
+	// subsumed range. The leader closes out timestamps on the merged key
+	// span again soon enough.
+	r.Lock()
+	r.closedTimestamp = proto.ZeroTimestamp
+	r.Unlock()
+
+	// Waiting pushers retry, as the lease of the subsumed range may not
+	// have been held by this store.
+	r.pushTxnQ.Clear()
+
 	return r.rm.MergeRange(r, merge.UpdatedDesc.EndKey, merge.SubsumedRaftID)
 }
 
@@ -1032,7 +1226,17 @@ func (r *Range) changeReplicasTrigger(change *proto.ChangeReplicasTrigger) error
 // ChangeReplicas adds or removes a replica of a range. The change is performed
 // in a distributed transaction and takes effect when that transaction is committed.
 // When removing a replica, only the NodeID and StoreID fields of the Replica are used.
+// A replica may not be added to a node which already holds one.
 func (r *Range) ChangeReplicas(changeType proto.ReplicaChangeType, replica proto.Replica) error {
+	return r.changeReplicas(changeType, replica, false)
+}
+
+// changeReplicas implements ChangeReplicas. If allowSameNode is true, a
+// replica may be added to another store of a node which already holds
+// one. This is the first step of moving a replica between the stores
+// of a node, after which the replica on the original store is removed.
+func (r *Range) changeReplicas(changeType proto.ReplicaChangeType, replica proto.Replica,
+	allowSameNode bool) error {
 	// Only allow a single change per range at a time.
 	r.metaLock.Lock()
 	defer r.metaLock.Unlock()
@@ -1053,8 +1257,9 @@ func (r *Range) ChangeReplicas(changeType proto.ReplicaChangeType, replica proto
 	}
 	if changeType == proto.ADD_REPLICA {
 		// If the replica exists on the remote node, no matter in which store,
-		// abort the replica add.
-		if nodeUsed {
+		// abort the replica add unless explicitly allowed. The exact store
+		// may never hold two replicas.
+		if found != -1 || (nodeUsed && !allowSameNode) {
 			return util.Errorf("adding replica %v which is already present in range %d",
 				replica, desc.RaftID)
 		}
@@ -325,6 +325,98 @@ func TestRangeReadConsistency(t *testing.T) {
 	}
 }
 
+// TestRangeFollowerRead verifies that writes are pushed above the
+// timestamp closed by the leader and that a replica which doesn't hold
+// the leader lease serves FOLLOWER reads at or below it.
+func TestRangeFollowerRead(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Write a value and an intent before closing the timestamp.
+	pArgs, pReply := putArgs(proto.Key("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	txn := newTransaction("test", proto.Key("b"), 1, proto.SERIALIZABLE, tc.clock)
+	pArgs, pReply = putArgs(proto.Key("b"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = txn.Timestamp
+	pArgs.Txn = txn
+	if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	tc.manualClock.Increment(10)
+	closed := tc.clock.Now()
+	if err := tc.rng.closeTimestamp(closed); err != nil {
+		t.Fatal(err)
+	}
+	if ts := tc.rng.getClosedTimestamp(); !ts.Equal(closed) {
+		t.Fatalf("expected closed timestamp %s; got %s", closed, ts)
+	}
+
+	// A write at the closed timestamp is pushed above it.
+	pArgs, pReply = putArgs(proto.Key("c"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = closed
+	if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !closed.Less(pReply.Timestamp) {
+		t.Errorf("expected write to be pushed above %s; got %s", closed, pReply.Timestamp)
+	}
+
+	// Lose the lease.
+	start := tc.rng.getLease().Expiration.Add(1, 0)
+	tc.manualClock.Set(start.WallTime)
+	setLeaderLease(t, tc.rng, &proto.Lease{
+		Start:      start,
+		Expiration: start.Add(10, 0),
+		RaftNodeID: uint64(MakeRaftNodeID(2, 2)), // a different node
+	})
+	if err := tc.rng.closeTimestamp(tc.clock.Now()); err == nil {
+		t.Error("expected error closing timestamp without leader lease")
+	}
+
+	// Reads at the closed timestamp are served.
+	gArgs, gReply := getArgs(proto.Key("a"), 1, tc.store.StoreID())
+	gArgs.ReadConsistency = proto.FOLLOWER
+	gArgs.Timestamp = closed
+	if err := tc.rng.AddCmd(gArgs, gReply, true); err != nil {
+		t.Fatalf("expected success on follower read: %s", err)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value")) {
+		t.Errorf("expected value; got %+v", gReply.Value)
+	}
+
+	// Reads above the closed timestamp, or encountering an intent, are
+	// redirected to the leader.
+	for _, test := range []struct {
+		key       proto.Key
+		timestamp proto.Timestamp
+	}{
+		{proto.Key("a"), closed.Next()},
+		{proto.Key("b"), closed},
+	} {
+		gArgs, gReply = getArgs(test.key, 1, tc.store.StoreID())
+		gArgs.ReadConsistency = proto.FOLLOWER
+		gArgs.Timestamp = test.timestamp
+		if _, ok := tc.rng.AddCmd(gArgs, gReply, true).(*proto.NotLeaderError); !ok {
+			t.Errorf("%s: expected not leader error; got %s", test.key, gReply.GoError())
+		}
+	}
+
+	// Follower reads aren't allowed within transactions.
+	gArgs, gReply = getArgs(proto.Key("a"), 1, tc.store.StoreID())
+	gArgs.ReadConsistency = proto.FOLLOWER
+	gArgs.Timestamp = closed
+	gArgs.Txn = newTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	if err := tc.rng.AddCmd(gArgs, gReply, true); err == nil {
+		t.Error("expected error on follower read within a txn")
+	}
+}
+
 func TestRangeRangeBoundsChecking(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	tc := testContext{}
@@ -367,6 +459,87 @@ func TestRangeHasLeaderLease(t *testing.T) {
 	}
 }
 
+// TestRangeLeaderLeaseTransfer verifies that the holder of the leader
+// lease can hand it to another replica before it expires, and that
+// only the current holder may do so.
+func TestRangeLeaderLeaseTransfer(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	proposeLease := func(l proto.Lease, transfer bool) error {
+		args := &proto.InternalLeaderLeaseRequest{Lease: l, Transfer: transfer}
+		errChan, pendingCmd := tc.rng.proposeRaftCommand(args, &proto.InternalLeaderLeaseResponse{})
+		err := <-errChan
+		if err == nil {
+			err = <-pendingCmd.done
+		}
+		return err
+	}
+
+	// Have another replica hold the lease first, so that the start of
+	// this replica's lease isn't wound back to zero.
+	setLeaderLease(t, tc.rng, &proto.Lease{
+		Start:      tc.clock.Now(),
+		Expiration: proto.Timestamp{WallTime: 5},
+		RaftNodeID: uint64(MakeRaftNodeID(3, 3)),
+	})
+	tc.manualClock.Set(10)
+	now := tc.clock.Now()
+	setLeaderLease(t, tc.rng, &proto.Lease{
+		Start:      now,
+		Expiration: now.Add(10, 0),
+		RaftNodeID: uint64(tc.store.RaftNodeID()),
+	})
+
+	// Transferring to a store without a replica fails; transferring to
+	// the current holder is a noop.
+	if err := tc.rng.TransferLeaderLease(2); err == nil {
+		t.Error("expected error transferring lease to store without replica")
+	}
+	if err := tc.rng.TransferLeaderLease(tc.store.StoreID()); err != nil {
+		t.Errorf("unexpected error transferring lease to holder: %s", err)
+	}
+
+	other := proto.Lease{
+		Start:      now.Add(1, 0),
+		Expiration: now.Add(20, 0),
+		RaftNodeID: uint64(MakeRaftNodeID(2, 2)),
+	}
+	// A regular lease request can't overlap the current lease.
+	if err := proposeLease(other, false); err == nil {
+		t.Error("expected lease overlapping current lease to be rejected")
+	}
+	// A transfer can't start before the current lease.
+	early := other
+	early.Start = tc.rng.getLease().Start.Prev()
+	if err := proposeLease(early, true); err == nil {
+		t.Error("expected transfer starting before current lease to be rejected")
+	}
+	if err := proposeLease(other, true); err != nil {
+		t.Fatal(err)
+	}
+	if held, expired := tc.rng.HasLeaderLease(other.Start); held || expired {
+		t.Errorf("expected another replica to have leader lease")
+	}
+	if l := tc.rng.getLease(); !l.Start.Equal(other.Start) {
+		t.Errorf("expected transferred lease to start at %s; got %s", other.Start, l.Start)
+	}
+
+	// This replica no longer holds the lease and can't transfer it.
+	if err := proposeLease(proto.Lease{
+		Start:      now.Add(2, 0),
+		Expiration: now.Add(20, 0),
+		RaftNodeID: uint64(tc.store.RaftNodeID()),
+	}, true); err == nil {
+		t.Error("expected transfer from replica not holding the lease to fail")
+	}
+	if err := tc.rng.TransferLeaderLease(tc.store.StoreID()); err == nil {
+		t.Error("expected transfer from replica not holding the lease to fail")
+	}
+}
+
 func TestRangeNotLeaderError(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	tc := testContext{}
@@ -950,6 +1123,129 @@ func TestRangeUpdateTSCache(t *testing.T) {
 	}
 }
 
+// TestRangeReadGCThreshold verifies that reads at timestamps older
+// than the GC TTL of the key's zone fail, while more recent historical
+// reads succeed.
+func TestRangeReadGCThreshold(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	zoneConfig := testDefaultZoneConfig
+	zoneConfig.GC = &proto.GCPolicy{TTLSeconds: 60 * 60} // 1 hour
+	pcc, err := NewPrefixConfigMap([]*PrefixConfig{{engine.KeyMin, nil, &zoneConfig}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tc.rng.rm.Gossip().AddInfo(gossip.KeyConfigZone, pcc, 0*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	// Acquire the leader lease with a read at the current time.
+	tc.manualClock.Set((2 * time.Hour).Nanoseconds())
+	gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		age    time.Duration
+		expErr bool
+	}{
+		{30 * time.Minute, false},
+		{59 * time.Minute, false},
+		{61 * time.Minute, true},
+		{90 * time.Minute, true},
+	}
+	for i, test := range testCases {
+		gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+		gArgs.Timestamp = proto.Timestamp{WallTime: tc.clock.Now().WallTime - test.age.Nanoseconds()}
+		err := tc.rng.AddCmd(gArgs, gReply, true)
+		if test.expErr {
+			if err == nil || !strings.Contains(err.Error(), "older than the GC TTL") {
+				t.Errorf("%d: expected GC TTL error; got %v", i, err)
+			}
+		} else if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err)
+		}
+	}
+
+	// Scans older than the TTL succeed only once protected timestamps
+	// cover their whole span.
+	ts := proto.Timestamp{WallTime: tc.clock.Now().WallTime - (90 * time.Minute).Nanoseconds()}
+	protectedSpans := []struct {
+		id          string
+		key, endKey proto.Key
+		expErr      bool
+	}{
+		{"a-m", proto.Key("a"), proto.Key("m"), true},
+		{"n-z", proto.Key("n"), proto.Key("z"), true},
+		{"l-n", proto.Key("l"), proto.Key("n"), false},
+	}
+	for i, span := range protectedSpans {
+		pts := &proto.ProtectedTimestamp{Key: span.key, EndKey: span.endKey, Timestamp: ts}
+		putProtectedTimestamp(t, &tc, span.id, pts)
+		sArgs, sReply := scanArgs([]byte("b"), []byte("y"), 1, tc.store.StoreID())
+		sArgs.Timestamp = ts
+		if err := tc.rng.AddCmd(sArgs, sReply, true); span.expErr != (err != nil) {
+			t.Errorf("%d: expected error %t; got %v", i, span.expErr, err)
+		}
+	}
+}
+
+// TestRangeExportGCThreshold verifies that exports whose start time
+// is older than the GC TTL fail unless a protected timestamp covers
+// their span.
+func TestRangeExportGCThreshold(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	zoneConfig := testDefaultZoneConfig
+	zoneConfig.GC = &proto.GCPolicy{TTLSeconds: 60 * 60} // 1 hour
+	pcc, err := NewPrefixConfigMap([]*PrefixConfig{{engine.KeyMin, nil, &zoneConfig}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tc.rng.rm.Gossip().AddInfo(gossip.KeyConfigZone, pcc, 0*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	tc.manualClock.Set((2 * time.Hour).Nanoseconds())
+
+	exportArgs := func(startTime proto.Timestamp) (*proto.ExportRequest, *proto.ExportResponse) {
+		return &proto.ExportRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       proto.Key("a"),
+				EndKey:    proto.Key("c"),
+				Timestamp: tc.clock.Now(),
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			},
+			StartTime: startTime,
+		}, &proto.ExportResponse{}
+	}
+	recent := proto.Timestamp{WallTime: tc.clock.Now().WallTime - (30 * time.Minute).Nanoseconds()}
+	old := proto.Timestamp{WallTime: tc.clock.Now().WallTime - (90 * time.Minute).Nanoseconds()}
+	eArgs, eReply := exportArgs(recent)
+	if err := tc.rng.AddCmd(eArgs, eReply, true); err != nil {
+		t.Errorf("unexpected error exporting since %s: %s", recent, err)
+	}
+	eArgs, eReply = exportArgs(old)
+	if err := tc.rng.AddCmd(eArgs, eReply, true); err == nil || !strings.Contains(err.Error(), "older than the GC TTL") {
+		t.Errorf("expected GC TTL error exporting since %s; got %v", old, err)
+	}
+
+	pts := &proto.ProtectedTimestamp{Key: proto.Key("a"), EndKey: proto.Key("c"), Timestamp: old}
+	putProtectedTimestamp(t, &tc, "backup", pts)
+	eArgs, eReply = exportArgs(old)
+	if err := tc.rng.AddCmd(eArgs, eReply, true); err != nil {
+		t.Errorf("unexpected error exporting since protected %s: %s", old, err)
+	}
+}
+
 // TestRangeCommandQueue verifies that reads/writes must wait for
 // pending commands to complete through Raft before being executed on
 // range.
@@ -1883,6 +2179,106 @@ func TestInternalPushTxnPushTimestampAlreadyPushed(t *testing.T) {
 	}
 }
 
+// TestInternalPushTxnForce verifies that a forced push succeeds
+// regardless of priorities.
+func TestInternalPushTxnForce(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	pusher := newTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	pushee := newTransaction("test", proto.Key("b"), 1, proto.SERIALIZABLE, tc.clock)
+	pusher.Priority = 1
+	pushee.Priority = 2 // Pusher would lose based on priority.
+
+	args, reply := pushTxnArgs(pusher, pushee, true, 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(args, reply, true); err == nil {
+		t.Fatal("expected push to fail")
+	}
+	args, reply = pushTxnArgs(pusher, pushee, true, 1, tc.store.StoreID())
+	args.Force = true
+	if err := tc.rng.AddCmd(args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if reply.PusheeTxn.Status != proto.ABORTED {
+		t.Errorf("expected pushee to be aborted; got %s", reply.PusheeTxn.Status)
+	}
+}
+
+// TestInternalQueryTxn verifies that querying a txn returns its
+// persisted record along with the txns waiting to push it.
+func TestInternalQueryTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	txn := newTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	query := func() *proto.InternalQueryTxnResponse {
+		args := &proto.InternalQueryTxnRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       txn.Key,
+				Timestamp: tc.clock.Now(),
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			},
+			QueriedTxn: *txn,
+		}
+		reply := &proto.InternalQueryTxnResponse{}
+		if err := tc.rng.AddCmd(args, reply, true); err != nil {
+			t.Fatal(err)
+		}
+		return reply
+	}
+
+	if reply := query(); reply.QueriedTxn != nil || len(reply.WaitingTxns) != 0 {
+		t.Errorf("expected no txn record or waiters; got %+v", reply)
+	}
+
+	hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
+	hbArgs.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(hbArgs, hbReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Enqueue a waiter on txn, itself waited on by another txn.
+	waiter := newTransaction("waiter", proto.Key("b"), 1, proto.SERIALIZABLE, tc.clock)
+	pushArgs, _ := pushTxnArgs(waiter, txn, true, 1, tc.store.StoreID())
+	w := &waitingPush{
+		args:       pushArgs,
+		updated:    make(chan *proto.Transaction, 1),
+		superseded: make(chan struct{}),
+		dependents: map[string]struct{}{"dependent": {}},
+	}
+	tc.rng.pushTxnQ.enqueue(txn.ID, w)
+
+	reply := query()
+	if reply.QueriedTxn == nil || !bytes.Equal(reply.QueriedTxn.ID, txn.ID) {
+		t.Errorf("expected txn record for %s; got %+v", txn, reply.QueriedTxn)
+	}
+	waiting := map[string]bool{}
+	for _, id := range reply.WaitingTxns {
+		waiting[string(id)] = true
+	}
+	if len(waiting) != 2 || !waiting[string(waiter.ID)] || !waiting["dependent"] {
+		t.Errorf("expected waiter and its dependent to be waiting; got %q", reply.WaitingTxns)
+	}
+
+	// Ending the txn releases the waiter.
+	etArgs, etReply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+	etArgs.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(etArgs, etReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if updated := <-w.updated; updated == nil || updated.Status != proto.COMMITTED {
+		t.Errorf("expected waiter to receive committed txn; got %+v", updated)
+	}
+	if reply := query(); len(reply.WaitingTxns) != 0 {
+		t.Errorf("expected no waiters; got %q", reply.WaitingTxns)
+	}
+}
+
 func verifyRangeStats(eng engine.Engine, raftID int64, expMS proto.MVCCStats, t *testing.T) {
 	var ms proto.MVCCStats
 	if err := engine.MVCCGetRangeStats(eng, raftID, &ms); err != nil {
@@ -1961,6 +2357,155 @@ func TestRangeStatsComputation(t *testing.T) {
 	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
 }
 
+// ingestArgs returns an IngestRequest and IngestResponse pair for an
+// SSTable holding a version of each key at the timestamp, with the
+// key as its value, to be ingested into [start, end).
+func ingestArgs(t *testing.T, start, end proto.Key, keys []proto.Key, ts proto.Timestamp,
+	raftID int64, storeID proto.StoreID) (*proto.IngestRequest, *proto.IngestResponse) {
+	w, err := engine.NewSSTWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	for _, key := range keys {
+		value := proto.Value{Bytes: key}
+		value.InitChecksum(key)
+		if err := engine.MVCCSSTPut(w, key, ts, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := w.Finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := &proto.IngestRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       start,
+			EndKey:    end,
+			Timestamp: ts,
+			RaftID:    raftID,
+			Replica:   proto.Replica{StoreID: storeID},
+		},
+		Data: data,
+	}
+	return args, &proto.IngestResponse{}
+}
+
+// TestRangeIngest verifies that SSTables are ingested into empty spans
+// within their bounds, updating the range's stats, and that retries
+// of an ingestion are harmless.
+func TestRangeIngest(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{
+		bootstrapMode: bootstrapRangeOnly,
+	}
+	tc.Start(t)
+	defer tc.Stop()
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value1"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	expMS := proto.MVCCStats{LiveBytes: 39, KeyBytes: 15, ValBytes: 24, LiveCount: 1, KeyCount: 1, ValCount: 1}
+
+	keys := []proto.Key{proto.Key("c"), proto.Key("d")}
+	iArgs, iReply := ingestArgs(t, proto.Key("c"), proto.Key("e"), keys, tc.clock.Now(), 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(iArgs, iReply, true); err != nil {
+		t.Fatal(err)
+	}
+	sstMS, err := engine.MVCCComputeSSTStats(iArgs.Data, iArgs.Key, iArgs.EndKey, iArgs.Timestamp.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expMS.Accumulate(&sstMS)
+	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
+
+	sArgs, sReply := scanArgs([]byte("a"), []byte("z"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(sArgs, sReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(sReply.Rows) != 3 || !bytes.Equal(sReply.Rows[1].Value.Bytes, []byte("c")) ||
+		!bytes.Equal(sReply.Rows[2].Value.Bytes, []byte("d")) {
+		t.Errorf("expected a, c and d; got %+v", sReply.Rows)
+	}
+
+	// Another command ingesting the same SSTable is rejected.
+	iArgs.Timestamp = tc.clock.Now()
+	iArgs.CmdID = proto.ClientCmdID{WallTime: 1, Random: 1}
+	iReply.Reset()
+	if err := tc.rng.AddCmd(iArgs, iReply, true); err == nil {
+		t.Error("expected repeated ingestion to fail")
+	}
+	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
+
+	// A command applied again after ingesting the SSTable, but before
+	// its batch committed, finds its marker and only commits the stats.
+	iArgs, iReply = ingestArgs(t, proto.Key("i"), proto.Key("k"), []proto.Key{proto.Key("i"), proto.Key("j")},
+		tc.clock.Now(), 1, tc.store.StoreID())
+	iArgs.CmdID = proto.ClientCmdID{WallTime: 1, Random: 2}
+	markerKey := engine.RangeIngestMarkerKey(tc.rng.Desc().RaftID)
+	if err := engine.MVCCPutProto(tc.engine, nil, markerKey, proto.ZeroTimestamp, nil, &iArgs.CmdID); err != nil {
+		t.Fatal(err)
+	}
+	if err := tc.engine.IngestExternalFile(iArgs.Data); err != nil {
+		t.Fatal(err)
+	}
+	if err := tc.rng.AddCmd(iArgs, iReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if sstMS, err = engine.MVCCComputeSSTStats(iArgs.Data, iArgs.Key, iArgs.EndKey, iArgs.Timestamp.WallTime); err != nil {
+		t.Fatal(err)
+	}
+	expMS.Accumulate(&sstMS)
+	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
+	if v, err := engine.MVCCGet(tc.engine, markerKey, proto.ZeroTimestamp, true, nil); err != nil || v != nil {
+		t.Errorf("expected ingestion marker to be cleared; got %+v, %v", v, err)
+	}
+
+	// Keys outside of the span, non-empty spans and versions following
+	// the request timestamp are rejected.
+	future := tc.clock.Now()
+	future.WallTime += time.Hour.Nanoseconds()
+	testCases := []struct {
+		start, end proto.Key
+		keys       []proto.Key
+		ts         proto.Timestamp
+	}{
+		{proto.Key("x"), proto.Key("z"), []proto.Key{proto.Key("f")}, tc.clock.Now()},
+		{proto.Key("a"), proto.Key("b"), []proto.Key{proto.Key("a")}, tc.clock.Now()},
+		{proto.Key("x"), proto.Key("z"), []proto.Key{proto.Key("y")}, future},
+	}
+	for i, test := range testCases {
+		iArgs, iReply := ingestArgs(t, test.start, test.end, test.keys, test.ts, 1, tc.store.StoreID())
+		iArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(iArgs, iReply, true); err == nil {
+			t.Errorf("%d: expected ingestion of %q into %q-%q to fail", i, test.keys, test.start, test.end)
+		}
+	}
+	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
+
+	// Ingestion is forwarded past reads of the span, so the ingested
+	// data doesn't appear beneath them.
+	ts := tc.clock.Now()
+	sArgs, sReply = scanArgs([]byte("f"), []byte("h"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(sArgs, sReply, true); err != nil {
+		t.Fatal(err)
+	}
+	iArgs, iReply = ingestArgs(t, proto.Key("f"), proto.Key("h"), []proto.Key{proto.Key("g")}, ts, 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(iArgs, iReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := engine.MVCCGet(tc.engine, proto.Key("g"), sArgs.Timestamp, true, nil); err != nil || v != nil {
+		t.Errorf("expected no value beneath the scan at %s; got %+v, %v", sArgs.Timestamp, v, err)
+	}
+	if v, err := engine.MVCCGet(tc.engine, proto.Key("g"), tc.clock.Now(), true, nil); err != nil || v == nil {
+		t.Errorf("expected ingested value; got %+v, %v", v, err)
+	}
+}
+
 // TestInternalMerge verifies that the InternalMerge command is behaving as
 // expected. Merge semantics for different data types are tested more robustly
 // at the engine level; this test is intended only to show that values passed to
@@ -2194,6 +2739,25 @@ func TestChangeReplicasDuplicateError(t *testing.T) {
 	}
 }
 
+// TestChangeReplicasSameNode tests that even when a replica may be
+// added to a node already holding one, a store can't hold two replicas
+// of a range.
+func TestChangeReplicasSameNode(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	if err := tc.rng.changeReplicas(proto.ADD_REPLICA, proto.Replica{
+		NodeID:  tc.store.Ident.NodeID,
+		StoreID: tc.store.Ident.StoreID,
+	}, true /* allowSameNode */); err == nil || !strings.Contains(err.Error(),
+		"already present") {
+		t.Fatalf("must not be able to add second replica to same store (err=%s)",
+			err)
+	}
+}
+
 // benchmarkEvents is designed to determine the impact of sending events on the
 // performance of write commands. This benchmark can be run with or without
 // events, and with or without a consumer reading the events.
@@ -38,18 +38,21 @@ const (
 // change to match the zone config.
 type replicateQueue struct {
 	*baseQueue
-	gossip    *gossip.Gossip
-	allocator *allocator
-	clock     *hlc.Clock
+	gossip        *gossip.Gossip
+	allocator     *allocator
+	clock         *hlc.Clock
+	timeUntilDead time.Duration
 }
 
-// newReplicateQueue returns a new instance of replicateQueue.
+// newReplicateQueue returns a new instance of replicateQueue. Replicas
+// on nodes which haven't heartbeated for timeUntilDead are replaced.
 func newReplicateQueue(gossip *gossip.Gossip, allocator *allocator,
-	clock *hlc.Clock) *replicateQueue {
+	clock *hlc.Clock, timeUntilDead time.Duration) *replicateQueue {
 	rq := &replicateQueue{
-		gossip:    gossip,
-		allocator: allocator,
-		clock:     clock,
+		gossip:        gossip,
+		allocator:     allocator,
+		clock:         clock,
+		timeUntilDead: timeUntilDead,
 	}
 	rq.baseQueue = newBaseQueue("replicate", rq, replicateQueueMaxSize)
 	return rq
@@ -76,18 +79,48 @@ func (rq *replicateQueue) shouldQueue(now proto.Timestamp, rng *Range) (
 	return rq.needsReplication(zone, rng)
 }
 
+// needsReplication returns true if the range has fewer replicas to
+// keep than its zone config requires, has replicas on nodes being
+// decommissioned or dead or on stores being removed which must be
+// replaced, has two replicas on the same node, or has a replica which
+// should be moved to another store of its node.
 func (rq *replicateQueue) needsReplication(zone proto.ZoneConfig, rng *Range) (bool, float64) {
 	// TODO(bdarnell): handle non-empty ReplicaAttrs.
 	need := len(zone.ReplicaAttrs)
-	have := len(rng.Desc().Replicas)
-	if need > have {
-		log.V(1).Infof("%s needs %d nodes; has %d", rng, need, have)
-		return true, float64(need - have)
+	keep, replace := rq.splitReplicas(rng)
+	if need > len(keep) {
+		log.V(1).Infof("%s needs %d nodes; has %d", rng, need, len(keep))
+		return true, float64(need - len(keep))
+	}
+	if len(replace) > 0 {
+		log.V(1).Infof("%s has %d replicas to replace", rng, len(replace))
+		return true, float64(len(replace))
+	}
+	desc := rng.Desc()
+	if dup := rq.sameNodeDuplicate(desc); dup != nil {
+		log.V(1).Infof("%s has two replicas on node %d", rng, dup.NodeID)
+		return true, 1
+	}
+	if from, to := rq.moveWithinNode(zone, desc, keep); to != nil {
+		// Rebalancing a fully replicated range has the lowest priority.
+		log.V(1).Infof("%s should move replica on store %d to store %d", rng, from.StoreID, to.StoreID)
+		return true, 0
 	}
 
 	return false, 0
 }
 
+// process adds a replica if the range is short of replicas to keep.
+// Otherwise it removes a replica from a decommissioning or dead node
+// or a store being removed, first handing the leader lease to a
+// replica being kept if the local replica is the one to be replaced.
+//
+// Replicas are moved between the stores of a node by adding a replica
+// on the target store, which briefly places two replicas on the node,
+// and then removing the replica on the original store. Replicas on
+// stores being removed are moved this way when possible, as are
+// replicas on stores with much less available capacity than another
+// store of the node.
 func (rq *replicateQueue) process(now proto.Timestamp, rng *Range) error {
 	zone, err := lookupZoneConfig(rq.gossip, rng)
 	if err != nil {
@@ -99,19 +132,64 @@ func (rq *replicateQueue) process(now proto.Timestamp, rng *Range) error {
 		return nil
 	}
 
-	// TODO(bdarnell): handle non-homogenous ReplicaAttrs.
-	newReplica, err := rq.allocator.allocate(zone.ReplicaAttrs[0], rng.Desc().Replicas)
-	if err != nil {
-		return err
+	desc := rng.Desc()
+	keep, replace := rq.splitReplicas(rng)
+	dup := rq.sameNodeDuplicate(desc)
+	if _, local := desc.FindReplica(rng.rm.StoreID()); local != nil &&
+		(isReplicaDecommissioning(rq.gossip, *local) || (dup != nil && dup.StoreID == local.StoreID)) {
+		for _, replica := range keep {
+			if replica.StoreID == local.StoreID {
+				continue
+			}
+			// The new leader carries on with the replica changes.
+			log.V(1).Infof("%s: transferring leader lease off store %d to store %d",
+				rng, local.StoreID, replica.StoreID)
+			return rng.TransferLeaderLease(replica.StoreID)
+		}
 	}
 
-	replica := proto.Replica{
-		NodeID:  newReplica.Node.NodeID,
-		StoreID: newReplica.StoreID,
-		Attrs:   newReplica.Attrs,
+	// Replicas on stores being removed from live nodes can be moved to
+	// another store of the same node.
+	var movable []proto.Replica
+	for _, replica := range replace {
+		if !IsDecommissioning(rq.gossip, replica.NodeID) {
+			movable = append(movable, replica)
+		}
 	}
-	if err = rng.ChangeReplicas(proto.ADD_REPLICA, replica); err != nil {
-		return err
+
+	if dup != nil {
+		log.V(1).Infof("%s: removing second replica on node %d from store %d", rng, dup.NodeID, dup.StoreID)
+		if err = rng.ChangeReplicas(proto.REMOVE_REPLICA, *dup); err != nil {
+			return err
+		}
+	} else if from, to := rq.moveWithinNode(zone, desc, movable); to != nil {
+		if err = rq.addReplicaWithinNode(rng, *from, to); err != nil {
+			return err
+		}
+	} else if len(zone.ReplicaAttrs) > len(keep) {
+		// TODO(bdarnell): handle non-homogenous ReplicaAttrs.
+		newReplica, err := rq.allocator.allocate(zone.ReplicaAttrs[0], desc.Replicas)
+		if err != nil {
+			return err
+		}
+
+		replica := proto.Replica{
+			NodeID:  newReplica.Node.NodeID,
+			StoreID: newReplica.StoreID,
+			Attrs:   newReplica.Attrs,
+		}
+		if err = rng.ChangeReplicas(proto.ADD_REPLICA, replica); err != nil {
+			return err
+		}
+	} else if len(replace) > 0 {
+		log.V(1).Infof("%s: removing replica on decommissioning or dead store %d", rng, replace[0].StoreID)
+		if err = rng.ChangeReplicas(proto.REMOVE_REPLICA, replace[0]); err != nil {
+			return err
+		}
+	} else if from, to := rq.moveWithinNode(zone, desc, keep); to != nil {
+		if err = rq.addReplicaWithinNode(rng, *from, to); err != nil {
+			return err
+		}
 	}
 
 	// Enqueue this range again to see if there are more changes to be made.
@@ -119,6 +197,82 @@ func (rq *replicateQueue) process(now proto.Timestamp, rng *Range) error {
 	return nil
 }
 
+// addReplicaWithinNode adds a replica on the target store, which is
+// on the same node as the replica being moved. The moved replica is
+// removed once the range is processed again.
+func (rq *replicateQueue) addReplicaWithinNode(rng *Range, from proto.Replica, to *proto.StoreDescriptor) error {
+	log.V(1).Infof("%s: moving replica on store %d to store %d of node %d",
+		rng, from.StoreID, to.StoreID, to.Node.NodeID)
+	return rng.changeReplicas(proto.ADD_REPLICA, proto.Replica{
+		NodeID:  to.Node.NodeID,
+		StoreID: to.StoreID,
+		Attrs:   to.Attrs,
+	}, true /* allowSameNode */)
+}
+
+// moveWithinNode returns the first of the candidate replicas which
+// should be moved to another store of its node, along with the target
+// store. Returns nils if no candidate should be moved.
+func (rq *replicateQueue) moveWithinNode(zone proto.ZoneConfig, desc *proto.RangeDescriptor,
+	candidates []proto.Replica) (*proto.Replica, *proto.StoreDescriptor) {
+	if len(zone.ReplicaAttrs) == 0 {
+		return nil, nil
+	}
+	for i := range candidates {
+		// TODO(bdarnell): handle non-homogenous ReplicaAttrs.
+		target, err := rq.allocator.allocateWithinNode(zone.ReplicaAttrs[0], candidates[i], desc.Replicas)
+		if err != nil {
+			log.Error(err)
+			return nil, nil
+		}
+		if target != nil {
+			return &candidates[i], target
+		}
+	}
+	return nil, nil
+}
+
+// sameNodeDuplicate returns the replica to remove if two replicas of
+// the range are on the same node, as happens while a replica is moved
+// between the node's stores. Of the two, the replica on a store no
+// longer available for allocation, or else on the store with less
+// available capacity, is returned. Returns nil if every replica is on
+// a different node.
+func (rq *replicateQueue) sameNodeDuplicate(desc *proto.RangeDescriptor) *proto.Replica {
+	var avail map[proto.StoreID]float64
+	storeAvail := func(storeID proto.StoreID) float64 {
+		if a, ok := avail[storeID]; ok {
+			return a
+		}
+		return -1
+	}
+	seen := make(map[proto.NodeID]proto.Replica)
+	for _, replica := range desc.Replicas {
+		other, ok := seen[replica.NodeID]
+		if !ok {
+			seen[replica.NodeID] = replica
+			continue
+		}
+		if avail == nil {
+			var err error
+			if avail, err = rq.allocator.percentAvail(); err != nil {
+				log.Error(err)
+			}
+		}
+		if storeAvail(replica.StoreID) < storeAvail(other.StoreID) {
+			return &replica
+		}
+		return &other
+	}
+	return nil
+}
+
+// splitReplicas partitions the range's replicas into those to keep and
+// those to replace.
+func (rq *replicateQueue) splitReplicas(rng *Range) (keep, replace []proto.Replica) {
+	return splitReplicas(rq.gossip, rng.Desc().Replicas, rq.clock.PhysicalNow(), rq.timeUntilDead)
+}
+
 func (rq *replicateQueue) timer() time.Duration {
 	return replicateQueueTimerDuration
 }
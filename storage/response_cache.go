@@ -189,12 +189,12 @@ func (rc *ResponseCache) PutResponse(cmdID proto.ClientCmdID, reply proto.Respon
 }
 
 // shouldCacheResponse returns whether the response should be cached.
-// Responses with write-too-old and write-intent errors are are
-// retried on the server, and so are not recorded in the response
+// Responses with write-too-old, write-intent and txn push errors
+// are retried on the server, and so are not recorded in the response
 // cache in the hopes of retrying to a successful outcome.
 func (rc *ResponseCache) shouldCacheResponse(reply proto.Response) bool {
 	switch reply.Header().GoError().(type) {
-	case *proto.WriteTooOldError, *proto.WriteIntentError:
+	case *proto.WriteTooOldError, *proto.WriteIntentError, *proto.TransactionPushError:
 		return false
 	}
 	return true
@@ -185,7 +185,7 @@ func TestResponseCacheShouldCache(t *testing.T) {
 		{nil, true},
 		{&proto.ReadWithinUncertaintyIntervalError{}, true},
 		{&proto.TransactionAbortedError{}, true},
-		{&proto.TransactionPushError{}, true},
+		{&proto.TransactionPushError{}, false},
 		{&proto.TransactionRetryError{}, true},
 		{&proto.Error{}, true},
 		{&proto.RangeNotFoundError{}, true},
@@ -30,6 +30,7 @@ import (
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/multiraft"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
@@ -225,17 +226,22 @@ type Store struct {
 
 	Ident          proto.StoreIdent
 	ctx            StoreContext
-	engine         engine.Engine   // The underlying key-value store
-	allocator      *allocator      // Makes allocation decisions
-	raftIDAlloc    *IDAllocator    // Raft ID allocator
-	gcQueue        *gcQueue        // Garbage collection queue
-	splitQueue     *splitQueue     // Range splitting queue
-	verifyQueue    *verifyQueue    // Checksum verification queue
-	replicateQueue *replicateQueue // Replication queue
-	scanner        *rangeScanner   // Range scanner
-	feed           StoreEventFeed  // Event Feed
+	engine         engine.Engine        // The underlying key-value store
+	allocator      *allocator           // Makes allocation decisions
+	acctLimiter    *acctLimiter         // Enforces accounting quotas and limits
+	admission      *admissionController // Throttles writes when compactions fall behind
+	raftIDAlloc    *IDAllocator         // Raft ID allocator
+	gcQueue        *gcQueue             // Garbage collection queue
+	splitQueue     *splitQueue          // Range splitting queue
+	verifyQueue    *verifyQueue         // Checksum verification queue
+	replicateQueue *replicateQueue      // Replication queue
+	leaseQueue     *leaseRebalanceQueue // Leader lease rebalancing queue
+	scanner        *rangeScanner        // Range scanner
+	feed           StoreEventFeed       // Event Feed
+	changeFeeds    *changeFeedRegistry  // Change feed subscriptions
 	multiraft      *multiraft.MultiRaft
 	started        int32
+	draining       int32 // Set while the store hands off its leader leases
 	stopper        *util.Stopper
 	startedAt      int64
 	nodeDesc       *proto.NodeDescriptor
@@ -278,8 +284,28 @@ type StoreContext struct {
 	// ScanInterval is the default value for the scan interval
 	ScanInterval time.Duration
 
+	// ClosedTimestampInterval is the interval at which ranges whose
+	// leader lease is held by this store close out timestamps older
+	// than the interval, allowing their followers to serve FOLLOWER
+	// reads. Zero disables closing timestamps.
+	ClosedTimestampInterval time.Duration
+
 	// EventFeed is a feed to which this store will publish events.
 	EventFeed *util.Feed
+
+	// Admission configures the throttling of user writes when the
+	// engine falls behind on compactions.
+	Admission AdmissionOptions
+
+	// RemoteClocks monitors the node's clock offset from the cluster.
+	// While the offset is unhealthy, ranges on this store neither hold
+	// leader leases nor serve reads. May be nil.
+	RemoteClocks *rpc.RemoteClockMonitor
+
+	// TimeUntilStoreDead is the duration after which the stores of a
+	// node which has stopped gossiping liveness heartbeats are
+	// considered dead, and their replicas are replaced.
+	TimeUntilStoreDead time.Duration
 }
 
 // Valid returns true if the StoreContext is populated correctly.
@@ -304,6 +330,10 @@ func (sc *StoreContext) setDefaults() {
 	if sc.RaftElectionTimeoutTicks == 0 {
 		sc.RaftElectionTimeoutTicks = defaultRaftElectionTimeoutTicks
 	}
+	if sc.TimeUntilStoreDead == 0 {
+		sc.TimeUntilStoreDead = defaultTimeUntilStoreDead
+	}
+	sc.Admission.setDefaults()
 }
 
 // NewStore returns a new instance of a store.
@@ -315,12 +345,15 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *proto.NodeDescripto
 		panic(fmt.Sprintf("invalid store configuration: %+v", &ctx))
 	}
 
-	sf := newStoreFinder(ctx.Gossip)
+	sf := newStoreFinder(ctx.Gossip, ctx.Clock, ctx.TimeUntilStoreDead)
 	s := &Store{
 		ctx:         ctx,
 		StoreFinder: sf,
 		engine:      eng,
 		allocator:   newAllocator(sf.findStores),
+		acctLimiter: newAcctLimiter(NewAcctUsageTracker(ctx.Gossip)),
+		admission:   newAdmissionController(eng, ctx.Admission),
+		changeFeeds: newChangeFeedRegistry(),
 		ranges:      map[int64]*Range{},
 		nodeDesc:    nodeDesc,
 	}
@@ -330,8 +363,9 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *proto.NodeDescripto
 	s.gcQueue = newGCQueue()
 	s.splitQueue = newSplitQueue(s.ctx.DB, s.ctx.Gossip)
 	s.verifyQueue = newVerifyQueue(s.scanner.Stats)
-	s.replicateQueue = newReplicateQueue(s.ctx.Gossip, s.allocator, s.ctx.Clock)
-	s.scanner.AddQueues(s.gcQueue, s.splitQueue, s.verifyQueue, s.replicateQueue)
+	s.replicateQueue = newReplicateQueue(s.ctx.Gossip, s.allocator, s.ctx.Clock, s.ctx.TimeUntilStoreDead)
+	s.leaseQueue = newLeaseRebalanceQueue(sf.findStores, s.LeaderLeaseCount)
+	s.scanner.AddQueues(s.gcQueue, s.splitQueue, s.verifyQueue, s.replicateQueue, s.leaseQueue)
 
 	return s
 }
@@ -480,6 +514,9 @@ func (s *Store) Start(stopper *util.Stopper) error {
 	// sentinel and first range metadata if we have a first range.
 	s.startGossip()
 
+	// Start closing out timestamps for follower reads.
+	s.startCloseTimestamps()
+
 	// Set the started flag (for unittests).
 	atomic.StoreInt32(&s.started, 1)
 
@@ -508,6 +545,60 @@ func (s *Store) startGossip() {
 	})
 }
 
+// startCloseTimestamps runs an infinite loop in a goroutine which
+// regularly closes out timestamps on all ranges for which this store
+// holds the leader lease. Does nothing if no closed timestamp interval
+// is configured.
+func (s *Store) startCloseTimestamps() {
+	interval := s.ctx.ClosedTimestampInterval
+	if interval == 0 {
+		return
+	}
+	s.stopper.RunWorker(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.closeTimestamps(s.ctx.Clock.Now().Add(-interval.Nanoseconds(), 0))
+			case <-s.stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// closeTimestamps closes out the specified timestamp on all ranges for
+// which this store holds the leader lease and waits for the closed
+// timestamps to be applied.
+func (s *Store) closeTimestamps(closed proto.Timestamp) {
+	now := s.ctx.Clock.Now()
+	var ranges []*Range
+	s.mu.RLock()
+	for _, rng := range s.ranges {
+		if held, expired := rng.HasLeaderLease(now); held && !expired {
+			ranges = append(ranges, rng)
+		}
+	}
+	s.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, rng := range ranges {
+		if !s.stopper.StartTask() {
+			break
+		}
+		wg.Add(1)
+		go func(rng *Range) {
+			defer wg.Done()
+			defer s.stopper.FinishTask()
+			if err := rng.closeTimestamp(closed); err != nil {
+				log.V(1).Infof("%s: unable to close timestamp %s: %s", rng, closed, err)
+			}
+		}(rng)
+	}
+	wg.Wait()
+}
+
 // configGossipUpdate is a callback for gossip updates to
 // configuration maps which affect range split boundaries.
 func (s *Store) configGossipUpdate(key string, contentsChanged bool) {
@@ -545,6 +636,39 @@ func (s *Store) GossipCapacity() {
 	s.ctx.Gossip.AddInfo(keyMaxCapacity, *storeDesc, ttlCapacityGossip)
 }
 
+// GossipAcctStats broadcasts the stats of the accounting prefixes of
+// the ranges for which the store holds the leader lease on the gossip
+// network. Ranges are split along accounting prefixes, so the prefix of
+// a range is determined by its start key.
+func (s *Store) GossipAcctStats() {
+	info, err := s.ctx.Gossip.GetInfo(gossip.KeyConfigAccounting)
+	if err != nil {
+		log.V(1).Infof("unable to fetch accounting config from gossip: %s", err)
+		return
+	}
+	configMap, ok := info.(PrefixConfigMap)
+	if !ok {
+		log.Errorf("gossiped info is not a prefix configuration map: %+v", info)
+		return
+	}
+	now := s.ctx.Clock.Now()
+	stats := AcctStats{}
+	s.mu.RLock()
+	for _, rng := range s.ranges {
+		if held, expired := rng.HasLeaderLease(now); !held || expired {
+			continue
+		}
+		prefix := string(configMap.MatchByPrefix(rng.Desc().StartKey).Prefix)
+		ms, rngMS := stats[prefix], rng.GetMVCCStats()
+		ms.Accumulate(&rngMS)
+		stats[prefix] = ms
+	}
+	s.mu.RUnlock()
+	// Unique gossip key per store.
+	key := gossip.MakeAcctStatsKey(s.Ident.NodeID, s.Ident.StoreID)
+	s.ctx.Gossip.AddInfo(key, stats, ttlCapacityGossip)
+}
+
 // maybeSplitRangesByConfigs determines ranges which should be
 // split by the boundaries of the prefix config map, if any, and
 // adds them to the split queue.
@@ -773,6 +897,9 @@ func (s *Store) DB() *client.KV { return s.ctx.DB }
 // Allocator accessor.
 func (s *Store) Allocator() *allocator { return s.allocator }
 
+// AcctLimiter accessor.
+func (s *Store) AcctLimiter() *acctLimiter { return s.acctLimiter }
+
 // Gossip accessor.
 func (s *Store) Gossip() *gossip.Gossip { return s.ctx.Gossip }
 
@@ -785,6 +912,26 @@ func (s *Store) Stopper() *util.Stopper { return s.stopper }
 // EventFeed accessor.
 func (s *Store) EventFeed() StoreEventFeed { return s.feed }
 
+// ChangeFeeds accessor.
+func (s *Store) ChangeFeeds() *changeFeedRegistry { return s.changeFeeds }
+
+// ClockOffsetErr returns an error if the node's clock offset from the
+// cluster is unhealthy.
+func (s *Store) ClockOffsetErr() error { return s.ctx.RemoteClocks.Err() }
+
+// Draining returns true if the store is draining, in which case its
+// replicas neither acquire nor renew leader leases.
+func (s *Store) Draining() bool { return atomic.LoadInt32(&s.draining) == 1 }
+
+// SetDraining sets whether the store is draining.
+func (s *Store) SetDraining(draining bool) {
+	var v int32
+	if draining {
+		v = 1
+	}
+	atomic.StoreInt32(&s.draining, v)
+}
+
 // NewRangeDescriptor creates a new descriptor based on start and end
 // keys and the supplied proto.Replicas slice. It allocates new Raft
 // and range IDs to fill out the supplied replicas.
@@ -942,13 +1089,82 @@ func (s *Store) Descriptor() (*proto.StoreDescriptor, error) {
 	}
 	// Initialize the store descriptor.
 	return &proto.StoreDescriptor{
-		StoreID:  s.Ident.StoreID,
-		Attrs:    s.Attrs(),
-		Node:     *s.nodeDesc,
-		Capacity: capacity,
+		StoreID:    s.Ident.StoreID,
+		Attrs:      s.Attrs(),
+		Node:       *s.nodeDesc,
+		Capacity:   capacity,
+		LeaseCount: s.LeaderLeaseCount(),
 	}, nil
 }
 
+// LeaderLeaseCount returns the number of ranges for which this store
+// currently holds an unexpired leader lease.
+func (s *Store) LeaderLeaseCount() int32 {
+	now := s.ctx.Clock.Now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var count int32
+	for _, rng := range s.ranges {
+		if held, expired := rng.HasLeaderLease(now); held && !expired {
+			count++
+		}
+	}
+	return count
+}
+
+// DrainLeaderLeases transfers each leader lease held by the store's
+// replicas to another replica of the range, preferring replicas on
+// live nodes which aren't being decommissioned. Returns the number of
+// leases which couldn't be transferred. Ranges without another
+// replica to take over the lease aren't counted. The store should be
+// draining, or its node's clock offset unhealthy, so that the leases
+// aren't reacquired.
+func (s *Store) DrainLeaderLeases() int {
+	now := s.ctx.Clock.Now()
+	var held []*Range
+	s.mu.RLock()
+	for _, rng := range s.ranges {
+		if h, expired := rng.HasLeaderLease(now); h && !expired {
+			held = append(held, rng)
+		}
+	}
+	s.mu.RUnlock()
+
+	var failed int
+	for _, rng := range held {
+		target := s.leaseDrainTarget(rng, now)
+		if target == nil {
+			continue
+		}
+		if err := rng.TransferLeaderLease(target.StoreID); err != nil {
+			log.Warningf("%s: unable to transfer leader lease to store %d: %s", rng, target.StoreID, err)
+			failed++
+		}
+	}
+	return failed
+}
+
+// leaseDrainTarget returns the replica of the range to which a
+// draining store should transfer the leader lease, or nil if the range
+// has no other replica.
+func (s *Store) leaseDrainTarget(rng *Range, now proto.Timestamp) *proto.Replica {
+	var others []proto.Replica
+	for _, replica := range rng.Desc().Replicas {
+		if replica.StoreID != s.StoreID() {
+			others = append(others, replica)
+		}
+	}
+	if len(others) == 0 {
+		return nil
+	}
+	if s.ctx.Gossip != nil {
+		if keep, _ := splitReplicas(s.ctx.Gossip, others, now.WallTime, s.ctx.TimeUntilStoreDead); len(keep) > 0 {
+			return &keep[0]
+		}
+	}
+	return &others[0]
+}
+
 // ExecuteCmd fetches a range based on the header's replica, assembles
 // method, args & reply into a Raft Cmd struct and executes the
 // command using the fetched range.
@@ -985,9 +1201,26 @@ func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
 			return util.RetryBreak, err
 		}
 
-		if err = rng.AddCmd(args, reply, true); err == nil {
+		if err = s.addCmdWithAdmission(rng, args, reply); err == nil {
 			return util.RetryBreak, nil
 		}
+		if _, ok := err.(*proto.StoreBackpressureError); ok {
+			return util.RetryBreak, err
+		}
+
+		// A failed push waits in the range's push txn queue until the
+		// pushee is done or the push can be retried for another reason.
+		// Waiting may be indefinite, so stores which limit the number of
+		// retry attempts return the push failure instead.
+		if pushErr, ok := err.(*proto.TransactionPushError); ok && retryOpts.MaxAttempts == 0 {
+			if pushArgs, ok := args.(*proto.InternalPushTxnRequest); ok {
+				if err = rng.pushTxnQ.waitForPush(pushArgs, &pushErr.PusheeTxn); err == nil {
+					return util.RetryReset, nil
+				}
+				reply.Header().SetGoError(err)
+				return util.RetryBreak, err
+			}
+		}
 
 		// Maybe resolve a potential write intent error. We do this here
 		// because this is the code path with the requesting client
@@ -997,6 +1230,14 @@ func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
 
 		switch t := err.(type) {
 		case *proto.WriteTooOldError:
+			// A snapshot isolation txn must restart in order to read the
+			// newer write before overwriting it.
+			if header.Txn != nil && header.Txn.Isolation == proto.SNAPSHOT {
+				retryErr := proto.NewTransactionRetryError(header.Txn)
+				retryErr.Txn.Timestamp.Forward(t.ExistingTimestamp.Next())
+				reply.Header().SetGoError(retryErr)
+				return util.RetryBreak, retryErr
+			}
 			// Update request timestamp and retry immediately.
 			header.Timestamp = t.ExistingTimestamp
 			header.Timestamp.Logical++
@@ -1027,14 +1268,32 @@ func (s *Store) ExecuteCmd(args proto.Request, reply proto.Response) error {
 	return reply.Header().GoError()
 }
 
+// addCmdWithAdmission adds the command to the range once admitted.
+// User writes are throttled or rejected while the engine is falling
+// behind on compactions. The admission slot is only held while the
+// command executes on the range, not while waiting on pushes or
+// backing off between retries.
+func (s *Store) addCmdWithAdmission(rng *Range, args proto.Request, reply proto.Response) error {
+	release, err := s.admission.admit(s.Ident.StoreID, args)
+	if err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
+	defer release()
+	return rng.AddCmd(args, reply, true)
+}
+
 // maybeResolveWriteIntentError checks the reply's error. If the error
 // is a writeIntentError, it tries to push the conflicting
 // transaction: either move its timestamp forward on a read/write
 // conflict, or abort it on a write/write conflict. If the push
 // succeeds, we immediately issue a resolve intent command and set the
 // error's Resolved flag to true so the client retries the command
-// immediately. If the push fails, we set the error's Resolved flag to
-// false so that the client backs off before reissuing the command.
+// immediately. A push which can't succeed right away waits at the range
+// holding the pushee's txn record, so a push fails only if the pusher
+// has to give up, for example because its own txn was aborted. If the
+// push fails, we set the error's Resolved flag to false so that the
+// client backs off before reissuing the command.
 func (s *Store) maybeResolveWriteIntentError(rng *Range, args proto.Request, reply proto.Response) error {
 	err := reply.Header().GoError()
 	wiErr, ok := err.(*proto.WriteIntentError)
@@ -20,9 +20,11 @@ package storage
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/hlc"
 )
 
 // FindStoreFunc finds the disks in a datacenter that have the requested
@@ -34,15 +36,19 @@ type stringSet map[string]struct{}
 // StoreFinder provides the data necessary to find stores with particular
 // attributes.
 type StoreFinder struct {
-	finderMu     sync.Mutex
-	cond         *sync.Cond
-	capacityKeys stringSet // Tracks gosisp keys used for capacity
-	gossip       *gossip.Gossip
+	finderMu      sync.Mutex
+	cond          *sync.Cond
+	capacityKeys  stringSet // Tracks gosisp keys used for capacity
+	gossip        *gossip.Gossip
+	clock         *hlc.Clock    // Used to determine node liveness; may be nil
+	timeUntilDead time.Duration // Time until the stores of a silent node are dead
 }
 
-// newStoreFinder creates a StoreFinder.
-func newStoreFinder(g *gossip.Gossip) *StoreFinder {
-	sf := &StoreFinder{gossip: g}
+// newStoreFinder creates a StoreFinder. Stores on nodes which aren't
+// live as of the clock's time are excluded; if clock is nil, all
+// nodes are considered live.
+func newStoreFinder(g *gossip.Gossip, clock *hlc.Clock, timeUntilDead time.Duration) *StoreFinder {
+	sf := &StoreFinder{gossip: g, clock: clock, timeUntilDead: timeUntilDead}
 	sf.cond = sync.NewCond(&sf.finderMu)
 	return sf
 }
@@ -76,7 +82,9 @@ func (sf *StoreFinder) WaitForNodes(n int) {
 // never returns an error.
 //
 // If it cannot retrieve a StoreDescriptor from the Store's gossip, it garbage
-// collects the failed key.
+// collects the failed key. Stores on decommissioning nodes or nodes
+// which are suspect or dead are never returned, keeping them from being
+// allocated new replicas or leases.
 //
 // TODO(embark, spencer): consider using a reverse index map from Attr->stores,
 // for efficiency.  Ensure that entries in this map still have an opportunity
@@ -91,6 +99,9 @@ func (sf *StoreFinder) findStores(required proto.Attributes) ([]*proto.StoreDesc
 			// We can no longer retrieve this key from the gossip store,
 			// perhaps it expired.
 			delete(sf.capacityKeys, key)
+		} else if IsDecommissioning(sf.gossip, storeDesc.Node.NodeID) ||
+			IsStoreDecommissioning(sf.gossip, storeDesc.StoreID) || !sf.isLive(storeDesc.Node.NodeID) {
+			continue
 		} else if required.IsSubset(storeDesc.Attrs) {
 			stores = append(stores, storeDesc)
 		}
@@ -98,6 +109,14 @@ func (sf *StoreFinder) findStores(required proto.Attributes) ([]*proto.StoreDesc
 	return stores, nil
 }
 
+// isLive returns true if the node is live.
+func (sf *StoreFinder) isLive(nodeID proto.NodeID) bool {
+	if sf.clock == nil {
+		return true
+	}
+	return nodeLiveness(sf.gossip, nodeID, sf.clock.PhysicalNow(), sf.timeUntilDead) == NodeLive
+}
+
 // storeDescFromGossip retrieves a StoreDescriptor from the specified capacity
 // gossip key. Returns an error if the gossip doesn't exist or is not
 // a StoreDescriptor.
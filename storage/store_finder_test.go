@@ -23,13 +23,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
 func TestCapacityGossipUpdate(t *testing.T) {
 	defer leaktest.AfterTest(t)
-	sf := newStoreFinder(nil)
+	sf := newStoreFinder(nil, nil, 0)
 	key := "testkey"
 
 	// Order and value of contentsChanged shouldn't matter.
@@ -122,3 +123,69 @@ func TestStoreFinderGarbageCollection(t *testing.T) {
 			s.capacityKeys)
 	}
 }
+
+// TestStoreFinderDecommissioning verifies that stores on nodes being
+// decommissioned are not returned.
+func TestStoreFinderDecommissioning(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	s.capacityKeys = stringSet{
+		"k1": struct{}{},
+		"k2": struct{}{},
+	}
+	s.gossip.AddInfo("k1", proto.StoreDescriptor{StoreID: 1, Node: proto.NodeDescriptor{NodeID: 1}}, time.Hour)
+	s.gossip.AddInfo("k2", proto.StoreDescriptor{StoreID: 2, Node: proto.NodeDescriptor{NodeID: 2}}, time.Hour)
+	if err := s.gossip.AddInfo(gossip.MakeDecommissionKey(2), true, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	stores, err := s.findStores(proto.Attributes{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stores) != 1 || stores[0].StoreID != 1 {
+		t.Errorf("expected only store 1, instead %+v", stores)
+	}
+	// The capacity key of the decommissioning store must be kept.
+	if len(s.capacityKeys) != 2 {
+		t.Errorf("expected capacity keys to be kept, instead %+v", s.capacityKeys)
+	}
+}
+
+// TestStoreFinderLiveness verifies that stores on suspect and dead
+// nodes are not returned.
+func TestStoreFinderLiveness(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, manual, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	s.capacityKeys = stringSet{
+		"k1": struct{}{},
+		"k2": struct{}{},
+		"k3": struct{}{},
+	}
+	s.gossip.AddInfo("k1", proto.StoreDescriptor{StoreID: 1, Node: proto.NodeDescriptor{NodeID: 1}}, time.Hour)
+	s.gossip.AddInfo("k2", proto.StoreDescriptor{StoreID: 2, Node: proto.NodeDescriptor{NodeID: 2}}, time.Hour)
+	s.gossip.AddInfo("k3", proto.StoreDescriptor{StoreID: 3, Node: proto.NodeDescriptor{NodeID: 3}}, time.Hour)
+
+	manual.Set(int64(time.Hour))
+	if err := GossipLiveness(s.gossip, 1, int64(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := GossipLiveness(s.gossip, 2, int64(time.Hour-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if err := GossipLiveness(s.gossip, 3, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	stores, err := s.findStores(proto.Attributes{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stores) != 1 || stores[0].StoreID != 1 {
+		t.Errorf("expected only store 1, instead %+v", stores)
+	}
+}
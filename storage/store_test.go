@@ -675,8 +675,8 @@ func TestStoreSetRangesMaxBytes(t *testing.T) {
 
 // TestStoreResolveWriteIntent adds write intent and then verifies
 // that a put returns success and aborts intent's txn in the event the
-// pushee has lower priority. Othwerise, verifies that a
-// TransactionPushError is returned.
+// pushee has lower priority. Othwerise, verifies that the put waits
+// for the pushee to commit.
 func TestStoreResolveWriteIntent(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	store, _, stopper := createTestStore(t)
@@ -705,32 +705,36 @@ func TestStoreResolveWriteIntent(t *testing.T) {
 		// Now, try a put using the pusher's txn.
 		pArgs.Timestamp = store.ctx.Clock.Now()
 		pArgs.Txn = pusher
-		err := store.ExecuteCmd(pArgs, pReply)
-		if resolvable {
-			if err != nil {
-				t.Errorf("expected intent resolved; got unexpected error: %s", err)
-			}
-			txnKey := engine.TransactionKey(pushee.Key, pushee.ID)
-			var txn proto.Transaction
-			ok, err := engine.MVCCGetProto(store.Engine(), txnKey, proto.ZeroTimestamp, true, nil, &txn)
-			if !ok || err != nil {
-				t.Fatalf("not found or err: %s", err)
-			}
-			if txn.Status != proto.ABORTED {
-				t.Errorf("expected pushee to be aborted; got %s", txn.Status)
-			}
-		} else {
-			rErr, ok := err.(*proto.TransactionPushError)
-			if !ok {
-				t.Errorf("expected txn push error; got %s", err)
-			}
-			if !bytes.Equal(rErr.PusheeTxn.ID, pushee.ID) {
-				t.Errorf("expected txn to match pushee %q; got %s", pushee.ID, rErr)
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- store.ExecuteCmd(pArgs, pReply)
+		}()
+		expStatus := proto.ABORTED
+		if !resolvable {
+			// The pusher waits for the pushee to finish.
+			select {
+			case err := <-errChan:
+				t.Fatalf("expected put to wait on pushee; got %v", err)
+			case <-time.After(50 * time.Millisecond):
 			}
-			// Trying again should fail again.
-			if err = store.ExecuteCmd(pArgs, pReply); err == nil {
-				t.Errorf("expected another error on latent write intent but succeeded")
+			etArgs, etReply := endTxnArgs(pushee, true, 1, store.StoreID())
+			etArgs.Timestamp = pushee.Timestamp
+			if err := store.ExecuteCmd(etArgs, etReply); err != nil {
+				t.Fatal(err)
 			}
+			expStatus = proto.COMMITTED
+		}
+		if err := <-errChan; err != nil {
+			t.Errorf("expected intent resolved; got unexpected error: %s", err)
+		}
+		txnKey := engine.TransactionKey(pushee.Key, pushee.ID)
+		var txn proto.Transaction
+		ok, err := engine.MVCCGetProto(store.Engine(), txnKey, proto.ZeroTimestamp, true, nil, &txn)
+		if !ok || err != nil {
+			t.Fatalf("not found or err: %s", err)
+		}
+		if txn.Status != expStatus {
+			t.Errorf("expected pushee to be %s; got %s", expStatus, txn.Status)
 		}
 	}
 }
@@ -928,6 +932,49 @@ func TestStoreResolveWriteIntentSnapshotIsolation(t *testing.T) {
 	}
 }
 
+// TestStoreWriteTooOldSnapshotIsolation verifies that a SNAPSHOT txn
+// writing to a key with a value committed after its original timestamp
+// gets a txn retry error with a timestamp past the committed value,
+// whereas a SERIALIZABLE txn's write is retried at a later timestamp.
+func TestStoreWriteTooOldSnapshotIsolation(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	for i, iso := range []proto.IsolationType{proto.SERIALIZABLE, proto.SNAPSHOT} {
+		key := proto.Key(fmt.Sprintf("key-%d", i))
+		txn := newTransaction("test", key, 1, iso, store.ctx.Clock)
+
+		// Write a value after the txn has started.
+		args, reply := putArgs(key, []byte("value1"), 1, store.StoreID())
+		args.Timestamp = store.ctx.Clock.Now()
+		if err := store.ExecuteCmd(args, reply); err != nil {
+			t.Fatal(err)
+		}
+		committedTS := args.Timestamp
+
+		// Now write using the txn.
+		args, reply = putArgs(key, []byte("value2"), 1, store.StoreID())
+		args.Timestamp = txn.Timestamp
+		args.Txn = txn
+		err := store.ExecuteCmd(args, reply)
+		if iso == proto.SNAPSHOT {
+			if rErr, ok := err.(*proto.TransactionRetryError); !ok {
+				t.Errorf("%d: expected txn retry error; got %v", i, err)
+			} else if !committedTS.Less(rErr.Txn.Timestamp) {
+				t.Errorf("%d: expected retry timestamp > %s; got %s", i, committedTS, rErr.Txn.Timestamp)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+		if !committedTS.Less(reply.Timestamp) {
+			t.Errorf("%d: expected write timestamp > %s; got %s", i, committedTS, reply.Timestamp)
+		}
+	}
+}
+
 // TestStoreResolveWriteIntentNoTxn verifies that reads and writes
 // which are not part of a transaction can push intents.
 func TestStoreResolveWriteIntentNoTxn(t *testing.T) {
@@ -34,9 +34,9 @@ func NewDB(kv *client.KV) *DB {
 	}
 }
 
-// storeData attempts to store the supplied time series data on the server.
+// StoreData attempts to store the supplied time series data on the server.
 // Data will be sampled at the supplied resolution.
-func (db *DB) storeData(r Resolution, data proto.TimeSeriesData) error {
+func (db *DB) StoreData(r Resolution, data proto.TimeSeriesData) error {
 	internalData, err := data.ToInternal(r.KeyDuration(), r.SampleDuration())
 	if err != nil {
 		return err
@@ -120,9 +120,16 @@ func (s *Stopper) FinishTask() {
 
 // Stop signals all live workers to stop and then waits for each to
 // confirm it has stopped (workers do this by calling SetStopped()).
+// Subsequent calls wait for the first to complete and are otherwise
+// noops.
 func (s *Stopper) Stop() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	select {
+	case <-s.stopped:
+		return
+	default:
+	}
 	atomic.StoreInt32(&s.draining, 1)
 	s.drain.Wait()
 	close(s.stopper)
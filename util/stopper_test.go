@@ -179,3 +179,20 @@ func TestStopperClosers(t *testing.T) {
 		t.Errorf("expected true & true; got %t & %t", tc1, tc2)
 	}
 }
+
+type countingCloser int
+
+func (cc *countingCloser) Close() {
+	*cc++
+}
+
+func TestStopperStopTwice(t *testing.T) {
+	s := NewStopper()
+	var cc countingCloser
+	s.AddCloser(&cc)
+	s.Stop()
+	s.Stop()
+	if cc != 1 {
+		t.Errorf("expected closer to be closed once; got %d", cc)
+	}
+}